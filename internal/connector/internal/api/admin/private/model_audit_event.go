@@ -0,0 +1,30 @@
+/*
+ * Connector Service Fleet Manager Admin APIs
+ *
+ * Connector Service Fleet Manager Admin is a Rest API to manage connector clusters.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// AuditEvent struct for AuditEvent
+type AuditEvent struct {
+	Id             string                 `json:"id"`
+	Kind           string                 `json:"kind"`
+	CreatedAt      time.Time              `json:"created_at"`
+	Actor          string                 `json:"actor,omitempty"`
+	OrganisationId string                 `json:"organisation_id,omitempty"`
+	Action         string                 `json:"action"`
+	ResourceId     string                 `json:"resource_id,omitempty"`
+	Method         string                 `json:"method"`
+	RequestPath    string                 `json:"request_path"`
+	RequestDiff    map[string]interface{} `json:"request_diff,omitempty"`
+	StatusCode     int32                  `json:"status_code"`
+	OperationId    string                 `json:"operation_id,omitempty"`
+}
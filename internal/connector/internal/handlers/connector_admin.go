@@ -23,6 +23,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	coreservices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"github.com/gorilla/mux"
 )
 
@@ -36,6 +37,7 @@ type ConnectorAdminHandler struct {
 	QuotaConfig           *config.ConnectorsQuotaConfig
 	ConnectorCluster      *ConnectorClusterHandler //TODO: eventually move deployment handling into a deployment service
 	ConnectorTypesService services.ConnectorTypesService
+	AuditEventService     audit.AuditEventService
 }
 
 type operator struct {
@@ -653,6 +655,35 @@ func (h *ConnectorAdminHandler) PatchConnectorDeployment(writer http.ResponseWri
 	handlers.Handle(writer, request, &cfg, http.StatusAccepted)
 }
 
+func (h *ConnectorAdminHandler) ListAuditEvents(writer http.ResponseWriter, request *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+
+			listArgs := coreservices.NewListArguments(request.URL.Query())
+			auditEvents, paging, err := h.AuditEventService.List(listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			result := private.AuditEventList{
+				Kind:  "AuditEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+			}
+
+			result.Items = make([]private.AuditEvent, len(auditEvents))
+			for i, auditEvent := range auditEvents {
+				result.Items[i] = presenters.PresentAuditEvent(auditEvent)
+			}
+
+			return result, nil
+		},
+	}
+
+	handlers.HandleList(writer, request, cfg)
+}
+
 func (h *ConnectorAdminHandler) isEvalOrg(id string) bool {
 	for _, eid := range h.ConnectorsConfig.ConnectorEvalOrganizations {
 		if id == eid {
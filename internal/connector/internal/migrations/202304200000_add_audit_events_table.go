@@ -0,0 +1,39 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAuditEventsTable(migrationId string) *gormigrate.Migration {
+
+	type AuditEvent struct {
+		db.Model
+		Actor          string `gorm:"index"`
+		OrganisationId string `gorm:"index"`
+		Action         string `gorm:"index"`
+		ResourceId     string `gorm:"index"`
+		Method         string
+		RequestPath    string
+		RequestDiff    api.JSON
+		StatusCode     int
+		OperationId    string
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to delete the audit events table on rollback because it's shared with the kas-fleet-manager
+			// so we just create it here if it does not exist yet.. but we don't drop it on rollback.
+			return tx.Migrator().AutoMigrate(&AuditEvent{})
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
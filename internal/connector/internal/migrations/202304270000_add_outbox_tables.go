@@ -0,0 +1,66 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addOutboxTables(migrationId string) *gormigrate.Migration {
+
+	type LeaderLease struct {
+		db.Model
+		Leader    string
+		LeaseType string
+		Expires   *time.Time
+	}
+
+	type OutboxEvent struct {
+		Sequence        int64  `gorm:"primaryKey;type:bigserial"`
+		EventType       string `gorm:"index"`
+		ResourceId      string `gorm:"index"`
+		ResourceVersion int64
+		Payload         api.JSON  `gorm:"type:jsonb"`
+		CreatedAt       time.Time `gorm:"index"`
+	}
+
+	type OutboxCursor struct {
+		Consumer  string `gorm:"primaryKey"`
+		Sequence  int64
+		UpdatedAt time.Time
+	}
+
+	leaderLeaseType := "outbox_pruning"
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to delete the outbox tables and lease on rollback because they're shared with the kas-fleet-manager
+			// so we just create them here if they do not exist yet.. but we don't drop them on rollback.
+			if err := tx.Migrator().AutoMigrate(&LeaderLease{}, &OutboxEvent{}, &OutboxCursor{}); err != nil {
+				return err
+			}
+			var count int64
+			if err := tx.Model(&LeaderLease{}).Where("lease_type = ?", leaderLeaseType).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+			now := time.Now().Add(-time.Minute) //set to a expired time
+			return tx.Create(&api.LeaderLease{
+				Expires:   &now,
+				LeaseType: leaderLeaseType,
+			}).Error
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,38 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAccessControlListTable(migrationId string) *gormigrate.Migration {
+	type AccessControlListEntry struct {
+		db.Model
+		Type      string `gorm:"index"`
+		Value     string
+		Reason    string
+		CreatedBy string
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to delete the access control list table on rollback because it's shared with the kas-fleet-manager
+			// so we just create it here if it does not exist yet.. but we don't drop it on rollback.
+			if err := tx.Migrator().AutoMigrate(&AccessControlListEntry{}); err != nil {
+				return err
+			}
+			return tx.Exec(`
+				CREATE UNIQUE INDEX IF NOT EXISTS uix_access_control_list_entries_type_value
+				ON access_control_list_entries (type, value) WHERE deleted_at IS NULL
+			`).Error
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
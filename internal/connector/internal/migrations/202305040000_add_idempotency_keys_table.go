@@ -0,0 +1,71 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addIdempotencyKeysTable(migrationId string) *gormigrate.Migration {
+
+	type LeaderLease struct {
+		db.Model
+		Leader    string
+		LeaseType string
+		Expires   *time.Time
+	}
+
+	type IdempotencyKey struct {
+		ID             string `gorm:"primaryKey"`
+		Key            string
+		Owner          string
+		OrganisationId string
+		Route          string
+		RequestHash    string
+		StatusCode     int
+		ResourceId     string
+		ResponseBody   []byte
+		CreatedAt      time.Time
+		ExpiresAt      time.Time `gorm:"index"`
+	}
+
+	leaderLeaseType := "idempotency_key_pruning"
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to delete the idempotency keys table and lease on rollback because they're shared with the kas-fleet-manager
+			// so we just create them here if they do not exist yet.. but we don't drop them on rollback.
+			if err := tx.Migrator().AutoMigrate(&LeaderLease{}, &IdempotencyKey{}); err != nil {
+				return err
+			}
+			if err := tx.Exec(`
+				CREATE UNIQUE INDEX IF NOT EXISTS uix_idempotency_keys_owner_route_key
+				ON idempotency_keys (owner, route, key)
+			`).Error; err != nil {
+				return err
+			}
+			var count int64
+			if err := tx.Model(&LeaderLease{}).Where("lease_type = ?", leaderLeaseType).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+			now := time.Now().Add(-time.Minute) //set to a expired time
+			return tx.Create(&api.LeaderLease{
+				Expires:   &now,
+				LeaseType: leaderLeaseType,
+			}).Error
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
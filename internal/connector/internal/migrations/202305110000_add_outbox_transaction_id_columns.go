@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addOutboxTransactionIdColumns(migrationId string) *gormigrate.Migration {
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to drop the columns on rollback because the outbox tables are shared with the kas-fleet-manager
+			// so we just add them here if they do not exist yet.. but we don't drop them on rollback.
+			for _, statement := range []string{
+				`ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS transaction_id bigint NOT NULL DEFAULT 0`,
+				`ALTER TABLE outbox_events ALTER COLUMN transaction_id SET DEFAULT txid_current()`,
+				`CREATE INDEX IF NOT EXISTS idx_outbox_events_transaction_id_sequence ON outbox_events (transaction_id, sequence)`,
+				`ALTER TABLE outbox_cursors ADD COLUMN IF NOT EXISTS transaction_id bigint NOT NULL DEFAULT 0`,
+			} {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
@@ -50,6 +50,13 @@ var migrations = []*gormigrate.Migration{
 	renameNamespaceProfileAnnotations("202211280000"),
 	addOrgIDAnnotations("202212050000"),
 	addConnectorTypeDeprecated("202301180000"),
+	addAuditEventsTable("202304200000"),
+	addWebhookTables("202304240000"),
+	addOutboxTables("202304270000"),
+	addAccessControlListTable("202305020000"),
+	addRateLimitTables("202305030000"),
+	addIdempotencyKeysTable("202305040000"),
+	addOutboxTransactionIdColumns("202305110000"),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
@@ -0,0 +1,29 @@
+package presenters
+
+import (
+	"encoding/json"
+
+	admin "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func PresentAuditEvent(auditEvent *api.AuditEvent) admin.AuditEvent {
+	result := admin.AuditEvent{
+		Id:             auditEvent.ID,
+		Kind:           "AuditEvent",
+		CreatedAt:      auditEvent.CreatedAt,
+		Actor:          auditEvent.Actor,
+		OrganisationId: auditEvent.OrganisationId,
+		Action:         auditEvent.Action,
+		ResourceId:     auditEvent.ResourceId,
+		Method:         auditEvent.Method,
+		RequestPath:    auditEvent.RequestPath,
+		StatusCode:     int32(auditEvent.StatusCode),
+		OperationId:    auditEvent.OperationId,
+	}
+	if len(auditEvent.RequestDiff) > 0 {
+		// request bodies that are not JSON objects are not presented
+		_ = json.Unmarshal(auditEvent.RequestDiff, &result.RequestDiff)
+	}
+	return result
+}
@@ -3,6 +3,9 @@ package routes
 import (
 	"net/http"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/ratelimit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
@@ -14,6 +17,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	kerrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	coreHandlers "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/goava/di"
@@ -39,6 +43,10 @@ type options struct {
 	ConnectorNamespaceHandler *handlers.ConnectorNamespaceHandler
 	DB                        *db.ConnectionFactory
 	AdminRoleAuthZConfig      *auth.AdminRoleAuthZConfig
+	AuditEventService         audit.AuditEventService
+	IdempotencyKeyService     idempotency.IdempotencyKeyService
+	RateLimitConfig           *ratelimit.RateLimitConfig
+	RateLimitService          ratelimit.RateLimitService
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -49,6 +57,12 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 
 	authorizeMiddleware := s.AuthorizeMiddleware.Authorize
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(kerrors.ErrorUnauthenticated)
+	auditEventMiddleware := auth.NewAuditEventMiddleware(s.AuditEventService)
+	recordMutations := auditEventMiddleware.RecordMutations
+	// the connector specs carry secrets whose field names are defined by the connector types
+	recordMutationFieldNames := auditEventMiddleware.RecordMutationFieldNames
+	idempotent := auth.NewIdempotencyMiddleware(s.IdempotencyKeyService).Idempotent
+	rateLimit := auth.NewRateLimitMiddleware(s.RateLimitConfig, s.RateLimitService).RateLimit
 
 	openAPIDefinitions, err := shared.LoadOpenAPISpecFromYAML(openapicontents.ConnectorMgmtOpenAPIYAMLBytes())
 	if err != nil {
@@ -60,6 +74,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 
 	//  /api/connector_mgmt/v1
 	apiV1Router := apiRouter.PathPrefix("/v1").Subrouter()
+	apiV1Router.Use(rateLimit)
 
 	//  /api/connector_mgmt/v1/openapi
 	apiV1Router.HandleFunc("/openapi", coreHandlers.NewOpenAPIHandler(openAPIDefinitions).Get).Methods(http.MethodGet)
@@ -90,13 +105,24 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	})
 
 	apiV1ConnectorsRouter := apiV1Router.PathPrefix("/kafka_connectors").Subrouter()
-	apiV1ConnectorsRouter.HandleFunc("", s.ConnectorsHandler.Create).Methods(http.MethodPost)
-	apiV1ConnectorsRouter.HandleFunc("", s.ConnectorsHandler.List).Methods(http.MethodGet)
-	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Get).Methods(http.MethodGet)
-	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Patch).Methods(http.MethodPatch)
-	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Delete).Methods(http.MethodDelete)
+	apiV1ConnectorsRouter.Handle("", idempotent(http.HandlerFunc(s.ConnectorsHandler.Create))).
+		Name(logger.NewLogEvent("create-connector", "create a connector").ToString()).
+		Methods(http.MethodPost)
+	apiV1ConnectorsRouter.HandleFunc("", s.ConnectorsHandler.List).
+		Name(logger.NewLogEvent("list-connector", "list all connectors").ToString()).
+		Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Get).
+		Name(logger.NewLogEvent("get-connector", "get a connector").ToString()).
+		Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Patch).
+		Name(logger.NewLogEvent("update-connector", "update a connector").ToString()).
+		Methods(http.MethodPatch)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Delete).
+		Name(logger.NewLogEvent("delete-connector", "delete a connector").ToString()).
+		Methods(http.MethodDelete)
 	apiV1ConnectorsRouter.Use(authorizeMiddleware)
 	apiV1ConnectorsRouter.Use(requireOrgID)
+	apiV1ConnectorsRouter.Use(recordMutationFieldNames)
 
 	//  /api/connector_mgmt/v1/kafka_connector_clusters
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -105,15 +131,26 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	})
 
 	apiV1ConnectorClustersRouter := apiV1Router.PathPrefix("/kafka_connector_clusters").Subrouter()
-	apiV1ConnectorClustersRouter.HandleFunc("", s.ConnectorClusterHandler.Create).Methods(http.MethodPost)
-	apiV1ConnectorClustersRouter.HandleFunc("", s.ConnectorClusterHandler.List).Methods(http.MethodGet)
-	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}", s.ConnectorClusterHandler.Get).Methods(http.MethodGet)
-	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}", s.ConnectorClusterHandler.Update).Methods(http.MethodPut)
-	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}", s.ConnectorClusterHandler.Delete).Methods(http.MethodDelete)
+	apiV1ConnectorClustersRouter.Handle("", idempotent(http.HandlerFunc(s.ConnectorClusterHandler.Create))).
+		Name(logger.NewLogEvent("create-connector-cluster", "create a connector cluster").ToString()).
+		Methods(http.MethodPost)
+	apiV1ConnectorClustersRouter.HandleFunc("", s.ConnectorClusterHandler.List).
+		Name(logger.NewLogEvent("list-connector-cluster", "list all connector clusters").ToString()).
+		Methods(http.MethodGet)
+	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}", s.ConnectorClusterHandler.Get).
+		Name(logger.NewLogEvent("get-connector-cluster", "get a connector cluster").ToString()).
+		Methods(http.MethodGet)
+	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}", s.ConnectorClusterHandler.Update).
+		Name(logger.NewLogEvent("update-connector-cluster", "update a connector cluster").ToString()).
+		Methods(http.MethodPut)
+	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}", s.ConnectorClusterHandler.Delete).
+		Name(logger.NewLogEvent("delete-connector-cluster", "delete a connector cluster").ToString()).
+		Methods(http.MethodDelete)
 	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}/addon_parameters", s.ConnectorClusterHandler.GetAddonParameters).Methods(http.MethodGet)
 	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}/namespaces", s.ConnectorClusterHandler.GetNamespaces).Methods(http.MethodGet)
 	apiV1ConnectorClustersRouter.Use(authorizeMiddleware)
 	apiV1ConnectorClustersRouter.Use(requireOrgID)
+	apiV1ConnectorClustersRouter.Use(recordMutations)
 
 	//  /api/connector_mgmt/v1/kafka_connector_namespaces
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -122,13 +159,25 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	})
 
 	apiV1ConnectorNamespacesRouter := apiV1Router.PathPrefix("/kafka_connector_namespaces").Subrouter()
-	apiV1ConnectorNamespacesRouter.HandleFunc("", s.ConnectorNamespaceHandler.List).Methods(http.MethodGet)
-	apiV1ConnectorNamespacesRouter.HandleFunc("/eval", s.ConnectorNamespaceHandler.CreateEvaluation).Methods(http.MethodPost)
-	apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", s.ConnectorNamespaceHandler.Get).Methods(http.MethodGet)
+	apiV1ConnectorNamespacesRouter.HandleFunc("", s.ConnectorNamespaceHandler.List).
+		Name(logger.NewLogEvent("list-connector-namespace", "list all connector namespaces").ToString()).
+		Methods(http.MethodGet)
+	apiV1ConnectorNamespacesRouter.HandleFunc("/eval", s.ConnectorNamespaceHandler.CreateEvaluation).
+		Name(logger.NewLogEvent("create-connector-namespace-evaluation", "create an evaluation connector namespace").ToString()).
+		Methods(http.MethodPost)
+	apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", s.ConnectorNamespaceHandler.Get).
+		Name(logger.NewLogEvent("get-connector-namespace", "get a connector namespace").ToString()).
+		Methods(http.MethodGet)
 	if s.ConnectorsConfig.ConnectorNamespaceLifecycleAPI {
-		apiV1ConnectorNamespacesRouter.HandleFunc("", s.ConnectorNamespaceHandler.Create).Methods(http.MethodPost)
-		apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", s.ConnectorNamespaceHandler.Update).Methods(http.MethodPatch)
-		apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", s.ConnectorNamespaceHandler.Delete).Methods(http.MethodDelete)
+		apiV1ConnectorNamespacesRouter.Handle("", idempotent(http.HandlerFunc(s.ConnectorNamespaceHandler.Create))).
+			Name(logger.NewLogEvent("create-connector-namespace", "create a connector namespace").ToString()).
+			Methods(http.MethodPost)
+		apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", s.ConnectorNamespaceHandler.Update).
+			Name(logger.NewLogEvent("update-connector-namespace", "update a connector namespace").ToString()).
+			Methods(http.MethodPatch)
+		apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", s.ConnectorNamespaceHandler.Delete).
+			Name(logger.NewLogEvent("delete-connector-namespace", "delete a connector namespace").ToString()).
+			Methods(http.MethodDelete)
 	} else {
 		apiV1ConnectorNamespacesRouter.HandleFunc("", api.SendMethodNotAllowed).Methods(http.MethodPost)
 		apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", api.SendMethodNotAllowed).Methods(http.MethodPatch)
@@ -136,6 +185,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	}
 	apiV1ConnectorNamespacesRouter.Use(authorizeMiddleware)
 	apiV1ConnectorNamespacesRouter.Use(requireOrgID)
+	apiV1ConnectorNamespacesRouter.Use(recordMutations)
 
 	// This section adds the API's accessed by the connector agent...
 	{
@@ -156,6 +206,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.KeycloakService.GetConfig().AdminAPISSORealm.ValidIssuerURI}, kerrors.ErrorNotFound))
 	adminRouter.Use(auth.NewRolesAuthzMiddleware(s.AdminRoleAuthZConfig).RequireRolesForMethods(kerrors.ErrorNotFound))
 	adminRouter.Use(auth.NewAuditLogMiddleware().AuditLog(kerrors.ErrorNotFound))
+	adminRouter.Use(recordMutationFieldNames)
 	adminRouter.HandleFunc("/kafka_connector_clusters", s.ConnectorAdminHandler.ListConnectorClusters).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connector_clusters/{connector_cluster_id}", s.ConnectorAdminHandler.GetConnectorCluster).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connector_clusters/{connector_cluster_id}/namespaces", s.ConnectorAdminHandler.GetClusterNamespaces).Methods(http.MethodGet)
@@ -177,6 +228,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	adminRouter.HandleFunc("/kafka_connectors/{connector_id}", s.ConnectorAdminHandler.PatchConnector).Methods(http.MethodPatch)
 	adminRouter.HandleFunc("/kafka_connector_types", s.ConnectorAdminHandler.ListConnectorTypes).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connector_types/{connector_type_id}", s.ConnectorAdminHandler.GetConnectorType).Methods(http.MethodGet)
+	adminRouter.HandleFunc("/audit_events", s.ConnectorAdminHandler.ListAuditEvents).Methods(http.MethodGet)
 
 	v1Metadata := api.VersionMetadata{
 		ID:          "v1",
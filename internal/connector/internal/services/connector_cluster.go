@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -58,11 +59,12 @@ type connectorClusterService struct {
 	keycloakService           sso.KafkaKeycloakService
 	connectorsService         ConnectorsService
 	connectorNamespaceService ConnectorNamespaceService
+	outbox                    signalbus.Outbox
 }
 
 func NewConnectorClusterService(connectionFactory *db.ConnectionFactory, bus signalbus.SignalBus, vaultService vault.VaultService,
 	connectorTypesService ConnectorTypesService, connectorsService ConnectorsService,
-	keycloakService sso.KafkaKeycloakService, connectorNamespaceService ConnectorNamespaceService) *connectorClusterService {
+	keycloakService sso.KafkaKeycloakService, connectorNamespaceService ConnectorNamespaceService, outbox signalbus.Outbox) *connectorClusterService {
 	return &connectorClusterService{
 		connectionFactory:         connectionFactory,
 		bus:                       bus,
@@ -71,6 +73,7 @@ func NewConnectorClusterService(connectionFactory *db.ConnectionFactory, bus sig
 		connectorsService:         connectorsService,
 		keycloakService:           keycloakService,
 		connectorNamespaceService: connectorNamespaceService,
+		outbox:                    outbox,
 	}
 }
 
@@ -505,40 +508,87 @@ func (k *connectorClusterService) UpdateConnectorDeploymentStatus(ctx context.Co
 		return services.HandleGoneError("Connector deployment", "id", deploymentStatus.ID)
 	}
 
-	if err := dbConn.Model(&deploymentStatus).Where("id = ? and version <= ?", deploymentStatus.ID, deploymentStatus.Version).Save(&deploymentStatus).Error; err != nil {
-		return errors.Conflict("failed to update deployment status: %s, probably a stale deployment status version was used: %d", err.Error(), deploymentStatus.Version)
-	}
-
 	connector := dbapi.Connector{}
-	if err := dbConn.Select("desired_state").
-		Where("id = ?", deployment.ConnectorID).
-		First(&connector).Error; err != nil {
-		return services.HandleGetError("Connector", "id", deployment.ConnectorID, err)
-	}
-
 	connectorStatus := dbapi.ConnectorStatus{}
-	if err := dbConn.Select("phase").
-		Where("id = ?", deployment.ConnectorID).
-		First(&connectorStatus).Error; err != nil {
-		return services.HandleGetError("Connector", "id", deployment.ConnectorID, err)
-	}
+	var previousPhase dbapi.ConnectorStatusPhase
+	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		if err := dbConn.Model(&deploymentStatus).Where("id = ? and version <= ?", deploymentStatus.ID, deploymentStatus.Version).Save(&deploymentStatus).Error; err != nil {
+			return errors.Conflict("failed to update deployment status: %s, probably a stale deployment status version was used: %d", err.Error(), deploymentStatus.Version)
+		}
 
-	connectorStatus.Phase = deploymentStatus.Phase
-	if deploymentStatus.Phase == dbapi.ConnectorStatusPhaseDeleted {
-		// we don't need the deployment anymore...
-		if err := deleteConnectorDeployment(dbConn, deploymentStatus.ID); err != nil {
-			return err
+		if err := dbConn.Select("id", "name", "organisation_id", "namespace_id", "desired_state").
+			Where("id = ?", deployment.ConnectorID).
+			First(&connector).Error; err != nil {
+			return services.HandleGetError("Connector", "id", deployment.ConnectorID, err)
+		}
+
+		if err := dbConn.Select("phase").
+			Where("id = ?", deployment.ConnectorID).
+			First(&connectorStatus).Error; err != nil {
+			return services.HandleGetError("Connector", "id", deployment.ConnectorID, err)
 		}
-	}
 
-	// update the connector status
-	if err := dbConn.Where("id = ?", deployment.ConnectorID).Updates(&connectorStatus).Error; err != nil {
-		return services.HandleUpdateError("Connector status", err)
+		previousPhase = connectorStatus.Phase
+		connectorStatus.Phase = deploymentStatus.Phase
+		if deploymentStatus.Phase == dbapi.ConnectorStatusPhaseDeleted {
+			// we don't need the deployment anymore...
+			if err := deleteConnectorDeployment(dbConn, deploymentStatus.ID); err != nil {
+				return err
+			}
+		}
+
+		// update the connector status
+		if err := dbConn.Where("id = ?", deployment.ConnectorID).Updates(&connectorStatus).Error; err != nil {
+			return services.HandleUpdateError("Connector status", err)
+		}
+
+		if previousPhase != connectorStatus.Phase {
+			if err := k.publishConnectorPhaseChanged(dbConn, &connector, previousPhase, connectorStatus.Phase, deploymentStatus.Version); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.ToServiceError(err)
 	}
 
 	return nil
 }
 
+// ConnectorPhaseChangedSignal is the type of the outbox events recorded every time the phase of a connector changes,
+// and so the name of the signal that is notified on the signal bus once the change is committed.
+// The resource version of the events is the version of the deployment status that changed the phase.
+const ConnectorPhaseChangedSignal = "connector.phase_changed"
+
+// ConnectorPhaseChangedPayload is the payload of the connector.phase_changed outbox events
+type ConnectorPhaseChangedPayload struct {
+	OrganisationId string  `json:"organisation_id"`
+	Name           string  `json:"name"`
+	NamespaceId    *string `json:"namespace_id,omitempty"`
+	PreviousPhase  string  `json:"previous_phase"`
+	Phase          string  `json:"phase"`
+}
+
+// publishConnectorPhaseChanged records the phase change of the connector in the outbox, using the transaction of the change
+func (k *connectorClusterService) publishConnectorPhaseChanged(dbConn *gorm.DB, connector *dbapi.Connector, previousPhase dbapi.ConnectorStatusPhase, newPhase dbapi.ConnectorStatusPhase, version int64) *errors.ServiceError {
+	payload, err := json.Marshal(ConnectorPhaseChangedPayload{
+		OrganisationId: connector.OrganisationId,
+		Name:           connector.Name,
+		NamespaceId:    connector.NamespaceId,
+		PreviousPhase:  string(previousPhase),
+		Phase:          string(newPhase),
+	})
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to marshal the phase change of connector %q", connector.ID)
+	}
+	return k.outbox.Publish(dbConn, &api.OutboxEvent{
+		EventType:       ConnectorPhaseChangedSignal,
+		ResourceId:      connector.ID,
+		ResourceVersion: version,
+		Payload:         payload,
+	})
+}
+
 func (k *connectorClusterService) FindAvailableNamespace(owner string, orgID string, namespaceID *string) (*dbapi.ConnectorNamespace, *errors.ServiceError) {
 	dbConn := k.connectionFactory.New()
 	var namespaces dbapi.ConnectorNamespaceList
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"encoding/json"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
+)
+
+// connectorPhaseEventTypes maps the connector phases the webhook subscriptions can be notified of to their event type
+var connectorPhaseEventTypes = map[dbapi.ConnectorStatusPhase]string{
+	dbapi.ConnectorStatusPhaseReady:   webhook.EventTypeConnectorReady,
+	dbapi.ConnectorStatusPhaseFailed:  webhook.EventTypeConnectorFailed,
+	dbapi.ConnectorStatusPhaseStopped: webhook.EventTypeConnectorStopped,
+}
+
+// ConnectorEventData is the content of the "data" field of the connector webhook events
+type ConnectorEventData struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	NamespaceId *string `json:"namespace_id,omitempty"`
+	Phase       string  `json:"phase"`
+}
+
+var _ webhook.OutboxEventConverter = &connectorOutboxEventConverter{}
+
+// connectorOutboxEventConverter converts the phase changes of the connectors recorded in the outbox into the connector webhook events
+type connectorOutboxEventConverter struct{}
+
+func NewConnectorOutboxEventConverter() *connectorOutboxEventConverter {
+	return &connectorOutboxEventConverter{}
+}
+
+func (c *connectorOutboxEventConverter) GetOutboxEventTypes() []string {
+	return []string{ConnectorPhaseChangedSignal}
+}
+
+func (c *connectorOutboxEventConverter) Convert(event *api.OutboxEvent) (*webhook.Event, *errors.ServiceError) {
+	var payload ConnectorPhaseChangedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to unmarshal the payload of outbox event %d", event.Sequence)
+	}
+	eventType, ok := connectorPhaseEventTypes[dbapi.ConnectorStatusPhase(payload.Phase)]
+	if !ok {
+		return nil, nil
+	}
+	return &webhook.Event{
+		Type:           eventType,
+		OrganisationId: payload.OrganisationId,
+		ResourceId:     event.ResourceId,
+		Data: ConnectorEventData{
+			Id:          event.ResourceId,
+			Name:        payload.Name,
+			NamespaceId: payload.NamespaceId,
+			Phase:       payload.Phase,
+		},
+	}, nil
+}
@@ -21,7 +21,7 @@ type EnterpriseClustersAccessControlMiddleware struct {
 
 func NewEnterpriseClustersAccessControlMiddleware(kafkaConfig *config.KafkaConfig, quotaServiceFactory services.QuotaServiceFactory) *EnterpriseClustersAccessControlMiddleware {
 	middleware := &EnterpriseClustersAccessControlMiddleware{}
-	standardInstanceTypeConfig, err := kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(types.STANDARD.String())
+	standardInstanceTypeConfig, err := kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(types.STANDARD.String())
 	if err != nil {
 		logger.Logger.Error(err)
 	}
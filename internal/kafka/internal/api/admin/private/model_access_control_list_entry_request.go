@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// AccessControlListEntryRequest Schema for the request to add an entry to the access control lists
+type AccessControlListEntryRequest struct {
+	// Accepted values: [denied_user, accepted_organisation]
+	Type string `json:"type"`
+	// The username of the denied user or the id of the accepted organisation
+	Value  string `json:"value"`
+	Reason string `json:"reason,omitempty"`
+}
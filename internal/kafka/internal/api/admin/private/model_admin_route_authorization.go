@@ -0,0 +1,22 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// AdminRouteAuthorization The roles allowed to send requests to an admin API route
+type AdminRouteAuthorization struct {
+	Kind   string `json:"kind"`
+	Route  string `json:"route"`
+	Method string `json:"method"`
+	// Values: [route, method]
+	GrantedBy          string            `json:"granted_by"`
+	ResourceAttributes map[string]string `json:"resource_attributes,omitempty"`
+	Roles              []string          `json:"roles"`
+}
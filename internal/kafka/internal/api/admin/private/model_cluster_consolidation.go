@@ -0,0 +1,25 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterConsolidation struct for ClusterConsolidation
+type ClusterConsolidation struct {
+	ClusterId              string `json:"cluster_id"`
+	CloudProvider          string `json:"cloud_provider"`
+	Region                 string `json:"region"`
+	ConsumedStreamingUnits int32  `json:"consumed_streaming_units"`
+	MaxStreamingUnits      int32  `json:"max_streaming_units"`
+	// Whether all the kafkas of the cluster can be moved to the other clusters of its region
+	CanBeEmptied bool                       `json:"can_be_emptied"`
+	Moves        []ClusterConsolidationMove `json:"moves"`
+	// Why the cluster cannot be emptied
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterConsolidationPlan struct for ClusterConsolidationPlan
+type ClusterConsolidationPlan struct {
+	Kind string `json:"kind"`
+	// Whether the planned kafka moves are started by the fleet manager
+	ConsolidationEnabled bool `json:"consolidation_enabled"`
+	// The percentage of its streaming units capacity up to which a cluster is underutilised
+	MaxUtilisationPercentage int32                  `json:"max_utilisation_percentage"`
+	Items                    []ClusterConsolidation `json:"items"`
+}
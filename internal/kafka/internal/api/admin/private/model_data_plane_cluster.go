@@ -0,0 +1,38 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// DataPlaneCluster struct for DataPlaneCluster
+type DataPlaneCluster struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// Values: [cluster_accepted, cluster_provisioning, cluster_provisioned, waiting_for_kas_fleetshard_operator, ready, failed, deprovisioning, cleanup]
+	Status        string `json:"status,omitempty"`
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	Region        string `json:"region,omitempty"`
+	MultiAz       bool   `json:"multi_az"`
+	// Values: [managed, enterprise]
+	ClusterType string `json:"cluster_type,omitempty"`
+	// Whether the data plane cluster is cordoned. No new Kafka instance is placed on a cordoned data plane cluster
+	Cordoned  bool      `json:"cordoned"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The capacity of the data plane cluster for each instance type it supports
+	Capacity                 []DataPlaneClusterCapacity       `json:"capacity,omitempty"`
+	AvailableStrimziVersions []DataPlaneClusterStrimziVersion `json:"available_strimzi_versions,omitempty"`
+	// The kafkas placed on the data plane cluster. Only returned when a single data plane cluster is retrieved
+	Kafkas []DataPlaneClusterKafka `json:"kafkas,omitempty"`
+}
@@ -0,0 +1,24 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// DataPlaneClusterCapacity struct for DataPlaneClusterCapacity
+type DataPlaneClusterCapacity struct {
+	InstanceType string `json:"instance_type"`
+	// The maximum number of worker nodes of the machine pool of the instance type
+	MaxNodes int32 `json:"max_nodes"`
+	// The maximum number of streaming units fitting in the machine pool of the instance type
+	MaxUnits int32 `json:"max_units"`
+	// The number of streaming units left in the machine pool of the instance type, as reported by the kas-fleetshard operator
+	RemainingUnits int32 `json:"remaining_units"`
+	// The number of streaming units consumed by the kafkas of the instance type placed on the cluster
+	ConsumedStreamingUnits int64 `json:"consumed_streaming_units"`
+}
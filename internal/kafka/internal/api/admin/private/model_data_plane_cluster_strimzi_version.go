@@ -0,0 +1,19 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// DataPlaneClusterStrimziVersion struct for DataPlaneClusterStrimziVersion
+type DataPlaneClusterStrimziVersion struct {
+	Version          string   `json:"version"`
+	Ready            bool     `json:"ready"`
+	KafkaVersions    []string `json:"kafka_versions"`
+	KafkaIbpVersions []string `json:"kafka_ibp_versions"`
+}
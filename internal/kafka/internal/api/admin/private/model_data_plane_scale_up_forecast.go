@@ -0,0 +1,28 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// DataPlaneScaleUpForecast struct for DataPlaneScaleUpForecast
+type DataPlaneScaleUpForecast struct {
+	CloudProvider                  string `json:"cloud_provider"`
+	Region                         string `json:"region"`
+	InstanceType                   string `json:"instance_type"`
+	FreeStreamingUnits             int32  `json:"free_streaming_units"`
+	HistoricalDemandStreamingUnits int32  `json:"historical_demand_streaming_units"`
+	ScheduledDemandStreamingUnits  int32  `json:"scheduled_demand_streaming_units"`
+	PredictedDemandStreamingUnits  int32  `json:"predicted_demand_streaming_units"`
+	// The minimum free streaming units to keep once the predicted demand is met
+	MinAvailableCapacitySlackStreamingUnits int32 `json:"min_available_capacity_slack_streaming_units"`
+	OngoingScaleUp                          bool  `json:"ongoing_scale_up"`
+	RegionLimitReached                      bool  `json:"region_limit_reached"`
+	// Whether a data plane cluster would be created ahead of the predicted demand
+	ScaleUpNeeded bool `json:"scale_up_needed"`
+}
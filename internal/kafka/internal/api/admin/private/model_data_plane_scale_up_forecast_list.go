@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// DataPlaneScaleUpForecastList struct for DataPlaneScaleUpForecastList
+type DataPlaneScaleUpForecastList struct {
+	Kind string `json:"kind"`
+	// Whether the dynamic scale up creates data plane clusters ahead of the predicted demand
+	PredictiveScaleUpEnabled bool `json:"predictive_scale_up_enabled"`
+	// The time the demand is forecast at
+	ForecastTime time.Time `json:"forecast_time"`
+	// How far ahead of the forecast time the demand is forecast, in seconds
+	ForecastHorizonSeconds int64                      `json:"forecast_horizon_seconds"`
+	Items                  []DataPlaneScaleUpForecast `json:"items"`
+}
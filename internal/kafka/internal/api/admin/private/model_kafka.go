@@ -53,4 +53,9 @@ type Kafka struct {
 	Namespace              string                           `json:"namespace,omitempty"`
 	SizeId                 string                           `json:"size_id,omitempty"`
 	MaxDataRetentionSize   SupportedKafkaSizeBytesValueItem `json:"max_data_retention_size,omitempty"`
+	// Values: [provisioning, target_ready, deprovisioning_source, deprovisioning_target, failed]. Empty when the kafka is not being migrated.
+	MigrationStatus          string `json:"migration_status,omitempty"`
+	MigrationSourceClusterId string `json:"migration_source_cluster_id,omitempty"`
+	MigrationTargetClusterId string `json:"migration_target_cluster_id,omitempty"`
+	MigrationDetails         string `json:"migration_details,omitempty"`
 }
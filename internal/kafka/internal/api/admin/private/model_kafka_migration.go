@@ -0,0 +1,22 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaMigration struct for KafkaMigration
+type KafkaMigration struct {
+	KafkaId         string `json:"kafka_id"`
+	SourceClusterId string `json:"source_cluster_id"`
+	TargetClusterId string `json:"target_cluster_id,omitempty"`
+	// Values: [provisioning]. Empty when the kafka is not migrated.
+	MigrationStatus string `json:"migration_status,omitempty"`
+	// Why the kafka is not migrated
+	Reason string `json:"reason,omitempty"`
+}
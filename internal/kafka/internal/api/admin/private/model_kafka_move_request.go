@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaMoveRequest struct for KafkaMoveRequest
+type KafkaMoveRequest struct {
+	// Id of the data plane cluster the kafka is moved to
+	TargetClusterId string `json:"target_cluster_id"`
+}
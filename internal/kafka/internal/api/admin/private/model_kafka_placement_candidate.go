@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaPlacementCandidate struct for KafkaPlacementCandidate
+type KafkaPlacementCandidate struct {
+	ClusterId             string `json:"cluster_id"`
+	CloudProvider         string `json:"cloud_provider"`
+	Region                string `json:"region"`
+	MultiAz               bool   `json:"multi_az"`
+	Status                string `json:"status"`
+	ClusterType           string `json:"cluster_type,omitempty"`
+	OrganizationId        string `json:"organization_id,omitempty"`
+	SupportedInstanceType string `json:"supported_instance_type,omitempty"`
+	Accepted              bool   `json:"accepted"`
+	// Values: [organisation, status, multi_az, instance_type, capacity]
+	RejectedBy string `json:"rejected_by,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
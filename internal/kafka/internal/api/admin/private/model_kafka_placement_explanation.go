@@ -0,0 +1,19 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaPlacementExplanation struct for KafkaPlacementExplanation
+type KafkaPlacementExplanation struct {
+	Kind                    string                    `json:"kind"`
+	RegionCapacityAvailable bool                      `json:"region_capacity_available"`
+	Candidates              []KafkaPlacementCandidate `json:"candidates"`
+	ChosenClusterId         string                    `json:"chosen_cluster_id,omitempty"`
+}
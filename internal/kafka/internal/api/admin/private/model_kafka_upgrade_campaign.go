@@ -0,0 +1,40 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaUpgradeCampaign struct for KafkaUpgradeCampaign
+type KafkaUpgradeCampaign struct {
+	Id              string                       `json:"id"`
+	Kind            string                       `json:"kind"`
+	Href            string                       `json:"href"`
+	Name            string                       `json:"name,omitempty"`
+	StrimziVersion  string                       `json:"strimzi_version,omitempty"`
+	KafkaVersion    string                       `json:"kafka_version,omitempty"`
+	KafkaIbpVersion string                       `json:"kafka_ibp_version,omitempty"`
+	Selector        KafkaUpgradeCampaignSelector `json:"selector,omitempty"`
+	// Number of kafkas upgraded concurrently
+	BatchSize int32 `json:"batch_size"`
+	// Ratio of failed upgrades within a batch above which the campaign is paused
+	MaxFailureRatio float64 `json:"max_failure_ratio"`
+	// Time, in minutes, given to each kafka to complete its upgrade
+	UpgradeTimeoutMinutes int32 `json:"upgrade_timeout_minutes"`
+	// Values: [running, paused, completed]
+	Status       string                       `json:"status"`
+	StatusReason string                       `json:"status_reason,omitempty"`
+	CurrentBatch int32                        `json:"current_batch"`
+	Progress     KafkaUpgradeCampaignProgress `json:"progress"`
+	CreatedAt    time.Time                    `json:"created_at,omitempty"`
+	UpdatedAt    time.Time                    `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaUpgradeCampaignItem struct for KafkaUpgradeCampaignItem
+type KafkaUpgradeCampaignItem struct {
+	KafkaId string `json:"kafka_id"`
+	// Values: [pending, upgrading, succeeded, failed, skipped]
+	State     string     `json:"state"`
+	Batch     int32      `json:"batch,omitempty"`
+	Details   string     `json:"details,omitempty"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
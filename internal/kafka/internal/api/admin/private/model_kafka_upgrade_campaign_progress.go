@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaUpgradeCampaignProgress Number of kafkas of an upgrade campaign in each upgrade state
+type KafkaUpgradeCampaignProgress struct {
+	Total     int32 `json:"total"`
+	Pending   int32 `json:"pending"`
+	Upgrading int32 `json:"upgrading"`
+	Succeeded int32 `json:"succeeded"`
+	Failed    int32 `json:"failed"`
+	Skipped   int32 `json:"skipped"`
+}
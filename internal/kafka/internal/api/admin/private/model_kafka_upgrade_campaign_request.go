@@ -0,0 +1,26 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaUpgradeCampaignRequest struct for KafkaUpgradeCampaignRequest
+type KafkaUpgradeCampaignRequest struct {
+	Name            string                       `json:"name"`
+	StrimziVersion  string                       `json:"strimzi_version,omitempty"`
+	KafkaVersion    string                       `json:"kafka_version,omitempty"`
+	KafkaIbpVersion string                       `json:"kafka_ibp_version,omitempty"`
+	Selector        KafkaUpgradeCampaignSelector `json:"selector,omitempty"`
+	// Number of kafkas upgraded concurrently
+	BatchSize int32 `json:"batch_size,omitempty"`
+	// Ratio of failed upgrades within a batch above which the campaign is paused
+	MaxFailureRatio float64 `json:"max_failure_ratio,omitempty"`
+	// Time, in minutes, given to each kafka to complete its upgrade
+	UpgradeTimeoutMinutes int32 `json:"upgrade_timeout_minutes,omitempty"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaUpgradeCampaignSelector Selects the kafkas targeted by an upgrade campaign. Empty fields match all the kafkas.
+type KafkaUpgradeCampaignSelector struct {
+	ClusterId     string `json:"cluster_id,omitempty"`
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	Region        string `json:"region,omitempty"`
+	InstanceType  string `json:"instance_type,omitempty"`
+	// Search criteria, in the syntax of the search parameter of the kafkas list endpoint
+	Search string `json:"search,omitempty"`
+}
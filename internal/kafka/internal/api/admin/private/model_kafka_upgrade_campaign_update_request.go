@@ -0,0 +1,19 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaUpgradeCampaignUpdateRequest struct for KafkaUpgradeCampaignUpdateRequest
+type KafkaUpgradeCampaignUpdateRequest struct {
+	// Values: [running, paused]
+	Status string `json:"status,omitempty"`
+	// Ratio of failed upgrades within a batch above which the campaign is paused
+	MaxFailureRatio *float64 `json:"max_failure_ratio,omitempty"`
+}
@@ -0,0 +1,31 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// QuotaManagementListEntry struct for QuotaManagementListEntry
+type QuotaManagementListEntry struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// Values: [organisation, service_account]
+	Type string `json:"type"`
+	// The id of the organisation or the username of the service account
+	Key string `json:"key"`
+	// The organisation or service account, with the fields of the quota management list configuration file
+	Definition map[string]interface{} `json:"definition"`
+	CreatedBy  string                 `json:"created_by,omitempty"`
+	CreatedAt  time.Time              `json:"created_at,omitempty"`
+	UpdatedAt  time.Time              `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// QuotaManagementListEntryRequest Schema for the request to add an organisation or a service account to the quota management list
+type QuotaManagementListEntryRequest struct {
+	// Accepted values: [organisation, service_account]
+	Type string `json:"type"`
+	// The organisation or service account, with the fields of the quota management list configuration file
+	Definition map[string]interface{} `json:"definition"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// QuotaManagementListEntryUpdateRequest Schema for the request to replace the definition of an entry of the quota management list
+type QuotaManagementListEntryUpdateRequest struct {
+	// The organisation or service account, with the fields of the quota management list configuration file
+	Definition map[string]interface{} `json:"definition"`
+}
@@ -0,0 +1,44 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+type ClusterCreationAttemptStatus string
+
+const (
+	// ClusterCreationAttemptWaitingForRetry - the failed data plane cluster waits for its backoff to elapse before being replaced
+	ClusterCreationAttemptWaitingForRetry ClusterCreationAttemptStatus = "waiting_for_retry"
+	// ClusterCreationAttemptRetried - the failed data plane cluster has been replaced by a new cluster
+	ClusterCreationAttemptRetried ClusterCreationAttemptStatus = "retried"
+	// ClusterCreationAttemptExhausted - the failed data plane cluster has been deprovisioned without being replaced
+	ClusterCreationAttemptExhausted ClusterCreationAttemptStatus = "exhausted"
+)
+
+func (s ClusterCreationAttemptStatus) String() string {
+	return string(s)
+}
+
+// ClusterCreationAttempt records a data plane cluster that failed to be created. The failed cluster is either replaced
+// by a new cluster, which is the next attempt, or deprovisioned once the retries are exhausted.
+// The cluster ids are the ids of the clusters table rows, not their cluster_id, as a replacement cluster only gets its
+// cluster_id once it is created by its provider.
+type ClusterCreationAttempt struct {
+	api.Meta
+	FailedClusterID string `json:"failed_cluster_id" gorm:"index"`
+	// Attempt is 1 when the failed cluster is not a replacement, and the attempt of the cluster it replaced plus one otherwise
+	Attempt              int                          `json:"attempt"`
+	Status               ClusterCreationAttemptStatus `json:"status"`
+	ReplacementClusterID string                       `json:"replacement_cluster_id" gorm:"index"`
+	RetriedAt            *time.Time                   `json:"retried_at"`
+}
+
+func (a *ClusterCreationAttempt) BeforeCreate(scope *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = api.NewID()
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package dbapi
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// KafkaInstanceTemplate holds the settings of the Kafka instances created from it by the users of its organisation.
+// The settings are only validated when an instance is created from the template, as the supported plans, regions
+// and billing models can change in the meantime.
+type KafkaInstanceTemplate struct {
+	api.Meta
+	OrganisationId string `json:"organisation_id" gorm:"index"`
+	// Name is unique within the organisation
+	Name                    string `json:"name"`
+	CloudProvider           string `json:"cloud_provider"`
+	Region                  string `json:"region"`
+	Plan                    string `json:"plan"`
+	ReauthenticationEnabled *bool  `json:"reauthentication_enabled"`
+	BillingModel            string `json:"billing_model"`
+	BillingCloudAccountId   string `json:"billing_cloud_account_id"`
+	Marketplace             string `json:"marketplace"`
+	ClusterId               string `json:"cluster_id"`
+	CreatedBy               string `json:"created_by"`
+}
+
+type KafkaInstanceTemplateList []*KafkaInstanceTemplate
+
+func (t *KafkaInstanceTemplate) BeforeCreate(scope *gorm.DB) error {
+	if t.ID == "" {
+		t.ID = api.NewID()
+	}
+	return nil
+}
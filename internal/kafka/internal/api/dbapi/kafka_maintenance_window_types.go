@@ -0,0 +1,103 @@
+package dbapi
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// KafkaMaintenanceWindow is a weekly recurring time window, in UTC, during which the version upgrades of a Kafka are allowed to start.
+// A window applies either to a single Kafka instance or, when KafkaID is empty, to all Kafka instances of an organisation
+// that don't have their own window.
+type KafkaMaintenanceWindow struct {
+	api.Meta
+	KafkaID        string `json:"kafka_id" gorm:"index"`
+	OrganisationId string `json:"organisation_id" gorm:"index"`
+	// DayOfWeek is the lowercase english name of the day the window starts on e.g. "sunday"
+	DayOfWeek     string `json:"day_of_week"`
+	StartHour     int    `json:"start_hour"`
+	DurationHours int    `json:"duration_hours"`
+}
+
+const (
+	// KafkaMaintenanceWindowMaxDurationHours is the maximum length of a maintenance window
+	KafkaMaintenanceWindowMaxDurationHours = 24
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// ParseWeekday returns the week day matching the given day name. The name is case insensitive.
+func ParseWeekday(day string) (time.Weekday, error) {
+	weekday, ok := weekdays[strings.ToLower(day)]
+	if !ok {
+		return time.Sunday, fmt.Errorf("cannot parse %q as a day of the week", day)
+	}
+	return weekday, nil
+}
+
+func (w *KafkaMaintenanceWindow) BeforeCreate(scope *gorm.DB) error {
+	if w.ID == "" {
+		w.ID = api.NewID()
+	}
+	return nil
+}
+
+// IsOrganisationWide returns true when the window applies to all the Kafka instances of the organisation
+func (w *KafkaMaintenanceWindow) IsOrganisationWide() bool {
+	return w.KafkaID == ""
+}
+
+// IsOpen returns whether the given time falls within the maintenance window
+func (w *KafkaMaintenanceWindow) IsOpen(t time.Time) bool {
+	start, ok := w.latestStart(t)
+	return ok && t.Before(start.Add(w.duration()))
+}
+
+// NextStart returns the start of the occurrence of the window that is open at the given time or, if the window is closed,
+// the start of its next occurrence. The zero time is returned when the window has an invalid day of the week.
+func (w *KafkaMaintenanceWindow) NextStart(t time.Time) time.Time {
+	start, ok := w.latestStart(t)
+	if !ok {
+		return time.Time{}
+	}
+
+	if t.Before(start.Add(w.duration())) {
+		return start
+	}
+
+	return start.AddDate(0, 0, 7)
+}
+
+func (w *KafkaMaintenanceWindow) duration() time.Duration {
+	return time.Duration(w.DurationHours) * time.Hour
+}
+
+// latestStart returns the start of the most recent occurrence of the window that is not after the given time
+func (w *KafkaMaintenanceWindow) latestStart(t time.Time) (time.Time, bool) {
+	weekday, err := ParseWeekday(w.DayOfWeek)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	t = t.UTC()
+	daysSinceWeekday := (int(t.Weekday()) - int(weekday) + 7) % 7
+	start := time.Date(t.Year(), t.Month(), t.Day(), w.StartHour, 0, 0, 0, time.UTC).AddDate(0, 0, -daysSinceWeekday)
+	if start.After(t) {
+		start = start.AddDate(0, 0, -7)
+	}
+
+	return start, true
+}
+
+type KafkaMaintenanceWindowList []*KafkaMaintenanceWindow
@@ -0,0 +1,101 @@
+package dbapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaMaintenanceWindow_IsOpenAndNextStart(t *testing.T) {
+	// Sunday 2 am to 6 am UTC
+	window := &KafkaMaintenanceWindow{
+		DayOfWeek:     "sunday",
+		StartHour:     2,
+		DurationHours: 4,
+	}
+	// 2023-04-09 is a Sunday
+	sundayStart := time.Date(2023, time.April, 9, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		window        *KafkaMaintenanceWindow
+		at            time.Time
+		wantOpen      bool
+		wantNextStart time.Time
+	}{
+		{
+			name:          "window is open at its start",
+			window:        window,
+			at:            sundayStart,
+			wantOpen:      true,
+			wantNextStart: sundayStart,
+		},
+		{
+			name:          "window is open before its end",
+			window:        window,
+			at:            sundayStart.Add(3 * time.Hour),
+			wantOpen:      true,
+			wantNextStart: sundayStart,
+		},
+		{
+			name:          "window is closed at its end",
+			window:        window,
+			at:            sundayStart.Add(4 * time.Hour),
+			wantOpen:      false,
+			wantNextStart: sundayStart.AddDate(0, 0, 7),
+		},
+		{
+			name:          "window is closed just before its start",
+			window:        window,
+			at:            sundayStart.Add(-time.Minute),
+			wantOpen:      false,
+			wantNextStart: sundayStart,
+		},
+		{
+			name:          "window is closed in the middle of the week",
+			window:        window,
+			at:            sundayStart.AddDate(0, 0, 3),
+			wantOpen:      false,
+			wantNextStart: sundayStart.AddDate(0, 0, 7),
+		},
+		{
+			name:          "time in other locations is converted to UTC",
+			window:        window,
+			at:            sundayStart.In(time.FixedZone("UTC-5", -5*60*60)),
+			wantOpen:      true,
+			wantNextStart: sundayStart,
+		},
+		{
+			name: "window spanning over midnight is open the next day",
+			window: &KafkaMaintenanceWindow{
+				DayOfWeek:     "Saturday",
+				StartHour:     22,
+				DurationHours: 6,
+			},
+			at:            sundayStart,
+			wantOpen:      true,
+			wantNextStart: sundayStart.Add(-4 * time.Hour),
+		},
+		{
+			name: "window with an invalid day of the week is never open",
+			window: &KafkaMaintenanceWindow{
+				DayOfWeek:     "someday",
+				StartHour:     2,
+				DurationHours: 4,
+			},
+			at:            sundayStart,
+			wantOpen:      false,
+			wantNextStart: time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			g.Expect(testcase.window.IsOpen(testcase.at)).To(gomega.Equal(testcase.wantOpen))
+			g.Expect(testcase.window.NextStart(testcase.at).Equal(testcase.wantNextStart)).To(gomega.BeTrue())
+		})
+	}
+}
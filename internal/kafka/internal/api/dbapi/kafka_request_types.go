@@ -66,6 +66,16 @@ type KafkaRequest struct {
 	DesiredKafkaBillingModel string               `json:"desired_kafka_billing_model"`
 	PromotionStatus          KafkaPromotionStatus `json:"promotion_status"`
 	PromotionDetails         string               `json:"promotion_details"`
+	// MigrationStatus is the state of the live migration of the kafka to another data plane cluster, if any
+	MigrationStatus KafkaMigrationStatus `json:"migration_status" gorm:"index"`
+	// MigrationSourceClusterID is the data plane cluster the kafka is migrated from
+	MigrationSourceClusterID string `json:"migration_source_cluster_id"`
+	// MigrationTargetClusterID is the data plane cluster the kafka is migrated to
+	MigrationTargetClusterID string `json:"migration_target_cluster_id"`
+	// MigrationRoutes are the routes of the kafka on the target cluster of the migration. The kafka routes are switched to them once the kafka is ready on the target cluster.
+	MigrationRoutes api.JSON `json:"migration_routes"`
+	// MigrationDetails describes the outcome of the last migration of the kafka
+	MigrationDetails string `json:"migration_details"`
 	// ExpiresAt contains the timestamp of when a Kafka instance is scheduled to expire.
 	// On expiration, the Kafka instance will be marked for deletion, its status will be set to 'deprovision'.
 	ExpiresAt sql.NullTime `json:"expires_at"`
@@ -75,6 +85,11 @@ type KafkaRequest struct {
 	KafkasRoutesBaseDomainTLSKeyRef string
 	// KafkasRoutesBaseDomainTLSCrtRef is the key referencing the TLS certificate crt (public part of the certificate) for the base kafka domain
 	KafkasRoutesBaseDomainTLSCrtRef string
+	// ResourceVersion is bumped by the database every time the kafka request is inserted or updated, soft deletions included.
+	// It is used by watchers to only list the kafka requests that changed since the last version they have seen.
+	ResourceVersion int64 `json:"resource_version" gorm:"type:bigserial;index"`
+	// Role is the role on the kafka of the user it has been retrieved for, if any. It is not stored in the database.
+	Role KafkaRole `json:"-" gorm:"-"`
 }
 
 type KafkaPromotionStatus string
@@ -105,6 +120,45 @@ func ParseKafkaPromotionStatus(status string) (KafkaPromotionStatus, error) {
 	return parsedStatus, nil
 }
 
+// KafkaMigrationStatus is the state of the live migration of a kafka between two data plane clusters:
+//  1. provisioning: the kafka is provisioned on the target cluster while still being served by the source cluster.
+//  2. target_ready: the kafka is ready on the target cluster, its routes are about to be switched to the target cluster.
+//  3. deprovisioning_source: the kafka is served by the target cluster and is removed from the source cluster.
+//     The migration state is cleared once the source cluster reports the kafka as deleted.
+//
+// If the kafka cannot be provisioned on the target cluster, it is removed from it (deprovisioning_target) and the migration is failed.
+type KafkaMigrationStatus string
+
+const (
+	KafkaMigrationStatusNoMigration          KafkaMigrationStatus = ""
+	KafkaMigrationStatusProvisioning         KafkaMigrationStatus = "provisioning"
+	KafkaMigrationStatusTargetReady          KafkaMigrationStatus = "target_ready"
+	KafkaMigrationStatusDeprovisioningSource KafkaMigrationStatus = "deprovisioning_source"
+	KafkaMigrationStatusDeprovisioningTarget KafkaMigrationStatus = "deprovisioning_target"
+	KafkaMigrationStatusFailed               KafkaMigrationStatus = "failed"
+)
+
+func (s KafkaMigrationStatus) String() string {
+	return string(s)
+}
+
+// InProgress returns whether the kafka is being migrated
+func (s KafkaMigrationStatus) InProgress() bool {
+	return s != KafkaMigrationStatusNoMigration && s != KafkaMigrationStatusFailed
+}
+
+type KafkaUpgradeState string
+
+const (
+	KafkaUpgradeStateUpToDate  KafkaUpgradeState = "up_to_date"
+	KafkaUpgradeStatePending   KafkaUpgradeState = "pending"
+	KafkaUpgradeStateUpgrading KafkaUpgradeState = "upgrading"
+)
+
+func (s KafkaUpgradeState) String() string {
+	return string(s)
+}
+
 type KafkaList []*KafkaRequest
 type KafkaIndex map[string]*KafkaRequest
 
@@ -146,6 +200,49 @@ func (k *KafkaRequest) SetRoutes(routes []DataPlaneKafkaRoute) error {
 	}
 }
 
+// GetPlacementOnCluster returns whether the ManagedKafka of the kafka has to be deployed on the given data plane cluster
+// and, if so, whether it has to be deleted from it. Besides the cluster it is assigned to, a kafka being migrated is
+// deployed on the target cluster of the migration until it is removed from it, and on the source cluster of the migration
+// until it is removed from it.
+func (k *KafkaRequest) GetPlacementOnCluster(clusterID string) (deployed bool, deleted bool) {
+	switch clusterID {
+	case k.ClusterID:
+		return true, false
+	case k.MigrationTargetClusterID:
+		switch k.MigrationStatus {
+		case KafkaMigrationStatusProvisioning, KafkaMigrationStatusTargetReady:
+			return true, false
+		case KafkaMigrationStatusDeprovisioningTarget:
+			return true, true
+		}
+	case k.MigrationSourceClusterID:
+		if k.MigrationStatus == KafkaMigrationStatusDeprovisioningSource {
+			return true, true
+		}
+	}
+	return false, false
+}
+
+func (k *KafkaRequest) GetMigrationRoutes() ([]DataPlaneKafkaRoute, error) {
+	var routes []DataPlaneKafkaRoute
+	if k.MigrationRoutes == nil {
+		return routes, nil
+	}
+	if err := json.Unmarshal(k.MigrationRoutes, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+func (k *KafkaRequest) SetMigrationRoutes(routes []DataPlaneKafkaRoute) error {
+	r, err := json.Marshal(routes)
+	if err != nil {
+		return err
+	}
+	k.MigrationRoutes = r
+	return nil
+}
+
 // GetExpirationTime returns when the Kafka request will expire based on the
 // provided lifespanSeconds value. lifespanSeconds is assumed to be greater
 // than 0
@@ -261,3 +358,31 @@ func (k *KafkaRequest) IsUsingSharedTLSCertificate(kafkaConfig *config.KafkaConf
 func (k *KafkaRequest) IsADeveloperInstance() bool {
 	return k.InstanceType == types.DEVELOPER.String()
 }
+
+// HasPendingUpgrade returns true when at least one of the desired versions that are set differs from the one reported
+// by the data plane and no upgrade is in progress
+func (k *KafkaRequest) HasPendingUpgrade() bool {
+	if k.KafkaUpgrading || k.StrimziUpgrading || k.KafkaIBPUpgrading {
+		return false
+	}
+
+	versionDiffers := func(desired, actual string) bool {
+		return desired != "" && actual != "" && desired != actual
+	}
+
+	return versionDiffers(k.DesiredStrimziVersion, k.ActualStrimziVersion) ||
+		versionDiffers(k.DesiredKafkaVersion, k.ActualKafkaVersion) ||
+		versionDiffers(k.DesiredKafkaIBPVersion, k.ActualKafkaIBPVersion)
+}
+
+// UpgradeState returns the state of the version upgrades of the kafka
+func (k *KafkaRequest) UpgradeState() KafkaUpgradeState {
+	switch {
+	case k.KafkaUpgrading || k.StrimziUpgrading || k.KafkaIBPUpgrading:
+		return KafkaUpgradeStateUpgrading
+	case k.HasPendingUpgrade():
+		return KafkaUpgradeStatePending
+	default:
+		return KafkaUpgradeStateUpToDate
+	}
+}
@@ -184,3 +184,65 @@ func TestKafkaRequest_IsADeveloperInstance(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaRequest_UpgradeState(t *testing.T) {
+	tests := []struct {
+		name        string
+		kafka       KafkaRequest
+		want        KafkaUpgradeState
+		wantPending bool
+	}{
+		{
+			name: "return up_to_date when the desired versions match the actual versions",
+			kafka: KafkaRequest{
+				DesiredStrimziVersion:  "strimzi-1",
+				ActualStrimziVersion:   "strimzi-1",
+				DesiredKafkaVersion:    "3.3.1",
+				ActualKafkaVersion:     "3.3.1",
+				DesiredKafkaIBPVersion: "3.3",
+				ActualKafkaIBPVersion:  "3.3",
+			},
+			want:        KafkaUpgradeStateUpToDate,
+			wantPending: false,
+		},
+		{
+			name: "return up_to_date when the actual versions have not been reported yet",
+			kafka: KafkaRequest{
+				DesiredStrimziVersion: "strimzi-1",
+				DesiredKafkaVersion:   "3.3.1",
+			},
+			want:        KafkaUpgradeStateUpToDate,
+			wantPending: false,
+		},
+		{
+			name: "return pending when a desired version differs from the actual version",
+			kafka: KafkaRequest{
+				DesiredStrimziVersion: "strimzi-1",
+				ActualStrimziVersion:  "strimzi-1",
+				DesiredKafkaVersion:   "3.3.2",
+				ActualKafkaVersion:    "3.3.1",
+			},
+			want:        KafkaUpgradeStatePending,
+			wantPending: true,
+		},
+		{
+			name: "return upgrading when an upgrade is in progress",
+			kafka: KafkaRequest{
+				DesiredStrimziVersion: "strimzi-2",
+				ActualStrimziVersion:  "strimzi-1",
+				StrimziUpgrading:      true,
+			},
+			want:        KafkaUpgradeStateUpgrading,
+			wantPending: false,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			g.Expect(testcase.kafka.UpgradeState()).To(gomega.Equal(testcase.want))
+			g.Expect(testcase.kafka.HasPendingUpgrade()).To(gomega.Equal(testcase.wantPending))
+		})
+	}
+}
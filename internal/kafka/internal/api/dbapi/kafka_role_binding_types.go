@@ -0,0 +1,60 @@
+package dbapi
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// KafkaRole is the role of a user on a Kafka instance. Each role grants the permissions of the roles before it:
+// viewers can get the instance, editors can also update it and owners can also delete it, change its owner and
+// manage its role bindings.
+type KafkaRole string
+
+const (
+	KafkaRoleViewer KafkaRole = "viewer"
+	KafkaRoleEditor KafkaRole = "editor"
+	KafkaRoleOwner  KafkaRole = "owner"
+)
+
+var kafkaRoleRanks = map[KafkaRole]int{
+	KafkaRoleViewer: 1,
+	KafkaRoleEditor: 2,
+	KafkaRoleOwner:  3,
+}
+
+// ValidKafkaRoles are the roles that can be bound to a user on a Kafka instance
+var ValidKafkaRoles = []string{KafkaRoleViewer.String(), KafkaRoleEditor.String(), KafkaRoleOwner.String()}
+
+func (r KafkaRole) String() string {
+	return string(r)
+}
+
+// IsValid returns true when the role is one of the roles that can be bound to a user
+func (r KafkaRole) IsValid() bool {
+	_, ok := kafkaRoleRanks[r]
+	return ok
+}
+
+// Includes returns true when the role grants the permissions of the given role
+func (r KafkaRole) Includes(role KafkaRole) bool {
+	return r.IsValid() && kafkaRoleRanks[r] >= kafkaRoleRanks[role]
+}
+
+// KafkaRoleBinding binds a role on a Kafka instance to a user of the organisation of the instance
+type KafkaRoleBinding struct {
+	api.Meta
+	KafkaID string `json:"kafka_id" gorm:"index"`
+	// Subject is the username of the user the role is bound to
+	Subject   string    `json:"subject" gorm:"index"`
+	Role      KafkaRole `json:"role"`
+	CreatedBy string    `json:"created_by"`
+}
+
+type KafkaRoleBindingList []*KafkaRoleBinding
+
+func (b *KafkaRoleBinding) BeforeCreate(scope *gorm.DB) error {
+	if b.ID == "" {
+		b.ID = api.NewID()
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+type KafkaUpgradeCampaignStatus string
+
+func (s KafkaUpgradeCampaignStatus) String() string {
+	return string(s)
+}
+
+const (
+	KafkaUpgradeCampaignStatusRunning   KafkaUpgradeCampaignStatus = "running"
+	KafkaUpgradeCampaignStatusPaused    KafkaUpgradeCampaignStatus = "paused"
+	KafkaUpgradeCampaignStatusCompleted KafkaUpgradeCampaignStatus = "completed"
+)
+
+type KafkaUpgradeCampaignItemState string
+
+func (s KafkaUpgradeCampaignItemState) String() string {
+	return string(s)
+}
+
+const (
+	KafkaUpgradeCampaignItemStatePending   KafkaUpgradeCampaignItemState = "pending"
+	KafkaUpgradeCampaignItemStateUpgrading KafkaUpgradeCampaignItemState = "upgrading"
+	KafkaUpgradeCampaignItemStateSucceeded KafkaUpgradeCampaignItemState = "succeeded"
+	KafkaUpgradeCampaignItemStateFailed    KafkaUpgradeCampaignItemState = "failed"
+	KafkaUpgradeCampaignItemStateSkipped   KafkaUpgradeCampaignItemState = "skipped"
+)
+
+const (
+	// KafkaUpgradeCampaignDefaultBatchSize is the number of kafkas upgraded concurrently when a campaign doesn't set it
+	KafkaUpgradeCampaignDefaultBatchSize = 10
+	// KafkaUpgradeCampaignDefaultUpgradeTimeout is how long a kafka is given to complete its upgrade when a campaign doesn't set it
+	KafkaUpgradeCampaignDefaultUpgradeTimeout = 2 * time.Hour
+)
+
+// KafkaUpgradeCampaign is a fleet-wide rolling upgrade of the kafkas matching a selector to the target versions.
+// The matching kafkas are resolved when the campaign is created and are upgraded in batches of BatchSize instances:
+// a batch is started only once every kafka of the previous batch has finished upgrading.
+type KafkaUpgradeCampaign struct {
+	api.Meta
+	Name string `json:"name"`
+
+	// target versions, an empty target version leaves the version of the kafkas unchanged
+	StrimziVersion  string `json:"strimzi_version"`
+	KafkaVersion    string `json:"kafka_version"`
+	KafkaIBPVersion string `json:"kafka_ibp_version"`
+
+	// selector of the kafkas to upgrade, empty fields match all the kafkas
+	ClusterID     string `json:"cluster_id"`
+	CloudProvider string `json:"cloud_provider"`
+	Region        string `json:"region"`
+	InstanceType  string `json:"instance_type"`
+	// Search is an additional search query, in the syntax of the kafkas list endpoint, the kafkas must match
+	Search string `json:"search"`
+
+	BatchSize int `json:"batch_size"`
+	// MaxFailureRatio is the ratio of failed upgrades within a batch above which the campaign is paused
+	MaxFailureRatio       float64 `json:"max_failure_ratio"`
+	UpgradeTimeoutMinutes int     `json:"upgrade_timeout_minutes"`
+
+	Status       KafkaUpgradeCampaignStatus `json:"status" gorm:"index"`
+	StatusReason string                     `json:"status_reason"`
+	// CurrentBatch is the number of the latest batch started, starting from 1
+	CurrentBatch int `json:"current_batch"`
+	// EvaluatedBatch is the number of the latest batch whose failure ratio has been checked against MaxFailureRatio
+	EvaluatedBatch int `json:"evaluated_batch"`
+}
+
+func (c *KafkaUpgradeCampaign) BeforeCreate(scope *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = api.NewID()
+	}
+	return nil
+}
+
+// UpgradeTimeout returns how long a kafka is given to complete its upgrade
+func (c *KafkaUpgradeCampaign) UpgradeTimeout() time.Duration {
+	if c.UpgradeTimeoutMinutes <= 0 {
+		return KafkaUpgradeCampaignDefaultUpgradeTimeout
+	}
+	return time.Duration(c.UpgradeTimeoutMinutes) * time.Minute
+}
+
+// DesiredVersions returns the desired version fields of a kafka to update in order to upgrade it to the campaign targets
+func (c *KafkaUpgradeCampaign) DesiredVersions() map[string]interface{} {
+	versions := map[string]interface{}{}
+	if c.StrimziVersion != "" {
+		versions["desired_strimzi_version"] = c.StrimziVersion
+	}
+	if c.KafkaVersion != "" {
+		versions["desired_kafka_version"] = c.KafkaVersion
+	}
+	if c.KafkaIBPVersion != "" {
+		versions["desired_kafka_ibp_version"] = c.KafkaIBPVersion
+	}
+	return versions
+}
+
+// IsTargetReached returns whether the actual versions of the given kafka match all the campaign targets
+func (c *KafkaUpgradeCampaign) IsTargetReached(kafkaRequest *KafkaRequest) bool {
+	return versionMatches(c.StrimziVersion, kafkaRequest.ActualStrimziVersion) &&
+		versionMatches(c.KafkaVersion, kafkaRequest.ActualKafkaVersion) &&
+		versionMatches(c.KafkaIBPVersion, kafkaRequest.ActualKafkaIBPVersion)
+}
+
+func versionMatches(target, actual string) bool {
+	return target == "" || target == actual
+}
+
+type KafkaUpgradeCampaignList []*KafkaUpgradeCampaign
+
+// KafkaUpgradeCampaignItem tracks the upgrade of a single kafka within a campaign
+type KafkaUpgradeCampaignItem struct {
+	api.Meta
+	CampaignID string                        `json:"campaign_id" gorm:"index"`
+	KafkaID    string                        `json:"kafka_id" gorm:"index"`
+	State      KafkaUpgradeCampaignItemState `json:"state"`
+	// Batch is the number of the batch the kafka has been upgraded in, 0 while pending
+	Batch     int        `json:"batch"`
+	Details   string     `json:"details"`
+	StartedAt *time.Time `json:"started_at"`
+	// UpgradeObserved is set once the data plane has reported the kafka as upgrading
+	UpgradeObserved bool `json:"upgrade_observed"`
+}
+
+func (i *KafkaUpgradeCampaignItem) BeforeCreate(scope *gorm.DB) error {
+	if i.ID == "" {
+		i.ID = api.NewID()
+	}
+	return nil
+}
+
+// IsFinished returns whether the upgrade of the kafka has reached a final state
+func (i *KafkaUpgradeCampaignItem) IsFinished() bool {
+	return i.State == KafkaUpgradeCampaignItemStateSucceeded ||
+		i.State == KafkaUpgradeCampaignItemStateFailed ||
+		i.State == KafkaUpgradeCampaignItemStateSkipped
+}
+
+type KafkaUpgradeCampaignItemList []*KafkaUpgradeCampaignItem
+
+// KafkaUpgradeCampaignProgress counts the kafkas of a campaign in each upgrade state
+type KafkaUpgradeCampaignProgress struct {
+	Total     int
+	Pending   int
+	Upgrading int
+	Succeeded int
+	Failed    int
+	Skipped   int
+}
+
+// Add counts count more kafkas in the given state
+func (p *KafkaUpgradeCampaignProgress) Add(state KafkaUpgradeCampaignItemState, count int) {
+	p.Total += count
+	switch state {
+	case KafkaUpgradeCampaignItemStatePending:
+		p.Pending += count
+	case KafkaUpgradeCampaignItemStateUpgrading:
+		p.Upgrading += count
+	case KafkaUpgradeCampaignItemStateSucceeded:
+		p.Succeeded += count
+	case KafkaUpgradeCampaignItemStateFailed:
+		p.Failed += count
+	case KafkaUpgradeCampaignItemStateSkipped:
+		p.Skipped += count
+	}
+}
+
+// FailureRatio returns the ratio of failed upgrades among the finished ones. Skipped kafkas are not taken into account.
+func (p *KafkaUpgradeCampaignProgress) FailureRatio() float64 {
+	finished := p.Succeeded + p.Failed
+	if finished == 0 {
+		return 0
+	}
+	return float64(p.Failed) / float64(finished)
+}
+
+// ProgressOf returns the progress of the given items, restricted to the given batch unless batch is 0
+func (l KafkaUpgradeCampaignItemList) ProgressOf(batch int) KafkaUpgradeCampaignProgress {
+	var progress KafkaUpgradeCampaignProgress
+	for _, item := range l {
+		if batch == 0 || item.Batch == batch {
+			progress.Add(item.State, 1)
+		}
+	}
+	return progress
+}
@@ -0,0 +1,90 @@
+package dbapi
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaUpgradeCampaign_IsTargetReached(t *testing.T) {
+	tests := []struct {
+		name     string
+		campaign *KafkaUpgradeCampaign
+		kafka    *KafkaRequest
+		want     bool
+	}{
+		{
+			name:     "should return true when all the targets are reached",
+			campaign: &KafkaUpgradeCampaign{StrimziVersion: "strimzi-cluster-operator.v0.24.0-0", KafkaVersion: "2.8.1"},
+			kafka:    &KafkaRequest{ActualStrimziVersion: "strimzi-cluster-operator.v0.24.0-0", ActualKafkaVersion: "2.8.1", ActualKafkaIBPVersion: "2.7"},
+			want:     true,
+		},
+		{
+			name:     "should return false when one of the targets is not reached",
+			campaign: &KafkaUpgradeCampaign{StrimziVersion: "strimzi-cluster-operator.v0.24.0-0", KafkaIBPVersion: "2.8"},
+			kafka:    &KafkaRequest{ActualStrimziVersion: "strimzi-cluster-operator.v0.24.0-0", ActualKafkaIBPVersion: "2.7"},
+			want:     false,
+		},
+		{
+			name:     "should return true when the campaign has no target",
+			campaign: &KafkaUpgradeCampaign{},
+			kafka:    &KafkaRequest{ActualKafkaVersion: "2.8.1"},
+			want:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(tt.campaign.IsTargetReached(tt.kafka)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestKafkaUpgradeCampaignItemList_ProgressOf(t *testing.T) {
+	items := KafkaUpgradeCampaignItemList{
+		{Batch: 1, State: KafkaUpgradeCampaignItemStateSucceeded},
+		{Batch: 1, State: KafkaUpgradeCampaignItemStateFailed},
+		{Batch: 1, State: KafkaUpgradeCampaignItemStateSkipped},
+		{Batch: 2, State: KafkaUpgradeCampaignItemStateUpgrading},
+		{Batch: 2, State: KafkaUpgradeCampaignItemStateSucceeded},
+		{State: KafkaUpgradeCampaignItemStatePending},
+	}
+
+	tests := []struct {
+		name             string
+		batch            int
+		want             KafkaUpgradeCampaignProgress
+		wantFailureRatio float64
+	}{
+		{
+			name:             "should count all the items when no batch is given",
+			batch:            0,
+			want:             KafkaUpgradeCampaignProgress{Total: 6, Pending: 1, Upgrading: 1, Succeeded: 2, Failed: 1, Skipped: 1},
+			wantFailureRatio: 1.0 / 3,
+		},
+		{
+			name:             "should only count the items of the given batch",
+			batch:            1,
+			want:             KafkaUpgradeCampaignProgress{Total: 3, Succeeded: 1, Failed: 1, Skipped: 1},
+			wantFailureRatio: 0.5,
+		},
+		{
+			name:             "should return a zero failure ratio when no upgrade has finished",
+			batch:            3,
+			want:             KafkaUpgradeCampaignProgress{},
+			wantFailureRatio: 0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got := items.ProgressOf(tt.batch)
+			g.Expect(got).To(gomega.Equal(tt.want))
+			g.Expect(got.FailureRatio()).To(gomega.BeNumerically("~", tt.wantFailureRatio, 0.0001))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaInstanceTemplate struct for KafkaInstanceTemplate
+type KafkaInstanceTemplate struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// The name of the template, unique within the organisation
+	Name                    string    `json:"name"`
+	CloudProvider           string    `json:"cloud_provider,omitempty"`
+	Region                  string    `json:"region,omitempty"`
+	Plan                    string    `json:"plan,omitempty"`
+	ReauthenticationEnabled *bool     `json:"reauthentication_enabled,omitempty"`
+	BillingModel            string    `json:"billing_model,omitempty"`
+	BillingCloudAccountId   string    `json:"billing_cloud_account_id,omitempty"`
+	Marketplace             string    `json:"marketplace,omitempty"`
+	ClusterId               string    `json:"cluster_id,omitempty"`
+	CreatedBy               string    `json:"created_by,omitempty"`
+	CreatedAt               time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,33 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaInstanceTemplateRequest Schema for the request to save the settings of Kafka instances as a template of the organisation
+type KafkaInstanceTemplateRequest struct {
+	// The name of the template, unique within the organisation
+	Name string `json:"name"`
+	// The cloud provider where the Kafka instances created from the template will be created in
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	// The region where the Kafka instances created from the template will be created in
+	Region string `json:"region,omitempty"`
+	// kafka plan in a format of <instance_type>.<size_id>
+	Plan string `json:"plan,omitempty"`
+	// Whether connection reauthentication is enabled or not on the Kafka instances created from the template
+	ReauthenticationEnabled *bool `json:"reauthentication_enabled,omitempty"`
+	// billing model to use
+	BillingModel *string `json:"billing_model,omitempty"`
+	// cloud account id used to purchase the instances
+	BillingCloudAccountId *string `json:"billing_cloud_account_id,omitempty"`
+	// marketplace where the instances are purchased on
+	Marketplace *string `json:"marketplace,omitempty"`
+	// enterprise OSD cluster ID to be used for kafka creation
+	ClusterId *string `json:"cluster_id,omitempty"`
+}
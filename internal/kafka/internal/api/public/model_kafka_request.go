@@ -71,4 +71,8 @@ type KafkaRequest struct {
 	ClusterId *string `json:"cluster_id,omitempty"`
 	// Details of the Kafka request promotion. It can be set when a Kafka request promotion is in progress or has failed
 	PromotionDetails string `json:"promotion_details,omitempty"`
+	// State of the version upgrades of the Kafka instance. Possible values: ['up_to_date', 'pending', 'upgrading']. A pending upgrade starts when the maintenance window of the Kafka instance opens.
+	UpgradeState string `json:"upgrade_state,omitempty"`
+	// Role of the user on the Kafka instance. Possible values: ['viewer', 'editor', 'owner']
+	Role string `json:"role,omitempty"`
 }
@@ -30,4 +30,6 @@ type KafkaRequestPayload struct {
 	BillingModel *string `json:"billing_model,omitempty"`
 	// enterprise OSD cluster ID to be used for kafka creation
 	ClusterId *string `json:"cluster_id,omitempty"`
+	// id of a kafka instance template of the organisation whose settings are used for the fields that are not set
+	TemplateId *string `json:"template_id,omitempty"`
 }
@@ -0,0 +1,21 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaRequestWatchEvent struct for KafkaRequestWatchEvent
+type KafkaRequestWatchEvent struct {
+	// the type of the event. One of CHANGE, DELETED, BOOKMARK or error
+	Type string `json:"type"`
+	// the version of the last change seen by the watch. It can be passed as the gt_version query parameter to resume watching from this event
+	Version int64         `json:"version,omitempty"`
+	Error   *Error        `json:"error,omitempty"`
+	Object  *KafkaRequest `json:"object,omitempty"`
+}
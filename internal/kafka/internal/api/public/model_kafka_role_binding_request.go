@@ -0,0 +1,19 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaRoleBindingRequest Schema for the request to bind a role on a Kafka instance to a user of its organisation
+type KafkaRoleBindingRequest struct {
+	// The username of the user the role is bound to
+	Subject string `json:"subject"`
+	// Values: [viewer, editor, owner]
+	Role string `json:"role"`
+}
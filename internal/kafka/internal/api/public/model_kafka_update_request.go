@@ -15,4 +15,6 @@ type KafkaUpdateRequest struct {
 	Owner *string `json:"owner,omitempty"`
 	// Whether connection reauthentication is enabled or not. If set to true, connection reauthentication on the Kafka instance will be required every 5 minutes.
 	ReauthenticationEnabled *bool `json:"reauthentication_enabled,omitempty"`
+	// The new plan of the Kafka instance in a format of <instance_type>.<size_id>. Only the size can be changed, the instance type must remain the same. A ready Kafka instance whose data plane cluster cannot host the new size is migrated to another data plane cluster.
+	Plan *string `json:"plan,omitempty"`
 }
@@ -0,0 +1,34 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// MaintenanceWindow struct for MaintenanceWindow
+type MaintenanceWindow struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// The day of the week the maintenance window starts on. Accepted values: ['monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday', 'sunday']
+	DayOfWeek string `json:"day_of_week"`
+	// The hour of the day, in UTC, the maintenance window starts at
+	StartHour int32 `json:"start_hour"`
+	// The duration of the maintenance window in hours
+	DurationHours int32 `json:"duration_hours"`
+	// The ID of the Kafka instance the maintenance window belongs to. It is not set when the maintenance window applies to the whole organisation
+	KafkaId string `json:"kafka_id,omitempty"`
+	// The start of the current occurrence of the maintenance window if it is open, or of its next occurrence otherwise
+	NextStartTime time.Time `json:"next_start_time,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+}
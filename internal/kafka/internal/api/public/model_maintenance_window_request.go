@@ -0,0 +1,21 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// MaintenanceWindowRequest A weekly recurring time window, in UTC, during which the version upgrades of Kafka instances are allowed to start
+type MaintenanceWindowRequest struct {
+	// The day of the week the maintenance window starts on. Accepted values: ['monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday', 'sunday']
+	DayOfWeek string `json:"day_of_week"`
+	// The hour of the day, in UTC, the maintenance window starts at
+	StartHour int32 `json:"start_hour"`
+	// The duration of the maintenance window in hours
+	DurationHours int32 `json:"duration_hours"`
+}
@@ -0,0 +1,29 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// WebhookSubscription struct for WebhookSubscription
+type WebhookSubscription struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// The https URL the events are sent to with a POST request. The host of the URL must resolve to public addresses only, and the redirects are not followed
+	Url string `json:"url"`
+	// The types of the events the subscription is notified of. Accepted values: ['kafka.ready', 'kafka.failed', 'kafka.suspended', 'kafka.expiring', 'connector.ready', 'connector.failed', 'connector.stopped']
+	EventTypes []string  `json:"event_types"`
+	Owner      string    `json:"owner,omitempty"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// WebhookSubscriptionRequest Schema for the request to subscribe to the lifecycle events of the resources of an organisation
+type WebhookSubscriptionRequest struct {
+	// The https URL the events are sent to with a POST request. The host of the URL must resolve to public addresses only, and the redirects are not followed
+	Url string `json:"url"`
+	// The types of the events the subscription is notified of. Accepted values: ['kafka.ready', 'kafka.failed', 'kafka.suspended', 'kafka.expiring', 'connector.ready', 'connector.failed', 'connector.stopped']
+	EventTypes []string `json:"event_types"`
+	// The secret used to sign the payload of the events. The hex encoded HMAC-SHA256 signature is sent in the X-Webhook-Signature header, prefixed by 'sha256='
+	Secret string `json:"secret"`
+}
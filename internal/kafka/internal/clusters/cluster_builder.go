@@ -67,8 +67,8 @@ func (r clusterBuilder) NewOCMClusterFromCluster(clusterRequest *types.ClusterRe
 	clusterBuilder.CloudProvider(clustersmgmtv1.NewCloudProvider().ID(clusterRequest.CloudProvider))
 	clusterBuilder.Region(clustersmgmtv1.NewCloudRegion().ID(clusterRequest.Region))
 	clusterBuilder.MultiAZ(clusterRequest.MultiAZ)
-	if r.dataplaneClusterConfig.DynamicScalingConfig.NewDataPlaneOpenShiftVersion != "" {
-		clusterBuilder.Version(clustersmgmtv1.NewVersion().ID(r.dataplaneClusterConfig.DynamicScalingConfig.NewDataPlaneOpenShiftVersion))
+	if r.dataplaneClusterConfig.GetDynamicScalingConfig().NewDataPlaneOpenShiftVersion != "" {
+		clusterBuilder.Version(clustersmgmtv1.NewVersion().ID(r.dataplaneClusterConfig.GetDynamicScalingConfig().NewDataPlaneOpenShiftVersion))
 	}
 	// setting CCS to always be true for now as this is the only available cluster type within our quota.
 	clusterBuilder.CCS(clustersmgmtv1.NewCCS().Enabled(true))
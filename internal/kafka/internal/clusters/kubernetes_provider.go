@@ -0,0 +1,409 @@
+package clusters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// resourceSetInventoryNamespace is the namespace of the config maps recording the resources applied as part of a resource set
+	resourceSetInventoryNamespace  = "default"
+	resourceSetInventoryNamePrefix = "kas-fleet-manager-resource-set-"
+	resourceSetInventoryKey        = "resources"
+)
+
+var nodesResource = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+
+// KubernetesProviderSpec is the provider specific information, stored in the provider_spec of a cluster, used by the
+// KubernetesProvider to access the cluster
+type KubernetesProviderSpec struct {
+	// Kubeconfig is the content of the kubeconfig giving access to the cluster. Its current context is used.
+	Kubeconfig string `json:"kubeconfig"`
+}
+
+// resourceReference identifies a resource applied to a cluster as part of a resource set
+type resourceReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+type kubernetesClients struct {
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+}
+
+type kubernetesClientFactory interface {
+	newClients(kubeconfig []byte) (*kubernetesClients, error)
+}
+
+type kubeconfigClientFactory struct{}
+
+func (f *kubeconfigClientFactory) newClients(kubeconfig []byte) (*kubernetesClients, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the kubeconfig")
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a REST mapper that tracks information about the available resources in the cluster.
+	dc, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kubernetesClients{
+		dynamicClient: dynamicClient,
+		mapper:        restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc)),
+	}, nil
+}
+
+// KubernetesProvider manages data plane clusters running on any kubernetes distribution with OLM installed.
+// The clusters are not created by the provider: they are registered with a kubeconfig, stored in their provider spec, giving access to them.
+type KubernetesProvider struct {
+	// StandaloneProvider builds the OLM resources installing the operators and implements the operations which are noop for both providers
+	*StandaloneProvider
+	clientFactory kubernetesClientFactory
+}
+
+// blank assignment to verify that KubernetesProvider implements Provider
+var _ Provider = &KubernetesProvider{}
+
+func newKubernetesProvider(connectionFactory *db.ConnectionFactory, dataplaneClusterConfig *config.DataplaneClusterConfig) *KubernetesProvider {
+	return &KubernetesProvider{
+		StandaloneProvider: newStandaloneProvider(connectionFactory, dataplaneClusterConfig),
+		clientFactory:      &kubeconfigClientFactory{},
+	}
+}
+
+func (k *KubernetesProvider) Create(request *types.ClusterRequest) (*types.ClusterSpec, error) {
+	return nil, errors.New("kubernetes clusters cannot be created, they have to be registered with the kubeconfig of an existing cluster")
+}
+
+func (k *KubernetesProvider) Delete(spec *types.ClusterSpec) (bool, error) {
+	return true, nil // the cluster is not owned by the provider, it is only unregistered
+}
+
+// CheckClusterStatus marks the cluster as provisioned once all of its nodes are ready
+func (k *KubernetesProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
+	clients, err := k.clientsForCluster(spec.InternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := clients.dynamicClient.Resource(nodesResource).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the nodes of cluster %q", spec.InternalID)
+	}
+
+	readyNodes := 0
+	for _, item := range nodes.Items {
+		var node v1.Node
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &node); err != nil {
+			return nil, errors.Wrapf(err, "failed to convert node %q of cluster %q", item.GetName(), spec.InternalID)
+		}
+		if isNodeReady(node) {
+			readyNodes++
+		}
+	}
+
+	if len(nodes.Items) > 0 && readyNodes == len(nodes.Items) {
+		spec.Status = api.ClusterProvisioned
+		spec.StatusDetails = ""
+	} else {
+		spec.Status = api.ClusterProvisioning
+		spec.StatusDetails = fmt.Sprintf("%d out of %d nodes are ready", readyNodes, len(nodes.Items))
+	}
+
+	return spec, nil
+}
+
+func isNodeReady(node v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// AddIdentityProvider is a noop as vanilla kubernetes clusters do not have an OAuth server to configure
+func (k *KubernetesProvider) AddIdentityProvider(clusterSpec *types.ClusterSpec, identityProvider types.IdentityProviderInfo) (*types.IdentityProviderInfo, error) {
+	return &identityProvider, nil
+}
+
+// ApplyResources applies the resources to the cluster with server-side apply.
+// The resources of a named resource set are recorded in an inventory config map so that the resources removed from the set
+// are pruned on the next apply and that all the resources of the set can be removed with RemoveResources.
+func (k *KubernetesProvider) ApplyResources(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
+	clients, err := k.clientsForCluster(clusterSpec.InternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := []resourceReference{}
+	for _, resource := range resources.Resources {
+		obj, err := toUnstructured(resource)
+		if err != nil {
+			return nil, err
+		}
+		if err := clients.apply(obj); err != nil {
+			return nil, err
+		}
+		applied = append(applied, resourceReference{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+	}
+
+	if resources.Name == "" {
+		return &resources, nil
+	}
+
+	previouslyApplied, err := clients.getInventory(resources.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range previouslyApplied {
+		if !containsResourceReference(applied, ref) {
+			if err := clients.delete(ref); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := clients.apply(buildResourceSetInventory(resources.Name, applied)); err != nil {
+		return nil, err
+	}
+
+	return &resources, nil
+}
+
+// RemoveResources deletes the resources recorded in the inventory of the resource set, then the inventory itself
+func (k *KubernetesProvider) RemoveResources(clusterSpec *types.ClusterSpec, syncSetName string) error {
+	clients, err := k.clientsForCluster(clusterSpec.InternalID)
+	if err != nil {
+		return err
+	}
+
+	applied, err := clients.getInventory(syncSetName)
+	if err != nil {
+		return err
+	}
+
+	// delete the resources in the reverse order of their creation so that namespaces are deleted last
+	for i := len(applied) - 1; i >= 0; i-- {
+		if err := clients.delete(applied[i]); err != nil {
+			return err
+		}
+	}
+
+	return clients.delete(resourceReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  resourceSetInventoryNamespace,
+		Name:       resourceSetInventoryNamePrefix + syncSetName,
+	})
+}
+
+func (k *KubernetesProvider) InstallStrimzi(clusterSpec *types.ClusterSpec) (bool, error) {
+	_, err := k.ApplyResources(clusterSpec, types.ResourceSet{
+		Resources: []interface{}{
+			k.buildStrimziOperatorNamespace(),
+			k.buildStrimziOperatorCatalogSource(),
+			k.buildStrimziOperatorOperatorGroup(),
+			k.buildStrimziOperatorSubscription(),
+		},
+	})
+
+	return err == nil, err
+}
+
+func (k *KubernetesProvider) InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	_, err := k.ApplyResources(clusterSpec, types.ResourceSet{
+		Resources: []interface{}{
+			k.buildKASFleetShardOperatorNamespace(),
+			k.buildKASFleetShardSyncSecret(params),
+			k.buildKASFleetShardOperatorCatalogSource(),
+			k.buildKASFleetShardOperatorOperatorGroup(),
+			k.buildKASFleetShardOperatorSubscription(),
+		},
+	})
+
+	return err == nil, err
+}
+
+// GetClusterDNS returns an error as the dns of kubernetes clusters cannot be discovered and has to be set when registering the cluster
+func (k *KubernetesProvider) GetClusterDNS(clusterSpec *types.ClusterSpec) (string, error) {
+	return "", errors.Errorf("the dns of the kubernetes cluster %q has to be set when registering the cluster", clusterSpec.InternalID)
+}
+
+func (k *KubernetesProvider) GetClusterSpec(clusterID string) (types.ClusterSpec, error) {
+	spec, err := k.CheckClusterStatus(&types.ClusterSpec{InternalID: clusterID})
+	if err != nil {
+		return types.ClusterSpec{}, err
+	}
+	return *spec, nil
+}
+
+func (k *KubernetesProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return listCloudProvidersOfProviderType(k.connectionFactory, api.ClusterProviderKubernetes)
+}
+
+func (k *KubernetesProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	return listCloudProviderRegionsOfProviderType(k.connectionFactory, api.ClusterProviderKubernetes, providerInf)
+}
+
+// clientsForCluster builds the clients accessing the cluster from the kubeconfig stored in its provider spec
+func (k *KubernetesProvider) clientsForCluster(clusterID string) (*kubernetesClients, error) {
+	var cluster api.Cluster
+	if err := k.connectionFactory.New().Where("cluster_id = ?", clusterID).First(&cluster).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to find cluster %q", clusterID)
+	}
+
+	var spec KubernetesProviderSpec
+	if len(cluster.ProviderSpec) > 0 {
+		if err := json.Unmarshal(cluster.ProviderSpec, &spec); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal the provider spec of cluster %q", clusterID)
+		}
+	}
+	if spec.Kubeconfig == "" {
+		return nil, errors.Errorf("the provider spec of cluster %q does not have a kubeconfig", clusterID)
+	}
+
+	clients, err := k.clientFactory.newClients([]byte(spec.Kubeconfig))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the clients of cluster %q", clusterID)
+	}
+	return clients, nil
+}
+
+func (c *kubernetesClients) resourceInterface(gvk schema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, error) {
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return c.dynamicClient.Resource(mapping.Resource).Namespace(namespace), nil
+	}
+	return c.dynamicClient.Resource(mapping.Resource), nil
+}
+
+func (c *kubernetesClients) apply(obj *unstructured.Unstructured) error {
+	dr, err := c.resourceInterface(obj.GroupVersionKind(), obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	_, err = dr.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+		FieldManager: fieldManager,
+		Force:        true,
+	})
+	return errors.Wrapf(err, "failed to apply %s %q", obj.GetKind(), obj.GetName())
+}
+
+func (c *kubernetesClients) delete(ref resourceReference) error {
+	dr, err := c.resourceInterface(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind), ref.Namespace)
+	if err != nil {
+		return err
+	}
+
+	err = dr.Delete(ctx, ref.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete %s %q", ref.Kind, ref.Name)
+	}
+	return nil
+}
+
+// getInventory returns the resources recorded in the inventory of the resource set, or an empty list when there is none
+func (c *kubernetesClients) getInventory(resourceSetName string) ([]resourceReference, error) {
+	inventory, err := c.dynamicClient.Resource(v1.SchemeGroupVersion.WithResource("configmaps")).
+		Namespace(resourceSetInventoryNamespace).
+		Get(ctx, resourceSetInventoryNamePrefix+resourceSetName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return []resourceReference{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the inventory of resource set %q", resourceSetName)
+	}
+
+	refs := []resourceReference{}
+	data, _, _ := unstructured.NestedString(inventory.Object, "data", resourceSetInventoryKey)
+	if data == "" {
+		return refs, nil
+	}
+	if err := json.Unmarshal([]byte(data), &refs); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the inventory of resource set %q", resourceSetName)
+	}
+	return refs, nil
+}
+
+func buildResourceSetInventory(resourceSetName string, refs []resourceReference) *unstructured.Unstructured {
+	data, _ := json.Marshal(refs)
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      resourceSetInventoryNamePrefix + resourceSetName,
+				"namespace": resourceSetInventoryNamespace,
+			},
+			"data": map[string]interface{}{
+				resourceSetInventoryKey: string(data),
+			},
+		},
+	}
+}
+
+func containsResourceReference(refs []resourceReference, ref resourceReference) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// toUnstructured converts a typed or map resource to an unstructured object suitable for server-side apply
+func toUnstructured(resource interface{}) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj unstructured.Unstructured
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	// the status and the creation timestamp are set by the cluster and must not be part of the applied configuration
+	unstructured.RemoveNestedField(obj.Object, "status")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	return &obj, nil
+}
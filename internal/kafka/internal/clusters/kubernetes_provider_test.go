@@ -0,0 +1,225 @@
+package clusters
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	mocket "github.com/selvatico/go-mocket"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	configMapsResource = v1.SchemeGroupVersion.WithResource("configmaps")
+	namespacesResource = v1.SchemeGroupVersion.WithResource("namespaces")
+)
+
+type fakeKubernetesClientFactory struct {
+	clients *kubernetesClients
+}
+
+func (f *fakeKubernetesClientFactory) newClients(kubeconfig []byte) (*kubernetesClients, error) {
+	return f.clients, nil
+}
+
+// newFakeKubernetesClients returns clients backed by an object tracker. The fake dynamic client does not support
+// server-side apply, the apply patches are converted to creations or updates of the tracked objects instead.
+func newFakeKubernetesClients(objects ...runtime.Object) *kubernetesClients {
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		nodesResource:      "NodeList",
+		configMapsResource: "ConfigMapList",
+		namespacesResource: "NamespaceList",
+	}, objects...)
+	dynamicClient.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patchAction := action.(k8stesting.PatchAction)
+		if patchAction.GetPatchType() != k8stypes.ApplyPatchType {
+			return false, nil, nil
+		}
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(patchAction.GetPatch(), &obj.Object); err != nil {
+			return true, nil, err
+		}
+		tracker := dynamicClient.Tracker()
+		if _, err := tracker.Get(patchAction.GetResource(), patchAction.GetNamespace(), patchAction.GetName()); err != nil {
+			return true, obj, tracker.Create(patchAction.GetResource(), obj, patchAction.GetNamespace())
+		}
+		return true, obj, tracker.Update(patchAction.GetResource(), obj, patchAction.GetNamespace())
+	})
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	mapper.Add(operatorsv1alpha1.SchemeGroupVersion.WithKind(operatorsv1alpha1.CatalogSourceKind), meta.RESTScopeNamespace)
+	mapper.Add(operatorsv1alpha1.SchemeGroupVersion.WithKind(operatorsv1alpha1.SubscriptionKind), meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha2", Kind: "OperatorGroup"}, meta.RESTScopeNamespace)
+
+	return &kubernetesClients{
+		dynamicClient: dynamicClient,
+		mapper:        mapper,
+	}
+}
+
+func newTestNode(name string, ready v1.ConditionStatus) runtime.Object {
+	obj, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&v1.Node{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: ready}},
+		},
+	})
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func newTestNamespace(name string) *v1.Namespace {
+	return &v1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+}
+
+func mockKubernetesClusterQuery(providerSpec string) {
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "clusters" WHERE cluster_id = $1`).
+		WithReply([]map[string]interface{}{{"cluster_id": "test-cluster", "provider_spec": []byte(providerSpec)}})
+}
+
+func getInventory(g *gomega.WithT, clients *kubernetesClients, resourceSetName string) []resourceReference {
+	refs, err := clients.getInventory(resourceSetName)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	return refs
+}
+
+func TestKubernetesProvider_CheckClusterStatus(t *testing.T) {
+	tests := []struct {
+		name              string
+		providerSpec      string
+		nodes             []runtime.Object
+		wantStatus        api.ClusterStatus
+		wantStatusDetails string
+		wantErr           bool
+	}{
+		{
+			name:         "should return an error when the cluster does not have a kubeconfig",
+			providerSpec: `{}`,
+			wantErr:      true,
+		},
+		{
+			name:              "should keep the cluster provisioning when it does not have any node",
+			providerSpec:      `{"kubeconfig": "kubeconfig-content"}`,
+			wantStatus:        api.ClusterProvisioning,
+			wantStatusDetails: "0 out of 0 nodes are ready",
+		},
+		{
+			name:              "should keep the cluster provisioning when some of its nodes are not ready",
+			providerSpec:      `{"kubeconfig": "kubeconfig-content"}`,
+			nodes:             []runtime.Object{newTestNode("node-1", v1.ConditionTrue), newTestNode("node-2", v1.ConditionFalse)},
+			wantStatus:        api.ClusterProvisioning,
+			wantStatusDetails: "1 out of 2 nodes are ready",
+		},
+		{
+			name:         "should mark the cluster as provisioned when all of its nodes are ready",
+			providerSpec: `{"kubeconfig": "kubeconfig-content"}`,
+			nodes:        []runtime.Object{newTestNode("node-1", v1.ConditionTrue), newTestNode("node-2", v1.ConditionTrue)},
+			wantStatus:   api.ClusterProvisioned,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mockKubernetesClusterQuery(tt.providerSpec)
+			provider := newKubernetesProvider(db.NewMockConnectionFactory(nil), config.NewDataplaneClusterConfig())
+			provider.clientFactory = &fakeKubernetesClientFactory{clients: newFakeKubernetesClients(tt.nodes...)}
+
+			spec, err := provider.CheckClusterStatus(&types.ClusterSpec{InternalID: "test-cluster"})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(spec.Status).To(gomega.Equal(tt.wantStatus))
+				g.Expect(spec.StatusDetails).To(gomega.Equal(tt.wantStatusDetails))
+			}
+		})
+	}
+}
+
+func TestKubernetesProvider_ApplyResources(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mockKubernetesClusterQuery(`{"kubeconfig": "kubeconfig-content"}`)
+	clients := newFakeKubernetesClients()
+	provider := newKubernetesProvider(db.NewMockConnectionFactory(nil), config.NewDataplaneClusterConfig())
+	provider.clientFactory = &fakeKubernetesClientFactory{clients: clients}
+	clusterSpec := &types.ClusterSpec{InternalID: "test-cluster"}
+
+	_, err := provider.ApplyResources(clusterSpec, types.ResourceSet{
+		Name:      "test-resource-set",
+		Resources: []interface{}{newTestNamespace("namespace-1"), newTestNamespace("namespace-2")},
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(getInventory(g, clients, "test-resource-set")).To(gomega.Equal([]resourceReference{
+		{APIVersion: "v1", Kind: "Namespace", Name: "namespace-1"},
+		{APIVersion: "v1", Kind: "Namespace", Name: "namespace-2"},
+	}))
+
+	// the resources removed from the resource set are pruned
+	_, err = provider.ApplyResources(clusterSpec, types.ResourceSet{
+		Name:      "test-resource-set",
+		Resources: []interface{}{newTestNamespace("namespace-2")},
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(getInventory(g, clients, "test-resource-set")).To(gomega.Equal([]resourceReference{
+		{APIVersion: "v1", Kind: "Namespace", Name: "namespace-2"},
+	}))
+	namespaces, err := clients.dynamicClient.Resource(namespacesResource).List(ctx, metav1.ListOptions{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(namespaces.Items).To(gomega.HaveLen(1))
+	g.Expect(namespaces.Items[0].GetName()).To(gomega.Equal("namespace-2"))
+	_, found := namespaces.Items[0].Object["status"]
+	g.Expect(found).To(gomega.BeFalse())
+
+	// the resources of the resource set and its inventory are removed
+	err = provider.RemoveResources(clusterSpec, "test-resource-set")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	namespaces, err = clients.dynamicClient.Resource(namespacesResource).List(ctx, metav1.ListOptions{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(namespaces.Items).To(gomega.BeEmpty())
+	configMaps, err := clients.dynamicClient.Resource(configMapsResource).Namespace(resourceSetInventoryNamespace).List(ctx, metav1.ListOptions{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(configMaps.Items).To(gomega.BeEmpty())
+
+	// removing the resources of an unknown resource set does nothing
+	g.Expect(provider.RemoveResources(clusterSpec, "unknown-resource-set")).To(gomega.Succeed())
+}
+
+func TestKubernetesProvider_InstallStrimzi(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mockKubernetesClusterQuery(`{"kubeconfig": "kubeconfig-content"}`)
+	clients := newFakeKubernetesClients()
+	dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+	provider := newKubernetesProvider(db.NewMockConnectionFactory(nil), dataplaneClusterConfig)
+	provider.clientFactory = &fakeKubernetesClientFactory{clients: clients}
+
+	ready, err := provider.InstallStrimzi(&types.ClusterSpec{InternalID: "test-cluster"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(ready).To(gomega.BeTrue())
+
+	subscription, err := clients.dynamicClient.Resource(operatorsv1alpha1.SchemeGroupVersion.WithResource("subscriptions")).
+		Namespace(dataplaneClusterConfig.StrimziOperatorOLMConfig.Namespace).
+		Get(ctx, strimziOperatorSubscriptionName, metav1.GetOptions{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	packageName, _, _ := unstructured.NestedString(subscription.Object, "spec", "name")
+	g.Expect(packageName).To(gomega.Equal(dataplaneClusterConfig.StrimziOperatorOLMConfig.Package))
+}
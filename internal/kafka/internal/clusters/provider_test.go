@@ -30,6 +30,10 @@ func TestNewDefaultProviderFactory(t *testing.T) {
 			want: &DefaultProviderFactory{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
+					api.ClusterProviderKubernetes: &KubernetesProvider{
+						StandaloneProvider: &StandaloneProvider{},
+						clientFactory:      &kubeconfigClientFactory{},
+					},
 					api.ClusterProviderOCM: &OCMProvider{
 						clusterBuilder: &clusterBuilder{
 							idGenerator: ocm.NewIDGenerator("mk-"),
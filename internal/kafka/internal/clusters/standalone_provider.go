@@ -408,13 +408,23 @@ func (s *StandaloneProvider) ApplyResources(clusterSpec *types.ClusterSpec, reso
 }
 
 func (s *StandaloneProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return listCloudProvidersOfProviderType(s.connectionFactory, api.ClusterProviderStandalone)
+}
+
+func (s *StandaloneProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	return listCloudProviderRegionsOfProviderType(s.connectionFactory, api.ClusterProviderStandalone, providerInf)
+}
+
+// listCloudProvidersOfProviderType returns the cloud providers of the clusters, not being deleted, of the given provider type.
+// It is used by the providers which do not have a cloud provider API and only know about the clusters registered in the database.
+func listCloudProvidersOfProviderType(connectionFactory *db.ConnectionFactory, providerType api.ClusterProviderType) (*types.CloudProviderInfoList, error) {
 	type Cluster struct {
 		CloudProvider string
 	}
-	dbConn := s.connectionFactory.New().
+	dbConn := connectionFactory.New().
 		Model(&Cluster{}).
 		Distinct("cloud_provider").
-		Where("provider_type = ?", api.ClusterProviderStandalone.String()).
+		Where("provider_type = ?", providerType.String()).
 		Where("status NOT IN (?)", api.ClusterDeletionStatuses)
 
 	var results []Cluster
@@ -435,16 +445,17 @@ func (s *StandaloneProvider) GetCloudProviders() (*types.CloudProviderInfoList,
 	return &types.CloudProviderInfoList{Items: items}, nil
 }
 
-func (s *StandaloneProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+// listCloudProviderRegionsOfProviderType returns the regions of the given cloud provider where clusters, not being deleted, of the given provider type are
+func listCloudProviderRegionsOfProviderType(connectionFactory *db.ConnectionFactory, providerType api.ClusterProviderType, providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
 	type Cluster struct {
 		Region  string
 		MultiAZ bool
 	}
-	dbConn := s.connectionFactory.New().
+	dbConn := connectionFactory.New().
 		Model(&Cluster{}).
 		Distinct("region", "multi_az").
 		Where("cloud_provider = ?", providerInf.ID).
-		Where("provider_type = ?", api.ClusterProviderStandalone.String()).
+		Where("provider_type = ?", providerType.String()).
 		Where("status NOT IN (?)", api.ClusterDeletionStatuses)
 
 	var results []Cluster
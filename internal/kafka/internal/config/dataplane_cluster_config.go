@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
@@ -55,6 +56,20 @@ type DataplaneClusterConfig struct {
 	ObservabilityOperatorOLMConfig              OperatorInstallationConfig
 	DynamicScalingConfig                        DynamicScalingConfig
 	NodePrewarmingConfig                        NodePrewarmingConfig
+	// ClusterPlacementStrategy is the strategy used to select the cluster of the kafkas, read from the data plane cluster configuration file.
+	// Possible values are 'first-fit', 'least-loaded' and 'best-fit'.
+	ClusterPlacementStrategy string
+	// reloaded holds the reloadable fields once the configuration is reloaded. The fields above keep their boot values, the
+	// reloadable ones have to be read through their getters.
+	reloaded *atomic.Pointer[dataplaneClusterConfigSnapshot]
+}
+
+// dataplaneClusterConfigSnapshot holds the fields of the data plane cluster configuration that are replaced all together on reload
+type dataplaneClusterConfigSnapshot struct {
+	clusterConfig            *ClusterConfig
+	dynamicScalingConfig     DynamicScalingConfig
+	nodePrewarmingConfig     NodePrewarmingConfig
+	clusterPlacementStrategy string
 }
 
 type OperatorInstallationConfig struct {
@@ -76,6 +91,18 @@ const (
 	NoScaling string = "none"
 )
 
+const (
+	// FirstFitPlacementStrategy places a kafka on the first cluster able to host it
+	FirstFitPlacementStrategy string = "first-fit"
+	// LeastLoadedPlacementStrategy places a kafka on the cluster with the fewest consumed streaming units, spreading the kafkas across the clusters
+	LeastLoadedPlacementStrategy string = "least-loaded"
+	// BestFitPlacementStrategy places a kafka on the cluster with the fewest remaining streaming units once the kafka is placed,
+	// packing the kafkas so that the other clusters can be scaled down
+	BestFitPlacementStrategy string = "best-fit"
+)
+
+var validClusterPlacementStrategies = []string{FirstFitPlacementStrategy, LeastLoadedPlacementStrategy, BestFitPlacementStrategy}
+
 // constants for operators installation through OpenShift Lifecycle Manager (OLM)
 // in `standalone` cluster provider type
 const (
@@ -115,7 +142,7 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 		ClusterConfig:                               &ClusterConfig{},
 		EnableReadyDataPlaneClustersReconcile:       true,
 		EnableKafkaSreIdentityProviderConfiguration: true,
-		Kubeconfig:                                  getDefaultKubeconfig(),
+		Kubeconfig: getDefaultKubeconfig(),
 		StrimziOperatorOLMConfig: OperatorInstallationConfig{
 			IndexImage:             defaultStrimziOperatorIndexImage,
 			Namespace:              constants.StrimziOperatorNamespace,
@@ -136,9 +163,51 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 			IndexImage:              defaultObservabilityOperatorIndexImage,
 			SubscriptionStartingCSV: defaultObservabilityOperatorStartingCSV,
 		},
-		DynamicScalingConfig: NewDynamicScalingConfig(),
-		NodePrewarmingConfig: NewNodePrewarmingConfig(),
+		DynamicScalingConfig:     NewDynamicScalingConfig(),
+		NodePrewarmingConfig:     NewNodePrewarmingConfig(),
+		ClusterPlacementStrategy: FirstFitPlacementStrategy,
+		reloaded:                 &atomic.Pointer[dataplaneClusterConfigSnapshot]{},
+	}
+}
+
+// snapshot returns the last reloaded fields, or nil when the configuration has not been reloaded
+func (c *DataplaneClusterConfig) snapshot() *dataplaneClusterConfigSnapshot {
+	if c.reloaded == nil {
+		return nil
+	}
+	return c.reloaded.Load()
+}
+
+// GetClusterConfig returns the manual clusters configuration, as last reloaded
+func (c *DataplaneClusterConfig) GetClusterConfig() *ClusterConfig {
+	if snapshot := c.snapshot(); snapshot != nil {
+		return snapshot.clusterConfig
 	}
+	return c.ClusterConfig
+}
+
+// GetDynamicScalingConfig returns the dynamic scaling configuration, as last reloaded
+func (c *DataplaneClusterConfig) GetDynamicScalingConfig() *DynamicScalingConfig {
+	if snapshot := c.snapshot(); snapshot != nil {
+		return &snapshot.dynamicScalingConfig
+	}
+	return &c.DynamicScalingConfig
+}
+
+// GetNodePrewarmingConfig returns the node prewarming configuration, as last reloaded
+func (c *DataplaneClusterConfig) GetNodePrewarmingConfig() *NodePrewarmingConfig {
+	if snapshot := c.snapshot(); snapshot != nil {
+		return &snapshot.nodePrewarmingConfig
+	}
+	return &c.NodePrewarmingConfig
+}
+
+// GetClusterPlacementStrategy returns the cluster placement strategy, as last reloaded
+func (c *DataplaneClusterConfig) GetClusterPlacementStrategy() string {
+	if snapshot := c.snapshot(); snapshot != nil {
+		return snapshot.clusterPlacementStrategy
+	}
+	return c.ClusterPlacementStrategy
 }
 
 // manual cluster configuration
@@ -154,6 +223,10 @@ type ManualCluster struct {
 	ProviderType          api.ClusterProviderType `yaml:"provider_type"`
 	ClusterDNS            string                  `yaml:"cluster_dns"`
 	SupportedInstanceType string                  `yaml:"supported_instance_type"`
+	// KubeconfigFile is the path to the kubeconfig giving access to a kubernetes cluster
+	KubeconfigFile string `yaml:"kubeconfig_file"`
+	// Kubeconfig is the content of the kubeconfig file, read when loading the configuration
+	Kubeconfig string `yaml:"-"`
 }
 
 func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -187,6 +260,20 @@ func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		}
 	}
 
+	if c.ProviderType == api.ClusterProviderKubernetes {
+		if c.ClusterDNS == "" {
+			return errors.Errorf("kubernetes cluster with id %s does not have the cluster dns field provided", c.ClusterId)
+		}
+
+		if c.KubeconfigFile == "" {
+			return errors.Errorf("kubernetes cluster with id %s does not have the kubeconfig file field provided", c.ClusterId)
+		}
+
+		if c.Status == api.ClusterAccepted {
+			c.Status = api.ClusterProvisioning // force to cluster provisioning status as kubernetes clusters cannot be created by the KubernetesProvider.
+		}
+	}
+
 	if c.SupportedInstanceType == "" {
 		c.SupportedInstanceType = api.AllInstanceTypeSupport.String()
 	}
@@ -250,6 +337,15 @@ func (conf *ClusterConfig) IsNumberOfStreamingUnitsWithinClusterLimit(clusterID
 	return true
 }
 
+// GetClusterStreamingUnitLimit returns the maximum number of streaming units the cluster can host, or -1 when it is unlimited
+func (conf *ClusterConfig) GetClusterStreamingUnitLimit(clusterID string) int {
+	if manualCluster, exist := conf.clusterConfigMap[clusterID]; exist {
+		return manualCluster.KafkaInstanceLimit
+	}
+
+	return -1
+}
+
 func (conf *ClusterConfig) IsClusterSchedulable(clusterID string) bool {
 	if clusterConfigMap, exist := conf.clusterConfigMap[clusterID]; exist {
 		return clusterConfigMap.Schedulable
@@ -308,9 +404,10 @@ func (c *DataplaneClusterConfig) IsReadyDataPlaneClustersReconcileEnabled() bool
 // DefaultComputeMachinesConfig returns the Compute Machine config for the
 // given `cloudProviderID`. If `cloudProviderID` is not a known cloud provider return an error.
 func (c *DataplaneClusterConfig) DefaultComputeMachinesConfig(cloudProviderID cloudproviders.CloudProviderID) (ComputeMachinesConfig, error) {
-	config, ok := c.DynamicScalingConfig.ComputeMachinePerCloudProvider[cloudProviderID]
+	dynamicScalingConfig := c.GetDynamicScalingConfig()
+	config, ok := dynamicScalingConfig.ComputeMachinePerCloudProvider[cloudProviderID]
 	if !ok {
-		return ComputeMachinesConfig{}, errors.Errorf("cloud provider %q is missing from the 'compute_machine_per_cloud_provider' field in the %q dynamic scaling file", cloudProviderID.String(), c.DynamicScalingConfig.filePath)
+		return ComputeMachinesConfig{}, errors.Errorf("cloud provider %q is missing from the 'compute_machine_per_cloud_provider' field in the %q dynamic scaling file", cloudProviderID.String(), dynamicScalingConfig.filePath)
 	}
 
 	return config, nil
@@ -355,6 +452,10 @@ func (c *DataplaneClusterConfig) Validate(env *environments.Env) error {
 		}
 	}
 
+	if !arrays.Contains(validClusterPlacementStrategies, c.ClusterPlacementStrategy) {
+		return errors.Errorf("invalid cluster placement strategy %q supplied. Valid cluster placement strategies are %v", c.ClusterPlacementStrategy, validClusterPlacementStrategies)
+	}
+
 	return c.NodePrewarmingConfig.validate(kafkaConfig)
 }
 
@@ -370,32 +471,21 @@ func (c *DataplaneClusterConfig) ReadFiles() error {
 		}
 	}
 
+	// the placement strategy of the configuration file applies to all the scaling types, the file is only required with the manual scaling
+	dataPlaneClusterConfigFile, err := readDataPlaneClusterConfig(c.DataPlaneClusterConfigFile)
+	if err != nil && (c.IsDataPlaneManualScalingEnabled() || !os.IsNotExist(err)) {
+		return err
+	}
+	if dataPlaneClusterConfigFile != nil && dataPlaneClusterConfigFile.PlacementStrategy != "" {
+		c.ClusterPlacementStrategy = dataPlaneClusterConfigFile.PlacementStrategy
+	}
+
 	if c.IsDataPlaneManualScalingEnabled() {
-		list, err := readDataPlaneClusterConfig(c.DataPlaneClusterConfigFile)
-		if err == nil {
-			c.ClusterConfig = NewClusterConfig(list)
-		} else {
+		c.ClusterConfig, err = c.newManualClusterConfig(dataPlaneClusterConfigFile.ClusterList)
+		if err != nil {
 			return err
 		}
 
-		// read kubeconfig and validate standalone clusters are in kubeconfig context
-		for _, cluster := range c.ClusterConfig.clusterList {
-			if cluster.ProviderType != api.ClusterProviderStandalone {
-				continue
-			}
-			// make sure we only read kubeconfig once
-			if c.RawKubernetesConfig == nil {
-				err = c.readKubeconfig()
-				if err != nil {
-					return err
-				}
-			}
-			validationErr := validateClusterIsInKubeconfigContext(*c.RawKubernetesConfig, cluster)
-			if validationErr != nil {
-				return validationErr
-			}
-		}
-
 		err = readOperatorsSubscriptionConfigFile(c.StrimziOperatorOLMConfig.SubscriptionConfigFile, &c.StrimziOperatorOLMConfig.SubscriptionConfig)
 		if err != nil {
 			if os.IsNotExist(err) {
@@ -422,7 +512,7 @@ func (c *DataplaneClusterConfig) ReadFiles() error {
 		}
 	}
 
-	err := readOnlyUserListFile(c.ReadOnlyUserListFile, &c.ReadOnlyUserList)
+	err = readOnlyUserListFile(c.ReadOnlyUserListFile, &c.ReadOnlyUserList)
 	if err != nil {
 		return err
 	}
@@ -440,6 +530,112 @@ func (c *DataplaneClusterConfig) ReadFiles() error {
 	return nil
 }
 
+var _ environments.ReloadableConfigModule = &DataplaneClusterConfig{}
+
+// ConfigFiles returns the data plane cluster configuration file, the dynamic scaling configuration file
+// when the auto scaling is enabled, and the node prewarming configuration file
+func (c *DataplaneClusterConfig) ConfigFiles() []string {
+	files := []string{c.DataPlaneClusterConfigFile}
+	if c.IsDataPlaneAutoScalingEnabled() {
+		files = append(files, c.DynamicScalingConfig.filePath)
+	}
+	return append(files, c.NodePrewarmingConfig.filePath)
+}
+
+// Reload re-reads the cluster placement strategy and the manual clusters of the data plane cluster configuration file,
+// the dynamic scaling configuration and the node prewarming configuration. They are validated as on boot and are only
+// replaced all together when they are all valid. The other fields of the configuration can not be reloaded.
+// The reloaded fields are swapped as a single snapshot, read through the getters, so that the workers and the handlers
+// reading them while the configuration is reloaded always see a consistent configuration.
+func (c *DataplaneClusterConfig) Reload(env *environments.Env) error {
+	if c.reloaded == nil {
+		return errors.New("the data plane cluster configuration was not created with NewDataplaneClusterConfig and can not be reloaded")
+	}
+
+	var kafkaConfig *KafkaConfig
+	env.MustResolve(&kafkaConfig)
+
+	dataPlaneClusterConfigFile, err := readDataPlaneClusterConfig(c.DataPlaneClusterConfigFile)
+	if err != nil && (c.IsDataPlaneManualScalingEnabled() || !os.IsNotExist(err)) {
+		return err
+	}
+
+	clusterPlacementStrategy := c.GetClusterPlacementStrategy()
+	if dataPlaneClusterConfigFile != nil && dataPlaneClusterConfigFile.PlacementStrategy != "" {
+		clusterPlacementStrategy = dataPlaneClusterConfigFile.PlacementStrategy
+	}
+	if !arrays.Contains(validClusterPlacementStrategies, clusterPlacementStrategy) {
+		return errors.Errorf("invalid cluster placement strategy %q supplied. Valid cluster placement strategies are %v", clusterPlacementStrategy, validClusterPlacementStrategies)
+	}
+
+	clusterConfig := c.GetClusterConfig()
+	if c.IsDataPlaneManualScalingEnabled() {
+		clusterConfig, err = c.newManualClusterConfig(dataPlaneClusterConfigFile.ClusterList)
+		if err != nil {
+			return err
+		}
+	}
+
+	dynamicScalingConfig := *c.GetDynamicScalingConfig()
+	if c.IsDataPlaneAutoScalingEnabled() {
+		dynamicScalingConfig = NewDynamicScalingConfig()
+		dynamicScalingConfig.filePath = c.DynamicScalingConfig.filePath
+		if err := shared.ReadYamlFile(dynamicScalingConfig.filePath, &dynamicScalingConfig); err != nil {
+			return err
+		}
+		if err := dynamicScalingConfig.validate(); err != nil {
+			return err
+		}
+	}
+
+	nodePrewarmingConfig := NewNodePrewarmingConfig()
+	nodePrewarmingConfig.filePath = c.NodePrewarmingConfig.filePath
+	if err := nodePrewarmingConfig.readFile(); err != nil {
+		return err
+	}
+	if err := nodePrewarmingConfig.validate(kafkaConfig); err != nil {
+		return err
+	}
+
+	c.reloaded.Store(&dataplaneClusterConfigSnapshot{
+		clusterConfig:            clusterConfig,
+		dynamicScalingConfig:     dynamicScalingConfig,
+		nodePrewarmingConfig:     nodePrewarmingConfig,
+		clusterPlacementStrategy: clusterPlacementStrategy,
+	})
+	return nil
+}
+
+// newManualClusterConfig reads the kubeconfig of the kubernetes clusters of the list and validates that the
+// standalone clusters are in the kubeconfig context
+func (c *DataplaneClusterConfig) newManualClusterConfig(list ClusterList) (*ClusterConfig, error) {
+	for i := range list {
+		if list[i].ProviderType != api.ClusterProviderKubernetes {
+			continue
+		}
+		if err := shared.ReadFileValueString(list[i].KubeconfigFile, &list[i].Kubeconfig); err != nil {
+			return nil, errors.Wrapf(err, "failed to read the kubeconfig of kubernetes cluster with id %s", list[i].ClusterId)
+		}
+	}
+
+	for _, cluster := range list {
+		if cluster.ProviderType != api.ClusterProviderStandalone {
+			continue
+		}
+		// make sure we only read kubeconfig once
+		if c.RawKubernetesConfig == nil {
+			if err := c.readKubeconfig(); err != nil {
+				return nil, err
+			}
+		}
+		if err := validateClusterIsInKubeconfigContext(*c.RawKubernetesConfig, cluster); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewClusterConfig(list), nil
+}
+
 func (c *DataplaneClusterConfig) readKubeconfig() error {
 	_, err := os.Stat(c.Kubeconfig)
 	if err != nil {
@@ -466,20 +662,23 @@ func validateClusterIsInKubeconfigContext(rawConfig clientcmdapi.Config, cluster
 	return errors.Errorf("standalone cluster with ID: %s, and Name %s not in kubeconfig context", cluster.ClusterId, cluster.Name)
 }
 
-func readDataPlaneClusterConfig(file string) (ClusterList, error) {
+// dataPlaneClusterConfigFile is the content of the data plane cluster configuration file
+type dataPlaneClusterConfigFile struct {
+	PlacementStrategy string      `yaml:"placement_strategy"`
+	ClusterList       ClusterList `yaml:"clusters"`
+}
+
+func readDataPlaneClusterConfig(file string) (*dataPlaneClusterConfigFile, error) {
 	fileContents, err := shared.ReadFile(file)
 	if err != nil {
 		return nil, err
 	}
 
-	c := struct {
-		ClusterList ClusterList `yaml:"clusters"`
-	}{}
-
-	if err = yaml.Unmarshal([]byte(fileContents), &c); err != nil {
+	c := &dataPlaneClusterConfigFile{}
+	if err = yaml.Unmarshal([]byte(fileContents), c); err != nil {
 		return nil, err
 	} else {
-		return c.ClusterList, nil
+		return c, nil
 	}
 }
 
@@ -493,7 +692,7 @@ func readOperatorsSubscriptionConfigFile(file string, subscriptionConfig **opera
 }
 
 func (c *DataplaneClusterConfig) FindClusterNameByClusterId(clusterId string) string {
-	for _, cluster := range c.ClusterConfig.clusterList {
+	for _, cluster := range c.GetClusterConfig().clusterList {
 		if cluster.ClusterId == clusterId {
 			return cluster.Name
 		}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"gopkg.in/yaml.v2"
@@ -9,6 +11,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/goava/di"
 
 	"github.com/onsi/gomega"
 )
@@ -451,6 +455,54 @@ supported_instance_type: "developer"
 			},
 			wantErr: false,
 		},
+		{
+			name: "should return an error if no kubeconfig_file is set for kubernetes cluster",
+			input: `
+---
+name: "test"
+cluster_id: "test"
+cloud_provider: "aws"
+cluster_dns: "test"
+region: "east-1"
+provider_type: "kubernetes"
+`,
+			output: ManualCluster{
+				Name:                  "test",
+				ClusterId:             "test",
+				CloudProvider:         "aws",
+				ClusterDNS:            "test",
+				Region:                "east-1",
+				Status:                api.ClusterProvisioning,
+				ProviderType:          api.ClusterProviderKubernetes,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return no error if ProviderType is kubernetes and kubeconfig_file is set",
+			input: `
+---
+name: "test"
+cluster_id: "test"
+cloud_provider: "aws"
+cluster_dns: "test"
+region: "east-1"
+provider_type: "kubernetes"
+kubeconfig_file: "secrets/test.kubeconfig"
+`,
+			output: ManualCluster{
+				Name:                  "test",
+				ClusterId:             "test",
+				CloudProvider:         "aws",
+				ClusterDNS:            "test",
+				Region:                "east-1",
+				Status:                api.ClusterProvisioning,
+				ProviderType:          api.ClusterProviderKubernetes,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+				KubeconfigFile:        "secrets/test.kubeconfig",
+			},
+			wantErr: false,
+		},
 		{
 			name: "should assign all instance types if supported_instance_type value is empty",
 			input: `
@@ -994,6 +1046,64 @@ func Test_ReadFiles(t *testing.T) {
 	}
 }
 
+func Test_ReadFiles_ClusterPlacementStrategy(t *testing.T) {
+	tests := []struct {
+		name         string
+		scalingType  string
+		fileContent  string
+		wantStrategy string
+		wantErr      bool
+	}{
+		{
+			name:         "should default to the first-fit strategy when the configuration file does not set it",
+			scalingType:  ManualScaling,
+			fileContent:  "clusters: []",
+			wantStrategy: FirstFitPlacementStrategy,
+		},
+		{
+			name:         "should read the placement strategy from the configuration file",
+			scalingType:  ManualScaling,
+			fileContent:  "placement_strategy: least-loaded\nclusters: []",
+			wantStrategy: LeastLoadedPlacementStrategy,
+		},
+		{
+			name:         "should read the placement strategy from the configuration file when manual scaling is disabled",
+			scalingType:  NoScaling,
+			fileContent:  "placement_strategy: best-fit",
+			wantStrategy: BestFitPlacementStrategy,
+		},
+		{
+			name:         "should not return an error when the configuration file does not exist and manual scaling is disabled",
+			scalingType:  NoScaling,
+			wantStrategy: FirstFitPlacementStrategy,
+		},
+		{
+			name:        "should return an error when the configuration file does not exist and manual scaling is enabled",
+			scalingType: ManualScaling,
+			wantErr:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			config := NewDataplaneClusterConfig()
+			config.ImagePullDockerConfigFile = ""
+			config.DataPlaneClusterScalingType = tt.scalingType
+			config.DataPlaneClusterConfigFile = filepath.Join(t.TempDir(), "dataplane-cluster-configuration.yaml")
+			if tt.fileContent != "" {
+				g.Expect(os.WriteFile(config.DataPlaneClusterConfigFile, []byte(tt.fileContent), 0600)).To(gomega.Succeed())
+			}
+			err := config.ReadFiles()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(config.ClusterPlacementStrategy).To(gomega.Equal(tt.wantStrategy))
+			}
+		})
+	}
+}
+
 func Test_readKubeconfig(t *testing.T) {
 	type fields struct {
 		config *DataplaneClusterConfig
@@ -1030,3 +1140,94 @@ func Test_readKubeconfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_DataplaneClusterConfig_Reload(t *testing.T) {
+	tests := []struct {
+		name               string
+		fileContent        string
+		nodePrewarming     string
+		wantErr            bool
+		wantStrategy       string
+		wantManualClusters int
+	}{
+		{
+			name:               "should replace the placement strategy and the manual clusters when they are valid",
+			fileContent:        "placement_strategy: least-loaded\nclusters:\n- name: a-cluster\n  cluster_id: cluster-id\n  cloud_provider: aws\n  region: us-east-1\n  multi_az: true\n  schedulable: true\n  kafka_instance_limit: 2\n  supported_instance_type: standard\n",
+			nodePrewarming:     "{}",
+			wantStrategy:       LeastLoadedPlacementStrategy,
+			wantManualClusters: 1,
+		},
+		{
+			name:           "should keep the configuration when the placement strategy is invalid",
+			fileContent:    "placement_strategy: random\nclusters: []\n",
+			nodePrewarming: "{}",
+			wantErr:        true,
+			wantStrategy:   FirstFitPlacementStrategy,
+		},
+		{
+			name:           "should keep the configuration when the node prewarming configuration is invalid",
+			fileContent:    "placement_strategy: best-fit\nclusters: []\n",
+			nodePrewarming: "standard:\n  num_reserved_instances: -1\n",
+			wantErr:        true,
+			wantStrategy:   FirstFitPlacementStrategy,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaConfig := NewKafkaConfig()
+			g.Expect(kafkaConfig.ReadFiles()).To(gomega.Succeed())
+			env, err := environments.New(environments.TestingEnv, di.ProvideValue(kafkaConfig))
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			dir := t.TempDir()
+			config := NewDataplaneClusterConfig()
+			config.DataPlaneClusterConfigFile = filepath.Join(dir, "dataplane-cluster-configuration.yaml")
+			config.NodePrewarmingConfig.filePath = filepath.Join(dir, "node-prewarming-configuration.yaml")
+			g.Expect(os.WriteFile(config.DataPlaneClusterConfigFile, []byte(tt.fileContent), 0600)).To(gomega.Succeed())
+			g.Expect(os.WriteFile(config.NodePrewarmingConfig.filePath, []byte(tt.nodePrewarming), 0600)).To(gomega.Succeed())
+			g.Expect(config.ConfigFiles()).To(gomega.ConsistOf(config.DataPlaneClusterConfigFile, config.NodePrewarmingConfig.filePath))
+
+			err = config.Reload(env)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(config.GetClusterPlacementStrategy()).To(gomega.Equal(tt.wantStrategy))
+			g.Expect(config.GetClusterConfig().GetManualClusters()).To(gomega.HaveLen(tt.wantManualClusters))
+			// the boot values are kept, the reloaded ones are only read through the getters
+			g.Expect(config.ClusterPlacementStrategy).To(gomega.Equal(FirstFitPlacementStrategy))
+		})
+	}
+}
+
+func Test_DataplaneClusterConfig_ReloadWhileRead(t *testing.T) {
+	g := gomega.NewWithT(t)
+	kafkaConfig := NewKafkaConfig()
+	g.Expect(kafkaConfig.ReadFiles()).To(gomega.Succeed())
+	env, err := environments.New(environments.TestingEnv, di.ProvideValue(kafkaConfig))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	dir := t.TempDir()
+	config := NewDataplaneClusterConfig()
+	config.DataPlaneClusterConfigFile = filepath.Join(dir, "dataplane-cluster-configuration.yaml")
+	config.NodePrewarmingConfig.filePath = filepath.Join(dir, "node-prewarming-configuration.yaml")
+	g.Expect(os.WriteFile(config.DataPlaneClusterConfigFile, []byte("placement_strategy: best-fit\nclusters: []\n"), 0600)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(config.NodePrewarmingConfig.filePath, []byte("{}"), 0600)).To(gomega.Succeed())
+
+	// the reads run concurrently with the reloads, the race detector reports any field written by the reloads
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			g.Expect(config.GetClusterPlacementStrategy()).To(gomega.BeElementOf(FirstFitPlacementStrategy, BestFitPlacementStrategy))
+			g.Expect(config.GetClusterConfig().GetManualClusters()).To(gomega.BeEmpty())
+			g.Expect(config.GetDynamicScalingConfig().IsDataplaneScaleUpTriggerEnabled()).To(gomega.BeTrue())
+			g.Expect(config.GetNodePrewarmingConfig().Configuration).To(gomega.BeEmpty())
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		g.Expect(config.Reload(env)).To(gomega.Succeed())
+	}
+	<-done
+	g.Expect(config.GetClusterPlacementStrategy()).To(gomega.Equal(BestFitPlacementStrategy))
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	Route53DNSProvider  = "route53"
+	RFC2136DNSProvider  = "rfc2136"
+	InMemoryDNSProvider = "in-memory"
+	// LocalDNSProvider does not register the CNAME records in any DNS server. The records are exported through the
+	// admin API instead so that they can be loaded in the DNS of air-gapped installations
+	LocalDNSProvider = "local"
+)
+
+var validDNSProviders = []string{Route53DNSProvider, RFC2136DNSProvider, InMemoryDNSProvider, LocalDNSProvider}
+var validTSIGAlgorithms = []string{"hmac-sha1.", "hmac-sha224.", "hmac-sha256.", "hmac-sha384.", "hmac-sha512."}
+
+// DNSConfig holds the configuration of the DNS provider used to register the CNAME records of the kafka routes
+type DNSConfig struct {
+	Provider string
+	RFC2136  RFC2136DNSConfig
+}
+
+type RFC2136DNSConfig struct {
+	// Nameserver is the address, in the host:port form, of the authoritative nameserver accepting the dynamic updates
+	Nameserver string `validate:"required,hostname_port"`
+	// Zone is the DNS zone to update. The kafka domain name is used when empty
+	Zone               string
+	TSIGKeyName        string
+	TSIGAlgorithm      string
+	TSIGSecret         string
+	TSIGSecretFilePath string
+	TTL                int64         `validate:"gt=0"`
+	Timeout            time.Duration `validate:"gt=0"`
+}
+
+func NewDNSConfig() *DNSConfig {
+	return &DNSConfig{
+		Provider: Route53DNSProvider,
+		RFC2136: RFC2136DNSConfig{
+			TSIGAlgorithm:      "hmac-sha256.",
+			TSIGSecretFilePath: "secrets/dns-rfc2136-tsig-secret",
+			TTL:                300,
+			Timeout:            10 * time.Second,
+		},
+	}
+}
+
+func (c *DNSConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Provider, "dns-provider", c.Provider, "The DNS provider used to register the kafka CNAME records: Supported values are 'route53', 'rfc2136', 'in-memory', 'local'. The default value is 'route53'")
+	fs.StringVar(&c.RFC2136.Nameserver, "dns-rfc2136-nameserver", c.RFC2136.Nameserver, "The host:port address of the nameserver receiving the RFC 2136 dynamic updates")
+	fs.StringVar(&c.RFC2136.Zone, "dns-rfc2136-zone", c.RFC2136.Zone, "The DNS zone updated through RFC 2136. Defaults to the kafka domain name")
+	fs.StringVar(&c.RFC2136.TSIGKeyName, "dns-rfc2136-tsig-key-name", c.RFC2136.TSIGKeyName, "The name of the TSIG key signing the RFC 2136 dynamic updates. The updates are not signed when empty")
+	fs.StringVar(&c.RFC2136.TSIGAlgorithm, "dns-rfc2136-tsig-algorithm", c.RFC2136.TSIGAlgorithm, "The algorithm of the TSIG key signing the RFC 2136 dynamic updates")
+	fs.StringVar(&c.RFC2136.TSIGSecretFilePath, "dns-rfc2136-tsig-secret-file", c.RFC2136.TSIGSecretFilePath, "File containing the base64 encoded secret of the TSIG key")
+	fs.Int64Var(&c.RFC2136.TTL, "dns-rfc2136-ttl", c.RFC2136.TTL, "The TTL in seconds of the CNAME records created through RFC 2136")
+	fs.DurationVar(&c.RFC2136.Timeout, "dns-rfc2136-timeout", c.RFC2136.Timeout, "The timeout of the RFC 2136 dynamic update requests")
+}
+
+func (c *DNSConfig) ReadFiles() error {
+	if c.Provider == RFC2136DNSProvider && c.RFC2136.TSIGKeyName != "" {
+		return shared.ReadFileValueString(c.RFC2136.TSIGSecretFilePath, &c.RFC2136.TSIGSecret)
+	}
+
+	return nil
+}
+
+func (c *DNSConfig) Validate(env *environments.Env) error {
+	if !arrays.Contains(validDNSProviders, c.Provider) {
+		return fmt.Errorf("invalid dns provider %q supplied. Valid dns providers are %v", c.Provider, validDNSProviders)
+	}
+
+	if c.Provider != RFC2136DNSProvider {
+		return nil
+	}
+
+	if err := validator.New().Struct(c.RFC2136); err != nil {
+		return errors.Wrap(err, "error validating the rfc2136 dns provider configuration")
+	}
+
+	if c.RFC2136.TSIGKeyName != "" {
+		if !arrays.Contains(validTSIGAlgorithms, c.RFC2136.TSIGAlgorithm) {
+			return fmt.Errorf("invalid tsig algorithm %q supplied. Valid tsig algorithms are %v", c.RFC2136.TSIGAlgorithm, validTSIGAlgorithms)
+		}
+		if c.RFC2136.TSIGSecret == "" {
+			return fmt.Errorf("the tsig secret is required when the tsig key name is set")
+		}
+	}
+
+	return nil
+}
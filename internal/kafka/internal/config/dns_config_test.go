@@ -0,0 +1,86 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/onsi/gomega"
+)
+
+func TestDNSConfig_Validate(t *testing.T) {
+	validRFC2136Config := func() RFC2136DNSConfig {
+		c := NewDNSConfig().RFC2136
+		c.Nameserver = "127.0.0.1:53"
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		config  *DNSConfig
+		wantErr bool
+	}{
+		{
+			name:    "should not return an error for the default configuration",
+			config:  NewDNSConfig(),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error when the dns provider is invalid",
+			config:  &DNSConfig{Provider: "some-provider"},
+			wantErr: true,
+		},
+		{
+			name:    "should not return an error when the rfc2136 configuration is invalid but another provider is used",
+			config:  &DNSConfig{Provider: InMemoryDNSProvider},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error when the rfc2136 nameserver is missing",
+			config:  &DNSConfig{Provider: RFC2136DNSProvider, RFC2136: NewDNSConfig().RFC2136},
+			wantErr: true,
+		},
+		{
+			name:    "should not return an error when the rfc2136 updates are not signed",
+			config:  &DNSConfig{Provider: RFC2136DNSProvider, RFC2136: validRFC2136Config()},
+			wantErr: false,
+		},
+		{
+			name: "should return an error when the tsig secret is missing",
+			config: &DNSConfig{Provider: RFC2136DNSProvider, RFC2136: func() RFC2136DNSConfig {
+				c := validRFC2136Config()
+				c.TSIGKeyName = "kas-fleet-manager."
+				return c
+			}()},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the tsig algorithm is invalid",
+			config: &DNSConfig{Provider: RFC2136DNSProvider, RFC2136: func() RFC2136DNSConfig {
+				c := validRFC2136Config()
+				c.TSIGKeyName = "kas-fleet-manager."
+				c.TSIGSecret = "c2VjcmV0"
+				c.TSIGAlgorithm = "md5"
+				return c
+			}()},
+			wantErr: true,
+		},
+		{
+			name: "should not return an error when the rfc2136 updates are signed",
+			config: &DNSConfig{Provider: RFC2136DNSProvider, RFC2136: func() RFC2136DNSConfig {
+				c := validRFC2136Config()
+				c.TSIGKeyName = "kas-fleet-manager."
+				c.TSIGSecret = "c2VjcmV0"
+				return c
+			}()},
+			wantErr: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(tt.config.Validate(&environments.Env{}) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/pkg/errors"
@@ -15,6 +16,9 @@ type DynamicScalingConfig struct {
 	EnableDynamicScaleUpManagerScaleUpTrigger     bool                                                     `yaml:"enable_dynamic_data_plane_scale_up"`
 	EnableDynamicScaleDownManagerScaleDownTrigger bool                                                     `yaml:"enable_dynamic_data_plane_scale_down"`
 	NewDataPlaneOpenShiftVersion                  string                                                   `yaml:"new_data_plane_openshift_version"`
+	PredictiveScaleUp                             PredictiveScaleUpConfig                                  `yaml:"predictive_scale_up"`
+	Consolidation                                 ConsolidationConfig                                      `yaml:"consolidation"`
+	FailedClusterRetry                            FailedClusterRetryConfig                                 `yaml:"failed_cluster_retry"`
 }
 
 func NewDynamicScalingConfig() DynamicScalingConfig {
@@ -27,6 +31,9 @@ func NewDynamicScalingConfig() DynamicScalingConfig {
 		// as the fleetshard operator addon is currently incompatible with 4.12.
 		// To be set back to an empty string once https://issues.redhat.com/browse/MGDSTRM-10450 is resolved.
 		NewDataPlaneOpenShiftVersion: "openshift-v4.11.22",
+		PredictiveScaleUp:            NewPredictiveScaleUpConfig(),
+		Consolidation:                NewConsolidationConfig(),
+		FailedClusterRetry:           NewFailedClusterRetryConfig(),
 	}
 }
 
@@ -51,9 +58,166 @@ func (c *DynamicScalingConfig) validate() error {
 		}
 	}
 
+	err = c.PredictiveScaleUp.validate()
+	if err != nil {
+		return err
+	}
+
+	err = c.Consolidation.validate()
+	if err != nil {
+		return err
+	}
+
+	return c.FailedClusterRetry.validate()
+}
+
+// PredictiveScaleUpConfig configures the creation of data plane clusters ahead of the demand forecast from the
+// kafkas created in the past and from the expected demand schedules
+type PredictiveScaleUpConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// LookbackPeriod is the period of the kafka creation history the demand is forecast from
+	LookbackPeriod time.Duration `yaml:"lookback_period"`
+	// ForecastHorizon is how far ahead the demand is forecast. It should cover the time needed to create a data plane cluster
+	ForecastHorizon         time.Duration            `yaml:"forecast_horizon"`
+	ExpectedDemandSchedules []ExpectedDemandSchedule `yaml:"expected_demand_schedules"`
+}
+
+func NewPredictiveScaleUpConfig() PredictiveScaleUpConfig {
+	return PredictiveScaleUpConfig{
+		Enabled:         false,
+		LookbackPeriod:  14 * 24 * time.Hour,
+		ForecastHorizon: time.Hour,
+	}
+}
+
+func (c *PredictiveScaleUpConfig) IsPredictiveScaleUpEnabled() bool {
+	return c.Enabled
+}
+
+// validate validates the configuration when the predictive scale up is enabled
+func (c *PredictiveScaleUpConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ForecastHorizon <= 0 {
+		return errors.Errorf("predictive scale up forecast horizon %q has to be greater than 0", c.ForecastHorizon)
+	}
+	if c.LookbackPeriod < c.ForecastHorizon {
+		return errors.Errorf("predictive scale up lookback period %q has to be greater or equal to the forecast horizon %q", c.LookbackPeriod, c.ForecastHorizon)
+	}
+
+	for _, schedule := range c.ExpectedDemandSchedules {
+		err := validate.Struct(schedule)
+		if err != nil {
+			return errors.Wrapf(err, "error validating expected demand schedule %q", schedule.Name)
+		}
+		if !schedule.End.After(schedule.Start) {
+			return errors.Errorf("the end of expected demand schedule %q has to be after its start", schedule.Name)
+		}
+	}
+
 	return nil
 }
 
+// ConsolidationConfig configures the consolidation of the kafkas of the underutilised data plane clusters onto the
+// other clusters of their region, so that the emptied clusters are removed by the dynamic scale down
+type ConsolidationConfig struct {
+	// Enabled controls whether the planned kafka moves are started. Otherwise, the plan is only available to the admins
+	Enabled bool `yaml:"enabled"`
+	// MaxUtilisationPercentage is the percentage of its streaming units capacity up to which a cluster is underutilised
+	MaxUtilisationPercentage int `yaml:"max_utilisation_percentage"`
+}
+
+func NewConsolidationConfig() ConsolidationConfig {
+	return ConsolidationConfig{
+		Enabled:                  false,
+		MaxUtilisationPercentage: 20,
+	}
+}
+
+func (c *ConsolidationConfig) IsConsolidationEnabled() bool {
+	return c.Enabled
+}
+
+func (c *ConsolidationConfig) validate() error {
+	if c.MaxUtilisationPercentage < 0 || c.MaxUtilisationPercentage > 100 {
+		return errors.Errorf("consolidation max utilisation percentage %d has to be between 0 and 100", c.MaxUtilisationPercentage)
+	}
+	return nil
+}
+
+// FailedClusterRetryConfig configures the replacement of the data plane clusters that failed to be created. A failed
+// cluster is deprovisioned and replaced by a new cluster with the same attributes once its backoff has elapsed.
+// The backoff starts at InitialBackoff and doubles with each failed attempt up to MaxBackoff.
+type FailedClusterRetryConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// MaxRetries is the number of times a failed cluster is replaced. A cluster failing once the retries are exhausted
+	// is deprovisioned without being replaced
+	MaxRetries     int           `yaml:"max_retries"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+func NewFailedClusterRetryConfig() FailedClusterRetryConfig {
+	return FailedClusterRetryConfig{
+		Enabled:        false,
+		MaxRetries:     3,
+		InitialBackoff: 10 * time.Minute,
+		MaxBackoff:     2 * time.Hour,
+	}
+}
+
+func (c *FailedClusterRetryConfig) IsFailedClusterRetryEnabled() bool {
+	return c.Enabled
+}
+
+// Backoff returns how long to wait after the failure of the given creation attempt, starting at 1, before retrying it
+func (c *FailedClusterRetryConfig) Backoff(attempt int) time.Duration {
+	backoff := c.InitialBackoff
+	for i := 1; i < attempt && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.MaxBackoff {
+		return c.MaxBackoff
+	}
+	return backoff
+}
+
+// validate validates the configuration when the failed cluster retry is enabled
+func (c *FailedClusterRetryConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxRetries < 0 {
+		return errors.Errorf("failed cluster retry max retries %d has to be greater or equal to 0", c.MaxRetries)
+	}
+	if c.InitialBackoff <= 0 {
+		return errors.Errorf("failed cluster retry initial backoff %q has to be greater than 0", c.InitialBackoff)
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		return errors.Errorf("failed cluster retry max backoff %q has to be greater or equal to the initial backoff %q", c.MaxBackoff, c.InitialBackoff)
+	}
+	return nil
+}
+
+// ExpectedDemandSchedule is a period during which a demand of streaming units is expected for an instance type in
+// a region, e.g. because of a planned event. The expected streaming units are added to the forecast demand when the
+// period overlaps the forecast horizon.
+type ExpectedDemandSchedule struct {
+	Name                   string    `yaml:"name" validate:"required"`
+	CloudProvider          string    `yaml:"cloud_provider" validate:"required"`
+	Region                 string    `yaml:"region" validate:"required"`
+	InstanceType           string    `yaml:"instance_type" validate:"required"`
+	Start                  time.Time `yaml:"start" validate:"required"`
+	End                    time.Time `yaml:"end" validate:"required"`
+	ExpectedStreamingUnits int       `yaml:"expected_streaming_units" validate:"gt=0"`
+}
+
+// Overlaps returns whether the schedule overlaps the period between from and to
+func (s ExpectedDemandSchedule) Overlaps(from time.Time, to time.Time) bool {
+	return s.Start.Before(to) && s.End.After(from)
+}
+
 type ComputeNodesAutoscalingConfig struct {
 	MaxComputeNodes int `yaml:"max_compute_nodes" validate:"gt=0,gtefield=MinComputeNodes"`
 	MinComputeNodes int `yaml:"min_compute_nodes" validate:"gt=0"`
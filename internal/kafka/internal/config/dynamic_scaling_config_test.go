@@ -1,7 +1,9 @@
 package config
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/onsi/gomega"
@@ -503,3 +505,206 @@ func TestDynamicScalingConfig_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPredictiveScaleUpConfig_validate(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+	validSchedule := ExpectedDemandSchedule{
+		Name:                   "product-launch",
+		CloudProvider:          "aws",
+		Region:                 "us-east-1",
+		InstanceType:           "standard",
+		Start:                  start,
+		End:                    start.Add(2 * time.Hour),
+		ExpectedStreamingUnits: 10,
+	}
+
+	tests := []struct {
+		name    string
+		config  PredictiveScaleUpConfig
+		wantErr bool
+	}{
+		{
+			name:    "should not return an error when the predictive scale up is disabled",
+			config:  PredictiveScaleUpConfig{Enabled: false},
+			wantErr: false,
+		},
+		{
+			name: "should not return an error when the configuration is valid",
+			config: PredictiveScaleUpConfig{
+				Enabled:                 true,
+				LookbackPeriod:          14 * 24 * time.Hour,
+				ForecastHorizon:         time.Hour,
+				ExpectedDemandSchedules: []ExpectedDemandSchedule{validSchedule},
+			},
+			wantErr: false,
+		},
+		{
+			name: "return an error when the forecast horizon is not set",
+			config: PredictiveScaleUpConfig{
+				Enabled:        true,
+				LookbackPeriod: 14 * 24 * time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "return an error when the lookback period is shorter than the forecast horizon",
+			config: PredictiveScaleUpConfig{
+				Enabled:         true,
+				LookbackPeriod:  time.Hour,
+				ForecastHorizon: 2 * time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "return an error when a schedule ends before it starts",
+			config: PredictiveScaleUpConfig{
+				Enabled:         true,
+				LookbackPeriod:  14 * 24 * time.Hour,
+				ForecastHorizon: time.Hour,
+				ExpectedDemandSchedules: []ExpectedDemandSchedule{
+					{
+						Name:                   validSchedule.Name,
+						CloudProvider:          validSchedule.CloudProvider,
+						Region:                 validSchedule.Region,
+						InstanceType:           validSchedule.InstanceType,
+						Start:                  validSchedule.End,
+						End:                    validSchedule.Start,
+						ExpectedStreamingUnits: validSchedule.ExpectedStreamingUnits,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "return an error when a schedule does not expect any streaming unit",
+			config: PredictiveScaleUpConfig{
+				Enabled:         true,
+				LookbackPeriod:  14 * 24 * time.Hour,
+				ForecastHorizon: time.Hour,
+				ExpectedDemandSchedules: []ExpectedDemandSchedule{
+					{
+						Name:          validSchedule.Name,
+						CloudProvider: validSchedule.CloudProvider,
+						Region:        validSchedule.Region,
+						InstanceType:  validSchedule.InstanceType,
+						Start:         validSchedule.Start,
+						End:           validSchedule.End,
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			err := testcase.config.validate()
+			g.Expect(err != nil).To(gomega.Equal(testcase.wantErr))
+		})
+	}
+}
+
+func TestConsolidationConfig_validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		config  ConsolidationConfig
+		wantErr bool
+	}{
+		{
+			name:    "should not return an error when the max utilisation percentage is within 0 and 100",
+			config:  NewConsolidationConfig(),
+			wantErr: false,
+		},
+		{
+			name:    "return an error when the max utilisation percentage is greater than 100",
+			config:  ConsolidationConfig{Enabled: true, MaxUtilisationPercentage: 150},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when the max utilisation percentage is negative",
+			config:  ConsolidationConfig{MaxUtilisationPercentage: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			g.Expect(tt.config.validate() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestFailedClusterRetryConfig_validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		config  FailedClusterRetryConfig
+		wantErr bool
+	}{
+		{
+			name:    "should not return an error when the failed cluster retry is disabled",
+			config:  FailedClusterRetryConfig{Enabled: false, MaxRetries: -1},
+			wantErr: false,
+		},
+		{
+			name:    "should not return an error when the default configuration is enabled",
+			config:  FailedClusterRetryConfig{Enabled: true, MaxRetries: 3, InitialBackoff: 10 * time.Minute, MaxBackoff: 2 * time.Hour},
+			wantErr: false,
+		},
+		{
+			name:    "return an error when the max retries is negative",
+			config:  FailedClusterRetryConfig{Enabled: true, MaxRetries: -1, InitialBackoff: time.Minute, MaxBackoff: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when the initial backoff is not set",
+			config:  FailedClusterRetryConfig{Enabled: true, MaxRetries: 3, MaxBackoff: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "return an error when the max backoff is lower than the initial backoff",
+			config:  FailedClusterRetryConfig{Enabled: true, MaxRetries: 3, InitialBackoff: time.Hour, MaxBackoff: time.Minute},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			g.Expect(tt.config.validate() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestFailedClusterRetryConfig_Backoff(t *testing.T) {
+	t.Parallel()
+	config := FailedClusterRetryConfig{Enabled: true, MaxRetries: 5, InitialBackoff: 10 * time.Minute, MaxBackoff: time.Hour}
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: 10 * time.Minute},
+		{attempt: 2, want: 20 * time.Minute},
+		{attempt: 3, want: 40 * time.Minute},
+		{attempt: 4, want: time.Hour},
+		{attempt: 50, want: time.Hour},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(fmt.Sprintf("attempt %d", tt.attempt), func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			g.Expect(config.Backoff(tt.attempt)).To(gomega.Equal(tt.want))
+		})
+	}
+}
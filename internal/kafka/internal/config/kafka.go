@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"sync/atomic"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
@@ -19,6 +21,15 @@ type KafkaConfig struct {
 	EnableKafkaOwnerConfig bool
 	KafkaOwnerList         []string
 	KafkaOwnerListFile     string
+	// reloaded holds the reloadable fields once the configuration is reloaded. The fields above keep their boot values, the
+	// reloadable ones have to be read through their getters.
+	reloaded *atomic.Pointer[kafkaConfigSnapshot]
+}
+
+// kafkaConfigSnapshot holds the fields of the kafka configuration that are replaced all together on reload
+type kafkaConfigSnapshot struct {
+	supportedInstanceTypes SupportedKafkaInstanceTypesConfig
+	kafkaOwnerList         []string
 }
 
 func NewKafkaConfig() *KafkaConfig {
@@ -31,7 +42,32 @@ func NewKafkaConfig() *KafkaConfig {
 		SupportedInstanceTypes:       NewKafkaSupportedInstanceTypesConfig(),
 		KafkaOwnerListFile:           "config/kafka-owner-list.yaml",
 		BrowserUrl:                   "http://localhost:8080/",
+		reloaded:                     &atomic.Pointer[kafkaConfigSnapshot]{},
+	}
+}
+
+// snapshot returns the last reloaded fields, or nil when the configuration has not been reloaded
+func (c *KafkaConfig) snapshot() *kafkaConfigSnapshot {
+	if c.reloaded == nil {
+		return nil
+	}
+	return c.reloaded.Load()
+}
+
+// GetSupportedInstanceTypes returns the supported instance types configuration, as last reloaded
+func (c *KafkaConfig) GetSupportedInstanceTypes() *SupportedKafkaInstanceTypesConfig {
+	if snapshot := c.snapshot(); snapshot != nil {
+		return &snapshot.supportedInstanceTypes
 	}
+	return &c.SupportedInstanceTypes.Configuration
+}
+
+// GetKafkaOwnerList returns the kafka owner list, as last reloaded
+func (c *KafkaConfig) GetKafkaOwnerList() []string {
+	if snapshot := c.snapshot(); snapshot != nil {
+		return snapshot.kafkaOwnerList
+	}
+	return c.KafkaOwnerList
 }
 
 func (c *KafkaConfig) AddFlags(fs *pflag.FlagSet) {
@@ -67,8 +103,55 @@ func (c *KafkaConfig) Validate(env *environments.Env) error {
 	return c.SupportedInstanceTypes.Configuration.validate()
 }
 
+var _ environments.ReloadableConfigModule = &KafkaConfig{}
+
+// ConfigFiles returns the supported instance types configuration file and, when enabled, the kafka owner list file
+func (c *KafkaConfig) ConfigFiles() []string {
+	files := []string{c.SupportedInstanceTypes.ConfigurationFile}
+	if c.EnableKafkaOwnerConfig {
+		files = append(files, c.KafkaOwnerListFile)
+	}
+	return files
+}
+
+// Reload re-reads the supported instance types and the kafka owner list. They are only replaced when the supported
+// instance types are valid and the node prewarming configuration is still valid against them. Both are swapped as a
+// single snapshot, read through the getters.
+func (c *KafkaConfig) Reload(env *environments.Env) error {
+	if c.reloaded == nil {
+		return errors.New(errors.ErrorGeneral, "the kafka configuration was not created with NewKafkaConfig and can not be reloaded")
+	}
+
+	var supportedInstanceTypes SupportedKafkaInstanceTypesConfig
+	if err := shared.ReadYamlFile(c.SupportedInstanceTypes.ConfigurationFile, &supportedInstanceTypes); err != nil {
+		return err
+	}
+	if err := supportedInstanceTypes.validate(); err != nil {
+		return err
+	}
+
+	kafkaOwnerList := c.GetKafkaOwnerList()
+	if c.EnableKafkaOwnerConfig {
+		kafkaOwnerList = []string{}
+		if err := shared.ReadYamlFile(c.KafkaOwnerListFile, &kafkaOwnerList); err != nil {
+			return err
+		}
+	}
+
+	// the node prewarming configuration refers to the sizes of the instance types
+	var dataplaneClusterConfig *DataplaneClusterConfig
+	env.MustResolve(&dataplaneClusterConfig)
+	reloaded := &KafkaConfig{SupportedInstanceTypes: &KafkaSupportedInstanceTypesConfig{Configuration: supportedInstanceTypes}}
+	if err := dataplaneClusterConfig.GetNodePrewarmingConfig().validate(reloaded); err != nil {
+		return err
+	}
+
+	c.reloaded.Store(&kafkaConfigSnapshot{supportedInstanceTypes: supportedInstanceTypes, kafkaOwnerList: kafkaOwnerList})
+	return nil
+}
+
 func (c *KafkaConfig) GetFirstAvailableSize(instanceType string) (*KafkaInstanceSize, error) {
-	kafkaInstanceType, err := c.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+	kafkaInstanceType, err := c.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +162,7 @@ func (c *KafkaConfig) GetFirstAvailableSize(instanceType string) (*KafkaInstance
 }
 
 func (c *KafkaConfig) GetKafkaInstanceSize(instanceType, sizeId string) (*KafkaInstanceSize, error) {
-	kafkaInstanceType, err := c.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+	kafkaInstanceType, err := c.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +170,7 @@ func (c *KafkaConfig) GetKafkaInstanceSize(instanceType, sizeId string) (*KafkaI
 }
 
 func (c *KafkaConfig) GetBillingModels(instanceType string) ([]KafkaBillingModel, error) {
-	kafkaInstanceType, err := c.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+	kafkaInstanceType, err := c.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +179,7 @@ func (c *KafkaConfig) GetBillingModels(instanceType string) ([]KafkaBillingModel
 }
 
 func (c *KafkaConfig) GetBillingModelByID(instanceType, billingModelID string) (KafkaBillingModel, error) {
-	kafkaInstanceType, err := c.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+	kafkaInstanceType, err := c.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 	if err != nil {
 		return KafkaBillingModel{}, err
 	}
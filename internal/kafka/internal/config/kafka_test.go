@@ -1,8 +1,13 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/goava/di"
 	"github.com/onsi/gomega"
 )
 
@@ -21,6 +26,7 @@ func Test_NewKafkaConfig(t *testing.T) {
 				SupportedInstanceTypes: NewKafkaSupportedInstanceTypesConfig(),
 				EnableKafkaOwnerConfig: false,
 				KafkaOwnerListFile:     "config/kafka-owner-list.yaml",
+				reloaded:               &atomic.Pointer[kafkaConfigSnapshot]{},
 			},
 		},
 	}
@@ -78,3 +84,54 @@ func Test_ReadFilesKafkaConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_KafkaConfig_Reload(t *testing.T) {
+	tests := []struct {
+		name                 string
+		fileContent          string
+		nodePrewarmingConfig map[string]InstanceTypeNodePrewarmingConfig
+		wantErr              bool
+	}{
+		{
+			name:                 "should replace the supported instance types when they are valid",
+			nodePrewarmingConfig: map[string]InstanceTypeNodePrewarmingConfig{"standard": {NumReservedInstances: 1}},
+		},
+		{
+			name:        "should keep the supported instance types when they are invalid",
+			fileContent: "supported_instance_types:\n- id: standard\n  display_name: Standard\n",
+			wantErr:     true,
+		},
+		{
+			name:                 "should keep the supported instance types when the node prewarming configuration refers to a missing size",
+			nodePrewarmingConfig: map[string]InstanceTypeNodePrewarmingConfig{"standard": {BaseStreamingUnitSize: "x100"}},
+			wantErr:              true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dataplaneClusterConfig := NewDataplaneClusterConfig()
+			dataplaneClusterConfig.NodePrewarmingConfig.Configuration = tt.nodePrewarmingConfig
+			env, err := environments.New(environments.TestingEnv, di.ProvideValue(dataplaneClusterConfig))
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// the default configuration file is used unless a content is given
+			config := NewKafkaConfig()
+			if tt.fileContent != "" {
+				config.SupportedInstanceTypes.ConfigurationFile = filepath.Join(t.TempDir(), "kafka-instance-types-configuration.yaml")
+				g.Expect(os.WriteFile(config.SupportedInstanceTypes.ConfigurationFile, []byte(tt.fileContent), 0600)).To(gomega.Succeed())
+			}
+
+			err = config.Reload(env)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(config.GetSupportedInstanceTypes().SupportedKafkaInstanceTypes).To(gomega.BeEmpty())
+			} else {
+				_, err := config.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID("standard")
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+		})
+	}
+}
@@ -63,7 +63,7 @@ func (r Region) getLimitSetForInstanceTypeInRegion(t string) (*int, *errs.Servic
 func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 	counter := 1
 	totalCapacityUsed := 0
-	regionCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegion(r.Name)
+	regionCapacity := dataplaneClusterConfig.GetClusterConfig().GetCapacityForRegion(r.Name)
 
 	// verify that Limits set in this configuration matches the capacity of clusters listed in the data plane configuration
 	for regionInstanceTypeName, regionInstanceType := range r.SupportedInstanceTypes {
@@ -83,7 +83,7 @@ func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 		// validate instance type limits with the data plane cluster configuration when manual scaling is enabled
 		if dataplaneClusterConfig.IsDataPlaneManualScalingEnabled() {
 			if len(r.SupportedInstanceTypes) == 1 {
-				capacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, regionInstanceTypeName, false)
+				capacity := dataplaneClusterConfig.GetClusterConfig().GetCapacityForRegionAndInstanceType(r.Name, regionInstanceTypeName, false)
 				if *regionInstanceType.Limit != capacity {
 					return fmt.Errorf("limit for instance type '%s'(%d) does not match the capacity in region %s(%d)", regionInstanceTypeName, *regionInstanceType.Limit, r.Name, capacity)
 				}
@@ -93,8 +93,8 @@ func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 			// ensure that limit is within min and max capacity
 			// min: the total capacity of clusters that support only this instance type
 			// max: the total capacity of clusters that supports this instance type
-			minCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, regionInstanceTypeName, true)
-			maxCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, regionInstanceTypeName, false)
+			minCapacity := dataplaneClusterConfig.GetClusterConfig().GetCapacityForRegionAndInstanceType(r.Name, regionInstanceTypeName, true)
+			maxCapacity := dataplaneClusterConfig.GetClusterConfig().GetCapacityForRegionAndInstanceType(r.Name, regionInstanceTypeName, false)
 			if minCapacity > *regionInstanceType.Limit || maxCapacity < *regionInstanceType.Limit {
 				return fmt.Errorf("limit for %s instance type (%d) does not match cluster capacity configuration in region '%s': min(%d), max(%d)", regionInstanceTypeName, *regionInstanceType.Limit, r.Name, minCapacity, maxCapacity)
 			}
@@ -21,6 +21,7 @@ func ConvertKafkaRequest(request *dbapi.KafkaRequest) []map[string]interface{} {
 			"deleted_at":            request.Meta.DeletedAt.Time,
 			"size_id":               request.SizeId,
 			"instance_type":         request.InstanceType,
+			"resource_version":      request.ResourceVersion,
 		},
 	}
 }
@@ -40,6 +40,7 @@ func (b IntegrationEnvLoader) Defaults() map[string]string {
 		"sso-provider-type":                 "mas_sso",
 		"osd-idp-mas-sso-realm":             "rhoas-kafka-sre",
 		"enable-kafka-external-certificate": "false",
+		"dns-provider":                      "in-memory",
 		"allow-developer-instance":          "true",
 		"quota-type":                        "quota-management-list",
 		"enable-deletion-of-expired-kafka":  "true",
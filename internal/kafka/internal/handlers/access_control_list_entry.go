@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/acl"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type adminAccessControlListEntryHandler struct {
+	accessControlListEntryService acl.AccessControlListEntryService
+}
+
+func NewAdminAccessControlListEntryHandler(accessControlListEntryService acl.AccessControlListEntryService) *adminAccessControlListEntryHandler {
+	return &adminAccessControlListEntryHandler{
+		accessControlListEntryService: accessControlListEntryService,
+	}
+}
+
+// List lists the entries of the access control lists, optionally filtered by the type query parameter
+func (h adminAccessControlListEntryHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			entries, err := h.accessControlListEntryService.ListEntries(api.AccessControlListEntryType(r.URL.Query().Get("type")))
+			if err != nil {
+				return nil, err
+			}
+
+			entryList := private.AccessControlListEntryList{
+				Kind:  "AccessControlListEntryList",
+				Page:  1,
+				Size:  int32(len(entries)),
+				Total: int32(len(entries)),
+				Items: []private.AccessControlListEntry{},
+			}
+			for _, entry := range entries {
+				entryList.Items = append(entryList.Items, presenters.PresentAccessControlListEntry(entry))
+			}
+
+			return entryList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+// Create adds an entry to the access control lists. The change is applied by every replica without restart
+func (h adminAccessControlListEntryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request private.AccessControlListEntryRequest
+	ctx := r.Context()
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			validateAccessControlListEntryRequest(&request),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			createdBy, _ := claims.GetUsername()
+			entry := presenters.ConvertAccessControlListEntryRequest(request, createdBy)
+			if err := h.accessControlListEntryService.CreateEntry(entry); err != nil {
+				return nil, err
+			}
+			return presenters.PresentAccessControlListEntry(entry), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h adminAccessControlListEntryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.accessControlListEntryService.DeleteEntry(mux.Vars(r)["id"])
+		},
+	}
+
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/acl"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminAccessControlListEntryHandler_Create(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         private.AccessControlListEntryRequest
+		createErr       *errors.ServiceError
+		wantStatusCode  int
+		wantCreateCalls int
+	}{
+		{
+			name:            "should create the entry",
+			request:         private.AccessControlListEntryRequest{Type: "denied_user", Value: "a-user", Reason: "abuse"},
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+		{
+			name:           "should fail when the type is not supported",
+			request:        private.AccessControlListEntryRequest{Type: "denied_organisation", Value: "13640203"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the value is empty",
+			request:        private.AccessControlListEntryRequest{Type: "accepted_organisation"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:            "should return a conflict when the entry already exists",
+			request:         private.AccessControlListEntryRequest{Type: "denied_user", Value: "a-user"},
+			createErr:       errors.Conflict("access control list entry already exists"),
+			wantStatusCode:  http.StatusConflict,
+			wantCreateCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			service := &acl.AccessControlListEntryServiceMock{
+				CreateEntryFunc: func(entry *api.AccessControlListEntry) *errors.ServiceError {
+					g.Expect(entry.Type.String()).To(gomega.Equal(tt.request.Type))
+					g.Expect(entry.Value).To(gomega.Equal(tt.request.Value))
+					g.Expect(entry.CreatedBy).To(gomega.Equal("test-user"))
+					entry.ID = "entry-id"
+					return tt.createErr
+				},
+			}
+			h := NewAdminAccessControlListEntryHandler(service)
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPost, "/access_control_list_entries", bytes.NewBuffer(body), t)
+			h.Create(rw, req.WithContext(ctx))
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(service.CreateEntryCalls()).To(gomega.HaveLen(tt.wantCreateCalls))
+			if tt.wantStatusCode == http.StatusCreated {
+				var entry private.AccessControlListEntry
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &entry)).To(gomega.Succeed())
+				g.Expect(entry.Id).To(gomega.Equal("entry-id"))
+				g.Expect(entry.Reason).To(gomega.Equal(tt.request.Reason))
+			}
+		})
+	}
+}
+
+func Test_adminAccessControlListEntryHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	service := &acl.AccessControlListEntryServiceMock{
+		ListEntriesFunc: func(entryType api.AccessControlListEntryType) (api.AccessControlListEntryList, *errors.ServiceError) {
+			g.Expect(entryType).To(gomega.Equal(api.AccessControlListEntryTypeDeniedUser))
+			return api.AccessControlListEntryList{
+				{Meta: api.Meta{ID: "entry-1"}, Type: api.AccessControlListEntryTypeDeniedUser, Value: "a-user"},
+				{Meta: api.Meta{ID: "entry-2"}, Type: api.AccessControlListEntryTypeDeniedUser, Value: "another-user"},
+			}, nil
+		},
+	}
+	h := NewAdminAccessControlListEntryHandler(service)
+	req, rw := GetHandlerParams(http.MethodGet, "/access_control_list_entries?type=denied_user", nil, t)
+	h.List(rw, req.WithContext(ctx))
+	g.Expect(rw.Code).To(gomega.Equal(http.StatusOK))
+
+	var list private.AccessControlListEntryList
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(gomega.Succeed())
+	g.Expect(list.Total).To(gomega.Equal(int32(2)))
+	g.Expect(list.Items[1].Value).To(gomega.Equal("another-user"))
+}
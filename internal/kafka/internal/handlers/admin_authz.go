@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/gorilla/mux"
+)
+
+const (
+	authzGrantedByRoute  = "route"
+	authzGrantedByMethod = "method"
+)
+
+var authzResourceAttributes = []string{
+	auth.ResourceAttributeCloudProvider,
+	auth.ResourceAttributeRegion,
+	auth.ResourceAttributeClusterType,
+	auth.ResourceAttributeInstanceType,
+}
+
+type adminAuthzHandler struct {
+	adminRoleAuthZConfig *auth.AdminRoleAuthZConfig
+	router               *mux.Router
+}
+
+// NewAdminAuthzHandler returns the handler explaining the authorization of the routes of the given router
+func NewAdminAuthzHandler(adminRoleAuthZConfig *auth.AdminRoleAuthZConfig, router *mux.Router) *adminAuthzHandler {
+	return &adminAuthzHandler{
+		adminRoleAuthZConfig: adminRoleAuthZConfig,
+		router:               router,
+	}
+}
+
+// WhoCan returns the roles allowed to send requests to the admin API route given by the "route" query parameter,
+// without sending any request to it. The attributes of the targeted resource can be given with the
+// "cloud_provider", "region", "cluster_type" and "instance_type" query parameters.
+func (h adminAuthzHandler) WhoCan(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	route := query.Get("route")
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&route, "route", 1, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			method, ok := h.findRouteMethod(route)
+			if !ok {
+				return nil, errors.NotFound("admin API route %q not found", route)
+			}
+
+			attributes := map[string]string{}
+			for _, name := range authzResourceAttributes {
+				if value := query.Get(name); value != "" {
+					attributes[name] = value
+				}
+			}
+
+			roles, byRoute := h.adminRoleAuthZConfig.GetAllowedRoles(route, method, attributes)
+			authorization := private.AdminRouteAuthorization{
+				Kind:               "AdminRouteAuthorization",
+				Route:              route,
+				Method:             method,
+				GrantedBy:          authzGrantedByMethod,
+				ResourceAttributes: attributes,
+				Roles:              []string{},
+			}
+			if byRoute {
+				authorization.GrantedBy = authzGrantedByRoute
+			}
+			authorization.Roles = append(authorization.Roles, roles...)
+			return authorization, nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// findRouteMethod returns the HTTP method of the route with the given name
+func (h adminAuthzHandler) findRouteMethod(name string) (string, bool) {
+	var method string
+	_ = h.router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		if method != "" || logger.NewLogEventFromString(route.GetName()).Type != name {
+			return nil
+		}
+		if methods, err := route.GetMethods(); err == nil && len(methods) > 0 {
+			method = methods[0]
+		}
+		return nil
+	})
+	return method, method != ""
+}
+
+// NewKafkaResourceAttributesLoader returns the loader of the attributes of the kafka with the "id" path parameter,
+// for the given routes
+func NewKafkaResourceAttributesLoader(kafkaService services.KafkaService, clusterService services.ClusterService, routes ...string) auth.ResourceAttributesLoader {
+	return auth.ResourceAttributesLoader{
+		Routes: routes,
+		Load: func(request *http.Request) (map[string]string, error) {
+			kafka, err := kafkaService.GetByID(mux.Vars(request)["id"])
+			if err != nil {
+				return nil, err
+			}
+			attributes := map[string]string{
+				auth.ResourceAttributeCloudProvider: kafka.CloudProvider,
+				auth.ResourceAttributeRegion:        kafka.Region,
+				auth.ResourceAttributeInstanceType:  kafka.InstanceType,
+			}
+			if kafka.ClusterID != "" {
+				cluster, err := clusterService.FindClusterByID(kafka.ClusterID)
+				if err != nil {
+					return nil, err
+				}
+				if cluster != nil {
+					attributes[auth.ResourceAttributeClusterType] = cluster.ClusterType
+				}
+			}
+			return attributes, nil
+		},
+	}
+}
+
+// NewClusterResourceAttributesLoader returns the loader of the attributes of the data plane cluster with the
+// "id" path parameter, for the given routes
+func NewClusterResourceAttributesLoader(clusterService services.ClusterService, routes ...string) auth.ResourceAttributesLoader {
+	return auth.ResourceAttributesLoader{
+		Routes: routes,
+		Load: func(request *http.Request) (map[string]string, error) {
+			id := mux.Vars(request)["id"]
+			cluster, err := clusterService.FindClusterByID(id)
+			if err != nil {
+				return nil, err
+			}
+			if cluster == nil {
+				return nil, errors.NotFound("cluster %q not found", id)
+			}
+			return map[string]string{
+				auth.ResourceAttributeCloudProvider: cluster.CloudProvider,
+				auth.ResourceAttributeRegion:        cluster.Region,
+				auth.ResourceAttributeClusterType:   cluster.ClusterType,
+			}, nil
+		},
+	}
+}
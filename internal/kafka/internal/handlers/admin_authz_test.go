@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminAuthzHandler_WhoCan(t *testing.T) {
+	config := &auth.AdminRoleAuthZConfig{
+		RolesConfig: auth.RoleConfig{
+			{HTTPMethod: http.MethodGet, RoleNames: []string{"admin-read", "admin-full"}},
+			{HTTPMethod: http.MethodDelete, RoleNames: []string{"admin-full"}},
+		},
+		RoutePolicies: []auth.RoutePolicy{
+			{Route: "admin-delete-kafka", RoleNames: []string{"admin-full"}},
+			{Route: "admin-delete-kafka", RoleNames: []string{"aws-operator"}, ResourceAttributes: map[string][]string{auth.ResourceAttributeCloudProvider: {"aws"}}},
+		},
+	}
+	router := mux.NewRouter()
+	noop := func(w http.ResponseWriter, r *http.Request) {}
+	router.HandleFunc("/kafkas/{id}", noop).Name(logger.NewLogEvent("admin-get-kafka", "[admin] get kafka by id").ToString()).Methods(http.MethodGet)
+	router.HandleFunc("/kafkas/{id}", noop).Name(logger.NewLogEvent("admin-delete-kafka", "[admin] delete kafka by id").ToString()).Methods(http.MethodDelete)
+
+	tests := []struct {
+		name              string
+		url               string
+		wantStatusCode    int
+		wantAuthorization private.AdminRouteAuthorization
+	}{
+		{
+			name:           "should fail when the route is not given",
+			url:            "/authz/who_can",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the route does not exist",
+			url:            "/authz/who_can?route=admin-unknown",
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "should return the roles of the method when the route has no policy",
+			url:            "/authz/who_can?route=admin-get-kafka",
+			wantStatusCode: http.StatusOK,
+			wantAuthorization: private.AdminRouteAuthorization{
+				Kind:      "AdminRouteAuthorization",
+				Route:     "admin-get-kafka",
+				Method:    http.MethodGet,
+				GrantedBy: "method",
+				Roles:     []string{"admin-read", "admin-full"},
+			},
+		},
+		{
+			name:           "should return the roles of the policies of the route matching the resource",
+			url:            "/authz/who_can?route=admin-delete-kafka&cloud_provider=aws",
+			wantStatusCode: http.StatusOK,
+			wantAuthorization: private.AdminRouteAuthorization{
+				Kind:               "AdminRouteAuthorization",
+				Route:              "admin-delete-kafka",
+				Method:             http.MethodDelete,
+				GrantedBy:          "route",
+				ResourceAttributes: map[string]string{auth.ResourceAttributeCloudProvider: "aws"},
+				Roles:              []string{"admin-full", "aws-operator"},
+			},
+		},
+		{
+			name:           "should not return the roles of the restricted policies when the resource is not given",
+			url:            "/authz/who_can?route=admin-delete-kafka",
+			wantStatusCode: http.StatusOK,
+			wantAuthorization: private.AdminRouteAuthorization{
+				Kind:      "AdminRouteAuthorization",
+				Route:     "admin-delete-kafka",
+				Method:    http.MethodDelete,
+				GrantedBy: "route",
+				Roles:     []string{"admin-full"},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminAuthzHandler(config, router)
+			req, rw := GetHandlerParams(http.MethodGet, tt.url, nil, t)
+			h.WhoCan(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var authorization private.AdminRouteAuthorization
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &authorization)).To(gomega.Succeed())
+				g.Expect(authorization).To(gomega.Equal(tt.wantAuthorization))
+			}
+		})
+	}
+}
+
+func Test_NewKafkaResourceAttributesLoader(t *testing.T) {
+	tests := []struct {
+		name           string
+		kafka          *dbapi.KafkaRequest
+		getErr         *errors.ServiceError
+		wantErr        bool
+		wantAttributes map[string]string
+	}{
+		{
+			name:    "should fail when the kafka cannot be found",
+			getErr:  errors.NotFound("not found"),
+			wantErr: true,
+		},
+		{
+			name:  "should return the attributes of the kafka and of its cluster",
+			kafka: &dbapi.KafkaRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", ClusterID: "cluster-id"},
+			wantAttributes: map[string]string{
+				auth.ResourceAttributeCloudProvider: "aws",
+				auth.ResourceAttributeRegion:        "us-east-1",
+				auth.ResourceAttributeInstanceType:  "standard",
+				auth.ResourceAttributeClusterType:   api.EnterpriseDataPlaneClusterType.String(),
+			},
+		},
+		{
+			name:  "should not return the cluster type of a kafka that is not placed yet",
+			kafka: &dbapi.KafkaRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard"},
+			wantAttributes: map[string]string{
+				auth.ResourceAttributeCloudProvider: "aws",
+				auth.ResourceAttributeRegion:        "us-east-1",
+				auth.ResourceAttributeInstanceType:  "standard",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &services.KafkaServiceMock{
+				GetByIDFunc: func(kafkaID string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					g.Expect(kafkaID).To(gomega.Equal(id))
+					return tt.kafka, tt.getErr
+				},
+			}
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID, ClusterType: api.EnterpriseDataPlaneClusterType.String()}, nil
+				},
+			}
+			loader := NewKafkaResourceAttributesLoader(kafkaService, clusterService, "admin-get-kafka")
+			g.Expect(loader.Routes).To(gomega.Equal([]string{"admin-get-kafka"}))
+
+			req, _ := GetHandlerParams(http.MethodGet, "/kafkas/{id}", nil, t)
+			attributes, err := loader.Load(mux.SetURLVars(req, map[string]string{"id": id}))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(attributes).To(gomega.Equal(tt.wantAttributes))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+)
+
+type adminClusterConsolidationHandler struct {
+	consolidationService   services.ClusterConsolidationService
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+}
+
+func NewAdminClusterConsolidationHandler(consolidationService services.ClusterConsolidationService, dataplaneClusterConfig *config.DataplaneClusterConfig) *adminClusterConsolidationHandler {
+	return &adminClusterConsolidationHandler{
+		consolidationService:   consolidationService,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+// Get returns the underutilised data plane clusters and the kafka moves that would empty them, without moving any kafka
+func (h adminClusterConsolidationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			consolidations, err := h.consolidationService.Plan()
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterConsolidationPlan(consolidations, h.dataplaneClusterConfig.GetDynamicScalingConfig().Consolidation), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
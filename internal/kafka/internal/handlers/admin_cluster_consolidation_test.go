@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminClusterConsolidationHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		planErr        *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the consolidation plan of the underutilised clusters",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return an error when the consolidation cannot be planned",
+			planErr:        errors.GeneralError("failed to plan"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			consolidationService := &services.ClusterConsolidationServiceMock{
+				PlanFunc: func() ([]services.ClusterConsolidation, *errors.ServiceError) {
+					if tt.planErr != nil {
+						return nil, tt.planErr
+					}
+					return []services.ClusterConsolidation{
+						{ClusterID: "cluster-1", ConsumedStreamingUnits: 1, MaxStreamingUnits: 10,
+							Moves: []services.ClusterConsolidationMove{{Kafka: &dbapi.KafkaRequest{Meta: api.Meta{ID: id}}, TargetClusterID: "cluster-2"}}},
+						{ClusterID: "cluster-3", ConsumedStreamingUnits: 2, MaxStreamingUnits: 10, Reason: "the cluster receives the kafkas of another consolidated cluster"},
+					}, nil
+				},
+			}
+			dataplaneClusterConfig := &config.DataplaneClusterConfig{DynamicScalingConfig: config.NewDynamicScalingConfig()}
+			h := NewAdminClusterConsolidationHandler(consolidationService, dataplaneClusterConfig)
+			req, rw := GetHandlerParams(http.MethodGet, "/dataplane_consolidation_plan", nil, t)
+			h.Get(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				var plan private.ClusterConsolidationPlan
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &plan)).To(gomega.Succeed())
+				g.Expect(plan.Kind).To(gomega.Equal("ClusterConsolidationPlan"))
+				g.Expect(plan.ConsolidationEnabled).To(gomega.BeFalse())
+				g.Expect(plan.Items).To(gomega.HaveLen(2))
+				g.Expect(plan.Items[0].CanBeEmptied).To(gomega.BeTrue())
+				g.Expect(plan.Items[0].Moves).To(gomega.Equal([]private.ClusterConsolidationMove{{KafkaId: id, TargetClusterId: "cluster-2"}}))
+				g.Expect(plan.Items[1].CanBeEmptied).To(gomega.BeFalse())
+				g.Expect(plan.Items[1].Moves).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/gorilla/mux"
+)
+
+var dataPlaneClusterStatuses = []string{
+	api.ClusterAccepted.String(),
+	api.ClusterProvisioning.String(),
+	api.ClusterProvisioned.String(),
+	api.ClusterWaitingForKasFleetShardOperator.String(),
+	api.ClusterReady.String(),
+	api.ClusterDeprovisioning.String(),
+	api.ClusterCleanup.String(),
+	api.ClusterFailed.String(),
+}
+
+var dataPlaneClusterTypes = []string{
+	api.ManagedDataPlaneClusterType.String(),
+	api.EnterpriseDataPlaneClusterType.String(),
+}
+
+type adminDataPlaneClusterHandler struct {
+	clusterService               services.ClusterService
+	dataPlaneClusterAdminService services.DataPlaneClusterAdminService
+}
+
+func NewAdminDataPlaneClusterHandler(clusterService services.ClusterService, dataPlaneClusterAdminService services.DataPlaneClusterAdminService) *adminDataPlaneClusterHandler {
+	return &adminDataPlaneClusterHandler{
+		clusterService:               clusterService,
+		dataPlaneClusterAdminService: dataPlaneClusterAdminService,
+	}
+}
+
+// List returns the data plane clusters filtered by status, cloud provider, region and cluster type, along with their capacity
+func (h adminDataPlaneClusterHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := services.DataPlaneClusterFilter{
+		Status:        query.Get("status"),
+		CloudProvider: query.Get("cloud_provider"),
+		Region:        query.Get("region"),
+		ClusterType:   query.Get("cluster_type"),
+	}
+
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			validateDataPlaneClusterFilter(filter),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(query)
+			clusters, paging, err := h.dataPlaneClusterAdminService.List(filter, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			clusterList := private.DataPlaneClusterList{
+				Kind:  "DataPlaneClusterList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.DataPlaneCluster{},
+			}
+
+			for _, cluster := range clusters {
+				converted, err := presenters.PresentDataPlaneClusterDetailsAdminEndpoint(cluster)
+				if err != nil {
+					return nil, err
+				}
+				clusterList.Items = append(clusterList.Items, converted)
+			}
+
+			return clusterList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get returns a data plane cluster along with its capacity and the kafkas placed on it
+func (h adminDataPlaneClusterHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			details, err := h.dataPlaneClusterAdminService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDataPlaneClusterDetailsAdminEndpoint(*details)
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Deprovision forces the deprovisioning of an empty data plane cluster
+func (h adminDataPlaneClusterHandler) Deprovision(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, err := h.dataPlaneClusterAdminService.ForceDeprovision(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDataPlaneClusterAdminEndpoint(cluster), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Retry replaces a failed data plane cluster with a new one. The new data plane cluster is returned
+func (h adminDataPlaneClusterHandler) Retry(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, err := h.dataPlaneClusterAdminService.Retry(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDataPlaneClusterAdminEndpoint(cluster), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Cordon stops new kafkas from being placed on the data plane cluster. The kafkas already placed on it are left untouched
+func (h adminDataPlaneClusterHandler) Cordon(w http.ResponseWriter, r *http.Request) {
+	h.updateCordoned(w, r, true)
+}
+
+// Uncordon allows new kafkas to be placed on the data plane cluster again
+func (h adminDataPlaneClusterHandler) Uncordon(w http.ResponseWriter, r *http.Request) {
+	h.updateCordoned(w, r, false)
+}
+
+func (h adminDataPlaneClusterHandler) updateCordoned(w http.ResponseWriter, r *http.Request, cordoned bool) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			cluster, err := h.clusterService.FindClusterByID(id)
+			if err != nil {
+				return nil, err
+			}
+			if cluster == nil {
+				return nil, errors.NotFound("cluster %q not found", id)
+			}
+
+			if cluster.Cordoned != cordoned {
+				if err := h.clusterService.UpdateCordoned(cluster.ClusterID, cordoned); err != nil {
+					return nil, err
+				}
+				cluster.Cordoned = cordoned
+			}
+			return presenters.PresentDataPlaneClusterAdminEndpoint(cluster), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func validateDataPlaneClusterFilter(filter services.DataPlaneClusterFilter) handlers.Validate {
+	return func() *errors.ServiceError {
+		if filter.Status != "" && !arrays.Contains(dataPlaneClusterStatuses, filter.Status) {
+			return errors.BadRequest("status %q is not valid. Valid values are %v", filter.Status, dataPlaneClusterStatuses)
+		}
+		if filter.ClusterType != "" && !arrays.Contains(dataPlaneClusterTypes, filter.ClusterType) {
+			return errors.BadRequest("cluster_type %q is not valid. Valid values are %v", filter.ClusterType, dataPlaneClusterTypes)
+		}
+		return nil
+	}
+}
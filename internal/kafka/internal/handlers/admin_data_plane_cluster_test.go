@@ -0,0 +1,332 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminDataPlaneClusterHandler_UpdateCordoned(t *testing.T) {
+	tests := []struct {
+		name                string
+		cordon              bool
+		cluster             *api.Cluster
+		findErr             *errors.ServiceError
+		updateErr           *errors.ServiceError
+		wantStatusCode      int
+		wantUpdateCalls     int
+		wantClusterCordoned bool
+	}{
+		{
+			name:                "should cordon the cluster",
+			cordon:              true,
+			cluster:             &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady},
+			wantStatusCode:      http.StatusOK,
+			wantUpdateCalls:     1,
+			wantClusterCordoned: true,
+		},
+		{
+			name:                "should uncordon the cluster",
+			cluster:             &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, Cordoned: true},
+			wantStatusCode:      http.StatusOK,
+			wantUpdateCalls:     1,
+			wantClusterCordoned: false,
+		},
+		{
+			name:                "should not update a cluster that is already cordoned",
+			cordon:              true,
+			cluster:             &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, Cordoned: true},
+			wantStatusCode:      http.StatusOK,
+			wantClusterCordoned: true,
+		},
+		{
+			name:           "should return not found when the cluster does not exist",
+			cordon:         true,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "should return an error when the cluster cannot be found",
+			cordon:         true,
+			findErr:        errors.GeneralError("failed to find cluster"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:            "should return an error when the cluster cannot be updated",
+			cordon:          true,
+			cluster:         &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady},
+			updateErr:       errors.GeneralError("failed to update cluster"),
+			wantStatusCode:  http.StatusInternalServerError,
+			wantUpdateCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return tt.cluster, tt.findErr
+				},
+				UpdateCordonedFunc: func(clusterID string, cordoned bool) *errors.ServiceError {
+					return tt.updateErr
+				},
+			}
+			h := NewAdminDataPlaneClusterHandler(clusterService, &services.DataPlaneClusterAdminServiceMock{})
+
+			handle, action := h.Uncordon, "uncordon"
+			if tt.cordon {
+				handle, action = h.Cordon, "cordon"
+			}
+			req, rw := GetHandlerParams(http.MethodPost, "/clusters/cluster-id/"+action, nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			handle(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(clusterService.UpdateCordonedCalls()).To(gomega.HaveLen(tt.wantUpdateCalls))
+			for _, call := range clusterService.UpdateCordonedCalls() {
+				g.Expect(call.ClusterID).To(gomega.Equal("cluster-id"))
+				g.Expect(call.Cordoned).To(gomega.Equal(tt.cordon))
+			}
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var response private.DataPlaneCluster
+			g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).To(gomega.Succeed())
+			g.Expect(response.Id).To(gomega.Equal("cluster-id"))
+			g.Expect(response.Kind).To(gomega.Equal("Cluster"))
+			g.Expect(response.Status).To(gomega.Equal(api.ClusterReady.String()))
+			g.Expect(response.Cordoned).To(gomega.Equal(tt.wantClusterCordoned))
+		})
+	}
+}
+
+func Test_adminDataPlaneClusterHandler_List(t *testing.T) {
+	cluster := &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, SupportedInstanceType: "standard,developer"}
+	if err := cluster.SetDynamicCapacityInfo(map[string]api.DynamicCapacityInfo{
+		"standard": {MaxNodes: 9, MaxUnits: 10, RemainingUnits: 6},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cluster.SetAvailableStrimziVersions([]api.StrimziVersion{
+		{Version: "strimzi-cluster-operator.v0.23.0-0", Ready: true, KafkaVersions: []api.KafkaVersion{{Version: "3.1.0"}}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		listErr        *errors.ServiceError
+		wantStatusCode int
+		wantFilter     services.DataPlaneClusterFilter
+	}{
+		{
+			name:           "should list the clusters matching the filter",
+			query:          "?status=ready&cloud_provider=aws&region=us-east-1&cluster_type=managed",
+			wantStatusCode: http.StatusOK,
+			wantFilter:     services.DataPlaneClusterFilter{Status: "ready", CloudProvider: "aws", Region: "us-east-1", ClusterType: "managed"},
+		},
+		{
+			name:           "should return a bad request when the status is not valid",
+			query:          "?status=unknown",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return a bad request when the cluster type is not valid",
+			query:          "?cluster_type=unknown",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return an error when the clusters cannot be listed",
+			listErr:        errors.GeneralError("failed to list clusters"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			adminService := &services.DataPlaneClusterAdminServiceMock{
+				ListFunc: func(filter services.DataPlaneClusterFilter, listArgs *coreServices.ListArguments) ([]services.DataPlaneClusterDetails, *api.PagingMeta, *errors.ServiceError) {
+					g.Expect(filter).To(gomega.Equal(tt.wantFilter))
+					if tt.listErr != nil {
+						return nil, nil, tt.listErr
+					}
+					return []services.DataPlaneClusterDetails{
+						{Cluster: cluster, ConsumedStreamingUnits: services.StreamingUnitCountPerInstanceType{types.STANDARD: 4}},
+					}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+				},
+			}
+			h := NewAdminDataPlaneClusterHandler(&services.ClusterServiceMock{}, adminService)
+
+			req, rw := GetHandlerParams(http.MethodGet, "/clusters"+tt.query, nil, t)
+			h.List(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var response private.DataPlaneClusterList
+			g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).To(gomega.Succeed())
+			g.Expect(response.Kind).To(gomega.Equal("DataPlaneClusterList"))
+			g.Expect(response.Total).To(gomega.Equal(int32(1)))
+			g.Expect(response.Items).To(gomega.HaveLen(1))
+			g.Expect(response.Items[0].Id).To(gomega.Equal("cluster-id"))
+			g.Expect(response.Items[0].Capacity).To(gomega.Equal([]private.DataPlaneClusterCapacity{
+				{InstanceType: "standard", MaxNodes: 9, MaxUnits: 10, RemainingUnits: 6, ConsumedStreamingUnits: 4},
+				{InstanceType: "developer"},
+			}))
+			g.Expect(response.Items[0].AvailableStrimziVersions).To(gomega.Equal([]private.DataPlaneClusterStrimziVersion{
+				{Version: "strimzi-cluster-operator.v0.23.0-0", Ready: true, KafkaVersions: []string{"3.1.0"}, KafkaIbpVersions: []string{}},
+			}))
+			g.Expect(response.Items[0].Kafkas).To(gomega.BeEmpty())
+		})
+	}
+}
+
+func Test_adminDataPlaneClusterHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		getErr         *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the cluster along with its kafkas",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found when the cluster does not exist",
+			getErr:         errors.NotFound("data plane cluster %q not found", "cluster-id"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			adminService := &services.DataPlaneClusterAdminServiceMock{
+				GetFunc: func(clusterID string) (*services.DataPlaneClusterDetails, *errors.ServiceError) {
+					g.Expect(clusterID).To(gomega.Equal("cluster-id"))
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &services.DataPlaneClusterDetails{
+						Cluster: &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, SupportedInstanceType: "standard"},
+						Kafkas: dbapi.KafkaList{
+							{Meta: api.Meta{ID: "kafka-id"}, Name: "kafka", Status: "ready", InstanceType: "standard", SizeId: "x1"},
+						},
+					}, nil
+				},
+			}
+			h := NewAdminDataPlaneClusterHandler(&services.ClusterServiceMock{}, adminService)
+
+			req, rw := GetHandlerParams(http.MethodGet, "/clusters/cluster-id", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			h.Get(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var response private.DataPlaneCluster
+			g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).To(gomega.Succeed())
+			g.Expect(response.Id).To(gomega.Equal("cluster-id"))
+			g.Expect(response.Kafkas).To(gomega.Equal([]private.DataPlaneClusterKafka{
+				{Id: "kafka-id", Name: "kafka", Status: "ready", InstanceType: "standard", SizeId: "x1"},
+			}))
+		})
+	}
+}
+
+func Test_adminDataPlaneClusterHandler_DeprovisionAndRetry(t *testing.T) {
+	tests := []struct {
+		name           string
+		retry          bool
+		err            *errors.ServiceError
+		wantStatusCode int
+		wantStatus     api.ClusterStatus
+	}{
+		{
+			name:           "should force the deprovisioning of the cluster",
+			wantStatusCode: http.StatusAccepted,
+			wantStatus:     api.ClusterDeprovisioning,
+		},
+		{
+			name:           "should return a bad request when the cluster cannot be deprovisioned",
+			err:            errors.BadRequest("data plane cluster %q has kafkas placed on it", "cluster-id"),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should retry the failed cluster",
+			retry:          true,
+			wantStatusCode: http.StatusAccepted,
+			wantStatus:     api.ClusterAccepted,
+		},
+		{
+			name:           "should return a bad request when the cluster cannot be retried",
+			retry:          true,
+			err:            errors.BadRequest("data plane cluster %q is not failed", "cluster-id"),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			adminService := &services.DataPlaneClusterAdminServiceMock{
+				ForceDeprovisionFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					g.Expect(clusterID).To(gomega.Equal("cluster-id"))
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &api.Cluster{ClusterID: clusterID, Status: api.ClusterDeprovisioning}, nil
+				},
+				RetryFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					g.Expect(clusterID).To(gomega.Equal("cluster-id"))
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &api.Cluster{Status: api.ClusterAccepted}, nil
+				},
+			}
+			h := NewAdminDataPlaneClusterHandler(&services.ClusterServiceMock{}, adminService)
+
+			handle, action := h.Deprovision, "deprovision"
+			if tt.retry {
+				handle, action = h.Retry, "retry"
+			}
+			req, rw := GetHandlerParams(http.MethodPost, "/clusters/cluster-id/"+action, nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "cluster-id"})
+			handle(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			if tt.retry {
+				g.Expect(adminService.RetryCalls()).To(gomega.HaveLen(1))
+				g.Expect(adminService.ForceDeprovisionCalls()).To(gomega.BeEmpty())
+			} else {
+				g.Expect(adminService.ForceDeprovisionCalls()).To(gomega.HaveLen(1))
+				g.Expect(adminService.RetryCalls()).To(gomega.BeEmpty())
+			}
+			if tt.wantStatusCode != http.StatusAccepted {
+				return
+			}
+
+			var response private.DataPlaneCluster
+			g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).To(gomega.Succeed())
+			g.Expect(response.Status).To(gomega.Equal(tt.wantStatus.String()))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+)
+
+type adminDataPlaneScaleUpForecastHandler struct {
+	forecastService        services.DataPlaneScaleUpForecastService
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+}
+
+func NewAdminDataPlaneScaleUpForecastHandler(forecastService services.DataPlaneScaleUpForecastService, dataplaneClusterConfig *config.DataplaneClusterConfig) *adminDataPlaneScaleUpForecastHandler {
+	return &adminDataPlaneScaleUpForecastHandler{
+		forecastService:        forecastService,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+// List returns the demand forecast of every instance type of every region and whether the dynamic scale up would create
+// a data plane cluster ahead of it, without creating any. The demand is forecast at the time given in the "at" query
+// parameter, in RFC3339 format, or at the current time.
+func (h adminDataPlaneScaleUpForecastHandler) List(w http.ResponseWriter, r *http.Request) {
+	forecastTime := time.Now()
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				at := r.URL.Query().Get("at")
+				if at == "" {
+					return nil
+				}
+				parsedTime, err := time.Parse(time.RFC3339, at)
+				if err != nil {
+					return errors.BadRequest("invalid 'at' query parameter %q: it should be a time in RFC3339 format", at)
+				}
+				forecastTime = parsedTime
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			forecasts, err := h.forecastService.Forecast(forecastTime)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentDataPlaneScaleUpForecasts(forecasts, forecastTime, h.dataplaneClusterConfig.GetDynamicScalingConfig().PredictiveScaleUp), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminDataPlaneScaleUpForecastHandler_List(t *testing.T) {
+	forecastTime := time.Date(2023, time.May, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		url               string
+		forecastErr       *errors.ServiceError
+		wantStatusCode    int
+		wantForecastCalls int
+	}{
+		{
+			name:              "should return the forecast at the given time",
+			url:               "/dataplane_scale_up_forecasts?at=2023-05-15T09:00:00Z",
+			wantStatusCode:    http.StatusOK,
+			wantForecastCalls: 1,
+		},
+		{
+			name:           "should fail when the time is not in RFC3339 format",
+			url:            "/dataplane_scale_up_forecasts?at=tomorrow",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:              "should return an error when the demand cannot be forecast",
+			url:               "/dataplane_scale_up_forecasts?at=2023-05-15T09:00:00Z",
+			forecastErr:       errors.GeneralError("failed to forecast"),
+			wantStatusCode:    http.StatusInternalServerError,
+			wantForecastCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			forecastService := &services.DataPlaneScaleUpForecastServiceMock{
+				ForecastFunc: func(now time.Time) ([]services.DataPlaneScaleUpForecast, *errors.ServiceError) {
+					g.Expect(now.Equal(forecastTime)).To(gomega.BeTrue())
+					if tt.forecastErr != nil {
+						return nil, tt.forecastErr
+					}
+					return []services.DataPlaneScaleUpForecast{
+						{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", FreeStreamingUnits: 2, HistoricalDemandStreamingUnits: 3, ScaleUpNeeded: true},
+					}, nil
+				},
+			}
+			dataplaneClusterConfig := &config.DataplaneClusterConfig{DynamicScalingConfig: config.NewDynamicScalingConfig()}
+			h := NewAdminDataPlaneScaleUpForecastHandler(forecastService, dataplaneClusterConfig)
+			req, rw := GetHandlerParams(http.MethodGet, tt.url, nil, t)
+			h.List(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(forecastService.ForecastCalls()).To(gomega.HaveLen(tt.wantForecastCalls))
+			if tt.wantStatusCode == http.StatusOK {
+				var list private.DataPlaneScaleUpForecastList
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(gomega.Succeed())
+				g.Expect(list.Kind).To(gomega.Equal("DataPlaneScaleUpForecastList"))
+				g.Expect(list.PredictiveScaleUpEnabled).To(gomega.BeFalse())
+				g.Expect(list.ForecastHorizonSeconds).To(gomega.Equal(int64(time.Hour.Seconds())))
+				g.Expect(list.Items).To(gomega.HaveLen(1))
+				g.Expect(list.Items[0].PredictedDemandStreamingUnits).To(gomega.Equal(int32(3)))
+				g.Expect(list.Items[0].ScaleUpNeeded).To(gomega.BeTrue())
+			}
+		})
+	}
+}
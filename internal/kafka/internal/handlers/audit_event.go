@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+)
+
+type adminAuditEventHandler struct {
+	auditEventService audit.AuditEventService
+}
+
+func NewAdminAuditEventHandler(auditEventService audit.AuditEventService) *adminAuditEventHandler {
+	return &adminAuditEventHandler{
+		auditEventService: auditEventService,
+	}
+}
+
+func (h adminAuditEventHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			auditEvents, paging, err := h.auditEventService.List(listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			auditEventList := private.AuditEventList{
+				Kind:  "AuditEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.AuditEvent{},
+			}
+			for _, auditEvent := range auditEvents {
+				auditEventList.Items = append(auditEventList.Items, presenters.PresentAuditEvent(auditEvent))
+			}
+
+			return auditEventList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
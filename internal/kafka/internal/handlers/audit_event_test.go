@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminAuditEventHandler_List(t *testing.T) {
+	tests := []struct {
+		name              string
+		url               string
+		auditEventService audit.AuditEventService
+		wantStatusCode    int
+		wantList          private.AuditEventList
+	}{
+		{
+			name: "should fail when the search query cannot be parsed",
+			url:  "/audit_events?search=actor%20%3D",
+			auditEventService: &audit.AuditEventServiceMock{
+				ListFunc: func(listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError) {
+					return nil, nil, errors.New(errors.ErrorFailedToParseSearch, "failed to parse search")
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should list the audit events matching the search",
+			url:  "/audit_events?search=resource_id%20%3D%20kafka-id&page=1&size=10",
+			auditEventService: &audit.AuditEventServiceMock{
+				ListFunc: func(listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError) {
+					if listArgs.Search != "resource_id = kafka-id" {
+						return nil, nil, errors.GeneralError("unexpected search %q", listArgs.Search)
+					}
+					return api.AuditEventList{
+						&api.AuditEvent{
+							Meta:        api.Meta{ID: "event-id"},
+							Actor:       "admin-user",
+							Action:      "admin-update-kafka",
+							ResourceId:  "kafka-id",
+							Method:      http.MethodPatch,
+							RequestPath: "/api/kafkas_mgmt/v1/admin/kafkas/kafka-id",
+							RequestDiff: api.JSON(`{"suspended":true}`),
+							StatusCode:  http.StatusOK,
+						},
+					}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantList: private.AuditEventList{
+				Kind:  "AuditEventList",
+				Page:  1,
+				Size:  1,
+				Total: 1,
+				Items: []private.AuditEvent{
+					{
+						Id:          "event-id",
+						Kind:        "AuditEvent",
+						Actor:       "admin-user",
+						Action:      "admin-update-kafka",
+						ResourceId:  "kafka-id",
+						Method:      http.MethodPatch,
+						RequestPath: "/api/kafkas_mgmt/v1/admin/kafkas/kafka-id",
+						RequestDiff: map[string]interface{}{"suspended": true},
+						StatusCode:  http.StatusOK,
+					},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminAuditEventHandler(tt.auditEventService)
+			req, rw := GetHandlerParams(http.MethodGet, tt.url, nil, t)
+			h.List(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+			var list private.AuditEventList
+			g.Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(gomega.Succeed())
+			g.Expect(list).To(gomega.Equal(tt.wantList))
+		})
+	}
+}
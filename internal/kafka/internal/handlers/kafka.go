@@ -1,14 +1,20 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	config "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 
 	"github.com/gorilla/mux"
 
@@ -17,22 +23,26 @@ import (
 )
 
 type kafkaHandler struct {
-	service        services.KafkaService
-	providerConfig *config.ProviderConfig
-	authService    authorization.Authorization
-	kafkaConfig    *config.KafkaConfig
+	service         services.KafkaService
+	providerConfig  *config.ProviderConfig
+	authService     authorization.Authorization
+	kafkaConfig     *config.KafkaConfig
+	bus             signalbus.SignalBus
+	templateService services.KafkaInstanceTemplateService
 }
 
 func GetAcceptedOrderByParams() []string {
 	return []string{"bootstrap_server_host", "cloud_provider", "cluster_id", "created_at", "href", "id", "instance_type", "multi_az", "name", "organisation_id", "owner", "reauthentication_enabled", "region", "status", "updated_at", "version"}
 }
 
-func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig) *kafkaHandler {
+func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig, bus signalbus.SignalBus, templateService services.KafkaInstanceTemplateService) *kafkaHandler {
 	return &kafkaHandler{
-		service:        service,
-		providerConfig: providerConfig,
-		authService:    authService,
-		kafkaConfig:    kafkaConfig,
+		service:         service,
+		providerConfig:  providerConfig,
+		authService:     authService,
+		kafkaConfig:     kafkaConfig,
+		bus:             bus,
+		templateService: templateService,
 	}
 }
 
@@ -40,37 +50,45 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 	var kafkaRequestPayload public.KafkaRequestPayload
 	ctx := r.Context()
 
+	validations := []handlers.Validate{
+		handlers.ValidateAsyncEnabled(r, "creating kafka requests"),
+		applyKafkaInstanceTemplate(ctx, h.templateService, &kafkaRequestPayload),
+	}
 	cfg := &handlers.HandlerConfig{
 		MarshalInto: &kafkaRequestPayload,
-		Validate: []handlers.Validate{
-			handlers.ValidateAsyncEnabled(r, "creating kafka requests"),
-			handlers.ValidateLength(&kafkaRequestPayload.Name, "name", handlers.MinRequiredFieldLength, &MaxKafkaNameLength),
-			ValidKafkaClusterName(&kafkaRequestPayload.Name, "name"),
-			ValidateKafkaClusterNameIsUnique(&kafkaRequestPayload.Name, h.service, r.Context()),
-			ValidateKafkaClaims(ctx, ValidateUsername(), ValidateOrganisationId()),
-			ValidateCloudProvider(ctx, h.service, &kafkaRequestPayload, h.providerConfig, "creating kafka requests"),
-			handlers.ValidateNotEmptyClusterId(kafkaRequestPayload.ClusterId, "cluster id"),
-			ValidateKafkaPlan(ctx, h.service, h.kafkaConfig, &kafkaRequestPayload),
-			validateKafkaBillingModel(ctx, h.service, h.kafkaConfig, &kafkaRequestPayload),
-			ValidateBillingCloudAccountIdAndMarketplace(ctx, h.service, &kafkaRequestPayload),
-		},
+		Validate:    append(validations, h.validateKafkaRequestPayload(ctx, &kafkaRequestPayload)...),
 		Action: func() (interface{}, *errors.ServiceError) {
-			convKafka := presenters.ConvertKafkaRequest(kafkaRequestPayload)
-
-			claims, _ := getClaims(ctx)
-			convKafka.Owner, _ = claims.GetUsername()
-			convKafka.OrganisationId, _ = claims.GetOrgId()
-			convKafka.OwnerAccountId, _ = claims.GetAccountId()
+			return h.registerKafka(ctx, &kafkaRequestPayload)
+		},
+	}
 
-			convKafka.InstanceType, convKafka.SizeId, _ = getInstanceTypeAndSize(ctx, h.service, h.kafkaConfig, &kafkaRequestPayload)
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
 
-			convKafka.CloudProvider, convKafka.Region, _ = getCloudProviderAndRegion(ctx, h.service, &kafkaRequestPayload, h.providerConfig)
+// Clone creates a kafka request with the settings of an existing kafka and a new name.
+// The new kafka goes through the same validations and quota checks as any created kafka.
+func (h kafkaHandler) Clone(w http.ResponseWriter, r *http.Request) {
+	var cloneRequest public.KafkaCloneRequest
+	var kafkaRequestPayload public.KafkaRequestPayload
+	ctx := r.Context()
 
-			svcErr := h.service.RegisterKafkaJob(convKafka)
-			if svcErr != nil {
-				return nil, svcErr
+	validations := []handlers.Validate{
+		handlers.ValidateAsyncEnabled(r, "cloning kafka requests"),
+		func() *errors.ServiceError {
+			source, err := h.service.Get(ctx, mux.Vars(r)["id"])
+			if err != nil {
+				return err
 			}
-			return presenters.PresentKafkaRequest(convKafka, h.kafkaConfig)
+			kafkaRequestPayload = presenters.ConvertKafkaCloneRequest(cloneRequest, source)
+			return nil
+		},
+	}
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &cloneRequest,
+		Validate:    append(validations, h.validateKafkaRequestPayload(ctx, &kafkaRequestPayload)...),
+		Action: func() (interface{}, *errors.ServiceError) {
+			return h.registerKafka(ctx, &kafkaRequestPayload)
 		},
 	}
 
@@ -78,6 +96,41 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 	handlers.Handle(w, r, cfg, http.StatusAccepted)
 }
 
+// validateKafkaRequestPayload returns the validations of the payload of a kafka request about to be created
+func (h kafkaHandler) validateKafkaRequestPayload(ctx context.Context, kafkaRequestPayload *public.KafkaRequestPayload) []handlers.Validate {
+	return []handlers.Validate{
+		handlers.ValidateLength(&kafkaRequestPayload.Name, "name", handlers.MinRequiredFieldLength, &MaxKafkaNameLength),
+		ValidKafkaClusterName(&kafkaRequestPayload.Name, "name"),
+		ValidateKafkaClusterNameIsUnique(&kafkaRequestPayload.Name, h.service, ctx),
+		ValidateKafkaClaims(ctx, ValidateUsername(), ValidateOrganisationId()),
+		ValidateCloudProvider(ctx, h.service, kafkaRequestPayload, h.providerConfig, "creating kafka requests"),
+		handlers.ValidateNotEmptyClusterId(kafkaRequestPayload.ClusterId, "cluster id"),
+		ValidateKafkaPlan(ctx, h.service, h.kafkaConfig, kafkaRequestPayload),
+		validateKafkaBillingModel(ctx, h.service, h.kafkaConfig, kafkaRequestPayload),
+		ValidateBillingCloudAccountIdAndMarketplace(ctx, h.service, kafkaRequestPayload),
+	}
+}
+
+// registerKafka registers the kafka request of the validated payload, owned by the user
+func (h kafkaHandler) registerKafka(ctx context.Context, kafkaRequestPayload *public.KafkaRequestPayload) (interface{}, *errors.ServiceError) {
+	convKafka := presenters.ConvertKafkaRequest(*kafkaRequestPayload)
+
+	claims, _ := getClaims(ctx)
+	convKafka.Owner, _ = claims.GetUsername()
+	convKafka.OrganisationId, _ = claims.GetOrgId()
+	convKafka.OwnerAccountId, _ = claims.GetAccountId()
+
+	convKafka.InstanceType, convKafka.SizeId, _ = getInstanceTypeAndSize(ctx, h.service, h.kafkaConfig, kafkaRequestPayload)
+
+	convKafka.CloudProvider, convKafka.Region, _ = getCloudProviderAndRegion(ctx, h.service, kafkaRequestPayload, h.providerConfig)
+
+	svcErr := h.service.RegisterKafkaJob(convKafka)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return presenters.PresentKafkaRequest(convKafka, h.kafkaConfig)
+}
+
 func (h kafkaHandler) Get(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
@@ -87,6 +140,9 @@ func (h kafkaHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			if query := r.URL.Query(); query.Get("watch") == "true" {
+				return h.watch(ctx, parseGtVersion(query.Get("gt_version")), id), nil
+			}
 			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
 		},
 	}
@@ -115,7 +171,12 @@ func (h kafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 
-			listArgs := coreServices.NewListArguments(r.URL.Query())
+			query := r.URL.Query()
+			if query.Get("watch") == "true" {
+				return h.watch(ctx, parseGtVersion(query.Get("gt_version"))), nil
+			}
+
+			listArgs := coreServices.NewListArguments(query)
 
 			if err := listArgs.Validate(GetAcceptedOrderByParams()); err != nil {
 				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "unable to list kafka requests: %s", err.Error())
@@ -164,9 +225,19 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 		MarshalInto: &kafkaUpdateReq,
 		Validate: []handlers.Validate{
 			validateKafkaFound(),
-			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, kafkaRequest, &kafkaUpdateReq),
+			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, h.kafkaConfig, kafkaRequest, &kafkaUpdateReq),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			if kafkaUpdateReq.Plan != nil {
+				sizeId, err := getSizeFromUpdatePlan(h.kafkaConfig, kafkaRequest, *kafkaUpdateReq.Plan)
+				if err != nil {
+					return nil, err
+				}
+				if err := h.service.Resize(kafkaRequest, sizeId); err != nil {
+					return nil, err
+				}
+			}
+
 			updatedNeeded := false
 			if kafkaUpdateReq.ReauthenticationEnabled != nil && kafkaRequest.ReauthenticationEnabled != *kafkaUpdateReq.ReauthenticationEnabled {
 				kafkaRequest.ReauthenticationEnabled = *kafkaUpdateReq.ReauthenticationEnabled
@@ -194,3 +265,122 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
+
+// watch returns an event stream that emits a CHANGE event every time one of the kafka requests the caller has access to
+// changes status. If ids are given, only the kafka requests with those ids are watched.
+// A BOOKMARK event is sent once all the pending changes have been sent.
+func (h kafkaHandler) watch(ctx context.Context, gtVersion int64, ids ...string) handlers.EventStream {
+	var changes dbapi.KafkaList
+	idx := 0
+	bookmarkSent := false
+	// the last status sent for each kafka request, so that only status changes are emitted
+	statuses := map[string]string{}
+	// a watch started without a version first lists the current kafka requests, the kafka requests deleted
+	// before it started are not reported
+	includeDeleted := gtVersion > 0
+	// the version sent to the client, which can resume from it without missing a change
+	resumeVersion := gtVersion
+
+	sub := h.bus.Subscribe(services.KafkaStatusChangedSignal)
+	return handlers.EventStream{
+		ContentType: "application/json;stream=watch",
+		Close:       sub.Close,
+		GetNextEvent: func() (interface{}, *errors.ServiceError) {
+			for { // This function blocks until there is an event to return...
+				for idx < len(changes) {
+					kafkaRequest := changes[idx]
+					idx++
+					gtVersion = kafkaRequest.ResourceVersion
+					// the kafka requests changed by the same transaction share its version, so the client can only
+					// resume from it once all of them have been sent
+					if idx == len(changes) || changes[idx].ResourceVersion != gtVersion {
+						resumeVersion = gtVersion
+					}
+					// a soft deleted kafka request is reported once as deleted, whatever its last status is
+					eventType := "CHANGE"
+					if kafkaRequest.DeletedAt.Valid {
+						eventType = "DELETED"
+						delete(statuses, kafkaRequest.ID)
+					} else {
+						if status, ok := statuses[kafkaRequest.ID]; ok && status == kafkaRequest.Status {
+							continue
+						}
+						statuses[kafkaRequest.ID] = kafkaRequest.Status
+					}
+
+					converted, err := presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
+					if err != nil {
+						return nil, err
+					}
+					bookmarkSent = false
+					return public.KafkaRequestWatchEvent{
+						Type:    eventType,
+						Version: resumeVersion,
+						Object:  &converted,
+					}, nil
+				}
+
+				// get the next changes..
+				var err *errors.ServiceError
+				changes, err = h.service.ListChanges(ctx, gtVersion, includeDeleted, ids...)
+				if err != nil {
+					return nil, err
+				}
+				idx = 0
+				includeDeleted = true
+
+				// did we run out of changes to send?
+				if len(changes) == 0 {
+
+					// bookmark idea taken from: https://kubernetes.io/docs/reference/using-api/api-concepts/#watch-bookmarks
+					if !bookmarkSent {
+						bookmarkSent = true
+						return public.KafkaRequestWatchEvent{
+							Type:    "BOOKMARK",
+							Version: resumeVersion,
+						}, nil
+					}
+
+					// release the DB connection so that we don't tie those up while we wait to poll again..
+					if err := db.Resolve(ctx); err != nil {
+						return nil, errors.GeneralError("internal error")
+					}
+
+					if waitForCancelOrTimeoutOrNotification(ctx, 30*time.Second, sub) {
+						// ctx was canceled... likely due to the http connection being closed by
+						// the client.  Signal the event stream is done.
+						return nil, nil
+					}
+
+					// get a new DB connection...
+					if err := db.Begin(ctx); err != nil {
+						return nil, errors.GeneralError("internal error")
+					}
+				}
+			}
+		},
+	}
+}
+
+// parseGtVersion parses the gt_version query parameter, defaulting to 0 when it is missing or invalid
+func parseGtVersion(v string) int64 {
+	gtVersion, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return gtVersion
+}
+
+// waitForCancelOrTimeoutOrNotification returns true if the context has been canceled or false after the timeout or sub signal
+func waitForCancelOrTimeoutOrNotification(ctx context.Context, timeout time.Duration, sub *signalbus.Subscription) bool {
+	tc, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	select {
+	case <-tc.Done():
+		return false
+	case <-sub.Signal():
+		return false
+	case <-ctx.Done():
+		return true
+	}
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type kafkaInstanceTemplateHandler struct {
+	templateService services.KafkaInstanceTemplateService
+}
+
+func NewKafkaInstanceTemplateHandler(templateService services.KafkaInstanceTemplateService) *kafkaInstanceTemplateHandler {
+	return &kafkaInstanceTemplateHandler{
+		templateService: templateService,
+	}
+}
+
+// Create saves a kafka instance template in the organisation of the user. Any user of the organisation can use it to create kafkas
+func (h kafkaInstanceTemplateHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var templateRequest public.KafkaInstanceTemplateRequest
+	ctx := r.Context()
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &templateRequest,
+		Validate: []handlers.Validate{
+			validateKafkaInstanceTemplateRequest(&templateRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			createdBy, _ := claims.GetUsername()
+			template := presenters.ConvertKafkaInstanceTemplateRequest(templateRequest, orgID, createdBy)
+			if err := h.templateService.Create(template); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaInstanceTemplate(template), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h kafkaInstanceTemplateHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			template, err := h.getTemplate(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaInstanceTemplate(template), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// List returns the kafka instance templates of the organisation of the user
+func (h kafkaInstanceTemplateHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			templates, err := h.templateService.List(orgID)
+			if err != nil {
+				return nil, err
+			}
+
+			templateList := public.KafkaInstanceTemplateList{
+				Kind:  "KafkaInstanceTemplateList",
+				Page:  1,
+				Size:  int32(len(templates)),
+				Total: int32(len(templates)),
+				Items: []public.KafkaInstanceTemplate{},
+			}
+			for _, template := range templates {
+				templateList.Items = append(templateList.Items, presenters.PresentKafkaInstanceTemplate(template))
+			}
+
+			return templateList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Delete deletes the kafka instance template. Only the user who created it and the organisation admins can delete it.
+// The kafkas created from the template are not affected.
+func (h kafkaInstanceTemplateHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	template, templateGetError := h.getTemplate(ctx, mux.Vars(r)["id"])
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return templateGetError
+			},
+			validateUserCanDeleteKafkaInstanceTemplate(ctx, template),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.templateService.Delete(template.OrganisationId, template.ID)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// getTemplate returns the kafka instance template with the given id of the organisation of the user
+func (h kafkaInstanceTemplateHandler) getTemplate(ctx context.Context, id string) (*dbapi.KafkaInstanceTemplate, *errors.ServiceError) {
+	claims, err := getClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, _ := claims.GetOrgId()
+	return h.templateService.Get(orgID, id)
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	mocks "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_kafkaInstanceTemplateHandler_Create(t *testing.T) {
+	validRequest := public.KafkaInstanceTemplateRequest{
+		Name:          "team-default",
+		CloudProvider: "aws",
+		Region:        "us-east-1",
+		Plan:          "standard.x1",
+	}
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		request         public.KafkaInstanceTemplateRequest
+		createErr       *errors.ServiceError
+		wantStatusCode  int
+		wantCreateCalls int
+	}{
+		{
+			name:            "should create the kafka instance template of the organisation",
+			ctx:             ctx,
+			request:         validRequest,
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+		{
+			name:            "should create the kafka instance template when the user is not an org admin",
+			ctx:             nonAdminCtxWithClaims,
+			request:         validRequest,
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+		{
+			name:           "should fail when the name is empty",
+			ctx:            ctx,
+			request:        public.KafkaInstanceTemplateRequest{CloudProvider: "aws"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should fail when the plan is not in the <instance_type>.<size_id> format",
+			ctx:  ctx,
+			request: public.KafkaInstanceTemplateRequest{
+				Name: validRequest.Name,
+				Plan: "standard",
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should fail when the region is set without a cloud provider",
+			ctx:  ctx,
+			request: public.KafkaInstanceTemplateRequest{
+				Name:   validRequest.Name,
+				Region: validRequest.Region,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:            "should return a conflict when a template with the same name exists",
+			ctx:             ctx,
+			request:         validRequest,
+			createErr:       errors.Conflict("kafka instance template with name %q already exists", validRequest.Name),
+			wantStatusCode:  http.StatusConflict,
+			wantCreateCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			templateService := &services.KafkaInstanceTemplateServiceMock{
+				CreateFunc: func(template *dbapi.KafkaInstanceTemplate) *errors.ServiceError {
+					g.Expect(template.OrganisationId).To(gomega.Equal(mocks.DefaultOrganisationId))
+					g.Expect(template.Name).To(gomega.Equal(tt.request.Name))
+					template.ID = "template-id"
+					return tt.createErr
+				},
+			}
+			h := NewKafkaInstanceTemplateHandler(templateService)
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPost, "/kafka_instance_templates", bytes.NewBuffer(body), t)
+			h.Create(rw, req.WithContext(tt.ctx))
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(templateService.CreateCalls()).To(gomega.HaveLen(tt.wantCreateCalls))
+			if tt.wantStatusCode == http.StatusCreated {
+				var template public.KafkaInstanceTemplate
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &template)).To(gomega.Succeed())
+				g.Expect(template.Id).To(gomega.Equal("template-id"))
+				g.Expect(template.Kind).To(gomega.Equal("KafkaInstanceTemplate"))
+				g.Expect(template.Plan).To(gomega.Equal(tt.request.Plan))
+			}
+		})
+	}
+}
+
+func Test_kafkaInstanceTemplateHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	templateService := &services.KafkaInstanceTemplateServiceMock{
+		ListFunc: func(organisationId string) (dbapi.KafkaInstanceTemplateList, *errors.ServiceError) {
+			g.Expect(organisationId).To(gomega.Equal(mocks.DefaultOrganisationId))
+			return dbapi.KafkaInstanceTemplateList{
+				{Meta: api.Meta{ID: "template-1"}, Name: "a"},
+				{Meta: api.Meta{ID: "template-2"}, Name: "b"},
+			}, nil
+		},
+	}
+	h := NewKafkaInstanceTemplateHandler(templateService)
+	req, rw := GetHandlerParams(http.MethodGet, "/kafka_instance_templates", nil, t)
+	h.List(rw, req.WithContext(ctx))
+	g.Expect(rw.Code).To(gomega.Equal(http.StatusOK))
+
+	var list public.KafkaInstanceTemplateList
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(gomega.Succeed())
+	g.Expect(list.Kind).To(gomega.Equal("KafkaInstanceTemplateList"))
+	g.Expect(list.Total).To(gomega.Equal(int32(2)))
+	g.Expect(list.Items[1].Id).To(gomega.Equal("template-2"))
+}
+
+func Test_kafkaInstanceTemplateHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		createdBy       string
+		getErr          *errors.ServiceError
+		wantStatusCode  int
+		wantDeleteCalls int
+	}{
+		{
+			name:            "should delete the kafka instance template when the user created it",
+			ctx:             nonAdminCtxWithClaims,
+			createdBy:       "non-admin-user",
+			wantStatusCode:  http.StatusNoContent,
+			wantDeleteCalls: 1,
+		},
+		{
+			name:            "should delete the kafka instance template when the user is an org admin",
+			ctx:             ctx,
+			createdBy:       "another-user",
+			wantStatusCode:  http.StatusNoContent,
+			wantDeleteCalls: 1,
+		},
+		{
+			name:           "should fail when the user did not create the template and is not an org admin",
+			ctx:            nonAdminCtxWithClaims,
+			createdBy:      "another-user",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "should return not found when the template does not belong to the organisation",
+			ctx:            ctx,
+			getErr:         errors.NotFound("not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			templateService := &services.KafkaInstanceTemplateServiceMock{
+				GetFunc: func(organisationId string, templateId string) (*dbapi.KafkaInstanceTemplate, *errors.ServiceError) {
+					g.Expect(organisationId).To(gomega.Equal(mocks.DefaultOrganisationId))
+					g.Expect(templateId).To(gomega.Equal(id))
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &dbapi.KafkaInstanceTemplate{Meta: api.Meta{ID: id}, OrganisationId: organisationId, CreatedBy: tt.createdBy}, nil
+				},
+				DeleteFunc: func(organisationId string, templateId string) *errors.ServiceError {
+					return nil
+				},
+			}
+			h := NewKafkaInstanceTemplateHandler(templateService)
+			req, rw := GetHandlerParams(http.MethodDelete, "/kafka_instance_templates/{id}", nil, t)
+			req = mux.SetURLVars(req.WithContext(tt.ctx), map[string]string{"id": id})
+			h.Delete(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(templateService.DeleteCalls()).To(gomega.HaveLen(tt.wantDeleteCalls))
+		})
+	}
+}
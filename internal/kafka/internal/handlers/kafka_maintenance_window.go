@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type kafkaMaintenanceWindowHandler struct {
+	kafkaService             services.KafkaService
+	maintenanceWindowService services.KafkaMaintenanceWindowService
+}
+
+func NewKafkaMaintenanceWindowHandler(kafkaService services.KafkaService, maintenanceWindowService services.KafkaMaintenanceWindowService) *kafkaMaintenanceWindowHandler {
+	return &kafkaMaintenanceWindowHandler{
+		kafkaService:             kafkaService,
+		maintenanceWindowService: maintenanceWindowService,
+	}
+}
+
+// Get returns the maintenance window applying to the kafka: its own one or the one of its organisation
+func (h kafkaMaintenanceWindowHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			window, err := h.maintenanceWindowService.Get(kafkaRequest)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentMaintenanceWindow(window, time.Now()), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h kafkaMaintenanceWindowHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var windowRequest public.MaintenanceWindowRequest
+	ctx := r.Context()
+	kafkaRequest, kafkaGetError := h.kafkaService.Get(ctx, mux.Vars(r)["id"])
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &windowRequest,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return kafkaGetError
+			},
+			validateUserHasKafkaRole(ctx, kafkaRequest, dbapi.KafkaRoleEditor),
+			validateMaintenanceWindowRequest(&windowRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			window := presenters.ConvertMaintenanceWindowRequest(windowRequest, kafkaRequest.ID, kafkaRequest.OrganisationId)
+			if err := h.maintenanceWindowService.Upsert(window); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentMaintenanceWindow(window, time.Now()), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Delete deletes the maintenance window of the kafka. The window of its organisation, if any, applies afterwards
+func (h kafkaMaintenanceWindowHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	kafkaRequest, kafkaGetError := h.kafkaService.Get(ctx, mux.Vars(r)["id"])
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return kafkaGetError
+			},
+			validateUserHasKafkaRole(ctx, kafkaRequest, dbapi.KafkaRoleEditor),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			window, err := h.maintenanceWindowService.Get(kafkaRequest)
+			if err != nil {
+				return nil, err
+			}
+
+			if window.IsOrganisationWide() {
+				return nil, errors.NotFound("KafkaMaintenanceWindow with kafka_id='%s' not found", kafkaRequest.ID)
+			}
+
+			return nil, h.maintenanceWindowService.Delete(window)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// GetForOrganisation returns the maintenance window applying to the kafkas of the organisation of the user
+func (h kafkaMaintenanceWindowHandler) GetForOrganisation(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			window, err := h.maintenanceWindowService.GetForOrganisation(orgID)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentMaintenanceWindow(window, time.Now()), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h kafkaMaintenanceWindowHandler) UpdateForOrganisation(w http.ResponseWriter, r *http.Request) {
+	var windowRequest public.MaintenanceWindowRequest
+	ctx := r.Context()
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &windowRequest,
+		Validate: []handlers.Validate{
+			validateUserIsOrgAdmin(ctx),
+			validateMaintenanceWindowRequest(&windowRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			window := presenters.ConvertMaintenanceWindowRequest(windowRequest, "", orgID)
+			if err := h.maintenanceWindowService.Upsert(window); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentMaintenanceWindow(window, time.Now()), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h kafkaMaintenanceWindowHandler) DeleteForOrganisation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			validateUserIsOrgAdmin(ctx),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			window, err := h.maintenanceWindowService.GetForOrganisation(orgID)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, h.maintenanceWindowService.Delete(window)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	mocks "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+var (
+	testKafkaMaintenanceWindow = &dbapi.KafkaMaintenanceWindow{
+		Meta:           api.Meta{ID: "window-id"},
+		KafkaID:        id,
+		OrganisationId: mocks.DefaultOrganisationId,
+		DayOfWeek:      "sunday",
+		StartHour:      2,
+		DurationHours:  4,
+	}
+	testOrganisationMaintenanceWindow = &dbapi.KafkaMaintenanceWindow{
+		Meta:           api.Meta{ID: "org-window-id"},
+		OrganisationId: mocks.DefaultOrganisationId,
+		DayOfWeek:      "sunday",
+		StartHour:      2,
+		DurationHours:  4,
+	}
+	nonOrgAdminCtx = auth.SetTokenInContext(context.TODO(), &jwt.Token{
+		Claims: jwt.MapClaims{
+			"username":     "another-user",
+			"org_id":       mocks.DefaultOrganisationId,
+			"is_org_admin": false,
+		},
+	})
+)
+
+func Test_kafkaMaintenanceWindowHandler_Get(t *testing.T) {
+	tests := []struct {
+		name                     string
+		kafkaService             services.KafkaService
+		maintenanceWindowService services.KafkaMaintenanceWindowService
+		wantStatusCode           int
+	}{
+		{
+			name: "should return the maintenance window of the kafka",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			},
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{
+				GetFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+					return testKafkaMaintenanceWindow, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should return not found when the kafka does not exist",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return nil, errors.NotFound("not found")
+				},
+			},
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{},
+			wantStatusCode:           http.StatusNotFound,
+		},
+		{
+			name: "should return not found when the kafka has no maintenance window",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			},
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{
+				GetFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+					return nil, errors.NotFound("not found")
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewKafkaMaintenanceWindowHandler(tt.kafkaService, tt.maintenanceWindowService)
+			req, rw := GetHandlerParams(http.MethodGet, "/{id}/maintenance_window", nil, t)
+			req = mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": id})
+			h.Get(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
+
+func Test_kafkaMaintenanceWindowHandler_Update(t *testing.T) {
+	validRequest := public.MaintenanceWindowRequest{
+		DayOfWeek:     "Sunday",
+		StartHour:     2,
+		DurationHours: 4,
+	}
+
+	tests := []struct {
+		name                     string
+		ctx                      context.Context
+		request                  public.MaintenanceWindowRequest
+		kafkaService             services.KafkaService
+		maintenanceWindowService services.KafkaMaintenanceWindowService
+		wantStatusCode           int
+	}{
+		{
+			name:    "should set the maintenance window of the kafka",
+			ctx:     ctx,
+			request: validRequest,
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			},
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{
+				UpsertFunc: func(window *dbapi.KafkaMaintenanceWindow) *errors.ServiceError {
+					if window.DayOfWeek != "sunday" || window.OrganisationId != mocks.DefaultOrganisationId {
+						return errors.GeneralError("unexpected maintenance window")
+					}
+					return nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should fail when the day of the week is invalid",
+			ctx:  ctx,
+			request: public.MaintenanceWindowRequest{
+				DayOfWeek:     "someday",
+				StartHour:     2,
+				DurationHours: 4,
+			},
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			},
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{},
+			wantStatusCode:           http.StatusBadRequest,
+		},
+		{
+			name: "should fail when the start hour is invalid",
+			ctx:  ctx,
+			request: public.MaintenanceWindowRequest{
+				DayOfWeek:     "sunday",
+				StartHour:     24,
+				DurationHours: 4,
+			},
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			},
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{},
+			wantStatusCode:           http.StatusBadRequest,
+		},
+		{
+			name: "should fail when the duration is invalid",
+			ctx:  ctx,
+			request: public.MaintenanceWindowRequest{
+				DayOfWeek:     "sunday",
+				StartHour:     2,
+				DurationHours: 0,
+			},
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			},
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{},
+			wantStatusCode:           http.StatusBadRequest,
+		},
+		{
+			name:    "should fail when the user is neither the owner of the kafka nor an org admin",
+			ctx:     nonOrgAdminCtx,
+			request: validRequest,
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			},
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{},
+			wantStatusCode:           http.StatusForbidden,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewKafkaMaintenanceWindowHandler(tt.kafkaService, tt.maintenanceWindowService)
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPut, "/{id}/maintenance_window", bytes.NewBuffer(body), t)
+			req = mux.SetURLVars(req.WithContext(tt.ctx), map[string]string{"id": id})
+			h.Update(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
+
+func Test_kafkaMaintenanceWindowHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name                     string
+		maintenanceWindowService *services.KafkaMaintenanceWindowServiceMock
+		wantStatusCode           int
+		wantDeleteCalls          int
+	}{
+		{
+			name: "should delete the maintenance window of the kafka",
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{
+				GetFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+					return testKafkaMaintenanceWindow, nil
+				},
+				DeleteFunc: func(window *dbapi.KafkaMaintenanceWindow) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantStatusCode:  http.StatusNoContent,
+			wantDeleteCalls: 1,
+		},
+		{
+			name: "should not delete the maintenance window of the organisation",
+			maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{
+				GetFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+					return testOrganisationMaintenanceWindow, nil
+				},
+				DeleteFunc: func(window *dbapi.KafkaMaintenanceWindow) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantStatusCode:  http.StatusNotFound,
+			wantDeleteCalls: 0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			}
+			h := NewKafkaMaintenanceWindowHandler(kafkaService, tt.maintenanceWindowService)
+			req, rw := GetHandlerParams(http.MethodDelete, "/{id}/maintenance_window", nil, t)
+			req = mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": id})
+			h.Delete(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(tt.maintenanceWindowService.DeleteCalls()).To(gomega.HaveLen(tt.wantDeleteCalls))
+		})
+	}
+}
+
+func Test_kafkaMaintenanceWindowHandler_UpdateForOrganisation(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		wantStatusCode int
+	}{
+		{
+			name:           "should set the maintenance window of the organisation when the user is an org admin",
+			ctx:            ctx,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should fail when the user is not an org admin",
+			ctx:            nonOrgAdminCtx,
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			maintenanceWindowService := &services.KafkaMaintenanceWindowServiceMock{
+				UpsertFunc: func(window *dbapi.KafkaMaintenanceWindow) *errors.ServiceError {
+					if !window.IsOrganisationWide() {
+						return errors.GeneralError("unexpected maintenance window")
+					}
+					return nil
+				},
+			}
+			h := NewKafkaMaintenanceWindowHandler(&services.KafkaServiceMock{}, maintenanceWindowService)
+			body, err := json.Marshal(public.MaintenanceWindowRequest{DayOfWeek: "monday", StartHour: 0, DurationHours: 24})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPut, "/maintenance_window", bytes.NewBuffer(body), t)
+			h.UpdateForOrganisation(rw, req.WithContext(tt.ctx))
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
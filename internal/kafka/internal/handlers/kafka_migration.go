@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
+	"github.com/gorilla/mux"
+)
+
+type adminKafkaMigrationHandler struct {
+	migrationService services.KafkaMigrationService
+	accountService   account.AccountService
+}
+
+func NewAdminKafkaMigrationHandler(migrationService services.KafkaMigrationService, accountService account.AccountService) *adminKafkaMigrationHandler {
+	return &adminKafkaMigrationHandler{
+		migrationService: migrationService,
+		accountService:   accountService,
+	}
+}
+
+// Move starts the live migration of the kafka to the data plane cluster given in the request
+func (h adminKafkaMigrationHandler) Move(w http.ResponseWriter, r *http.Request) {
+	var request private.KafkaMoveRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&request.TargetClusterId, "target_cluster_id", 1, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafka, err := h.migrationService.Move(mux.Vars(r)["id"], request.TargetClusterId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaRequestAdminEndpoint(kafka, h.accountService)
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// Drain starts the live migration of the kafkas of the data plane cluster to the other clusters of its region
+func (h adminKafkaMigrationHandler) Drain(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			migrations, err := h.migrationService.Drain(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaMigrations(migrations), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
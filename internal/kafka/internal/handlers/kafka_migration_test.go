@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaMigrationHandler_Move(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        private.KafkaMoveRequest
+		moveErr        *errors.ServiceError
+		wantStatusCode int
+		wantMoveCalls  int
+	}{
+		{
+			name:           "should start the migration of the kafka to the target cluster",
+			request:        private.KafkaMoveRequest{TargetClusterId: "target"},
+			wantStatusCode: http.StatusAccepted,
+			wantMoveCalls:  1,
+		},
+		{
+			name:           "should fail when the target cluster is not given",
+			request:        private.KafkaMoveRequest{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the kafka cannot be moved",
+			request:        private.KafkaMoveRequest{TargetClusterId: "target"},
+			moveErr:        errors.BadRequest("kafka cannot be moved"),
+			wantStatusCode: http.StatusBadRequest,
+			wantMoveCalls:  1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			migrationService := &services.KafkaMigrationServiceMock{
+				MoveFunc: func(kafkaID string, targetClusterID string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					if tt.moveErr != nil {
+						return nil, tt.moveErr
+					}
+					return &dbapi.KafkaRequest{
+						Meta:                     api.Meta{ID: kafkaID},
+						ClusterID:                "source",
+						MaxDataRetentionSize:     "100Gi",
+						MigrationStatus:          dbapi.KafkaMigrationStatusProvisioning,
+						MigrationSourceClusterID: "source",
+						MigrationTargetClusterID: targetClusterID,
+					}, nil
+				},
+			}
+			h := NewAdminKafkaMigrationHandler(migrationService, account.NewMockAccountService())
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPost, "/kafkas/kafka-id/move", bytes.NewBuffer(body), t)
+			req = mux.SetURLVars(req, map[string]string{"id": "kafka-id"})
+			h.Move(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(migrationService.MoveCalls()).To(gomega.HaveLen(tt.wantMoveCalls))
+			if tt.wantStatusCode != http.StatusAccepted {
+				return
+			}
+
+			g.Expect(migrationService.MoveCalls()[0].KafkaID).To(gomega.Equal("kafka-id"))
+			var response private.Kafka
+			g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).To(gomega.Succeed())
+			g.Expect(response.MigrationStatus).To(gomega.Equal("provisioning"))
+			g.Expect(response.MigrationTargetClusterId).To(gomega.Equal("target"))
+		})
+	}
+}
+
+func Test_adminKafkaMigrationHandler_Drain(t *testing.T) {
+	g := gomega.NewWithT(t)
+	migrationService := &services.KafkaMigrationServiceMock{
+		DrainFunc: func(clusterID string) ([]services.KafkaMigration, *errors.ServiceError) {
+			return []services.KafkaMigration{
+				{Kafka: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, ClusterID: clusterID, MigrationStatus: dbapi.KafkaMigrationStatusProvisioning, MigrationTargetClusterID: "target"}},
+				{Kafka: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-2"}, ClusterID: clusterID, MigrationStatus: dbapi.KafkaMigrationStatusFailed, MigrationTargetClusterID: "other"},
+					Reason: `kafka status is "suspended"`},
+			}, nil
+		},
+	}
+	h := NewAdminKafkaMigrationHandler(migrationService, account.NewMockAccountService())
+	req, rw := GetHandlerParams(http.MethodPost, "/clusters/source/drain", nil, t)
+	req = mux.SetURLVars(req, map[string]string{"id": "source"})
+	h.Drain(rw, req)
+	g.Expect(rw.Code).To(gomega.Equal(http.StatusAccepted))
+	g.Expect(migrationService.DrainCalls()[0].ClusterID).To(gomega.Equal("source"))
+
+	var response private.KafkaMigrationList
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).To(gomega.Succeed())
+	g.Expect(response).To(gomega.Equal(private.KafkaMigrationList{
+		Kind: "KafkaMigrationList",
+		Items: []private.KafkaMigration{
+			{KafkaId: "kafka-1", SourceClusterId: "source", TargetClusterId: "target", MigrationStatus: "provisioning"},
+			{KafkaId: "kafka-2", SourceClusterId: "source", Reason: `kafka status is "suspended"`},
+		},
+	}))
+}
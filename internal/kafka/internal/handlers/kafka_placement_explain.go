@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+)
+
+type adminKafkaPlacementHandler struct {
+	placementExplainService services.KafkaPlacementExplainService
+	kafkaConfig             *config.KafkaConfig
+	providerConfig          *config.ProviderConfig
+}
+
+func NewAdminKafkaPlacementHandler(placementExplainService services.KafkaPlacementExplainService, kafkaConfig *config.KafkaConfig, providerConfig *config.ProviderConfig) *adminKafkaPlacementHandler {
+	return &adminKafkaPlacementHandler{
+		placementExplainService: placementExplainService,
+		kafkaConfig:             kafkaConfig,
+		providerConfig:          providerConfig,
+	}
+}
+
+// Explain runs the placement of the kafka described by the payload without creating it.
+// The organisation owning the kafka can be given with the "organisation_id" query parameter.
+func (h adminKafkaPlacementHandler) Explain(w http.ResponseWriter, r *http.Request) {
+	var kafkaRequestPayload public.KafkaRequestPayload
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &kafkaRequestPayload,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				// the cluster id is only known once the payload is unmarshalled
+				return handlers.ValidateNotEmptyClusterId(kafkaRequestPayload.ClusterId, "cluster id")()
+			},
+			validateKafkaPlacementPlan(h.kafkaConfig, &kafkaRequestPayload),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafka, err := h.convertKafkaPlacementRequest(kafkaRequestPayload, r.URL.Query().Get("organisation_id"))
+			if err != nil {
+				return nil, err
+			}
+
+			explanation, err := h.placementExplainService.ExplainPlacement(kafka)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaPlacementExplanation(explanation), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// convertKafkaPlacementRequest converts the payload to a kafka request, defaulting the cloud provider and region of the
+// kafkas not assigned to a dedicated cluster like when creating a kafka
+func (h adminKafkaPlacementHandler) convertKafkaPlacementRequest(kafkaRequestPayload public.KafkaRequestPayload, organisationId string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+	kafka := presenters.ConvertKafkaRequest(kafkaRequestPayload)
+	kafka.OrganisationId = organisationId
+	kafka.InstanceType, kafka.SizeId = getKafkaPlacementInstanceTypeAndSize(h.kafkaConfig, &kafkaRequestPayload)
+
+	if !shared.StringEmpty(kafkaRequestPayload.ClusterId) {
+		return kafka, nil
+	}
+
+	supportedProviders := h.providerConfig.ProvidersConfig.SupportedProviders
+	if kafka.CloudProvider == "" {
+		defaultProvider, _ := supportedProviders.GetDefault()
+		kafka.CloudProvider = defaultProvider.Name
+	}
+	provider, providerSupported := supportedProviders.GetByName(kafka.CloudProvider)
+	if !providerSupported {
+		return nil, errors.ProviderNotSupported("provider %s is not supported, supported providers are: %s", kafka.CloudProvider, supportedProviders)
+	}
+	if kafka.Region == "" {
+		region, _ := provider.GetDefaultRegion()
+		kafka.Region = region.Name
+	} else if !provider.IsRegionSupported(kafka.Region) {
+		return nil, errors.RegionNotSupported("region %s is not supported for %s, supported regions are: %s", kafka.Region, kafka.CloudProvider, provider.Regions)
+	}
+
+	return kafka, nil
+}
+
+// getKafkaPlacementInstanceTypeAndSize returns the instance type and size of the plan, or the first size of the standard instance type
+// when no plan is given. The plan has to be validated first.
+func getKafkaPlacementInstanceTypeAndSize(kafkaConfig *config.KafkaConfig, kafkaRequestPayload *public.KafkaRequestPayload) (string, string) {
+	if !stringSet(&kafkaRequestPayload.Plan) {
+		size, _ := kafkaConfig.GetFirstAvailableSize(types.STANDARD.String())
+		return types.STANDARD.String(), size.Id
+	}
+
+	plan := config.Plan(kafkaRequestPayload.Plan)
+	instanceType, _ := plan.GetInstanceType()
+	sizeId, _ := plan.GetSizeID()
+	return instanceType, sizeId
+}
+
+func validateKafkaPlacementPlan(kafkaConfig *config.KafkaConfig, kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		if !stringSet(&kafkaRequestPayload.Plan) {
+			if _, err := kafkaConfig.GetFirstAvailableSize(types.STANDARD.String()); err != nil {
+				return errors.InstanceTypeNotSupported("unsupported kafka instance type: %q provided", types.STANDARD.String())
+			}
+			return nil
+		}
+
+		plan := config.Plan(kafkaRequestPayload.Plan)
+		instanceType, err := plan.GetInstanceType()
+		if err != nil {
+			return errors.New(errors.ErrorBadRequest, fmt.Sprintf("unable to detect instance type in plan provided: %q", kafkaRequestPayload.Plan))
+		}
+		sizeId, err := plan.GetSizeID()
+		if err != nil {
+			return errors.New(errors.ErrorBadRequest, fmt.Sprintf("unable to detect instance size in plan provided: %q", kafkaRequestPayload.Plan))
+		}
+		if _, err := kafkaConfig.GetKafkaInstanceSize(instanceType, sizeId); err != nil {
+			return errors.InstancePlanNotSupported("unsupported plan provided: %q", kafkaRequestPayload.Plan)
+		}
+		return nil
+	}
+}
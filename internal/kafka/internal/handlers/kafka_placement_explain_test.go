@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaPlacementHandler_Explain(t *testing.T) {
+	clusterID := "clusterid"
+
+	tests := []struct {
+		name           string
+		request        public.KafkaRequestPayload
+		url            string
+		wantKafka      *dbapi.KafkaRequest
+		wantStatusCode int
+		wantResponse   private.KafkaPlacementExplanation
+	}{
+		{
+			name:    "should explain the placement of the first size of the standard instance type when no plan is given",
+			request: public.KafkaRequestPayload{Name: "test", CloudProvider: "aws", Region: "us-east-1"},
+			url:     "/kafkas/placement_explain",
+			wantKafka: &dbapi.KafkaRequest{
+				Name:                    "test",
+				CloudProvider:           "aws",
+				Region:                  "us-east-1",
+				InstanceType:            "standard",
+				SizeId:                  "x1",
+				ReauthenticationEnabled: true,
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: private.KafkaPlacementExplanation{
+				Kind:                    "KafkaPlacementExplanation",
+				RegionCapacityAvailable: true,
+				Candidates: []private.KafkaPlacementCandidate{
+					{ClusterId: "cluster-1", CloudProvider: "aws", Region: "us-east-1", Status: "cluster_provisioning", Accepted: false, RejectedBy: "status", Reason: "cluster status is \"cluster_provisioning\""},
+					{ClusterId: "cluster-2", CloudProvider: "aws", Region: "us-east-1", Status: "ready", Accepted: true},
+				},
+				ChosenClusterId: "cluster-2",
+			},
+		},
+		{
+			name:    "should explain the placement of an enterprise kafka of the given organisation",
+			request: public.KafkaRequestPayload{Name: "test", Plan: "developer.x1", ClusterId: &clusterID},
+			url:     "/kafkas/placement_explain?organisation_id=org-id",
+			wantKafka: &dbapi.KafkaRequest{
+				Name:                     "test",
+				InstanceType:             "developer",
+				SizeId:                   "x1",
+				ClusterID:                clusterID,
+				OrganisationId:           "org-id",
+				DesiredKafkaBillingModel: "enterprise",
+				ReauthenticationEnabled:  true,
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should fail when the plan is not supported",
+			request:        public.KafkaRequestPayload{Name: "test", CloudProvider: "aws", Region: "us-east-1", Plan: "standard.x2"},
+			url:            "/kafkas/placement_explain",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the region is not supported",
+			request:        public.KafkaRequestPayload{Name: "test", CloudProvider: "aws", Region: "eu-west-1"},
+			url:            "/kafkas/placement_explain",
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			placementExplainService := &services.KafkaPlacementExplainServiceMock{
+				ExplainPlacementFunc: func(kafka *dbapi.KafkaRequest) (*services.KafkaPlacementExplanation, *errors.ServiceError) {
+					return &services.KafkaPlacementExplanation{
+						RegionCapacityAvailable: true,
+						Candidates: []services.KafkaPlacementCandidate{
+							{Cluster: &api.Cluster{ClusterID: "cluster-1", CloudProvider: "aws", Region: "us-east-1", Status: api.ClusterProvisioning}, RejectedBy: services.KafkaPlacementFilterStatus, Reason: "cluster status is \"cluster_provisioning\""},
+							{Cluster: &api.Cluster{ClusterID: "cluster-2", CloudProvider: "aws", Region: "us-east-1", Status: api.ClusterReady}},
+						},
+						ChosenCluster: &api.Cluster{ClusterID: "cluster-2"},
+					}, nil
+				},
+			}
+			h := NewAdminKafkaPlacementHandler(placementExplainService, &fullKafkaConfig, &supportedProviders)
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPost, tt.url, bytes.NewBuffer(body), t)
+			h.Explain(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantStatusCode != http.StatusOK {
+				g.Expect(placementExplainService.ExplainPlacementCalls()).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(placementExplainService.ExplainPlacementCalls()).To(gomega.HaveLen(1))
+			g.Expect(placementExplainService.ExplainPlacementCalls()[0].Kafka).To(gomega.Equal(tt.wantKafka))
+			if tt.wantResponse.Kind != "" {
+				var response private.KafkaPlacementExplanation
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).To(gomega.Succeed())
+				g.Expect(response).To(gomega.Equal(tt.wantResponse))
+			}
+		})
+	}
+}
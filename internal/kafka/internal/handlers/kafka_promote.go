@@ -44,7 +44,7 @@ func (h kafkaPromoteHandler) Promote(w http.ResponseWriter, r *http.Request) {
 			handlers.ValidateAsyncEnabled(r, "promote a kafka request"),
 			handlers.ValidateMinLength(&kafkaPromoteRequest.DesiredKafkaBillingModel, "desired_kafka_billing_model", 1),
 			validateKafkaFound(),
-			validateUserIsKafkaOwnerOrOrgAdmin(ctx, kafkaRequest),
+			validateUserHasKafkaRole(ctx, kafkaRequest, dbapi.KafkaRoleOwner),
 			validateKafkaRequestToPromoteHasAPromotableActualKafkaBillingModel(kafkaRequest),
 			validateKafkaRequestToPromoteHasAPromotableStatus(kafkaRequest),
 			validateRequestedKafkaPromotionHasDifferentKafkaBillingModel(&kafkaPromoteRequest, kafkaRequest),
@@ -41,7 +41,7 @@ func (v *amsKafkaPromoteValidator) Validate(r kafkaPromoteValidatorRequest) erro
 		desiredAMSBillingModel = fmt.Sprintf("%s-%s", desiredAMSBillingModel, r.DesiredKafkaMarketplace)
 	}
 
-	kafkaInstanceTypeConfig, err := v.KafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(r.KafkaInstanceType)
+	kafkaInstanceTypeConfig, err := v.KafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(r.KafkaInstanceType)
 	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "error getting instance type: %s", err.Error())
 	}
@@ -66,7 +66,7 @@ type quotaManagementListKafkaPromoteValidator struct {
 var _ kafkaPromoteValidator = &quotaManagementListKafkaPromoteValidator{}
 
 func (v *quotaManagementListKafkaPromoteValidator) Validate(r kafkaPromoteValidatorRequest) error {
-	kafkaInstanceTypeConfig, err := v.KafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(r.KafkaInstanceType)
+	kafkaInstanceTypeConfig, err := v.KafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(r.KafkaInstanceType)
 	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "error getting instance type: %s", err.Error())
 	}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/gorilla/mux"
+)
+
+type kafkaRoleBindingHandler struct {
+	kafkaService       services.KafkaService
+	roleBindingService services.KafkaRoleBindingService
+	authService        authorization.Authorization
+}
+
+func NewKafkaRoleBindingHandler(kafkaService services.KafkaService, roleBindingService services.KafkaRoleBindingService, authService authorization.Authorization) *kafkaRoleBindingHandler {
+	return &kafkaRoleBindingHandler{
+		kafkaService:       kafkaService,
+		roleBindingService: roleBindingService,
+		authService:        authService,
+	}
+}
+
+// List returns the role bindings of the kafka. They can be listed by any user who can get the kafka
+func (h kafkaRoleBindingHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			bindings, err := h.roleBindingService.List(kafkaRequest.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			bindingList := public.KafkaRoleBindingList{
+				Kind:  "KafkaRoleBindingList",
+				Page:  1,
+				Size:  int32(len(bindings)),
+				Total: int32(len(bindings)),
+				Items: []public.KafkaRoleBinding{},
+			}
+			for _, binding := range bindings {
+				bindingList.Items = append(bindingList.Items, presenters.PresentKafkaRoleBinding(binding))
+			}
+
+			return bindingList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Create binds a role on the kafka to a user of its organisation. Only the owners of the kafka can manage its role bindings
+func (h kafkaRoleBindingHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var bindingRequest public.KafkaRoleBindingRequest
+	ctx := r.Context()
+	kafkaRequest, kafkaGetError := h.kafkaService.Get(ctx, mux.Vars(r)["id"])
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &bindingRequest,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return kafkaGetError
+			},
+			validateUserHasKafkaRole(ctx, kafkaRequest, dbapi.KafkaRoleOwner),
+			validateKafkaRoleBindingRequest(ctx, h.authService, &bindingRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			createdBy, _ := claims.GetUsername()
+			binding := presenters.ConvertKafkaRoleBindingRequest(bindingRequest, kafkaRequest.ID, createdBy)
+			if err := h.roleBindingService.Create(binding); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaRoleBinding(binding), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h kafkaRoleBindingHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	kafkaRequest, kafkaGetError := h.kafkaService.Get(ctx, mux.Vars(r)["id"])
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return kafkaGetError
+			},
+			validateUserHasKafkaRole(ctx, kafkaRequest, dbapi.KafkaRoleOwner),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.roleBindingService.Delete(kafkaRequest.ID, mux.Vars(r)["role_binding_id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
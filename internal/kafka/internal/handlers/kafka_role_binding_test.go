@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	mocks "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func buildRoleBindingTestKafkaService(role dbapi.KafkaRole) *services.KafkaServiceMock {
+	return &services.KafkaServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			return &dbapi.KafkaRequest{
+				Meta:           api.Meta{ID: id},
+				OrganisationId: mocks.DefaultOrganisationId,
+				Owner:          "kafka-owner",
+				Role:           role,
+			}, nil
+		},
+	}
+}
+
+func Test_kafkaRoleBindingHandler_Create(t *testing.T) {
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		role            dbapi.KafkaRole
+		request         public.KafkaRoleBindingRequest
+		userValid       bool
+		wantStatusCode  int
+		wantCreateCalls int
+	}{
+		{
+			name:            "should bind the role when the user is an owner of the kafka",
+			ctx:             nonOrgAdminCtx,
+			role:            dbapi.KafkaRoleOwner,
+			request:         public.KafkaRoleBindingRequest{Subject: "a-user", Role: "editor"},
+			userValid:       true,
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+		{
+			name:            "should bind the role when the user is an org admin",
+			ctx:             ctx,
+			request:         public.KafkaRoleBindingRequest{Subject: "a-user", Role: "viewer"},
+			userValid:       true,
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+		{
+			name:           "should fail when the user is only an editor of the kafka",
+			ctx:            nonOrgAdminCtx,
+			role:           dbapi.KafkaRoleEditor,
+			request:        public.KafkaRoleBindingRequest{Subject: "a-user", Role: "viewer"},
+			userValid:      true,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "should fail when the role is not supported",
+			ctx:            ctx,
+			request:        public.KafkaRoleBindingRequest{Subject: "a-user", Role: "admin"},
+			userValid:      true,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the subject does not belong to the organisation",
+			ctx:            ctx,
+			request:        public.KafkaRoleBindingRequest{Subject: "a-user", Role: "viewer"},
+			userValid:      false,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			roleBindingService := &services.KafkaRoleBindingServiceMock{
+				CreateFunc: func(binding *dbapi.KafkaRoleBinding) *errors.ServiceError {
+					g.Expect(binding.KafkaID).To(gomega.Equal(id))
+					g.Expect(binding.Subject).To(gomega.Equal(tt.request.Subject))
+					g.Expect(binding.Role.String()).To(gomega.Equal(tt.request.Role))
+					binding.ID = "binding-id"
+					return nil
+				},
+			}
+			authService := &authorization.AuthorizationMock{
+				CheckUserValidFunc: func(username string, orgId string) (bool, error) {
+					return tt.userValid, nil
+				},
+			}
+			h := NewKafkaRoleBindingHandler(buildRoleBindingTestKafkaService(tt.role), roleBindingService, authService)
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPost, "/kafkas/{id}/role_bindings", bytes.NewBuffer(body), t)
+			req = mux.SetURLVars(req.WithContext(tt.ctx), map[string]string{"id": id})
+			h.Create(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(roleBindingService.CreateCalls()).To(gomega.HaveLen(tt.wantCreateCalls))
+			if tt.wantStatusCode == http.StatusCreated {
+				var binding public.KafkaRoleBinding
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &binding)).To(gomega.Succeed())
+				g.Expect(binding.Id).To(gomega.Equal("binding-id"))
+				g.Expect(binding.Role).To(gomega.Equal(tt.request.Role))
+			}
+		})
+	}
+}
+
+func Test_kafkaRoleBindingHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	roleBindingService := &services.KafkaRoleBindingServiceMock{
+		ListFunc: func(kafkaID string) (dbapi.KafkaRoleBindingList, *errors.ServiceError) {
+			g.Expect(kafkaID).To(gomega.Equal(id))
+			return dbapi.KafkaRoleBindingList{
+				{Meta: api.Meta{ID: "binding-1"}, KafkaID: id, Subject: "a-user", Role: dbapi.KafkaRoleViewer},
+				{Meta: api.Meta{ID: "binding-2"}, KafkaID: id, Subject: "another-user", Role: dbapi.KafkaRoleEditor},
+			}, nil
+		},
+	}
+	h := NewKafkaRoleBindingHandler(buildRoleBindingTestKafkaService(dbapi.KafkaRoleViewer), roleBindingService, &authorization.AuthorizationMock{})
+	req, rw := GetHandlerParams(http.MethodGet, "/kafkas/{id}/role_bindings", nil, t)
+	req = mux.SetURLVars(req.WithContext(nonOrgAdminCtx), map[string]string{"id": id})
+	h.List(rw, req)
+	g.Expect(rw.Code).To(gomega.Equal(http.StatusOK))
+
+	var list public.KafkaRoleBindingList
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(gomega.Succeed())
+	g.Expect(list.Total).To(gomega.Equal(int32(2)))
+	g.Expect(list.Items[1].Role).To(gomega.Equal(dbapi.KafkaRoleEditor.String()))
+}
+
+func Test_kafkaRoleBindingHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name            string
+		role            dbapi.KafkaRole
+		deleteErr       *errors.ServiceError
+		wantStatusCode  int
+		wantDeleteCalls int
+	}{
+		{
+			name:            "should delete the role binding when the user is an owner of the kafka",
+			role:            dbapi.KafkaRoleOwner,
+			wantStatusCode:  http.StatusNoContent,
+			wantDeleteCalls: 1,
+		},
+		{
+			name:           "should fail when the user is only a viewer of the kafka",
+			role:           dbapi.KafkaRoleViewer,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:            "should return not found when the role binding does not exist",
+			role:            dbapi.KafkaRoleOwner,
+			deleteErr:       errors.NotFound("not found"),
+			wantStatusCode:  http.StatusNotFound,
+			wantDeleteCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			roleBindingService := &services.KafkaRoleBindingServiceMock{
+				DeleteFunc: func(kafkaID string, bindingID string) *errors.ServiceError {
+					g.Expect(kafkaID).To(gomega.Equal(id))
+					g.Expect(bindingID).To(gomega.Equal("binding-id"))
+					return tt.deleteErr
+				},
+			}
+			h := NewKafkaRoleBindingHandler(buildRoleBindingTestKafkaService(tt.role), roleBindingService, &authorization.AuthorizationMock{})
+			req, rw := GetHandlerParams(http.MethodDelete, "/kafkas/{id}/role_bindings/{role_binding_id}", nil, t)
+			req = mux.SetURLVars(req.WithContext(nonOrgAdminCtx), map[string]string{"id": id, "role_binding_id": "binding-id"})
+			h.Delete(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(roleBindingService.DeleteCalls()).To(gomega.HaveLen(tt.wantDeleteCalls))
+		})
+	}
+}
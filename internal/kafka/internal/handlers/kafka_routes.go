@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+)
+
+const kafkaRoutesExportContentType = "text/plain; charset=utf-8"
+
+type adminKafkaRoutesHandler struct {
+	routesExportService services.KafkaRoutesExportService
+}
+
+func NewAdminKafkaRoutesHandler(routesExportService services.KafkaRoutesExportService) *adminKafkaRoutesHandler {
+	return &adminKafkaRoutesHandler{
+		routesExportService: routesExportService,
+	}
+}
+
+// GetZoneFile exports the routes of all the kafkas as a BIND zone file
+func (h adminKafkaRoutesHandler) GetZoneFile(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			zoneFile, err := h.routesExportService.ZoneFile()
+			if err != nil {
+				return nil, err
+			}
+			return handlers.TextResponse{ContentType: kafkaRoutesExportContentType, Body: zoneFile}, nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// GetHostsFile exports the routes of all the kafkas as an /etc/hosts snippet
+func (h adminKafkaRoutesHandler) GetHostsFile(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			hostsFile, err := h.routesExportService.HostsFile()
+			if err != nil {
+				return nil, err
+			}
+			return handlers.TextResponse{ContentType: kafkaRoutesExportContentType, Body: hostsFile}, nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
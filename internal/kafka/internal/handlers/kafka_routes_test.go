@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaRoutesHandler(t *testing.T) {
+	tests := []struct {
+		name                string
+		routesExportService services.KafkaRoutesExportService
+		getZoneFile         bool
+		wantStatusCode      int
+		wantBody            string
+	}{
+		{
+			name: "should return the zone file",
+			routesExportService: &services.KafkaRoutesExportServiceMock{
+				ZoneFileFunc: func() (string, *errors.ServiceError) {
+					return "$TTL 300\n", nil
+				},
+			},
+			getZoneFile:    true,
+			wantStatusCode: http.StatusOK,
+			wantBody:       "$TTL 300\n",
+		},
+		{
+			name: "should return the hosts file",
+			routesExportService: &services.KafkaRoutesExportServiceMock{
+				HostsFileFunc: func() (string, *errors.ServiceError) {
+					return "10.0.0.1\tkafka-id.kafka.bf2.dev\n", nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       "10.0.0.1\tkafka-id.kafka.bf2.dev\n",
+		},
+		{
+			name: "should return an error when the routes cannot be exported",
+			routesExportService: &services.KafkaRoutesExportServiceMock{
+				ZoneFileFunc: func() (string, *errors.ServiceError) {
+					return "", errors.GeneralError("failed to list kafka requests")
+				},
+			},
+			getZoneFile:    true,
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminKafkaRoutesHandler(tt.routesExportService)
+			req, rw := GetHandlerParams(http.MethodGet, "/kafka_routes", nil, t)
+			if tt.getZoneFile {
+				h.GetZoneFile(rw, req)
+			} else {
+				h.GetHostsFile(rw, req)
+			}
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusOK {
+				g.Expect(rw.Header().Get("Content-Type")).To(gomega.Equal(kafkaRoutesExportContentType))
+				g.Expect(rw.Body.String()).To(gomega.Equal(tt.wantBody))
+			}
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
@@ -17,9 +19,11 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	s "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/gorilla/mux"
 	"github.com/onsi/gomega"
+	"gorm.io/gorm"
 )
 
 var (
@@ -77,7 +81,7 @@ func Test_KafkaHandler_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, signalbus.NewSignalBus(), nil)
 			req, rw := GetHandlerParams("GET", "/{id}", nil, t)
 			req = mux.SetURLVars(req, map[string]string{"id": id})
 			h.Get(rw, req)
@@ -145,7 +149,7 @@ func Test_KafkaHandler_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, signalbus.NewSignalBus(), nil)
 			req, rw := GetHandlerParams("DELETE", tt.args.url, nil, t)
 			h.Delete(rw, req)
 			resp := rw.Result()
@@ -238,7 +242,7 @@ func Test_KafkaHandler_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, signalbus.NewSignalBus(), nil)
 			req, rw := GetHandlerParams("GET", tt.args.url, nil, t)
 			h.List(rw, req)
 			resp := rw.Result()
@@ -248,6 +252,138 @@ func Test_KafkaHandler_List(t *testing.T) {
 	}
 }
 
+func Test_KafkaHandler_watch(t *testing.T) {
+	buildKafka := func(id string, status string, version int64) *dbapi.KafkaRequest {
+		kafkaRequest := mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues(), mocks.With(mocks.ID, id), mocks.With(mocks.STATUS, status))
+		kafkaRequest.ResourceVersion = version
+		return kafkaRequest
+	}
+	buildDeletedKafka := func(id string, status string, version int64) *dbapi.KafkaRequest {
+		kafkaRequest := buildKafka(id, status, version)
+		kafkaRequest.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		return kafkaRequest
+	}
+
+	tests := []struct {
+		name               string
+		gtVersion          int64
+		changes            []dbapi.KafkaList
+		listErr            *errors.ServiceError
+		wantEvents         []public.KafkaRequestWatchEvent
+		wantGtVersion      []int64
+		wantIncludeDeleted []bool
+		wantErr            bool
+	}{
+		{
+			name: "should only emit an event when the status of a kafka changes and then a bookmark",
+			changes: []dbapi.KafkaList{
+				{buildKafka("kafka-1", "accepted", 1), buildKafka("kafka-2", "ready", 2)},
+				{buildKafka("kafka-1", "accepted", 3), buildKafka("kafka-1", "preparing", 4)},
+				{},
+			},
+			wantEvents: []public.KafkaRequestWatchEvent{
+				{Type: "CHANGE", Version: 1},
+				{Type: "CHANGE", Version: 2},
+				{Type: "CHANGE", Version: 4},
+				{Type: "BOOKMARK", Version: 4},
+			},
+			wantGtVersion:      []int64{0, 2, 4},
+			wantIncludeDeleted: []bool{false, true, true},
+		},
+		{
+			name: "should emit a deleted event when a kafka is soft deleted even if its status did not change",
+			changes: []dbapi.KafkaList{
+				{buildKafka("kafka-1", "deleting", 1)},
+				{buildDeletedKafka("kafka-1", "deleting", 2)},
+				{},
+			},
+			wantEvents: []public.KafkaRequestWatchEvent{
+				{Type: "CHANGE", Version: 1},
+				{Type: "DELETED", Version: 2},
+				{Type: "BOOKMARK", Version: 2},
+			},
+			wantGtVersion:      []int64{0, 1, 2},
+			wantIncludeDeleted: []bool{false, true, true},
+		},
+		{
+			name:      "should report the kafkas deleted since the version the client resumes from",
+			gtVersion: 5,
+			changes: []dbapi.KafkaList{
+				{buildDeletedKafka("kafka-1", "deleting", 6)},
+				{},
+			},
+			wantEvents: []public.KafkaRequestWatchEvent{
+				{Type: "DELETED", Version: 6},
+				{Type: "BOOKMARK", Version: 6},
+			},
+			wantGtVersion:      []int64{5, 6},
+			wantIncludeDeleted: []bool{true, true},
+		},
+		{
+			name: "should only send the version of a transaction once all the kafkas it changed have been sent",
+			changes: []dbapi.KafkaList{
+				{buildKafka("kafka-1", "accepted", 3), buildKafka("kafka-2", "accepted", 3), buildKafka("kafka-3", "ready", 4)},
+				{},
+			},
+			wantEvents: []public.KafkaRequestWatchEvent{
+				{Type: "CHANGE", Version: 0},
+				{Type: "CHANGE", Version: 3},
+				{Type: "CHANGE", Version: 4},
+				{Type: "BOOKMARK", Version: 4},
+			},
+			wantGtVersion:      []int64{0, 4},
+			wantIncludeDeleted: []bool{false, true},
+		},
+		{
+			name:    "should return an error if listing the changes fails",
+			listErr: errors.GeneralError("test"),
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			var gtVersions []int64
+			var includeDeleted []bool
+			service := &services.KafkaServiceMock{
+				ListChangesFunc: func(ctx context.Context, gtVersion int64, deleted bool, ids ...string) (dbapi.KafkaList, *errors.ServiceError) {
+					if tt.listErr != nil {
+						return nil, tt.listErr
+					}
+					g.Expect(ids).To(gomega.Equal([]string{id}))
+					changes := tt.changes[len(gtVersions)]
+					gtVersions = append(gtVersions, gtVersion)
+					includeDeleted = append(includeDeleted, deleted)
+					return changes, nil
+				},
+			}
+			h := NewKafkaHandler(service, nil, nil, &fullKafkaConfig, signalbus.NewSignalBus(), nil)
+			stream := h.watch(ctx, tt.gtVersion, id)
+			defer stream.Close()
+
+			if tt.wantErr {
+				_, err := stream.GetNextEvent()
+				g.Expect(err).To(gomega.Equal(tt.listErr))
+				return
+			}
+
+			for _, want := range tt.wantEvents {
+				got, err := stream.GetNextEvent()
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+				event := got.(public.KafkaRequestWatchEvent)
+				g.Expect(event.Type).To(gomega.Equal(want.Type))
+				g.Expect(event.Version).To(gomega.Equal(want.Version))
+				g.Expect(event.Object != nil).To(gomega.Equal(want.Type != "BOOKMARK"))
+			}
+			g.Expect(gtVersions).To(gomega.Equal(tt.wantGtVersion))
+			g.Expect(includeDeleted).To(gomega.Equal(tt.wantIncludeDeleted))
+		})
+	}
+}
+
 func Test_KafkaHandler_Update(t *testing.T) {
 	type fields struct {
 		service        services.KafkaService
@@ -344,6 +480,60 @@ func Test_KafkaHandler_Update(t *testing.T) {
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "succeeds if the plan is set",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					ResizeFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeId string) *errors.ServiceError {
+						return nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"plan": "standard.x1"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "fails if Resize in the kafka service returns an error",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					ResizeFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeId string) *errors.ServiceError {
+						return errors.GeneralError("resize fail")
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"plan": "standard.x1"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "fails if the plan is not supported",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"plan": "standard.x9"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -351,7 +541,7 @@ func Test_KafkaHandler_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, signalbus.NewSignalBus(), nil)
 			req, rw := GetHandlerParams("PATCH", tt.args.url, bytes.NewBuffer(tt.args.body), t)
 			req = req.WithContext(tt.args.ctx)
 			h.Update(rw, req)
@@ -364,10 +554,11 @@ func Test_KafkaHandler_Update(t *testing.T) {
 
 func Test_KafkaHandler_Create(t *testing.T) {
 	type fields struct {
-		service        services.KafkaService
-		providerConfig *config.ProviderConfig
-		authService    authorization.Authorization
-		kafkaConfig    *config.KafkaConfig
+		service         services.KafkaService
+		providerConfig  *config.ProviderConfig
+		authService     authorization.Authorization
+		kafkaConfig     *config.KafkaConfig
+		templateService services.KafkaInstanceTemplateService
 	}
 
 	type args struct {
@@ -597,6 +788,69 @@ func Test_KafkaHandler_Create(t *testing.T) {
 			},
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			name: "succeeds if the settings of the referenced instance template are applied",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					ListFunc: func(ctx context.Context, listArgs *s.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError) {
+						return dbapi.KafkaList{}, &api.PagingMeta{}, nil
+					},
+					RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						if kafkaRequest.CloudProvider != "aws" || kafkaRequest.Region != "us-east-1" {
+							return errors.GeneralError("template not applied")
+						}
+						kafkaRequest.MaxDataRetentionSize = mocksupportedinstancetypes.DefaultMaxDataRetentionSize
+						return nil
+					},
+					AssignInstanceTypeFunc: func(owner, organisationID string) (types.KafkaInstanceType, *errors.ServiceError) {
+						return types.STANDARD, nil
+					},
+				},
+				templateService: &services.KafkaInstanceTemplateServiceMock{
+					GetFunc: func(organisationId, id string) (*dbapi.KafkaInstanceTemplate, *errors.ServiceError) {
+						return &dbapi.KafkaInstanceTemplate{
+							OrganisationId: organisationId,
+							CloudProvider:  "aws",
+							Region:         "us-east-1",
+						}, nil
+					},
+				},
+				providerConfig: &supportedProviders,
+				kafkaConfig:    &fullKafkaConfig,
+			},
+			args: args{
+				url:  "/kafkas?async=true",
+				body: []byte(`{"name": "name", "template_id": "template-id"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "fails if the referenced instance template does not exist",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+				templateService: &services.KafkaInstanceTemplateServiceMock{
+					GetFunc: func(organisationId, id string) (*dbapi.KafkaInstanceTemplate, *errors.ServiceError) {
+						return nil, errors.NotFound("kafka instance template not found")
+					},
+				},
+				providerConfig: &supportedProviders,
+				kafkaConfig:    &fullKafkaConfig,
+			},
+			args: args{
+				url:  "/kafkas?async=true",
+				body: []byte(`{"name": "name", "template_id": "template-id"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -604,7 +858,7 @@ func Test_KafkaHandler_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, signalbus.NewSignalBus(), tt.fields.templateService)
 			req, rw := GetHandlerParams("CREATE", tt.args.url, bytes.NewBuffer(tt.args.body), t)
 			req = req.WithContext(tt.args.ctx)
 			h.Create(rw, req)
@@ -614,3 +868,116 @@ func Test_KafkaHandler_Create(t *testing.T) {
 		})
 	}
 }
+
+func Test_KafkaHandler_Clone(t *testing.T) {
+	type fields struct {
+		service        services.KafkaService
+		providerConfig *config.ProviderConfig
+		kafkaConfig    *config.KafkaConfig
+	}
+
+	type args struct {
+		url  string
+		body []byte
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+	}{
+		{
+			name: "succeeds if the kafka is registered with the settings of the source kafka",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					ListFunc: func(ctx context.Context, listArgs *s.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError) {
+						return dbapi.KafkaList{}, &api.PagingMeta{}, nil
+					},
+					RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						if kafkaRequest.Name != "clone" || kafkaRequest.CloudProvider != mocks.DefaultKafkaRequestProvider || kafkaRequest.Region != mocks.DefaultKafkaRequestRegion {
+							return errors.GeneralError("unexpected kafka request")
+						}
+						kafkaRequest.MaxDataRetentionSize = mocksupportedinstancetypes.DefaultMaxDataRetentionSize
+						return nil
+					},
+					AssignInstanceTypeFunc: func(owner, organisationID string) (types.KafkaInstanceType, *errors.ServiceError) {
+						return types.STANDARD, nil
+					},
+				},
+				providerConfig: &supportedProviders,
+				kafkaConfig:    &fullKafkaConfig,
+			},
+			args: args{
+				url:  "/kafkas/{id}/clone?async=true",
+				body: []byte(`{"name": "clone"}`),
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "fails if the source kafka does not exist",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return nil, errors.NotFound("kafka not found")
+					},
+				},
+				providerConfig: &supportedProviders,
+				kafkaConfig:    &fullKafkaConfig,
+			},
+			args: args{
+				url:  "/kafkas/{id}/clone?async=true",
+				body: []byte(`{"name": "clone"}`),
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "fails if the name of the clone is not valid",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+				providerConfig: &supportedProviders,
+				kafkaConfig:    &fullKafkaConfig,
+			},
+			args: args{
+				url:  "/kafkas/{id}/clone?async=true",
+				body: []byte(`{"name": "Invalid_Name"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails if async is not set",
+			fields: fields{
+				service:        &services.KafkaServiceMock{},
+				providerConfig: &supportedProviders,
+				kafkaConfig:    &fullKafkaConfig,
+			},
+			args: args{
+				url:  "/kafkas/{id}/clone",
+				body: []byte(`{"name": "clone"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, nil, tt.fields.kafkaConfig, signalbus.NewSignalBus(), nil)
+			req, rw := GetHandlerParams("POST", tt.args.url, bytes.NewBuffer(tt.args.body), t)
+			req = mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": id})
+			h.Clone(rw, req)
+			resp := rw.Result()
+			resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
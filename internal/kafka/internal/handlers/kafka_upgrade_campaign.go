@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type adminKafkaUpgradeCampaignHandler struct {
+	upgradeCampaignService services.KafkaUpgradeCampaignService
+}
+
+func NewAdminKafkaUpgradeCampaignHandler(upgradeCampaignService services.KafkaUpgradeCampaignService) *adminKafkaUpgradeCampaignHandler {
+	return &adminKafkaUpgradeCampaignHandler{
+		upgradeCampaignService: upgradeCampaignService,
+	}
+}
+
+func (h adminKafkaUpgradeCampaignHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request private.KafkaUpgradeCampaignRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			validateKafkaUpgradeCampaignRequest(&request),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			campaign := presenters.ConvertKafkaUpgradeCampaignRequest(request)
+			if err := h.upgradeCampaignService.Create(campaign); err != nil {
+				return nil, err
+			}
+
+			progress, err := h.upgradeCampaignService.GetProgress(campaign.ID)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaUpgradeCampaign(campaign, progress[campaign.ID]), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h adminKafkaUpgradeCampaignHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			campaign, err := h.upgradeCampaignService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			progress, err := h.upgradeCampaignService.GetProgress(campaign.ID)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaUpgradeCampaign(campaign, progress[campaign.ID]), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminKafkaUpgradeCampaignHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			campaigns, err := h.upgradeCampaignService.List()
+			if err != nil {
+				return nil, err
+			}
+
+			campaignIDs := make([]string, 0, len(campaigns))
+			for _, campaign := range campaigns {
+				campaignIDs = append(campaignIDs, campaign.ID)
+			}
+			progress, err := h.upgradeCampaignService.GetProgress(campaignIDs...)
+			if err != nil {
+				return nil, err
+			}
+
+			campaignList := private.KafkaUpgradeCampaignList{
+				Kind:  "KafkaUpgradeCampaignList",
+				Page:  1,
+				Size:  int32(len(campaigns)),
+				Total: int32(len(campaigns)),
+				Items: []private.KafkaUpgradeCampaign{},
+			}
+			for _, campaign := range campaigns {
+				campaignList.Items = append(campaignList.Items, presenters.PresentKafkaUpgradeCampaign(campaign, progress[campaign.ID]))
+			}
+
+			return campaignList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+// ListKafkas lists the upgrade state of the kafkas targeted by a campaign, optionally filtered by the state query parameter
+func (h adminKafkaUpgradeCampaignHandler) ListKafkas(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			campaign, err := h.upgradeCampaignService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			items, err := h.upgradeCampaignService.ListItems(campaign.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			state := r.URL.Query().Get("state")
+			itemList := private.KafkaUpgradeCampaignItemList{
+				Kind:  "KafkaUpgradeCampaignItemList",
+				Page:  1,
+				Items: []private.KafkaUpgradeCampaignItem{},
+			}
+			for _, item := range items {
+				if state == "" || item.State.String() == state {
+					itemList.Items = append(itemList.Items, presenters.PresentKafkaUpgradeCampaignItem(item))
+				}
+			}
+			itemList.Size = int32(len(itemList.Items))
+			itemList.Total = int32(len(itemList.Items))
+
+			return itemList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+// Update pauses or resumes a campaign and updates its maximum failure ratio
+func (h adminKafkaUpgradeCampaignHandler) Update(w http.ResponseWriter, r *http.Request) {
+	campaign, getErr := h.upgradeCampaignService.Get(mux.Vars(r)["id"])
+
+	var request private.KafkaUpgradeCampaignUpdateRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return getErr
+			},
+			func() *errors.ServiceError {
+				return validateKafkaUpgradeCampaignUpdateRequest(campaign, &request)()
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			updates := map[string]interface{}{}
+			if request.Status != "" && request.Status != campaign.Status.String() {
+				updates["status"] = request.Status
+				updates["status_reason"] = ""
+				if request.Status == dbapi.KafkaUpgradeCampaignStatusPaused.String() {
+					updates["status_reason"] = "paused by an administrator"
+				}
+			}
+			if request.MaxFailureRatio != nil {
+				updates["max_failure_ratio"] = *request.MaxFailureRatio
+			}
+
+			if len(updates) > 0 {
+				if err := h.upgradeCampaignService.Updates(campaign, updates); err != nil {
+					return nil, err
+				}
+			}
+
+			updated, err := h.upgradeCampaignService.Get(campaign.ID)
+			if err != nil {
+				return nil, err
+			}
+			progress, err := h.upgradeCampaignService.GetProgress(updated.ID)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaUpgradeCampaign(updated, progress[updated.ID]), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaUpgradeCampaignHandler_Create(t *testing.T) {
+	upgradeCampaignService := &services.KafkaUpgradeCampaignServiceMock{
+		CreateFunc: func(campaign *dbapi.KafkaUpgradeCampaign) *errors.ServiceError {
+			campaign.ID = "campaign-id"
+			return nil
+		},
+		GetProgressFunc: func(campaignIDs ...string) (map[string]dbapi.KafkaUpgradeCampaignProgress, *errors.ServiceError) {
+			return map[string]dbapi.KafkaUpgradeCampaignProgress{"campaign-id": {Total: 2, Pending: 2}}, nil
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		request                private.KafkaUpgradeCampaignRequest
+		upgradeCampaignService services.KafkaUpgradeCampaignService
+		wantStatusCode         int
+	}{
+		{
+			name:                   "should create the upgrade campaign",
+			request:                private.KafkaUpgradeCampaignRequest{Name: "campaign", KafkaVersion: "2.8.1", KafkaIbpVersion: "2.8", MaxFailureRatio: 0.2},
+			upgradeCampaignService: upgradeCampaignService,
+			wantStatusCode:         http.StatusCreated,
+		},
+		{
+			name:                   "should fail when no target version is given",
+			request:                private.KafkaUpgradeCampaignRequest{Name: "campaign"},
+			upgradeCampaignService: upgradeCampaignService,
+			wantStatusCode:         http.StatusBadRequest,
+		},
+		{
+			name:                   "should fail when the ibp version is greater than the kafka version",
+			request:                private.KafkaUpgradeCampaignRequest{Name: "campaign", KafkaVersion: "2.7.0", KafkaIbpVersion: "2.8"},
+			upgradeCampaignService: upgradeCampaignService,
+			wantStatusCode:         http.StatusBadRequest,
+		},
+		{
+			name:                   "should fail when the maximum failure ratio is greater than 1",
+			request:                private.KafkaUpgradeCampaignRequest{Name: "campaign", KafkaVersion: "2.8.1", MaxFailureRatio: 2},
+			upgradeCampaignService: upgradeCampaignService,
+			wantStatusCode:         http.StatusBadRequest,
+		},
+		{
+			name:    "should fail when the search query cannot be parsed",
+			request: private.KafkaUpgradeCampaignRequest{Name: "campaign", KafkaVersion: "2.8.1", Selector: private.KafkaUpgradeCampaignSelector{Search: "name ="}},
+			upgradeCampaignService: &services.KafkaUpgradeCampaignServiceMock{
+				CreateFunc: func(campaign *dbapi.KafkaUpgradeCampaign) *errors.ServiceError {
+					return errors.New(errors.ErrorFailedToParseSearch, "failed to parse search")
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminKafkaUpgradeCampaignHandler(tt.upgradeCampaignService)
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPost, "/upgrade_campaigns", bytes.NewBuffer(body), t)
+			h.Create(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
+
+func Test_adminKafkaUpgradeCampaignHandler_Update(t *testing.T) {
+	invalidRatio := -1.0
+
+	tests := []struct {
+		name           string
+		campaign       *dbapi.KafkaUpgradeCampaign
+		request        private.KafkaUpgradeCampaignUpdateRequest
+		wantUpdates    map[string]interface{}
+		wantStatusCode int
+	}{
+		{
+			name:     "should resume a paused campaign and clear its status reason",
+			campaign: &dbapi.KafkaUpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.KafkaUpgradeCampaignStatusPaused, StatusReason: "too many failures"},
+			request:  private.KafkaUpgradeCampaignUpdateRequest{Status: "running"},
+			wantUpdates: map[string]interface{}{
+				"status":        "running",
+				"status_reason": "",
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:     "should pause a running campaign",
+			campaign: &dbapi.KafkaUpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.KafkaUpgradeCampaignStatusRunning},
+			request:  private.KafkaUpgradeCampaignUpdateRequest{Status: "paused"},
+			wantUpdates: map[string]interface{}{
+				"status":        "paused",
+				"status_reason": "paused by an administrator",
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should fail when the campaign is completed",
+			campaign:       &dbapi.KafkaUpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.KafkaUpgradeCampaignStatusCompleted},
+			request:        private.KafkaUpgradeCampaignUpdateRequest{Status: "paused"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the status is not supported",
+			campaign:       &dbapi.KafkaUpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.KafkaUpgradeCampaignStatusRunning},
+			request:        private.KafkaUpgradeCampaignUpdateRequest{Status: "completed"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the maximum failure ratio is negative",
+			campaign:       &dbapi.KafkaUpgradeCampaign{Meta: api.Meta{ID: "campaign-id"}, Status: dbapi.KafkaUpgradeCampaignStatusRunning},
+			request:        private.KafkaUpgradeCampaignUpdateRequest{MaxFailureRatio: &invalidRatio},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var gotUpdates map[string]interface{}
+			h := NewAdminKafkaUpgradeCampaignHandler(&services.KafkaUpgradeCampaignServiceMock{
+				GetFunc: func(id string) (*dbapi.KafkaUpgradeCampaign, *errors.ServiceError) {
+					return tt.campaign, nil
+				},
+				UpdatesFunc: func(campaign *dbapi.KafkaUpgradeCampaign, fields map[string]interface{}) *errors.ServiceError {
+					gotUpdates = fields
+					return nil
+				},
+				GetProgressFunc: func(campaignIDs ...string) (map[string]dbapi.KafkaUpgradeCampaignProgress, *errors.ServiceError) {
+					return map[string]dbapi.KafkaUpgradeCampaignProgress{}, nil
+				},
+			})
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPatch, "/upgrade_campaigns/{id}", bytes.NewBuffer(body), t)
+			req = mux.SetURLVars(req, map[string]string{"id": "campaign-id"})
+			h.Update(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(gotUpdates).To(gomega.Equal(tt.wantUpdates))
+		})
+	}
+}
+
+func Test_adminKafkaUpgradeCampaignHandler_Get(t *testing.T) {
+	tests := []struct {
+		name                   string
+		upgradeCampaignService services.KafkaUpgradeCampaignService
+		wantStatusCode         int
+	}{
+		{
+			name: "should return the upgrade campaign with its progress",
+			upgradeCampaignService: &services.KafkaUpgradeCampaignServiceMock{
+				GetFunc: func(id string) (*dbapi.KafkaUpgradeCampaign, *errors.ServiceError) {
+					return &dbapi.KafkaUpgradeCampaign{Meta: api.Meta{ID: id}}, nil
+				},
+				GetProgressFunc: func(campaignIDs ...string) (map[string]dbapi.KafkaUpgradeCampaignProgress, *errors.ServiceError) {
+					return map[string]dbapi.KafkaUpgradeCampaignProgress{}, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should return not found when the upgrade campaign does not exist",
+			upgradeCampaignService: &services.KafkaUpgradeCampaignServiceMock{
+				GetFunc: func(id string) (*dbapi.KafkaUpgradeCampaign, *errors.ServiceError) {
+					return nil, errors.NotFound("not found")
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminKafkaUpgradeCampaignHandler(tt.upgradeCampaignService)
+			req, rw := GetHandlerParams(http.MethodGet, "/upgrade_campaigns/{id}", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "campaign-id"})
+			h.Get(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
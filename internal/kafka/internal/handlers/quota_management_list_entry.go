@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+	"github.com/gorilla/mux"
+)
+
+type adminQuotaManagementListEntryHandler struct {
+	quotaManagementListEntryService quota_management.QuotaManagementListEntryService
+}
+
+func NewAdminQuotaManagementListEntryHandler(quotaManagementListEntryService quota_management.QuotaManagementListEntryService) *adminQuotaManagementListEntryHandler {
+	return &adminQuotaManagementListEntryHandler{
+		quotaManagementListEntryService: quotaManagementListEntryService,
+	}
+}
+
+// List lists the entries of the quota management list, optionally filtered by the type query parameter
+func (h adminQuotaManagementListEntryHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			entries, err := h.quotaManagementListEntryService.ListEntries(api.QuotaManagementListEntryType(r.URL.Query().Get("type")))
+			if err != nil {
+				return nil, err
+			}
+
+			entryList := private.QuotaManagementListEntryList{
+				Kind:  "QuotaManagementListEntryList",
+				Page:  1,
+				Size:  int32(len(entries)),
+				Total: int32(len(entries)),
+				Items: []private.QuotaManagementListEntry{},
+			}
+			for _, entry := range entries {
+				entryList.Items = append(entryList.Items, presenters.PresentQuotaManagementListEntry(entry))
+			}
+
+			return entryList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminQuotaManagementListEntryHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			entry, err := h.quotaManagementListEntryService.GetEntry(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentQuotaManagementListEntry(entry), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Create adds an organisation or a service account to the quota management list. The change is applied by every replica without restart
+func (h adminQuotaManagementListEntryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request private.QuotaManagementListEntryRequest
+	ctx := r.Context()
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			validateQuotaManagementListEntryRequest(&request),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			createdBy, _ := claims.GetUsername()
+			entry, convErr := presenters.ConvertQuotaManagementListEntryRequest(request, createdBy)
+			if convErr != nil {
+				return nil, errors.NewWithCause(errors.ErrorBadRequest, convErr, "invalid quota management list entry definition")
+			}
+			if err := h.quotaManagementListEntryService.CreateEntry(entry); err != nil {
+				return nil, err
+			}
+			return presenters.PresentQuotaManagementListEntry(entry), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Update replaces the definition of an entry of the quota management list. Its type can not be changed
+func (h adminQuotaManagementListEntryHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var request private.QuotaManagementListEntryUpdateRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				if len(request.Definition) == 0 {
+					return errors.FieldValidationError("failed to update quota management list entry. definition is required")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			definition, convErr := json.Marshal(request.Definition)
+			if convErr != nil {
+				return nil, errors.NewWithCause(errors.ErrorBadRequest, convErr, "invalid quota management list entry definition")
+			}
+			entry, err := h.quotaManagementListEntryService.UpdateEntry(mux.Vars(r)["id"], definition)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentQuotaManagementListEntry(entry), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminQuotaManagementListEntryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.quotaManagementListEntryService.DeleteEntry(mux.Vars(r)["id"])
+		},
+	}
+
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminQuotaManagementListEntryHandler_Create(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         private.QuotaManagementListEntryRequest
+		wantStatusCode  int
+		wantCreateCalls int
+	}{
+		{
+			name: "should create the entry",
+			request: private.QuotaManagementListEntryRequest{
+				Type:       "service_account",
+				Definition: map[string]interface{}{"username": "sa-user", "max_allowed_instances": 2},
+			},
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+		{
+			name: "should fail when the type is not supported",
+			request: private.QuotaManagementListEntryRequest{
+				Type:       "user",
+				Definition: map[string]interface{}{"username": "sa-user"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the definition is empty",
+			request:        private.QuotaManagementListEntryRequest{Type: "organisation"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			service := &quota_management.QuotaManagementListEntryServiceMock{
+				CreateEntryFunc: func(entry *api.QuotaManagementListEntry) *errors.ServiceError {
+					g.Expect(entry.Type.String()).To(gomega.Equal(tt.request.Type))
+					g.Expect(entry.CreatedBy).To(gomega.Equal("test-user"))
+					entry.ID = "entry-id"
+					entry.Key = "sa-user"
+					return nil
+				},
+			}
+			h := NewAdminQuotaManagementListEntryHandler(service)
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPost, "/quota_management_list_entries", bytes.NewBuffer(body), t)
+			h.Create(rw, req.WithContext(ctx))
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(service.CreateEntryCalls()).To(gomega.HaveLen(tt.wantCreateCalls))
+			if tt.wantStatusCode == http.StatusCreated {
+				var entry private.QuotaManagementListEntry
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &entry)).To(gomega.Succeed())
+				g.Expect(entry.Id).To(gomega.Equal("entry-id"))
+				g.Expect(entry.Key).To(gomega.Equal("sa-user"))
+			}
+		})
+	}
+}
+
+func Test_adminQuotaManagementListEntryHandler_Update(t *testing.T) {
+	g := gomega.NewWithT(t)
+	service := &quota_management.QuotaManagementListEntryServiceMock{
+		UpdateEntryFunc: func(entryId string, definition api.JSON) (*api.QuotaManagementListEntry, *errors.ServiceError) {
+			g.Expect(entryId).To(gomega.Equal(id))
+			g.Expect(string(definition)).To(gomega.MatchJSON(`{"username":"sa-user","max_allowed_instances":5}`))
+			return &api.QuotaManagementListEntry{
+				Meta:       api.Meta{ID: entryId},
+				Type:       api.QuotaManagementListEntryTypeServiceAccount,
+				Key:        "sa-user",
+				Definition: definition,
+			}, nil
+		},
+	}
+	h := NewAdminQuotaManagementListEntryHandler(service)
+	body, err := json.Marshal(private.QuotaManagementListEntryUpdateRequest{
+		Definition: map[string]interface{}{"username": "sa-user", "max_allowed_instances": 5},
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	req, rw := GetHandlerParams(http.MethodPatch, "/quota_management_list_entries/{id}", bytes.NewBuffer(body), t)
+	req = mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": id})
+	h.Update(rw, req)
+	g.Expect(rw.Code).To(gomega.Equal(http.StatusOK))
+
+	var entry private.QuotaManagementListEntry
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), &entry)).To(gomega.Succeed())
+	g.Expect(entry.Definition["max_allowed_instances"]).To(gomega.BeEquivalentTo(5))
+}
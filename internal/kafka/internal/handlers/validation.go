@@ -18,12 +18,14 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -35,6 +37,10 @@ var ClusterIdLength = 32
 
 const minimunNumberOfNodesForTheKafkaMachinePool = 3
 
+const webhookSubscriptionSecretMinLength = 16
+
+const maxKafkaInstanceTemplateNameLength = 64
+
 func validateKafkaBillingModel(ctx context.Context, kafkaService services.KafkaService, kafkaConfig *config.KafkaConfig, kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
 	return func() *errors.ServiceError {
 		billingModel := shared.SafeString(kafkaRequestPayload.BillingModel)
@@ -61,7 +67,7 @@ func validateKafkaBillingModel(ctx context.Context, kafkaService services.KafkaS
 			return svcErr
 		}
 
-		instanceTypeConfig, err := kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+		instanceTypeConfig, err := kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 		if err != nil {
 			return errors.ToServiceError(err)
 		}
@@ -327,7 +333,9 @@ func stringSet(value *string) bool {
 	return value != nil && len(strings.Trim(*value, " ")) > 0
 }
 
-func validateUserIsKafkaOwnerOrOrgAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) handlers.Validate {
+// validateUserHasKafkaRole checks that the user is a member of the organisation of the kafka and is either its owner,
+// an admin of the organisation or has been bound the given role, or a role including it, on the kafka
+func validateUserHasKafkaRole(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, role dbapi.KafkaRole) handlers.Validate {
 	return func() *errors.ServiceError {
 		claims, claimsErr := getClaims(ctx)
 		if claimsErr != nil {
@@ -338,7 +346,7 @@ func validateUserIsKafkaOwnerOrOrgAdmin(ctx context.Context, kafkaRequest *dbapi
 		orgID, _ := claims.GetOrgId()
 		isOrgAdmin := claims.IsOrgAdmin()
 
-		authorized := kafkaRequest.OrganisationId == orgID && (isOrgAdmin || kafkaRequest.Owner == username)
+		authorized := kafkaRequest.OrganisationId == orgID && (isOrgAdmin || kafkaRequest.Owner == username || kafkaRequest.Role.Includes(role))
 		if !authorized {
 			return errors.New(errors.ErrorUnauthorized, "user not authorized to perform this action")
 		}
@@ -346,19 +354,24 @@ func validateUserIsKafkaOwnerOrOrgAdmin(ctx context.Context, kafkaRequest *dbapi
 	}
 }
 
-func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService authorization.Authorization, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
+func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService authorization.Authorization, kafkaConfig *config.KafkaConfig, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		claims, claimsErr := getClaims(ctx)
 		if claimsErr != nil {
 			return claimsErr
 		}
 
-		err := validateUserIsKafkaOwnerOrOrgAdmin(ctx, kafkaRequest)()
+		err := validateUserHasKafkaRole(ctx, kafkaRequest, dbapi.KafkaRoleEditor)()
 		if err != nil {
 			return err
 		}
 
 		if kafkaUpdateReq.Owner != nil {
+			// only the owners of the kafka can transfer its ownership
+			if err := validateUserHasKafkaRole(ctx, kafkaRequest, dbapi.KafkaRoleOwner)(); err != nil {
+				return err
+			}
+
 			validationError := handlers.ValidateMinLength(kafkaUpdateReq.Owner, "owner", 1)()
 			if validationError != nil {
 				return validationError
@@ -374,10 +387,34 @@ func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService author
 			}
 		}
 
+		if kafkaUpdateReq.Plan != nil {
+			if _, err := getSizeFromUpdatePlan(kafkaConfig, kafkaRequest, *kafkaUpdateReq.Plan); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}
 }
 
+// getSizeFromUpdatePlan returns the size id of the given plan. The plan must be supported and must not change the
+// instance type of the kafka request
+func getSizeFromUpdatePlan(kafkaConfig *config.KafkaConfig, kafkaRequest *dbapi.KafkaRequest, updatePlan string) (string, *errors.ServiceError) {
+	plan := config.Plan(updatePlan)
+	instanceType, err := plan.GetInstanceType()
+	if err != nil || instanceType != kafkaRequest.InstanceType {
+		return "", errors.New(errors.ErrorBadRequest, fmt.Sprintf("unable to detect instance type %q in plan provided: %q", kafkaRequest.InstanceType, updatePlan))
+	}
+	size, err := plan.GetSizeID()
+	if err != nil {
+		return "", errors.New(errors.ErrorBadRequest, fmt.Sprintf("unable to detect instance size in plan provided: %q", updatePlan))
+	}
+	if _, err := kafkaConfig.GetKafkaInstanceSize(instanceType, size); err != nil {
+		return "", errors.InstancePlanNotSupported("unsupported plan provided: %q", updatePlan)
+	}
+	return size, nil
+}
+
 func getClaims(ctx context.Context) (auth.KFMClaims, *errors.ServiceError) {
 	claims, err := auth.GetClaimsFromContext(ctx)
 	if err != nil {
@@ -606,3 +643,208 @@ func validateKafkaPromoteRequestFields(kafkaPromoteRequest *public.KafkaPromoteR
 		return nil
 	}
 }
+
+func validateMaintenanceWindowRequest(request *public.MaintenanceWindowRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if _, err := dbapi.ParseWeekday(request.DayOfWeek); err != nil {
+			return errors.FieldValidationError("failed to set maintenance window. Invalid day_of_week: %q", request.DayOfWeek)
+		}
+		if request.StartHour < 0 || request.StartHour > 23 {
+			return errors.FieldValidationError("failed to set maintenance window. start_hour: %d should be between 0 and 23", request.StartHour)
+		}
+		if request.DurationHours < 1 || request.DurationHours > dbapi.KafkaMaintenanceWindowMaxDurationHours {
+			return errors.FieldValidationError("failed to set maintenance window. duration_hours: %d should be between 1 and %d", request.DurationHours, dbapi.KafkaMaintenanceWindowMaxDurationHours)
+		}
+		return nil
+	}
+}
+
+func validateWebhookSubscriptionRequest(request *public.WebhookSubscriptionRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if err := webhook.ValidateURL(request.Url); err != nil {
+			return errors.FieldValidationError("failed to create webhook subscription. %s", err.Error())
+		}
+		if len(request.EventTypes) == 0 {
+			return errors.FieldValidationError("failed to create webhook subscription. At least one event type is required")
+		}
+		for _, eventType := range request.EventTypes {
+			if !arrays.Contains(webhook.SupportedEventTypes, eventType) {
+				return errors.FieldValidationError("failed to create webhook subscription. Invalid event type: %q, accepted values are %v", eventType, webhook.SupportedEventTypes)
+			}
+		}
+		if len(request.Secret) < webhookSubscriptionSecretMinLength {
+			return errors.FieldValidationError("failed to create webhook subscription. secret should be at least %d characters long", webhookSubscriptionSecretMinLength)
+		}
+		return nil
+	}
+}
+
+func validateUserIsOrgAdmin(ctx context.Context) handlers.Validate {
+	return func() *errors.ServiceError {
+		claims, claimsErr := getClaims(ctx)
+		if claimsErr != nil {
+			return claimsErr
+		}
+
+		if !claims.IsOrgAdmin() {
+			return errors.New(errors.ErrorUnauthorized, "user not authorized to perform this action")
+		}
+		return nil
+	}
+}
+
+func validateKafkaUpgradeCampaignRequest(request *private.KafkaUpgradeCampaignRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if request.Name == "" {
+			return errors.FieldValidationError("failed to create upgrade campaign. name is required")
+		}
+		if request.StrimziVersion == "" && request.KafkaVersion == "" && request.KafkaIbpVersion == "" {
+			return errors.FieldValidationError("failed to create upgrade campaign. At least one of strimzi_version, kafka_version or kafka_ibp_version is required")
+		}
+		if request.KafkaVersion != "" && request.KafkaIbpVersion != "" {
+			if vCompIbpKafka, err := api.CompareBuildAwareSemanticVersions(request.KafkaIbpVersion, request.KafkaVersion); err != nil {
+				return errors.FieldValidationError("failed to create upgrade campaign. Unable to compare kafka ibp version: %s with kafka version: %s", request.KafkaIbpVersion, request.KafkaVersion)
+			} else if vCompIbpKafka > 0 {
+				return errors.FieldValidationError("failed to create upgrade campaign. kafka_ibp_version: %s is greater than kafka_version: %s", request.KafkaIbpVersion, request.KafkaVersion)
+			}
+		}
+		if request.BatchSize < 0 {
+			return errors.FieldValidationError("failed to create upgrade campaign. batch_size: %d should not be negative", request.BatchSize)
+		}
+		if request.UpgradeTimeoutMinutes < 0 {
+			return errors.FieldValidationError("failed to create upgrade campaign. upgrade_timeout_minutes: %d should not be negative", request.UpgradeTimeoutMinutes)
+		}
+		return validateMaxFailureRatio(request.MaxFailureRatio)
+	}
+}
+
+func validateKafkaUpgradeCampaignUpdateRequest(campaign *dbapi.KafkaUpgradeCampaign, request *private.KafkaUpgradeCampaignUpdateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if campaign.Status == dbapi.KafkaUpgradeCampaignStatusCompleted {
+			return errors.BadRequest("upgrade campaign %q is completed and cannot be updated", campaign.ID)
+		}
+		if request.Status != "" && request.Status != dbapi.KafkaUpgradeCampaignStatusRunning.String() && request.Status != dbapi.KafkaUpgradeCampaignStatusPaused.String() {
+			return errors.FieldValidationError("failed to update upgrade campaign. status: %q should be one of %q or %q",
+				request.Status, dbapi.KafkaUpgradeCampaignStatusRunning, dbapi.KafkaUpgradeCampaignStatusPaused)
+		}
+		if request.MaxFailureRatio != nil {
+			return validateMaxFailureRatio(*request.MaxFailureRatio)
+		}
+		return nil
+	}
+}
+
+func validateMaxFailureRatio(ratio float64) *errors.ServiceError {
+	if ratio < 0 || ratio > 1 {
+		return errors.FieldValidationError("max_failure_ratio: %g should be between 0 and 1", ratio)
+	}
+	return nil
+}
+
+// validateKafkaRoleBindingRequest checks that the role can be bound and that the subject is a user of the organisation of the user
+func validateKafkaRoleBindingRequest(ctx context.Context, authService authorization.Authorization, request *public.KafkaRoleBindingRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if request.Subject == "" {
+			return errors.FieldValidationError("failed to create kafka role binding. subject is required")
+		}
+		if !dbapi.KafkaRole(request.Role).IsValid() {
+			return errors.FieldValidationError("failed to create kafka role binding. Invalid role: %q, accepted values are %v", request.Role, dbapi.ValidKafkaRoles)
+		}
+
+		claims, claimsErr := getClaims(ctx)
+		if claimsErr != nil {
+			return claimsErr
+		}
+		orgId, _ := claims.GetOrgId()
+		userValid, err := authService.CheckUserValid(request.Subject, orgId)
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "unable to create kafka role binding")
+		}
+		if !userValid {
+			return errors.BadRequest("user %s does not belong in your organization", request.Subject)
+		}
+		return nil
+	}
+}
+
+func validateAccessControlListEntryRequest(request *private.AccessControlListEntryRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if !api.AccessControlListEntryType(request.Type).IsValid() {
+			return errors.FieldValidationError("failed to create access control list entry. Invalid type: %q, accepted values are %v", request.Type, api.ValidAccessControlListEntryTypes)
+		}
+		if request.Value == "" {
+			return errors.FieldValidationError("failed to create access control list entry. value is required")
+		}
+		return nil
+	}
+}
+
+func validateQuotaManagementListEntryRequest(request *private.QuotaManagementListEntryRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if !api.QuotaManagementListEntryType(request.Type).IsValid() {
+			return errors.FieldValidationError("failed to create quota management list entry. Invalid type: %q, accepted values are %v", request.Type, api.ValidQuotaManagementListEntryTypes)
+		}
+		if len(request.Definition) == 0 {
+			return errors.FieldValidationError("failed to create quota management list entry. definition is required")
+		}
+		return nil
+	}
+}
+
+// applyKafkaInstanceTemplate sets the fields of the payload that are not set to the values of the instance template
+// it references, if any. The template must belong to the organisation of the user.
+func applyKafkaInstanceTemplate(ctx context.Context, templateService services.KafkaInstanceTemplateService, kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		if shared.StringEmpty(kafkaRequestPayload.TemplateId) {
+			return nil
+		}
+
+		claims, err := getClaims(ctx)
+		if err != nil {
+			return err
+		}
+
+		orgId, _ := claims.GetOrgId()
+		template, err := templateService.Get(orgId, *kafkaRequestPayload.TemplateId)
+		if err != nil {
+			if err.Is404() {
+				return errors.FieldValidationError("failed to create kafka request. template_id: kafka instance template %q not found", *kafkaRequestPayload.TemplateId)
+			}
+			return err
+		}
+
+		presenters.ApplyKafkaInstanceTemplate(kafkaRequestPayload, template)
+		return nil
+	}
+}
+
+func validateKafkaInstanceTemplateRequest(request *public.KafkaInstanceTemplateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if len(request.Name) < handlers.MinRequiredFieldLength || len(request.Name) > maxKafkaInstanceTemplateNameLength {
+			return errors.FieldValidationError("failed to create kafka instance template. name should be between %d and %d characters long", handlers.MinRequiredFieldLength, maxKafkaInstanceTemplateNameLength)
+		}
+		if request.Plan != "" && len(strings.Split(request.Plan, ".")) != 2 {
+			return errors.FieldValidationError("failed to create kafka instance template. plan: %q should be in the format <instance_type>.<size_id>", request.Plan)
+		}
+		if request.Region != "" && request.CloudProvider == "" {
+			return errors.FieldValidationError("failed to create kafka instance template. cloud_provider is required when region is set")
+		}
+		return nil
+	}
+}
+
+// validateUserCanDeleteKafkaInstanceTemplate checks that the user created the template or is an admin of its organisation
+func validateUserCanDeleteKafkaInstanceTemplate(ctx context.Context, template *dbapi.KafkaInstanceTemplate) handlers.Validate {
+	return func() *errors.ServiceError {
+		claims, err := getClaims(ctx)
+		if err != nil {
+			return err
+		}
+
+		username, _ := claims.GetUsername()
+		if template.CreatedBy != username && !claims.IsOrgAdmin() {
+			return errors.New(errors.ErrorUnauthorized, "user not authorized to perform this action")
+		}
+		return nil
+	}
+}
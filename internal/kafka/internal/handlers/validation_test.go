@@ -451,6 +451,9 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 	emptyOwner := ""
 	newOwner := "some-owner"
 	reauthenticationEnabled := true
+	supportedPlan := "standard.x1"
+	unsupportedPlan := "standard.x9"
+	otherInstanceTypePlan := "developer.x1"
 	username := "username"
 	orgId := "organisation_id"
 	token := &jwt.Token{
@@ -603,6 +606,62 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 				wantErr: false,
 			},
 		},
+		{
+			name: "should succeed when a supported plan is provided",
+			arg: args{
+				ctx: auth.SetTokenInContext(context.TODO(), token),
+				kafka: &dbapi.KafkaRequest{
+					Owner:          username,
+					OrganisationId: orgId,
+					InstanceType:   "standard",
+				},
+				kafkaUpdateRequest: public.KafkaUpdateRequest{
+					Plan: &supportedPlan,
+				},
+				authService: authorization.NewMockAuthorization(),
+			},
+			want: result{
+				wantErr: false,
+			},
+		},
+		{
+			name: "should throw an error if the plan size is not supported",
+			arg: args{
+				ctx: auth.SetTokenInContext(context.TODO(), token),
+				kafka: &dbapi.KafkaRequest{
+					Owner:          username,
+					OrganisationId: orgId,
+					InstanceType:   "standard",
+				},
+				kafkaUpdateRequest: public.KafkaUpdateRequest{
+					Plan: &unsupportedPlan,
+				},
+				authService: authorization.NewMockAuthorization(),
+			},
+			want: result{
+				wantErr: true,
+				reason:  `unsupported plan provided: "standard.x9"`,
+			},
+		},
+		{
+			name: "should throw an error if the plan changes the instance type",
+			arg: args{
+				ctx: auth.SetTokenInContext(context.TODO(), token),
+				kafka: &dbapi.KafkaRequest{
+					Owner:          username,
+					OrganisationId: orgId,
+					InstanceType:   "standard",
+				},
+				kafkaUpdateRequest: public.KafkaUpdateRequest{
+					Plan: &otherInstanceTypePlan,
+				},
+				authService: authorization.NewMockAuthorization(),
+			},
+			want: result{
+				wantErr: true,
+				reason:  `unable to detect instance type "standard" in plan provided: "developer.x1"`,
+			},
+		},
 		{
 			name: "should throw an error if user is not valid",
 			arg: args{
@@ -639,7 +698,7 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			validateFn := ValidateKafkaUserFacingUpdateFields(tt.arg.ctx, tt.arg.authService, tt.arg.kafka, &tt.arg.kafkaUpdateRequest)
+			validateFn := ValidateKafkaUserFacingUpdateFields(tt.arg.ctx, tt.arg.authService, &fullKafkaConfig, tt.arg.kafka, &tt.arg.kafkaUpdateRequest)
 			err := validateFn()
 			g.Expect(err != nil).To(gomega.Equal(tt.want.wantErr), "ValidateKafkaUserFacingUpdateFields() expected not to throw error but threw %v", err)
 			if tt.want.wantErr {
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
+	"github.com/gorilla/mux"
+)
+
+type webhookSubscriptionHandler struct {
+	webhookService webhook.WebhookService
+}
+
+func NewWebhookSubscriptionHandler(webhookService webhook.WebhookService) *webhookSubscriptionHandler {
+	return &webhookSubscriptionHandler{
+		webhookService: webhookService,
+	}
+}
+
+// Create subscribes the organisation of the user to the lifecycle events of its resources. Only organisation admins can manage the subscriptions
+func (h webhookSubscriptionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var subscriptionRequest public.WebhookSubscriptionRequest
+	ctx := r.Context()
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &subscriptionRequest,
+		Validate: []handlers.Validate{
+			validateUserIsOrgAdmin(ctx),
+			validateWebhookSubscriptionRequest(&subscriptionRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			owner, _ := claims.GetUsername()
+			subscription, err := presenters.ConvertWebhookSubscriptionRequest(subscriptionRequest, orgID, owner)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.webhookService.CreateSubscription(subscription); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentWebhookSubscription(subscription)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h webhookSubscriptionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			subscription, err := h.webhookService.GetSubscription(orgID, mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentWebhookSubscription(subscription)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// List returns the webhook subscriptions of the organisation of the user
+func (h webhookSubscriptionHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			subscriptions, err := h.webhookService.ListSubscriptions(orgID)
+			if err != nil {
+				return nil, err
+			}
+
+			subscriptionList := public.WebhookSubscriptionList{
+				Kind:  "WebhookSubscriptionList",
+				Page:  1,
+				Size:  int32(len(subscriptions)),
+				Total: int32(len(subscriptions)),
+				Items: []public.WebhookSubscription{},
+			}
+			for _, subscription := range subscriptions {
+				presented, err := presenters.PresentWebhookSubscription(subscription)
+				if err != nil {
+					return nil, err
+				}
+				subscriptionList.Items = append(subscriptionList.Items, presented)
+			}
+
+			return subscriptionList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h webhookSubscriptionHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			validateUserIsOrgAdmin(ctx),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			orgID, _ := claims.GetOrgId()
+			return nil, h.webhookService.DeleteSubscription(orgID, mux.Vars(r)["id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	mocks "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_webhookSubscriptionHandler_Create(t *testing.T) {
+	validRequest := public.WebhookSubscriptionRequest{
+		Url:        "https://example.com/hooks",
+		EventTypes: []string{webhook.EventTypeKafkaReady, webhook.EventTypeConnectorFailed},
+		Secret:     "a-very-secret-key",
+	}
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		request         public.WebhookSubscriptionRequest
+		createErr       *errors.ServiceError
+		wantStatusCode  int
+		wantCreateCalls int
+	}{
+		{
+			name:            "should create the webhook subscription of the organisation",
+			ctx:             ctx,
+			request:         validRequest,
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+		{
+			name: "should fail when the url is not an http url",
+			ctx:  ctx,
+			request: public.WebhookSubscriptionRequest{
+				Url:        "ftp://example.com/hooks",
+				EventTypes: validRequest.EventTypes,
+				Secret:     validRequest.Secret,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should fail when the url is not an https url",
+			ctx:  ctx,
+			request: public.WebhookSubscriptionRequest{
+				Url:        "http://example.com/hooks",
+				EventTypes: validRequest.EventTypes,
+				Secret:     validRequest.Secret,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should fail when an event type is not supported",
+			ctx:  ctx,
+			request: public.WebhookSubscriptionRequest{
+				Url:        validRequest.Url,
+				EventTypes: []string{"kafka.unknown"},
+				Secret:     validRequest.Secret,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should fail when no event type is given",
+			ctx:  ctx,
+			request: public.WebhookSubscriptionRequest{
+				Url:    validRequest.Url,
+				Secret: validRequest.Secret,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should fail when the secret is too short",
+			ctx:  ctx,
+			request: public.WebhookSubscriptionRequest{
+				Url:        validRequest.Url,
+				EventTypes: validRequest.EventTypes,
+				Secret:     "secret",
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should fail when the user is not an org admin",
+			ctx:            nonOrgAdminCtx,
+			request:        validRequest,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:            "should return an error when the subscription cannot be created",
+			ctx:             ctx,
+			request:         validRequest,
+			createErr:       errors.GeneralError("db down"),
+			wantStatusCode:  http.StatusInternalServerError,
+			wantCreateCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			webhookService := &webhook.WebhookServiceMock{
+				CreateSubscriptionFunc: func(subscription *api.WebhookSubscription) *errors.ServiceError {
+					g.Expect(subscription.OrganisationId).To(gomega.Equal(mocks.DefaultOrganisationId))
+					g.Expect(subscription.Secret).To(gomega.Equal(tt.request.Secret))
+					subscription.ID = "subscription-id"
+					return tt.createErr
+				},
+			}
+			h := NewWebhookSubscriptionHandler(webhookService)
+			body, err := json.Marshal(tt.request)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			req, rw := GetHandlerParams(http.MethodPost, "/webhook_subscriptions", bytes.NewBuffer(body), t)
+			h.Create(rw, req.WithContext(tt.ctx))
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(webhookService.CreateSubscriptionCalls()).To(gomega.HaveLen(tt.wantCreateCalls))
+			if tt.wantStatusCode == http.StatusCreated {
+				// the secret is never returned
+				g.Expect(rw.Body.String()).NotTo(gomega.ContainSubstring(tt.request.Secret))
+				var subscription public.WebhookSubscription
+				g.Expect(json.Unmarshal(rw.Body.Bytes(), &subscription)).To(gomega.Succeed())
+				g.Expect(subscription.Id).To(gomega.Equal("subscription-id"))
+				g.Expect(subscription.EventTypes).To(gomega.Equal(tt.request.EventTypes))
+			}
+		})
+	}
+}
+
+func Test_webhookSubscriptionHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		getErr         *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the webhook subscription of the organisation",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found when the subscription does not belong to the organisation",
+			getErr:         errors.NotFound("not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			webhookService := &webhook.WebhookServiceMock{
+				GetSubscriptionFunc: func(organisationId string, subscriptionId string) (*api.WebhookSubscription, *errors.ServiceError) {
+					g.Expect(organisationId).To(gomega.Equal(mocks.DefaultOrganisationId))
+					g.Expect(subscriptionId).To(gomega.Equal(id))
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &api.WebhookSubscription{Meta: api.Meta{ID: id}, Url: "https://example.com/hooks", EventTypes: api.JSON(`["kafka.ready"]`)}, nil
+				},
+			}
+			h := NewWebhookSubscriptionHandler(webhookService)
+			req, rw := GetHandlerParams(http.MethodGet, "/webhook_subscriptions/{id}", nil, t)
+			req = mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": id})
+			h.Get(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
+
+func Test_webhookSubscriptionHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	webhookService := &webhook.WebhookServiceMock{
+		ListSubscriptionsFunc: func(organisationId string) (api.WebhookSubscriptionList, *errors.ServiceError) {
+			g.Expect(organisationId).To(gomega.Equal(mocks.DefaultOrganisationId))
+			return api.WebhookSubscriptionList{
+				{Meta: api.Meta{ID: "subscription-1"}, EventTypes: api.JSON(`["kafka.ready"]`), Secret: "a-very-secret-key"},
+				{Meta: api.Meta{ID: "subscription-2"}, EventTypes: api.JSON(`["connector.failed"]`), Secret: "a-very-secret-key"},
+			}, nil
+		},
+	}
+	h := NewWebhookSubscriptionHandler(webhookService)
+	req, rw := GetHandlerParams(http.MethodGet, "/webhook_subscriptions", nil, t)
+	h.List(rw, req.WithContext(ctx))
+	g.Expect(rw.Code).To(gomega.Equal(http.StatusOK))
+	g.Expect(rw.Body.String()).NotTo(gomega.ContainSubstring("a-very-secret-key"))
+
+	var list public.WebhookSubscriptionList
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), &list)).To(gomega.Succeed())
+	g.Expect(list.Total).To(gomega.Equal(int32(2)))
+	g.Expect(list.Items[1].EventTypes).To(gomega.Equal([]string{webhook.EventTypeConnectorFailed}))
+}
+
+func Test_webhookSubscriptionHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		deleteErr       *errors.ServiceError
+		wantStatusCode  int
+		wantDeleteCalls int
+	}{
+		{
+			name:            "should delete the webhook subscription of the organisation",
+			ctx:             ctx,
+			wantStatusCode:  http.StatusNoContent,
+			wantDeleteCalls: 1,
+		},
+		{
+			name:            "should return not found when the subscription does not belong to the organisation",
+			ctx:             ctx,
+			deleteErr:       errors.NotFound("not found"),
+			wantStatusCode:  http.StatusNotFound,
+			wantDeleteCalls: 1,
+		},
+		{
+			name:           "should fail when the user is not an org admin",
+			ctx:            nonOrgAdminCtx,
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			webhookService := &webhook.WebhookServiceMock{
+				DeleteSubscriptionFunc: func(organisationId string, subscriptionId string) *errors.ServiceError {
+					return tt.deleteErr
+				},
+			}
+			h := NewWebhookSubscriptionHandler(webhookService)
+			req, rw := GetHandlerParams(http.MethodDelete, "/webhook_subscriptions/{id}", nil, t)
+			req = mux.SetURLVars(req.WithContext(tt.ctx), map[string]string{"id": id})
+			h.Delete(rw, req)
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(webhookService.DeleteSubscriptionCalls()).To(gomega.HaveLen(tt.wantDeleteCalls))
+		})
+	}
+}
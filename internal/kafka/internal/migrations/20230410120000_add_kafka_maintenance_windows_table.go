@@ -0,0 +1,35 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addKafkaMaintenanceWindowsTable adds the table storing the maintenance windows during which
+// the version upgrades of the kafkas are allowed to start. A window with an empty kafka_id
+// applies to all the kafkas of the organisation.
+func addKafkaMaintenanceWindowsTable() *gormigrate.Migration {
+	type KafkaMaintenanceWindow struct {
+		db.Model
+		KafkaID        string `gorm:"index"`
+		OrganisationId string `gorm:"index"`
+		DayOfWeek      string
+		StartHour      int
+		DurationHours  int
+	}
+
+	return db.CreateMigrationFromActions("20230410120000",
+		db.CreateTableAction(&KafkaMaintenanceWindow{}),
+		db.ExecAction(`
+			CREATE UNIQUE INDEX IF NOT EXISTS uix_kafka_maintenance_windows_organisation_id_kafka_id
+			ON kafka_maintenance_windows (organisation_id, kafka_id) WHERE deleted_at IS NULL
+		`, `
+			DROP INDEX IF EXISTS uix_kafka_maintenance_windows_organisation_id_kafka_id
+		`),
+	)
+}
@@ -0,0 +1,62 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addKafkaUpgradeCampaignsTables adds the tables storing the fleet-wide upgrade campaigns and the upgrade
+// state of each of the kafkas they target, as well as the leader lease of the worker running the campaigns.
+func addKafkaUpgradeCampaignsTables() *gormigrate.Migration {
+	type KafkaUpgradeCampaign struct {
+		db.Model
+		Name                  string
+		StrimziVersion        string
+		KafkaVersion          string
+		KafkaIBPVersion       string
+		ClusterID             string
+		CloudProvider         string
+		Region                string
+		InstanceType          string
+		Search                string
+		BatchSize             int
+		MaxFailureRatio       float64
+		UpgradeTimeoutMinutes int
+		Status                string `gorm:"index"`
+		StatusReason          string
+		CurrentBatch          int
+		EvaluatedBatch        int
+	}
+
+	type KafkaUpgradeCampaignItem struct {
+		db.Model
+		CampaignID      string `gorm:"index"`
+		KafkaID         string `gorm:"index"`
+		State           string
+		Batch           int
+		Details         string
+		StartedAt       *time.Time
+		UpgradeObserved bool
+	}
+
+	leaderLeaseType := "kafka_upgrade_campaign"
+
+	return db.CreateMigrationFromActions("20230412120000",
+		db.CreateTableAction(&KafkaUpgradeCampaign{}),
+		db.CreateTableAction(&KafkaUpgradeCampaignItem{}),
+		db.FuncAction(func(tx *gorm.DB) error {
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: leaderLeaseType, Leader: api.NewID()}).Error
+		}, func(tx *gorm.DB) error {
+			return tx.Unscoped().Where("lease_type = ?", leaderLeaseType).Delete(&api.LeaderLease{}).Error
+		}),
+	)
+}
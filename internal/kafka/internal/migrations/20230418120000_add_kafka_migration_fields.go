@@ -0,0 +1,52 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addKafkaMigrationFields adds the columns tracking the live migration of a kafka between data plane clusters,
+// as well as the leader lease of the worker switching the routes of the migrated kafkas.
+func addKafkaMigrationFields() *gormigrate.Migration {
+	type KafkaRequest struct {
+		MigrationStatus          string   `json:"migration_status" gorm:"index"`
+		MigrationSourceClusterID string   `json:"migration_source_cluster_id"`
+		MigrationTargetClusterID string   `json:"migration_target_cluster_id"`
+		MigrationRoutes          api.JSON `json:"migration_routes"`
+		MigrationDetails         string   `json:"migration_details"`
+	}
+
+	leaderLeaseType := "migrating_kafka"
+	columns := []string{"migration_status", "migration_source_cluster_id", "migration_target_cluster_id", "migration_routes", "migration_details"}
+
+	return &gormigrate.Migration{
+		ID: "20230418120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&KafkaRequest{}); err != nil {
+				return err
+			}
+
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: leaderLeaseType, Leader: api.NewID()}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Unscoped().Where("lease_type = ?", leaderLeaseType).Delete(&api.LeaderLease{}).Error; err != nil {
+				return err
+			}
+
+			for _, column := range columns {
+				if err := tx.Migrator().DropColumn(&KafkaRequest{}, column); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addAuditEventsTable adds the table recording the mutating requests made against the admin and public APIs.
+// The table is shared with the connector service which records its own requests in it.
+func addAuditEventsTable() *gormigrate.Migration {
+	type AuditEvent struct {
+		db.Model
+		Actor          string `gorm:"index"`
+		OrganisationId string `gorm:"index"`
+		Action         string `gorm:"index"`
+		ResourceId     string `gorm:"index"`
+		Method         string
+		RequestPath    string
+		RequestDiff    api.JSON
+		StatusCode     int
+		OperationId    string
+	}
+
+	return db.CreateMigrationFromActions("20230420120000",
+		db.CreateTableAction(&AuditEvent{}),
+	)
+}
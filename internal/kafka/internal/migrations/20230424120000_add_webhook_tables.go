@@ -0,0 +1,52 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addWebhookTables adds the tables storing the webhook subscriptions of the organisations and the outbox of
+// the deliveries of their events, as well as the leader lease of the worker sending the deliveries.
+func addWebhookTables() *gormigrate.Migration {
+	type WebhookSubscription struct {
+		db.Model
+		OrganisationId string `gorm:"index"`
+		Owner          string
+		Url            string
+		EventTypes     api.JSON
+		Secret         string
+	}
+
+	type WebhookDelivery struct {
+		db.Model
+		SubscriptionId string `gorm:"index"`
+		EventType      string `gorm:"index"`
+		ResourceId     string `gorm:"index"`
+		Payload        api.JSON
+		Status         string `gorm:"index"`
+		Attempts       int
+		NextAttemptAt  time.Time
+		LastError      string
+	}
+
+	leaderLeaseType := "webhook_delivery"
+
+	return db.CreateMigrationFromActions("20230424120000",
+		db.CreateTableAction(&WebhookSubscription{}),
+		db.CreateTableAction(&WebhookDelivery{}),
+		db.FuncAction(func(tx *gorm.DB) error {
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: leaderLeaseType, Leader: api.NewID()}).Error
+		}, func(tx *gorm.DB) error {
+			return tx.Unscoped().Where("lease_type = ?", leaderLeaseType).Delete(&api.LeaderLease{}).Error
+		}),
+	)
+}
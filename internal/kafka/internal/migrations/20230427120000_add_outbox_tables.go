@@ -0,0 +1,68 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addOutboxTables adds the outbox of the events published along with the state changes and the cursors of its consumers,
+// as well as the leader lease of the worker pruning the outbox.
+// The status changes of the kafka requests are recorded in the outbox by a trigger so that they are published in the
+// same transaction as the change whatever the code path updating the status is.
+func addOutboxTables() *gormigrate.Migration {
+	type OutboxEvent struct {
+		Sequence        int64  `gorm:"primaryKey;type:bigserial"`
+		EventType       string `gorm:"index"`
+		ResourceId      string `gorm:"index"`
+		ResourceVersion int64
+		Payload         api.JSON  `gorm:"type:jsonb"`
+		CreatedAt       time.Time `gorm:"index"`
+	}
+
+	type OutboxCursor struct {
+		Consumer  string `gorm:"primaryKey"`
+		Sequence  int64
+		UpdatedAt time.Time
+	}
+
+	leaderLeaseType := "outbox_pruning"
+
+	return db.CreateMigrationFromActions("20230427120000",
+		db.CreateTableAction(&OutboxEvent{}),
+		db.CreateTableAction(&OutboxCursor{}),
+		db.ExecAction(`
+			CREATE OR REPLACE FUNCTION kafka_requests_outbox_trigger() RETURNS TRIGGER LANGUAGE plpgsql AS '
+			BEGIN
+			IF TG_OP = ''INSERT'' OR NEW.status IS DISTINCT FROM OLD.status THEN
+				INSERT INTO outbox_events (event_type, resource_id, resource_version, payload, created_at)
+				VALUES (''kafka.status_changed'', NEW.id, NEW.version, jsonb_build_object(''status'', NEW.status, ''organisation_id'', NEW.organisation_id, ''owner'', NEW.owner), now());
+				PERFORM pg_notify(''signalbus'', ''kafka.status_changed'');
+			END IF;
+			RETURN NEW;
+			END;'
+		`, `
+			DROP FUNCTION IF EXISTS kafka_requests_outbox_trigger
+		`),
+		db.ExecAction(`DROP TRIGGER IF EXISTS kafka_requests_outbox_trigger ON kafka_requests`, ``),
+		db.ExecAction(`
+			CREATE TRIGGER kafka_requests_outbox_trigger AFTER INSERT OR UPDATE ON kafka_requests
+			FOR EACH ROW EXECUTE PROCEDURE kafka_requests_outbox_trigger();
+		`, `
+			DROP TRIGGER IF EXISTS kafka_requests_outbox_trigger ON kafka_requests
+		`),
+		db.FuncAction(func(tx *gorm.DB) error {
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: leaderLeaseType, Leader: api.NewID()}).Error
+		}, func(tx *gorm.DB) error {
+			return tx.Unscoped().Where("lease_type = ?", leaderLeaseType).Delete(&api.LeaderLease{}).Error
+		}),
+	)
+}
@@ -0,0 +1,33 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addKafkaRoleBindingsTable adds the table storing the roles (viewer, editor or owner) bound to the users
+// on the kafkas. A user has at most one role on a kafka.
+func addKafkaRoleBindingsTable() *gormigrate.Migration {
+	type KafkaRoleBinding struct {
+		db.Model
+		KafkaID   string `gorm:"index"`
+		Subject   string `gorm:"index"`
+		Role      string
+		CreatedBy string
+	}
+
+	return db.CreateMigrationFromActions("20230501120000",
+		db.CreateTableAction(&KafkaRoleBinding{}),
+		db.ExecAction(`
+			CREATE UNIQUE INDEX IF NOT EXISTS uix_kafka_role_bindings_kafka_id_subject
+			ON kafka_role_bindings (kafka_id, subject) WHERE deleted_at IS NULL
+		`, `
+			DROP INDEX IF EXISTS uix_kafka_role_bindings_kafka_id_subject
+		`),
+	)
+}
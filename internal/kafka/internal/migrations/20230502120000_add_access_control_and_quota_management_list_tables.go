@@ -0,0 +1,50 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addAccessControlAndQuotaManagementListTables adds the tables storing the deny list of users, the access list of
+// organisations and the quota management list, which were only read from configuration files until now.
+// A value is at most once in each list.
+func addAccessControlAndQuotaManagementListTables() *gormigrate.Migration {
+	type AccessControlListEntry struct {
+		db.Model
+		Type      string `gorm:"index"`
+		Value     string
+		Reason    string
+		CreatedBy string
+	}
+
+	type QuotaManagementListEntry struct {
+		db.Model
+		Type       string `gorm:"index"`
+		Key        string
+		Definition api.JSON
+		CreatedBy  string
+	}
+
+	return db.CreateMigrationFromActions("20230502120000",
+		db.CreateTableAction(&AccessControlListEntry{}),
+		db.ExecAction(`
+			CREATE UNIQUE INDEX IF NOT EXISTS uix_access_control_list_entries_type_value
+			ON access_control_list_entries (type, value) WHERE deleted_at IS NULL
+		`, `
+			DROP INDEX IF EXISTS uix_access_control_list_entries_type_value
+		`),
+		db.CreateTableAction(&QuotaManagementListEntry{}),
+		db.ExecAction(`
+			CREATE UNIQUE INDEX IF NOT EXISTS uix_quota_management_list_entries_type_key
+			ON quota_management_list_entries (type, key) WHERE deleted_at IS NULL
+		`, `
+			DROP INDEX IF EXISTS uix_quota_management_list_entries_type_key
+		`),
+	)
+}
@@ -0,0 +1,47 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addRateLimitTables adds the tables counting the requests and the concurrent requests of each organisation or user
+// to the rate limited routes, so that the limits are shared across replicas, as well as the leader lease of the worker
+// pruning them.
+func addRateLimitTables() *gormigrate.Migration {
+	type RateLimitCounter struct {
+		Subject     string    `gorm:"primaryKey"`
+		Route       string    `gorm:"primaryKey"`
+		WindowStart time.Time `gorm:"primaryKey"`
+		Count       int
+		ExpiresAt   time.Time `gorm:"index"`
+	}
+
+	type RateLimitLease struct {
+		ID        string    `gorm:"primaryKey"`
+		Subject   string    `gorm:"index:idx_rate_limit_leases_subject_route"`
+		Route     string    `gorm:"index:idx_rate_limit_leases_subject_route"`
+		ExpiresAt time.Time `gorm:"index"`
+	}
+
+	leaderLeaseType := "rate_limit_pruning"
+
+	return db.CreateMigrationFromActions("20230503120000",
+		db.CreateTableAction(&RateLimitCounter{}),
+		db.CreateTableAction(&RateLimitLease{}),
+		db.FuncAction(func(tx *gorm.DB) error {
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: leaderLeaseType, Leader: api.NewID()}).Error
+		}, func(tx *gorm.DB) error {
+			return tx.Unscoped().Where("lease_type = ?", leaderLeaseType).Delete(&api.LeaderLease{}).Error
+		}),
+	)
+}
@@ -0,0 +1,50 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addIdempotencyKeysTable adds the table storing the Idempotency-Key headers of the create requests along with their response.
+// A key is used at most once by a user on a route. The leader lease of the worker pruning the expired keys is added too.
+func addIdempotencyKeysTable() *gormigrate.Migration {
+	type IdempotencyKey struct {
+		ID             string `gorm:"primaryKey"`
+		Key            string
+		Owner          string
+		OrganisationId string
+		Route          string
+		RequestHash    string
+		StatusCode     int
+		ResourceId     string
+		ResponseBody   []byte
+		CreatedAt      time.Time
+		ExpiresAt      time.Time `gorm:"index"`
+	}
+
+	leaderLeaseType := "idempotency_key_pruning"
+
+	return db.CreateMigrationFromActions("20230504120000",
+		db.CreateTableAction(&IdempotencyKey{}),
+		db.ExecAction(`
+			CREATE UNIQUE INDEX IF NOT EXISTS uix_idempotency_keys_owner_route_key
+			ON idempotency_keys (owner, route, key)
+		`, `
+			DROP INDEX IF EXISTS uix_idempotency_keys_owner_route_key
+		`),
+		db.FuncAction(func(tx *gorm.DB) error {
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: leaderLeaseType, Leader: api.NewID()}).Error
+		}, func(tx *gorm.DB) error {
+			return tx.Unscoped().Where("lease_type = ?", leaderLeaseType).Delete(&api.LeaderLease{}).Error
+		}),
+	)
+}
@@ -0,0 +1,40 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addKafkaInstanceTemplatesTable adds the table storing the kafka instance templates of the organisations.
+// The name of a template is unique within its organisation.
+func addKafkaInstanceTemplatesTable() *gormigrate.Migration {
+	type KafkaInstanceTemplate struct {
+		db.Model
+		OrganisationId          string `gorm:"index"`
+		Name                    string
+		CloudProvider           string
+		Region                  string
+		Plan                    string
+		ReauthenticationEnabled *bool
+		BillingModel            string
+		BillingCloudAccountId   string
+		Marketplace             string
+		ClusterId               string
+		CreatedBy               string
+	}
+
+	return db.CreateMigrationFromActions("20230505120000",
+		db.CreateTableAction(&KafkaInstanceTemplate{}),
+		db.ExecAction(`
+			CREATE UNIQUE INDEX IF NOT EXISTS uix_kafka_instance_templates_organisation_id_name
+			ON kafka_instance_templates (organisation_id, name) WHERE deleted_at IS NULL
+		`, `
+			DROP INDEX IF EXISTS uix_kafka_instance_templates_organisation_id_name
+		`),
+	)
+}
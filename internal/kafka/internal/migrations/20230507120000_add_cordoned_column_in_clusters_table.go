@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+const cordonedColumnName = "cordoned"
+
+func addCordonedColumnInClustersTable() *gormigrate.Migration {
+	type Cluster struct {
+		Cordoned bool `gorm:"default:false"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230507120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if !tx.Migrator().HasColumn(&Cluster{}, cordonedColumnName) {
+				return nil
+			}
+
+			return tx.Migrator().DropColumn(&Cluster{}, cordonedColumnName)
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addClusterCreationAttemptsTable adds the table storing the history of the data plane clusters that failed to be
+// created and of their replacements
+func addClusterCreationAttemptsTable() *gormigrate.Migration {
+	type ClusterCreationAttempt struct {
+		db.Model
+		FailedClusterID      string `gorm:"index"`
+		Attempt              int
+		Status               string
+		ReplacementClusterID string `gorm:"index"`
+		RetriedAt            *time.Time
+	}
+
+	return db.CreateMigrationFromActions("20230508120000",
+		db.CreateTableAction(&ClusterCreationAttempt{}),
+	)
+}
@@ -0,0 +1,84 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// renameKafkaVersionColumn renames the version column of the kafka_requests table to resource_version, so that it
+// does not take over the "version" key the kafka requests can be ordered by.
+// The resource version becomes the id of the last transaction that changed the kafka request. The watchers only list the
+// changes of the transactions older than the oldest running one, so that a slow transaction cannot commit a change with
+// a lower version once they have moved past it. The outbox trigger is updated to record the renamed column, and the
+// payload of its events holds the fields of the kafka webhook events.
+func renameKafkaVersionColumn() *gormigrate.Migration {
+	return db.CreateMigrationFromActions("20230510120000",
+		db.ExecAction(`DROP TRIGGER IF EXISTS kafka_requests_version_trigger ON kafka_requests`, `
+			CREATE TRIGGER kafka_requests_version_trigger BEFORE INSERT OR UPDATE ON kafka_requests
+			FOR EACH ROW EXECUTE PROCEDURE kafka_requests_version_trigger();
+		`),
+		db.ExecAction(`DROP FUNCTION IF EXISTS kafka_requests_version_trigger`, `
+			CREATE OR REPLACE FUNCTION kafka_requests_version_trigger() RETURNS TRIGGER LANGUAGE plpgsql AS '
+			BEGIN
+			NEW.version := nextval(''kafka_requests_version_seq'');
+			RETURN NEW;
+			END;'
+		`),
+		db.ExecAction(`ALTER TABLE kafka_requests RENAME COLUMN version TO resource_version`, `
+			ALTER TABLE kafka_requests RENAME COLUMN resource_version TO version
+		`),
+		db.ExecAction(`ALTER SEQUENCE kafka_requests_version_seq RENAME TO kafka_requests_resource_version_seq`, `
+			ALTER SEQUENCE kafka_requests_resource_version_seq RENAME TO kafka_requests_version_seq
+		`),
+		db.ExecAction(`ALTER INDEX IF EXISTS idx_kafka_requests_version RENAME TO idx_kafka_requests_resource_version`, `
+			ALTER INDEX IF EXISTS idx_kafka_requests_resource_version RENAME TO idx_kafka_requests_version
+		`),
+		// the versions taken from the sequence are not comparable with the transaction ids. The deleted kafka requests
+		// are moved before any watcher, so that their deletion is not reported again.
+		db.ExecAction(`
+			UPDATE kafka_requests SET resource_version = CASE WHEN deleted_at IS NULL THEN txid_current() ELSE 0 END
+		`, ``),
+		db.ExecAction(`
+			CREATE OR REPLACE FUNCTION kafka_requests_resource_version_trigger() RETURNS TRIGGER LANGUAGE plpgsql AS '
+			BEGIN
+			NEW.resource_version := txid_current();
+			RETURN NEW;
+			END;'
+		`, `
+			DROP FUNCTION IF EXISTS kafka_requests_resource_version_trigger
+		`),
+		db.ExecAction(`DROP TRIGGER IF EXISTS kafka_requests_resource_version_trigger ON kafka_requests`, ``),
+		db.ExecAction(`
+			CREATE TRIGGER kafka_requests_resource_version_trigger BEFORE INSERT OR UPDATE ON kafka_requests
+			FOR EACH ROW EXECUTE PROCEDURE kafka_requests_resource_version_trigger();
+		`, `
+			DROP TRIGGER IF EXISTS kafka_requests_resource_version_trigger ON kafka_requests
+		`),
+		db.ExecAction(`
+			CREATE OR REPLACE FUNCTION kafka_requests_outbox_trigger() RETURNS TRIGGER LANGUAGE plpgsql AS '
+			BEGIN
+			IF TG_OP = ''INSERT'' OR NEW.status IS DISTINCT FROM OLD.status THEN
+				INSERT INTO outbox_events (event_type, resource_id, resource_version, payload, created_at)
+				VALUES (''kafka.status_changed'', NEW.id, NEW.resource_version, jsonb_build_object(''status'', NEW.status, ''organisation_id'', NEW.organisation_id, ''owner'', NEW.owner, ''name'', NEW.name, ''cloud_provider'', NEW.cloud_provider, ''region'', NEW.region, ''instance_type'', NEW.instance_type, ''failed_reason'', NEW.failed_reason, ''expires_at'', NEW.expires_at), now());
+				PERFORM pg_notify(''signalbus'', ''kafka.status_changed'');
+			END IF;
+			RETURN NEW;
+			END;'
+		`, `
+			CREATE OR REPLACE FUNCTION kafka_requests_outbox_trigger() RETURNS TRIGGER LANGUAGE plpgsql AS '
+			BEGIN
+			IF TG_OP = ''INSERT'' OR NEW.status IS DISTINCT FROM OLD.status THEN
+				INSERT INTO outbox_events (event_type, resource_id, resource_version, payload, created_at)
+				VALUES (''kafka.status_changed'', NEW.id, NEW.version, jsonb_build_object(''status'', NEW.status, ''organisation_id'', NEW.organisation_id, ''owner'', NEW.owner), now());
+				PERFORM pg_notify(''signalbus'', ''kafka.status_changed'');
+			END IF;
+			RETURN NEW;
+			END;'
+		`),
+	)
+}
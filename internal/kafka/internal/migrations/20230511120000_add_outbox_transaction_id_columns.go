@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addOutboxTransactionIdColumns records the id of the transaction that published each outbox event, as well as the one of
+// the last event handled by each consumer.
+// The sequence of an event is assigned when it is inserted, so a transaction may commit an event with a lower sequence
+// after the ones of another transaction have been consumed. The consumers only read the events of the transactions
+// older than the oldest running one, ordered by transaction id first, so that none is committed behind their cursor.
+// The events recorded before this migration are left in transaction 0 and are consumed first.
+func addOutboxTransactionIdColumns() *gormigrate.Migration {
+	return db.CreateMigrationFromActions("20230511120000",
+		db.ExecAction(`ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS transaction_id bigint NOT NULL DEFAULT 0`, `
+			ALTER TABLE outbox_events DROP COLUMN IF EXISTS transaction_id
+		`),
+		db.ExecAction(`ALTER TABLE outbox_events ALTER COLUMN transaction_id SET DEFAULT txid_current()`, ``),
+		db.ExecAction(`CREATE INDEX IF NOT EXISTS idx_outbox_events_transaction_id_sequence ON outbox_events (transaction_id, sequence)`, `
+			DROP INDEX IF EXISTS idx_outbox_events_transaction_id_sequence
+		`),
+		db.ExecAction(`ALTER TABLE outbox_cursors ADD COLUMN IF NOT EXISTS transaction_id bigint NOT NULL DEFAULT 0`, `
+			ALTER TABLE outbox_cursors DROP COLUMN IF EXISTS transaction_id
+		`),
+	)
+}
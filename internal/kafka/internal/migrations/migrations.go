@@ -99,6 +99,24 @@ var migrations = []*gormigrate.Migration{
 	renameKafkaStorageSizeColumn(),
 	addKafkaDomainCertificateManagementInfoInKafkaRequestsTable(),
 	addKafkasRoutesTLSCertificateManagerInLeaderLeases(),
+	addKafkaVersionColumn(),
+	addKafkaMaintenanceWindowsTable(),
+	addKafkaUpgradeCampaignsTables(),
+	addKafkaMigrationFields(),
+	addAuditEventsTable(),
+	addWebhookTables(),
+	addOutboxTables(),
+	addKafkaRoleBindingsTable(),
+	addAccessControlAndQuotaManagementListTables(),
+	addRateLimitTables(),
+	addIdempotencyKeysTable(),
+	addKafkaInstanceTemplatesTable(),
+	addClusterConsolidationWorkerLease(),
+	addCordonedColumnInClustersTable(),
+	addClusterCreationAttemptsTable(),
+	addFailedClusterRetryWorkerLease(),
+	renameKafkaVersionColumn(),
+	addOutboxTransactionIdColumns(),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
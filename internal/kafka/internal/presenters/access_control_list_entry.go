@@ -0,0 +1,31 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// ConvertAccessControlListEntryRequest from payload to AccessControlListEntry
+func ConvertAccessControlListEntryRequest(request private.AccessControlListEntryRequest, createdBy string) *api.AccessControlListEntry {
+	return &api.AccessControlListEntry{
+		Type:      api.AccessControlListEntryType(request.Type),
+		Value:     request.Value,
+		Reason:    request.Reason,
+		CreatedBy: createdBy,
+	}
+}
+
+// PresentAccessControlListEntry - create AccessControlListEntry in an appropriate format ready to be returned by the API
+func PresentAccessControlListEntry(entry *api.AccessControlListEntry) private.AccessControlListEntry {
+	reference := PresentReference(entry.ID, entry)
+	return private.AccessControlListEntry{
+		Id:        reference.Id,
+		Kind:      reference.Kind,
+		Href:      reference.Href,
+		Type:      entry.Type.String(),
+		Value:     entry.Value,
+		Reason:    entry.Reason,
+		CreatedBy: entry.CreatedBy,
+		CreatedAt: entry.CreatedAt,
+	}
+}
@@ -0,0 +1,78 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// PresentDataPlaneClusterAdminEndpoint - create DataPlaneCluster in an appropriate format ready to be returned by the admin API
+func PresentDataPlaneClusterAdminEndpoint(cluster *api.Cluster) private.DataPlaneCluster {
+	reference := PresentReference(cluster.ClusterID, cluster)
+	return private.DataPlaneCluster{
+		Id:            reference.Id,
+		Kind:          reference.Kind,
+		Href:          reference.Href,
+		Status:        cluster.Status.String(),
+		CloudProvider: cluster.CloudProvider,
+		Region:        cluster.Region,
+		MultiAz:       cluster.MultiAZ,
+		ClusterType:   cluster.ClusterType,
+		Cordoned:      cluster.Cordoned,
+		CreatedAt:     cluster.CreatedAt,
+		UpdatedAt:     cluster.UpdatedAt,
+	}
+}
+
+// PresentDataPlaneClusterDetailsAdminEndpoint - create DataPlaneCluster along with its capacity, available strimzi versions
+// and kafkas in an appropriate format ready to be returned by the admin API
+func PresentDataPlaneClusterDetailsAdminEndpoint(details services.DataPlaneClusterDetails) (private.DataPlaneCluster, *errors.ServiceError) {
+	cluster := details.Cluster
+	dataPlaneCluster := PresentDataPlaneClusterAdminEndpoint(cluster)
+
+	capacityInfo := cluster.RetrieveDynamicCapacityInfo()
+	for _, instanceType := range cluster.GetSupportedInstanceTypes() {
+		info := capacityInfo[instanceType]
+		dataPlaneCluster.Capacity = append(dataPlaneCluster.Capacity, private.DataPlaneClusterCapacity{
+			InstanceType:           instanceType,
+			MaxNodes:               info.MaxNodes,
+			MaxUnits:               info.MaxUnits,
+			RemainingUnits:         info.RemainingUnits,
+			ConsumedStreamingUnits: details.ConsumedStreamingUnits[types.KafkaInstanceType(instanceType)],
+		})
+	}
+
+	strimziVersions, err := cluster.GetAvailableStrimziVersions()
+	if err != nil {
+		return dataPlaneCluster, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the available strimzi versions of data plane cluster %q", cluster.ClusterID)
+	}
+	for _, strimziVersion := range strimziVersions {
+		version := private.DataPlaneClusterStrimziVersion{
+			Version:          strimziVersion.Version,
+			Ready:            strimziVersion.Ready,
+			KafkaVersions:    []string{},
+			KafkaIbpVersions: []string{},
+		}
+		for _, kafkaVersion := range strimziVersion.KafkaVersions {
+			version.KafkaVersions = append(version.KafkaVersions, kafkaVersion.Version)
+		}
+		for _, kafkaIBPVersion := range strimziVersion.KafkaIBPVersions {
+			version.KafkaIbpVersions = append(version.KafkaIbpVersions, kafkaIBPVersion.Version)
+		}
+		dataPlaneCluster.AvailableStrimziVersions = append(dataPlaneCluster.AvailableStrimziVersions, version)
+	}
+
+	for _, kafka := range details.Kafkas {
+		dataPlaneCluster.Kafkas = append(dataPlaneCluster.Kafkas, private.DataPlaneClusterKafka{
+			Id:           kafka.ID,
+			Name:         kafka.Name,
+			Status:       kafka.Status,
+			InstanceType: kafka.InstanceType,
+			SizeId:       kafka.SizeId,
+		})
+	}
+
+	return dataPlaneCluster, nil
+}
@@ -67,6 +67,10 @@ func PresentKafkaRequestAdminEndpoint(kafkaRequest *dbapi.KafkaRequest, accountS
 		MaxDataRetentionSize: private.SupportedKafkaSizeBytesValueItem{
 			Bytes: maxDataRetentionSizeBytes,
 		},
+		MigrationStatus:          kafkaRequest.MigrationStatus.String(),
+		MigrationSourceClusterId: kafkaRequest.MigrationSourceClusterID,
+		MigrationTargetClusterId: kafkaRequest.MigrationTargetClusterID,
+		MigrationDetails:         kafkaRequest.MigrationDetails,
 	}, nil
 }
 
@@ -0,0 +1,32 @@
+package presenters
+
+import (
+	"encoding/json"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+const auditEventKind = "AuditEvent"
+
+// PresentAuditEvent - create AuditEvent in an appropriate format ready to be returned by the API
+func PresentAuditEvent(auditEvent *api.AuditEvent) private.AuditEvent {
+	result := private.AuditEvent{
+		Id:             auditEvent.ID,
+		Kind:           auditEventKind,
+		CreatedAt:      auditEvent.CreatedAt,
+		Actor:          auditEvent.Actor,
+		OrganisationId: auditEvent.OrganisationId,
+		Action:         auditEvent.Action,
+		ResourceId:     auditEvent.ResourceId,
+		Method:         auditEvent.Method,
+		RequestPath:    auditEvent.RequestPath,
+		StatusCode:     int32(auditEvent.StatusCode),
+		OperationId:    auditEvent.OperationId,
+	}
+	if len(auditEvent.RequestDiff) > 0 {
+		// request bodies that are not JSON objects are not presented
+		_ = json.Unmarshal(auditEvent.RequestDiff, &result.RequestDiff)
+	}
+	return result
+}
@@ -100,7 +100,7 @@ func presentEnterpriseClusterCapacityInfo(consumedStreamingUnitsInTheCluster int
 
 func presentEnterpriseClusterSupportedInstanceTypes(kafkaConfig *config.KafkaConfig) (public.SupportedKafkaInstanceTypesList, error) {
 	// enterprise clusters only supports standard instance type for now. It is safe to hardcode this.
-	standardInstanceType, err := kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(types.STANDARD.String())
+	standardInstanceType, err := kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(types.STANDARD.String())
 	if err != nil { // this should never happen, lets log an error in case it happens.
 		logger.Logger.Errorf("failed to find standard instance type from supported instance type config due to %q.", err.Error())
 		return public.SupportedKafkaInstanceTypesList{
@@ -0,0 +1,39 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+const clusterConsolidationPlanKind = "ClusterConsolidationPlan"
+
+// PresentClusterConsolidationPlan - create ClusterConsolidationPlan in an appropriate format ready to be returned by the API
+func PresentClusterConsolidationPlan(consolidations []services.ClusterConsolidation, consolidationConfig config.ConsolidationConfig) private.ClusterConsolidationPlan {
+	result := private.ClusterConsolidationPlan{
+		Kind:                     clusterConsolidationPlanKind,
+		ConsolidationEnabled:     consolidationConfig.IsConsolidationEnabled(),
+		MaxUtilisationPercentage: int32(consolidationConfig.MaxUtilisationPercentage),
+		Items:                    []private.ClusterConsolidation{},
+	}
+	for _, consolidation := range consolidations {
+		item := private.ClusterConsolidation{
+			ClusterId:              consolidation.ClusterID,
+			CloudProvider:          consolidation.CloudProvider,
+			Region:                 consolidation.Region,
+			ConsumedStreamingUnits: int32(consolidation.ConsumedStreamingUnits),
+			MaxStreamingUnits:      int32(consolidation.MaxStreamingUnits),
+			CanBeEmptied:           consolidation.CanBeEmptied(),
+			Moves:                  []private.ClusterConsolidationMove{},
+			Reason:                 consolidation.Reason,
+		}
+		for _, move := range consolidation.Moves {
+			item.Moves = append(item.Moves, private.ClusterConsolidationMove{
+				KafkaId:         move.Kafka.ID,
+				TargetClusterId: move.TargetClusterID,
+			})
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result
+}
@@ -0,0 +1,38 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+const dataPlaneScaleUpForecastListKind = "DataPlaneScaleUpForecastList"
+
+// PresentDataPlaneScaleUpForecasts - create DataPlaneScaleUpForecastList in an appropriate format ready to be returned by the API
+func PresentDataPlaneScaleUpForecasts(forecasts []services.DataPlaneScaleUpForecast, forecastTime time.Time, predictiveScaleUpConfig config.PredictiveScaleUpConfig) private.DataPlaneScaleUpForecastList {
+	result := private.DataPlaneScaleUpForecastList{
+		Kind:                     dataPlaneScaleUpForecastListKind,
+		PredictiveScaleUpEnabled: predictiveScaleUpConfig.IsPredictiveScaleUpEnabled(),
+		ForecastTime:             forecastTime,
+		ForecastHorizonSeconds:   int64(predictiveScaleUpConfig.ForecastHorizon.Seconds()),
+		Items:                    []private.DataPlaneScaleUpForecast{},
+	}
+	for _, forecast := range forecasts {
+		result.Items = append(result.Items, private.DataPlaneScaleUpForecast{
+			CloudProvider:                           forecast.CloudProvider,
+			Region:                                  forecast.Region,
+			InstanceType:                            forecast.InstanceType,
+			FreeStreamingUnits:                      int32(forecast.FreeStreamingUnits),
+			HistoricalDemandStreamingUnits:          int32(forecast.HistoricalDemandStreamingUnits),
+			ScheduledDemandStreamingUnits:           int32(forecast.ScheduledDemandStreamingUnits),
+			PredictedDemandStreamingUnits:           int32(forecast.PredictedDemandStreamingUnits()),
+			MinAvailableCapacitySlackStreamingUnits: int32(forecast.MinAvailableCapacitySlackStreamingUnits),
+			OngoingScaleUp:                          forecast.OngoingScaleUp,
+			RegionLimitReached:                      forecast.RegionLimitReached,
+			ScaleUpNeeded:                           forecast.ScaleUpNeeded,
+		})
+	}
+	return result
+}
@@ -50,6 +50,38 @@ func ConvertKafkaRequest(kafkaRequestPayload public.KafkaRequestPayload, dbKafka
 	return kafka
 }
 
+// ConvertKafkaCloneRequest returns the payload creating a kafka request with the settings of the source kafka and the name of the clone request
+func ConvertKafkaCloneRequest(cloneRequest public.KafkaCloneRequest, source *dbapi.KafkaRequest) public.KafkaRequestPayload {
+	reauthenticationEnabled := source.ReauthenticationEnabled
+	kafkaRequestPayload := public.KafkaRequestPayload{
+		Name:                    cloneRequest.Name,
+		CloudProvider:           source.CloudProvider,
+		Region:                  source.Region,
+		Plan:                    fmt.Sprintf("%s.%s", source.InstanceType, source.SizeId),
+		ReauthenticationEnabled: &reauthenticationEnabled,
+	}
+
+	if source.DesiredKafkaBillingModel != "" {
+		billingModel := source.DesiredKafkaBillingModel
+		kafkaRequestPayload.BillingModel = &billingModel
+	}
+	if source.BillingCloudAccountId != "" {
+		billingCloudAccountId := source.BillingCloudAccountId
+		kafkaRequestPayload.BillingCloudAccountId = &billingCloudAccountId
+	}
+	if source.Marketplace != "" {
+		marketplace := source.Marketplace
+		kafkaRequestPayload.Marketplace = &marketplace
+	}
+	// enterprise kafkas are created on the cluster of the organisation they were created on
+	if source.DesiredBillingModelIsEnterprise() {
+		clusterID := source.ClusterID
+		kafkaRequestPayload.ClusterId = &clusterID
+	}
+
+	return kafkaRequestPayload
+}
+
 // PresentKafkaRequest - create KafkaRequest in an appropriate format ready to be returned by the API
 func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.KafkaConfig) (public.KafkaRequest, *errors.ServiceError) {
 	reference := PresentReference(kafkaRequest.ID, kafkaRequest)
@@ -124,6 +156,8 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 		PromotionStatus:                       kafkaRequest.PromotionStatus.String(),
 		PromotionDetails:                      kafkaRequest.PromotionDetails,
 		ClusterId:                             getClusterID(kafkaRequest),
+		UpgradeState:                          kafkaRequest.UpgradeState().String(),
+		Role:                                  kafkaRequest.Role.String(),
 	}, nil
 }
 
@@ -136,7 +170,7 @@ func setBootstrapServerHost(bootstrapServerHost string) string {
 
 func getDisplayName(instanceType string, config *config.KafkaConfig) (string, *errors.ServiceError) {
 	if config != nil && strings.Trim(instanceType, " ") != "" {
-		kafkaInstanceType, err := config.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+		kafkaInstanceType, err := config.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 		if err != nil {
 			return "", errors.NewWithCause(errors.ErrorGeneral, err, "unable to get kafka display name for '%s' instance type", instanceType)
 		}
@@ -0,0 +1,73 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+)
+
+// ConvertKafkaInstanceTemplateRequest from payload to KafkaInstanceTemplate
+func ConvertKafkaInstanceTemplateRequest(request public.KafkaInstanceTemplateRequest, organisationId, createdBy string) *dbapi.KafkaInstanceTemplate {
+	return &dbapi.KafkaInstanceTemplate{
+		OrganisationId:          organisationId,
+		Name:                    request.Name,
+		CloudProvider:           request.CloudProvider,
+		Region:                  request.Region,
+		Plan:                    request.Plan,
+		ReauthenticationEnabled: request.ReauthenticationEnabled,
+		BillingModel:            shared.SafeString(request.BillingModel),
+		BillingCloudAccountId:   shared.SafeString(request.BillingCloudAccountId),
+		Marketplace:             shared.SafeString(request.Marketplace),
+		ClusterId:               shared.SafeString(request.ClusterId),
+		CreatedBy:               createdBy,
+	}
+}
+
+// PresentKafkaInstanceTemplate - create KafkaInstanceTemplate in an appropriate format ready to be returned by the API
+func PresentKafkaInstanceTemplate(template *dbapi.KafkaInstanceTemplate) public.KafkaInstanceTemplate {
+	reference := PresentReference(template.ID, template)
+	return public.KafkaInstanceTemplate{
+		Id:                      reference.Id,
+		Kind:                    reference.Kind,
+		Href:                    reference.Href,
+		Name:                    template.Name,
+		CloudProvider:           template.CloudProvider,
+		Region:                  template.Region,
+		Plan:                    template.Plan,
+		ReauthenticationEnabled: template.ReauthenticationEnabled,
+		BillingModel:            template.BillingModel,
+		BillingCloudAccountId:   template.BillingCloudAccountId,
+		Marketplace:             template.Marketplace,
+		ClusterId:               template.ClusterId,
+		CreatedBy:               template.CreatedBy,
+		CreatedAt:               template.CreatedAt,
+	}
+}
+
+// ApplyKafkaInstanceTemplate sets the fields of the kafka request payload that are not set to the values of the template
+func ApplyKafkaInstanceTemplate(kafkaRequestPayload *public.KafkaRequestPayload, template *dbapi.KafkaInstanceTemplate) {
+	if kafkaRequestPayload.CloudProvider == "" {
+		kafkaRequestPayload.CloudProvider = template.CloudProvider
+	}
+	if kafkaRequestPayload.Region == "" {
+		kafkaRequestPayload.Region = template.Region
+	}
+	if kafkaRequestPayload.Plan == "" {
+		kafkaRequestPayload.Plan = template.Plan
+	}
+	if kafkaRequestPayload.ReauthenticationEnabled == nil {
+		kafkaRequestPayload.ReauthenticationEnabled = template.ReauthenticationEnabled
+	}
+	if kafkaRequestPayload.BillingModel == nil && template.BillingModel != "" {
+		kafkaRequestPayload.BillingModel = &template.BillingModel
+	}
+	if kafkaRequestPayload.BillingCloudAccountId == nil && template.BillingCloudAccountId != "" {
+		kafkaRequestPayload.BillingCloudAccountId = &template.BillingCloudAccountId
+	}
+	if kafkaRequestPayload.Marketplace == nil && template.Marketplace != "" {
+		kafkaRequestPayload.Marketplace = &template.Marketplace
+	}
+	if kafkaRequestPayload.ClusterId == nil && template.ClusterId != "" {
+		kafkaRequestPayload.ClusterId = &template.ClusterId
+	}
+}
@@ -0,0 +1,37 @@
+package presenters
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+// ConvertMaintenanceWindowRequest from payload to KafkaMaintenanceWindow
+func ConvertMaintenanceWindowRequest(request public.MaintenanceWindowRequest, kafkaID, organisationID string) *dbapi.KafkaMaintenanceWindow {
+	return &dbapi.KafkaMaintenanceWindow{
+		KafkaID:        kafkaID,
+		OrganisationId: organisationID,
+		DayOfWeek:      strings.ToLower(request.DayOfWeek),
+		StartHour:      int(request.StartHour),
+		DurationHours:  int(request.DurationHours),
+	}
+}
+
+// PresentMaintenanceWindow - create MaintenanceWindow in an appropriate format ready to be returned by the API
+func PresentMaintenanceWindow(window *dbapi.KafkaMaintenanceWindow, now time.Time) public.MaintenanceWindow {
+	reference := PresentReference(window.ID, window)
+	return public.MaintenanceWindow{
+		Id:            reference.Id,
+		Kind:          reference.Kind,
+		Href:          reference.Href,
+		KafkaId:       window.KafkaID,
+		DayOfWeek:     window.DayOfWeek,
+		StartHour:     int32(window.StartHour),
+		DurationHours: int32(window.DurationHours),
+		NextStartTime: window.NextStart(now),
+		CreatedAt:     window.CreatedAt,
+		UpdatedAt:     window.UpdatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_ConvertMaintenanceWindowRequest(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	got := ConvertMaintenanceWindowRequest(public.MaintenanceWindowRequest{
+		DayOfWeek:     "Sunday",
+		StartHour:     2,
+		DurationHours: 4,
+	}, "kafka-id", "org-id")
+
+	g.Expect(got).To(gomega.Equal(&dbapi.KafkaMaintenanceWindow{
+		KafkaID:        "kafka-id",
+		OrganisationId: "org-id",
+		DayOfWeek:      "sunday",
+		StartHour:      2,
+		DurationHours:  4,
+	}))
+}
+
+func Test_PresentMaintenanceWindow(t *testing.T) {
+	// 2023-04-10 is a Monday
+	now := time.Date(2023, time.April, 10, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		window *dbapi.KafkaMaintenanceWindow
+		want   public.MaintenanceWindow
+	}{
+		{
+			name: "should present the maintenance window of a kafka",
+			window: &dbapi.KafkaMaintenanceWindow{
+				Meta:           api.Meta{ID: "window-id", CreatedAt: createdAt, UpdatedAt: createdAt},
+				KafkaID:        "kafka-id",
+				OrganisationId: "org-id",
+				DayOfWeek:      "sunday",
+				StartHour:      2,
+				DurationHours:  4,
+			},
+			want: public.MaintenanceWindow{
+				Id:            "window-id",
+				Kind:          KindMaintenanceWindow,
+				Href:          "/api/kafkas_mgmt/v1/kafkas/kafka-id/maintenance_window",
+				KafkaId:       "kafka-id",
+				DayOfWeek:     "sunday",
+				StartHour:     2,
+				DurationHours: 4,
+				NextStartTime: time.Date(2023, time.April, 16, 2, 0, 0, 0, time.UTC),
+				CreatedAt:     createdAt,
+				UpdatedAt:     createdAt,
+			},
+		},
+		{
+			name: "should present the maintenance window of an organisation",
+			window: &dbapi.KafkaMaintenanceWindow{
+				Meta:           api.Meta{ID: "window-id", CreatedAt: createdAt, UpdatedAt: createdAt},
+				OrganisationId: "org-id",
+				DayOfWeek:      "monday",
+				StartHour:      10,
+				DurationHours:  4,
+			},
+			want: public.MaintenanceWindow{
+				Id:            "window-id",
+				Kind:          KindMaintenanceWindow,
+				Href:          "/api/kafkas_mgmt/v1/maintenance_window",
+				DayOfWeek:     "monday",
+				StartHour:     10,
+				DurationHours: 4,
+				NextStartTime: time.Date(2023, time.April, 10, 10, 0, 0, 0, time.UTC),
+				CreatedAt:     createdAt,
+				UpdatedAt:     createdAt,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentMaintenanceWindow(tt.window, now)).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+const kafkaMigrationListKind = "KafkaMigrationList"
+
+// PresentKafkaMigrations - create KafkaMigrationList in an appropriate format ready to be returned by the API
+func PresentKafkaMigrations(migrations []services.KafkaMigration) private.KafkaMigrationList {
+	result := private.KafkaMigrationList{
+		Kind:  kafkaMigrationListKind,
+		Items: []private.KafkaMigration{},
+	}
+	for _, migration := range migrations {
+		item := private.KafkaMigration{
+			KafkaId:         migration.Kafka.ID,
+			SourceClusterId: migration.Kafka.ClusterID,
+			Reason:          migration.Reason,
+		}
+		if migration.Reason == "" {
+			item.TargetClusterId = migration.Kafka.MigrationTargetClusterID
+			item.MigrationStatus = migration.Kafka.MigrationStatus.String()
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result
+}
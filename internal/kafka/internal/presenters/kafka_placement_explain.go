@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+const kafkaPlacementExplanationKind = "KafkaPlacementExplanation"
+
+// PresentKafkaPlacementExplanation - create KafkaPlacementExplanation in an appropriate format ready to be returned by the API
+func PresentKafkaPlacementExplanation(explanation *services.KafkaPlacementExplanation) private.KafkaPlacementExplanation {
+	result := private.KafkaPlacementExplanation{
+		Kind:                    kafkaPlacementExplanationKind,
+		RegionCapacityAvailable: explanation.RegionCapacityAvailable,
+		Candidates:              []private.KafkaPlacementCandidate{},
+	}
+	for _, candidate := range explanation.Candidates {
+		result.Candidates = append(result.Candidates, private.KafkaPlacementCandidate{
+			ClusterId:             candidate.Cluster.ClusterID,
+			CloudProvider:         candidate.Cluster.CloudProvider,
+			Region:                candidate.Cluster.Region,
+			MultiAz:               candidate.Cluster.MultiAZ,
+			Status:                candidate.Cluster.Status.String(),
+			ClusterType:           candidate.Cluster.ClusterType,
+			OrganizationId:        candidate.Cluster.OrganizationID,
+			SupportedInstanceType: candidate.Cluster.SupportedInstanceType,
+			Accepted:              candidate.RejectedBy == "",
+			RejectedBy:            candidate.RejectedBy.String(),
+			Reason:                candidate.Reason,
+		})
+	}
+	if explanation.ChosenCluster != nil {
+		result.ChosenClusterId = explanation.ChosenCluster.ClusterID
+	}
+	return result
+}
@@ -0,0 +1,30 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+// ConvertKafkaRoleBindingRequest from payload to KafkaRoleBinding
+func ConvertKafkaRoleBindingRequest(request public.KafkaRoleBindingRequest, kafkaID, createdBy string) *dbapi.KafkaRoleBinding {
+	return &dbapi.KafkaRoleBinding{
+		KafkaID:   kafkaID,
+		Subject:   request.Subject,
+		Role:      dbapi.KafkaRole(request.Role),
+		CreatedBy: createdBy,
+	}
+}
+
+// PresentKafkaRoleBinding - create KafkaRoleBinding in an appropriate format ready to be returned by the API
+func PresentKafkaRoleBinding(binding *dbapi.KafkaRoleBinding) public.KafkaRoleBinding {
+	reference := PresentReference(binding.ID, binding)
+	return public.KafkaRoleBinding{
+		Id:        reference.Id,
+		Kind:      reference.Kind,
+		Href:      reference.Href,
+		Subject:   binding.Subject,
+		Role:      binding.Role.String(),
+		CreatedBy: binding.CreatedBy,
+		CreatedAt: binding.CreatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+)
+
+// ConvertKafkaUpgradeCampaignRequest from payload to KafkaUpgradeCampaign
+func ConvertKafkaUpgradeCampaignRequest(request private.KafkaUpgradeCampaignRequest) *dbapi.KafkaUpgradeCampaign {
+	campaign := &dbapi.KafkaUpgradeCampaign{
+		Name:                  request.Name,
+		StrimziVersion:        request.StrimziVersion,
+		KafkaVersion:          request.KafkaVersion,
+		KafkaIBPVersion:       request.KafkaIbpVersion,
+		ClusterID:             request.Selector.ClusterId,
+		CloudProvider:         request.Selector.CloudProvider,
+		Region:                request.Selector.Region,
+		InstanceType:          request.Selector.InstanceType,
+		Search:                request.Selector.Search,
+		BatchSize:             int(request.BatchSize),
+		MaxFailureRatio:       request.MaxFailureRatio,
+		UpgradeTimeoutMinutes: int(request.UpgradeTimeoutMinutes),
+		Status:                dbapi.KafkaUpgradeCampaignStatusRunning,
+	}
+
+	if campaign.BatchSize == 0 {
+		campaign.BatchSize = dbapi.KafkaUpgradeCampaignDefaultBatchSize
+	}
+	if campaign.UpgradeTimeoutMinutes == 0 {
+		campaign.UpgradeTimeoutMinutes = int(dbapi.KafkaUpgradeCampaignDefaultUpgradeTimeout.Minutes())
+	}
+
+	return campaign
+}
+
+// PresentKafkaUpgradeCampaign - create KafkaUpgradeCampaign in an appropriate format ready to be returned by the API
+func PresentKafkaUpgradeCampaign(campaign *dbapi.KafkaUpgradeCampaign, progress dbapi.KafkaUpgradeCampaignProgress) private.KafkaUpgradeCampaign {
+	reference := PresentReference(campaign.ID, campaign)
+	return private.KafkaUpgradeCampaign{
+		Id:              reference.Id,
+		Kind:            reference.Kind,
+		Href:            reference.Href,
+		Name:            campaign.Name,
+		StrimziVersion:  campaign.StrimziVersion,
+		KafkaVersion:    campaign.KafkaVersion,
+		KafkaIbpVersion: campaign.KafkaIBPVersion,
+		Selector: private.KafkaUpgradeCampaignSelector{
+			ClusterId:     campaign.ClusterID,
+			CloudProvider: campaign.CloudProvider,
+			Region:        campaign.Region,
+			InstanceType:  campaign.InstanceType,
+			Search:        campaign.Search,
+		},
+		BatchSize:             int32(campaign.BatchSize),
+		MaxFailureRatio:       campaign.MaxFailureRatio,
+		UpgradeTimeoutMinutes: int32(campaign.UpgradeTimeoutMinutes),
+		Status:                campaign.Status.String(),
+		StatusReason:          campaign.StatusReason,
+		CurrentBatch:          int32(campaign.CurrentBatch),
+		Progress: private.KafkaUpgradeCampaignProgress{
+			Total:     int32(progress.Total),
+			Pending:   int32(progress.Pending),
+			Upgrading: int32(progress.Upgrading),
+			Succeeded: int32(progress.Succeeded),
+			Failed:    int32(progress.Failed),
+			Skipped:   int32(progress.Skipped),
+		},
+		CreatedAt: campaign.CreatedAt,
+		UpdatedAt: campaign.UpdatedAt,
+	}
+}
+
+// PresentKafkaUpgradeCampaignItem - create KafkaUpgradeCampaignItem in an appropriate format ready to be returned by the API
+func PresentKafkaUpgradeCampaignItem(item *dbapi.KafkaUpgradeCampaignItem) private.KafkaUpgradeCampaignItem {
+	return private.KafkaUpgradeCampaignItem{
+		KafkaId:   item.KafkaID,
+		State:     item.State.String(),
+		Batch:     int32(item.Batch),
+		Details:   item.Details,
+		StartedAt: item.StartedAt,
+	}
+}
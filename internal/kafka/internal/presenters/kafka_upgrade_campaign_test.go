@@ -0,0 +1,97 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_ConvertKafkaUpgradeCampaignRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request private.KafkaUpgradeCampaignRequest
+		want    *dbapi.KafkaUpgradeCampaign
+	}{
+		{
+			name: "should convert the request and default the batch size and the upgrade timeout",
+			request: private.KafkaUpgradeCampaignRequest{
+				Name:            "upgrade-to-2.8.1",
+				KafkaVersion:    "2.8.1",
+				Selector:        private.KafkaUpgradeCampaignSelector{Region: "us-east-1", Search: "name like test%"},
+				MaxFailureRatio: 0.2,
+			},
+			want: &dbapi.KafkaUpgradeCampaign{
+				Name:                  "upgrade-to-2.8.1",
+				KafkaVersion:          "2.8.1",
+				Region:                "us-east-1",
+				Search:                "name like test%",
+				BatchSize:             dbapi.KafkaUpgradeCampaignDefaultBatchSize,
+				MaxFailureRatio:       0.2,
+				UpgradeTimeoutMinutes: 120,
+				Status:                dbapi.KafkaUpgradeCampaignStatusRunning,
+			},
+		},
+		{
+			name: "should keep the given batch size and upgrade timeout",
+			request: private.KafkaUpgradeCampaignRequest{
+				StrimziVersion:        "strimzi-cluster-operator.v0.24.0-0",
+				Selector:              private.KafkaUpgradeCampaignSelector{ClusterId: "cluster-id"},
+				BatchSize:             3,
+				UpgradeTimeoutMinutes: 30,
+			},
+			want: &dbapi.KafkaUpgradeCampaign{
+				StrimziVersion:        "strimzi-cluster-operator.v0.24.0-0",
+				ClusterID:             "cluster-id",
+				BatchSize:             3,
+				UpgradeTimeoutMinutes: 30,
+				Status:                dbapi.KafkaUpgradeCampaignStatusRunning,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(ConvertKafkaUpgradeCampaignRequest(tt.request)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_PresentKafkaUpgradeCampaign(t *testing.T) {
+	g := gomega.NewWithT(t)
+	createdAt := time.Date(2023, time.April, 12, 12, 0, 0, 0, time.UTC)
+
+	got := PresentKafkaUpgradeCampaign(&dbapi.KafkaUpgradeCampaign{
+		Meta:            api.Meta{ID: "campaign-id", CreatedAt: createdAt, UpdatedAt: createdAt},
+		Name:            "upgrade-to-2.8.1",
+		KafkaVersion:    "2.8.1",
+		CloudProvider:   "aws",
+		BatchSize:       5,
+		MaxFailureRatio: 0.2,
+		Status:          dbapi.KafkaUpgradeCampaignStatusPaused,
+		StatusReason:    "too many failures",
+		CurrentBatch:    2,
+	}, dbapi.KafkaUpgradeCampaignProgress{Total: 10, Pending: 5, Succeeded: 3, Failed: 2})
+
+	g.Expect(got).To(gomega.Equal(private.KafkaUpgradeCampaign{
+		Id:              "campaign-id",
+		Kind:            KindKafkaUpgradeCampaign,
+		Href:            "/api/kafkas_mgmt/v1/admin/upgrade_campaigns/campaign-id",
+		Name:            "upgrade-to-2.8.1",
+		KafkaVersion:    "2.8.1",
+		Selector:        private.KafkaUpgradeCampaignSelector{CloudProvider: "aws"},
+		BatchSize:       5,
+		MaxFailureRatio: 0.2,
+		Status:          "paused",
+		StatusReason:    "too many failures",
+		CurrentBatch:    2,
+		Progress:        private.KafkaUpgradeCampaignProgress{Total: 10, Pending: 5, Succeeded: 3, Failed: 2},
+		CreatedAt:       createdAt,
+		UpdatedAt:       createdAt,
+	}))
+}
@@ -23,6 +23,20 @@ const (
 	KindServiceAccount = "ServiceAccount"
 
 	KindCluster = "Cluster"
+	// KindMaintenanceWindow is a string identifier for the type dbapi.KafkaMaintenanceWindow
+	KindMaintenanceWindow = "MaintenanceWindow"
+	// KindKafkaUpgradeCampaign is a string identifier for the type dbapi.KafkaUpgradeCampaign
+	KindKafkaUpgradeCampaign = "KafkaUpgradeCampaign"
+	// KindWebhookSubscription is a string identifier for the type api.WebhookSubscription
+	KindWebhookSubscription = "WebhookSubscription"
+	// KindKafkaRoleBinding is a string identifier for the type dbapi.KafkaRoleBinding
+	KindKafkaRoleBinding = "KafkaRoleBinding"
+	// KindAccessControlListEntry is a string identifier for the type api.AccessControlListEntry
+	KindAccessControlListEntry = "AccessControlListEntry"
+	// KindQuotaManagementListEntry is a string identifier for the type api.QuotaManagementListEntry
+	KindQuotaManagementListEntry = "QuotaManagementListEntry"
+	// KindKafkaInstanceTemplate is a string identifier for the type dbapi.KafkaInstanceTemplate
+	KindKafkaInstanceTemplate = "KafkaInstanceTemplate"
 
 	BasePath = "/api/kafkas_mgmt/v1"
 )
@@ -45,6 +59,20 @@ func objectKind(i interface{}) string {
 		return KindServiceAccount
 	case api.Cluster, *api.Cluster:
 		return KindCluster
+	case dbapi.KafkaMaintenanceWindow, *dbapi.KafkaMaintenanceWindow:
+		return KindMaintenanceWindow
+	case dbapi.KafkaUpgradeCampaign, *dbapi.KafkaUpgradeCampaign:
+		return KindKafkaUpgradeCampaign
+	case api.WebhookSubscription, *api.WebhookSubscription:
+		return KindWebhookSubscription
+	case dbapi.KafkaRoleBinding, *dbapi.KafkaRoleBinding:
+		return KindKafkaRoleBinding
+	case api.AccessControlListEntry, *api.AccessControlListEntry:
+		return KindAccessControlListEntry
+	case api.QuotaManagementListEntry, *api.QuotaManagementListEntry:
+		return KindQuotaManagementListEntry
+	case dbapi.KafkaInstanceTemplate, *dbapi.KafkaInstanceTemplate:
+		return KindKafkaInstanceTemplate
 	default:
 		return ""
 	}
@@ -60,7 +88,41 @@ func objectPath(id string, obj interface{}) string {
 		return fmt.Sprintf("%s/clusters/%s", BasePath, id)
 	case api.ServiceAccount, *api.ServiceAccount:
 		return fmt.Sprintf("%s/service_accounts/%s", BasePath, id)
+	case *dbapi.KafkaMaintenanceWindow:
+		return maintenanceWindowPath(obj.(*dbapi.KafkaMaintenanceWindow))
+	case dbapi.KafkaMaintenanceWindow:
+		window := obj.(dbapi.KafkaMaintenanceWindow)
+		return maintenanceWindowPath(&window)
+	case dbapi.KafkaUpgradeCampaign, *dbapi.KafkaUpgradeCampaign:
+		return fmt.Sprintf("%s/admin/upgrade_campaigns/%s", BasePath, id)
+	case api.WebhookSubscription, *api.WebhookSubscription:
+		return fmt.Sprintf("%s/webhook_subscriptions/%s", BasePath, id)
+	case *dbapi.KafkaRoleBinding:
+		return kafkaRoleBindingPath(obj.(*dbapi.KafkaRoleBinding))
+	case dbapi.KafkaRoleBinding:
+		binding := obj.(dbapi.KafkaRoleBinding)
+		return kafkaRoleBindingPath(&binding)
+	case api.AccessControlListEntry, *api.AccessControlListEntry:
+		return fmt.Sprintf("%s/admin/access_control_list_entries/%s", BasePath, id)
+	case api.QuotaManagementListEntry, *api.QuotaManagementListEntry:
+		return fmt.Sprintf("%s/admin/quota_management_list_entries/%s", BasePath, id)
+	case dbapi.KafkaInstanceTemplate, *dbapi.KafkaInstanceTemplate:
+		return fmt.Sprintf("%s/kafka_instance_templates/%s", BasePath, id)
 	default:
 		return ""
 	}
 }
+
+// maintenanceWindowPath returns the path of the maintenance window which is a sub resource of the kafka
+// or of the organisation it belongs to
+func maintenanceWindowPath(window *dbapi.KafkaMaintenanceWindow) string {
+	if window.IsOrganisationWide() {
+		return fmt.Sprintf("%s/maintenance_window", BasePath)
+	}
+	return fmt.Sprintf("%s/kafkas/%s/maintenance_window", BasePath, window.KafkaID)
+}
+
+// kafkaRoleBindingPath returns the path of the role binding which is a sub resource of the kafka
+func kafkaRoleBindingPath(binding *dbapi.KafkaRoleBinding) string {
+	return fmt.Sprintf("%s/kafkas/%s/role_bindings/%s", BasePath, binding.KafkaID, binding.ID)
+}
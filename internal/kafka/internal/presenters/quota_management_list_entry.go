@@ -0,0 +1,42 @@
+package presenters
+
+import (
+	"encoding/json"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// ConvertQuotaManagementListEntryRequest from payload to QuotaManagementListEntry. The key of the entry
+// is set from its definition when it is created.
+func ConvertQuotaManagementListEntryRequest(request private.QuotaManagementListEntryRequest, createdBy string) (*api.QuotaManagementListEntry, error) {
+	definition, err := json.Marshal(request.Definition)
+	if err != nil {
+		return nil, err
+	}
+	return &api.QuotaManagementListEntry{
+		Type:       api.QuotaManagementListEntryType(request.Type),
+		Definition: definition,
+		CreatedBy:  createdBy,
+	}, nil
+}
+
+// PresentQuotaManagementListEntry - create QuotaManagementListEntry in an appropriate format ready to be returned by the API
+func PresentQuotaManagementListEntry(entry *api.QuotaManagementListEntry) private.QuotaManagementListEntry {
+	reference := PresentReference(entry.ID, entry)
+	result := private.QuotaManagementListEntry{
+		Id:        reference.Id,
+		Kind:      reference.Kind,
+		Href:      reference.Href,
+		Type:      entry.Type.String(),
+		Key:       entry.Key,
+		CreatedBy: entry.CreatedBy,
+		CreatedAt: entry.CreatedAt,
+		UpdatedAt: entry.UpdatedAt,
+	}
+	if len(entry.Definition) > 0 {
+		// the definition is validated when the entry is stored
+		_ = json.Unmarshal(entry.Definition, &result.Definition)
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// ConvertWebhookSubscriptionRequest from payload to WebhookSubscription
+func ConvertWebhookSubscriptionRequest(request public.WebhookSubscriptionRequest, organisationID, owner string) (*api.WebhookSubscription, *errors.ServiceError) {
+	subscription := &api.WebhookSubscription{
+		OrganisationId: organisationID,
+		Owner:          owner,
+		Url:            request.Url,
+		Secret:         request.Secret,
+	}
+	if err := subscription.SetEventTypes(request.EventTypes); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to convert webhook subscription request")
+	}
+	return subscription, nil
+}
+
+// PresentWebhookSubscription - create WebhookSubscription in an appropriate format ready to be returned by the API.
+// The secret of the subscription is never returned.
+func PresentWebhookSubscription(subscription *api.WebhookSubscription) (public.WebhookSubscription, *errors.ServiceError) {
+	eventTypes, err := subscription.GetEventTypes()
+	if err != nil {
+		return public.WebhookSubscription{}, errors.NewWithCause(errors.ErrorGeneral, err, "failed to present webhook subscription %q", subscription.ID)
+	}
+	reference := PresentReference(subscription.ID, subscription)
+	return public.WebhookSubscription{
+		Id:         reference.Id,
+		Kind:       reference.Kind,
+		Href:       reference.Href,
+		Url:        subscription.Url,
+		EventTypes: eventTypes,
+		Owner:      subscription.Owner,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}, nil
+}
@@ -7,8 +7,13 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/ratelimit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
@@ -27,6 +32,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	coreHandlers "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 
@@ -38,10 +44,11 @@ import (
 
 type options struct {
 	di.Inject
-	ServerConfig   *server.ServerConfig
-	OCMConfig      *ocm.OCMConfig
-	ProviderConfig *config.ProviderConfig
-	KafkaConfig    *config.KafkaConfig
+	ServerConfig           *server.ServerConfig
+	OCMConfig              *ocm.OCMConfig
+	ProviderConfig         *config.ProviderConfig
+	KafkaConfig            *config.KafkaConfig
+	DataplaneClusterConfig *config.DataplaneClusterConfig
 
 	AMSClient                                 ocm.AMSClient
 	Kafka                                     services.KafkaService
@@ -59,10 +66,28 @@ type options struct {
 	SupportedKafkaInstanceTypes               services.SupportedKafkaInstanceTypesService
 	AccessControlListMiddleware               *acl.AccessControlListMiddleware
 	AccessControlListConfig                   *acl.AccessControlListConfig
+	AccessControlListEntryService             acl.AccessControlListEntryService
+	QuotaManagementListEntryService           quota_management.QuotaManagementListEntryService
 	EnterpriseClustersAccessControlMiddleware *internalAcl.EnterpriseClustersAccessControlMiddleware
 	AdminRoleAuthZConfig                      *auth.AdminRoleAuthZConfig
 	KasFleetshardOperatorAddon                services.KasFleetshardOperatorAddon
 	KafkaTLSCertificateManagementService      kafkatlscertmgmt.KafkaTLSCertificateManagementService
+	SignalBus                                 signalbus.SignalBus
+	KafkaMaintenanceWindowService             services.KafkaMaintenanceWindowService
+	KafkaUpgradeCampaignService               services.KafkaUpgradeCampaignService
+	KafkaRoutesExportService                  services.KafkaRoutesExportService
+	KafkaPlacementExplainService              services.KafkaPlacementExplainService
+	DataPlaneScaleUpForecastService           services.DataPlaneScaleUpForecastService
+	ClusterConsolidationService               services.ClusterConsolidationService
+	DataPlaneClusterAdminService              services.DataPlaneClusterAdminService
+	KafkaMigrationService                     services.KafkaMigrationService
+	KafkaRoleBindingService                   services.KafkaRoleBindingService
+	KafkaInstanceTemplateService              services.KafkaInstanceTemplateService
+	AuditEventService                         audit.AuditEventService
+	WebhookService                            webhook.WebhookService
+	RateLimitConfig                           *ratelimit.RateLimitConfig
+	RateLimitService                          ratelimit.RateLimitService
+	IdempotencyKeyService                     idempotency.IdempotencyKeyService
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -85,25 +110,33 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		return pkgerrors.Wrapf(err, "can't load OpenAPI specification")
 	}
 
-	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig)
+	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig, s.SignalBus, s.KafkaInstanceTemplateService)
 	kafkaPromoteValidatorFactory := handlers.NewDefaultKafkaPromoteValidatorFactory(s.KafkaConfig)
 	kafkaPromoteHandler := handlers.NewKafkaPromoteHandler(s.Kafka, s.KafkaConfig, kafkaPromoteValidatorFactory)
+	kafkaMaintenanceWindowHandler := handlers.NewKafkaMaintenanceWindowHandler(s.Kafka, s.KafkaMaintenanceWindowService)
+	kafkaRoleBindingHandler := handlers.NewKafkaRoleBindingHandler(s.Kafka, s.KafkaRoleBindingService, s.AuthService)
 	cloudProvidersHandler := handlers.NewCloudProviderHandler(s.CloudProviders, s.ProviderConfig, s.Kafka, s.ClusterPlacementStrategy, s.KafkaConfig)
 	errorsHandler := coreHandlers.NewErrorsHandler()
 	serviceAccountsHandler := handlers.NewServiceAccountHandler(s.Keycloak)
 	metricsHandler := handlers.NewMetricsHandler(s.Observatorium)
 	supportedKafkaInstanceTypesHandler := handlers.NewSupportedKafkaInstanceTypesHandler(s.SupportedKafkaInstanceTypes)
+	webhookSubscriptionHandler := handlers.NewWebhookSubscriptionHandler(s.WebhookService)
+	kafkaInstanceTemplateHandler := handlers.NewKafkaInstanceTemplateHandler(s.KafkaInstanceTemplateService)
 
 	authorizeMiddleware := s.AccessControlListMiddleware.Authorize
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(errors.ErrorUnauthenticated)
 	requireIssuer := auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.ServerConfig.TokenIssuerURL}, errors.ErrorUnauthenticated)
 	requireTermsAcceptance := auth.NewRequireTermsAcceptanceMiddleware().RequireTermsAcceptance(s.ServerConfig.EnableTermsAcceptance, s.AMSClient, errors.ErrorTermsNotAccepted)
+	recordMutations := auth.NewAuditEventMiddleware(s.AuditEventService).RecordMutations
+	rateLimit := auth.NewRateLimitMiddleware(s.RateLimitConfig, s.RateLimitService).RateLimit
+	idempotent := auth.NewIdempotencyMiddleware(s.IdempotencyKeyService).Idempotent
 
 	// base path. Could be /api/kafkas_mgmt
 	apiRouter := mainRouter.PathPrefix(basePath).Subrouter()
 
 	// /v1
 	apiV1Router := apiRouter.PathPrefix("/v1").Subrouter()
+	apiV1Router.Use(rateLimit)
 
 	//  /openapi
 	apiV1Router.HandleFunc("/openapi", coreHandlers.NewOpenAPIHandler(openAPIDefinitions).Get).Methods(http.MethodGet)
@@ -139,12 +172,17 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1KafkasRouter.Use(requireIssuer)
 	apiV1KafkasRouter.Use(requireOrgID)
 	apiV1KafkasRouter.Use(authorizeMiddleware)
+	apiV1KafkasRouter.Use(recordMutations)
 
 	apiV1KafkasCreateRouter := apiV1KafkasRouter.NewRoute().Subrouter()
 	apiV1KafkasCreateRouter.HandleFunc("", kafkaHandler.Create).
 		Name(logger.NewLogEvent("create-kafka", "create a kafka instance").ToString()).
 		Methods(http.MethodPost)
+	apiV1KafkasCreateRouter.HandleFunc("/{id}/clone", kafkaHandler.Clone).
+		Name(logger.NewLogEvent("clone-kafka", "create a kafka instance with the settings of an existing one").ToString()).
+		Methods(http.MethodPost)
 	apiV1KafkasCreateRouter.Use(requireTermsAcceptance)
+	apiV1KafkasCreateRouter.Use(idempotent)
 
 	// /kafkas/{id}/promote
 	apiV1KafkasPromoteRouter := apiV1KafkasRouter.PathPrefix("/{id}/promote").Subrouter()
@@ -152,6 +190,92 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		Name(logger.NewLogEvent("promote-kafka", "promote a kafka instance").ToString()).
 		Methods(http.MethodPost)
 
+	// /kafkas/{id}/maintenance_window
+	apiV1KafkasMaintenanceWindowRouter := apiV1KafkasRouter.PathPrefix("/{id}/maintenance_window").Subrouter()
+	apiV1KafkasMaintenanceWindowRouter.HandleFunc("", kafkaMaintenanceWindowHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-maintenance-window", "get the maintenance window of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkasMaintenanceWindowRouter.HandleFunc("", kafkaMaintenanceWindowHandler.Update).
+		Name(logger.NewLogEvent("update-kafka-maintenance-window", "update the maintenance window of a kafka instance").ToString()).
+		Methods(http.MethodPut)
+	apiV1KafkasMaintenanceWindowRouter.HandleFunc("", kafkaMaintenanceWindowHandler.Delete).
+		Name(logger.NewLogEvent("delete-kafka-maintenance-window", "delete the maintenance window of a kafka instance").ToString()).
+		Methods(http.MethodDelete)
+
+	// /kafkas/{id}/role_bindings
+	apiV1KafkasRoleBindingsRouter := apiV1KafkasRouter.PathPrefix("/{id}/role_bindings").Subrouter()
+	apiV1KafkasRoleBindingsRouter.HandleFunc("", kafkaRoleBindingHandler.List).
+		Name(logger.NewLogEvent("list-kafka-role-bindings", "list the role bindings of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkasRoleBindingsRouter.HandleFunc("", kafkaRoleBindingHandler.Create).
+		Name(logger.NewLogEvent("create-kafka-role-binding", "bind a role on a kafka instance to a user").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRoleBindingsRouter.HandleFunc("/{role_binding_id}", kafkaRoleBindingHandler.Delete).
+		Name(logger.NewLogEvent("delete-kafka-role-binding", "delete a role binding of a kafka instance").ToString()).
+		Methods(http.MethodDelete)
+
+	// /maintenance_window
+	apiV1MaintenanceWindowRouter := apiV1Router.PathPrefix("/maintenance_window").Subrouter()
+	apiV1MaintenanceWindowRouter.HandleFunc("", kafkaMaintenanceWindowHandler.GetForOrganisation).
+		Name(logger.NewLogEvent("get-organisation-maintenance-window", "get the maintenance window of an organisation").ToString()).
+		Methods(http.MethodGet)
+	apiV1MaintenanceWindowRouter.HandleFunc("", kafkaMaintenanceWindowHandler.UpdateForOrganisation).
+		Name(logger.NewLogEvent("update-organisation-maintenance-window", "update the maintenance window of an organisation").ToString()).
+		Methods(http.MethodPut)
+	apiV1MaintenanceWindowRouter.HandleFunc("", kafkaMaintenanceWindowHandler.DeleteForOrganisation).
+		Name(logger.NewLogEvent("delete-organisation-maintenance-window", "delete the maintenance window of an organisation").ToString()).
+		Methods(http.MethodDelete)
+	apiV1MaintenanceWindowRouter.Use(requireIssuer)
+	apiV1MaintenanceWindowRouter.Use(requireOrgID)
+	apiV1MaintenanceWindowRouter.Use(authorizeMiddleware)
+	apiV1MaintenanceWindowRouter.Use(recordMutations)
+
+	// /webhook_subscriptions
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "webhook_subscriptions",
+		Kind: "WebhookSubscriptionList",
+	})
+	apiV1WebhookSubscriptionsRouter := apiV1Router.PathPrefix("/webhook_subscriptions").Subrouter()
+	apiV1WebhookSubscriptionsRouter.HandleFunc("", webhookSubscriptionHandler.List).
+		Name(logger.NewLogEvent("list-webhook-subscriptions", "list the webhook subscriptions of an organisation").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhookSubscriptionsRouter.HandleFunc("", webhookSubscriptionHandler.Create).
+		Name(logger.NewLogEvent("create-webhook-subscription", "create a webhook subscription").ToString()).
+		Methods(http.MethodPost)
+	apiV1WebhookSubscriptionsRouter.HandleFunc("/{id}", webhookSubscriptionHandler.Get).
+		Name(logger.NewLogEvent("get-webhook-subscription", "get a webhook subscription").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhookSubscriptionsRouter.HandleFunc("/{id}", webhookSubscriptionHandler.Delete).
+		Name(logger.NewLogEvent("delete-webhook-subscription", "delete a webhook subscription").ToString()).
+		Methods(http.MethodDelete)
+	apiV1WebhookSubscriptionsRouter.Use(requireIssuer)
+	apiV1WebhookSubscriptionsRouter.Use(requireOrgID)
+	apiV1WebhookSubscriptionsRouter.Use(authorizeMiddleware)
+	apiV1WebhookSubscriptionsRouter.Use(recordMutations)
+
+	// /kafka_instance_templates
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "kafka_instance_templates",
+		Kind: "KafkaInstanceTemplateList",
+	})
+	apiV1KafkaInstanceTemplatesRouter := apiV1Router.PathPrefix("/kafka_instance_templates").Subrouter()
+	apiV1KafkaInstanceTemplatesRouter.HandleFunc("", kafkaInstanceTemplateHandler.List).
+		Name(logger.NewLogEvent("list-kafka-instance-templates", "list the kafka instance templates of an organisation").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkaInstanceTemplatesRouter.HandleFunc("", kafkaInstanceTemplateHandler.Create).
+		Name(logger.NewLogEvent("create-kafka-instance-template", "create a kafka instance template").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkaInstanceTemplatesRouter.HandleFunc("/{id}", kafkaInstanceTemplateHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-instance-template", "get a kafka instance template").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkaInstanceTemplatesRouter.HandleFunc("/{id}", kafkaInstanceTemplateHandler.Delete).
+		Name(logger.NewLogEvent("delete-kafka-instance-template", "delete a kafka instance template").ToString()).
+		Methods(http.MethodDelete)
+	apiV1KafkaInstanceTemplatesRouter.Use(requireIssuer)
+	apiV1KafkaInstanceTemplatesRouter.Use(requireOrgID)
+	apiV1KafkaInstanceTemplatesRouter.Use(authorizeMiddleware)
+	apiV1KafkaInstanceTemplatesRouter.Use(recordMutations)
+
 	//  /kafkas/{id}/metrics
 	apiV1MetricsRouter := apiV1KafkasRouter.PathPrefix("/{id}/metrics").Subrouter()
 	apiV1MetricsRouter.HandleFunc("/query_range", metricsHandler.GetMetricsByRangeQuery).
@@ -188,7 +312,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1ServiceAccountsRouter.HandleFunc("", serviceAccountsHandler.ListServiceAccounts).
 		Name(logger.NewLogEvent("list-service-accounts", "lists all service accounts").ToString()).
 		Methods(http.MethodGet)
-	apiV1ServiceAccountsRouter.HandleFunc("", serviceAccountsHandler.CreateServiceAccount).
+	apiV1ServiceAccountsRouter.Handle("", idempotent(http.HandlerFunc(serviceAccountsHandler.CreateServiceAccount))).
 		Name(logger.NewLogEvent("create-service-accounts", "create a service accounts").ToString()).
 		Methods(http.MethodPost)
 	apiV1ServiceAccountsRouter.HandleFunc("/{id}", serviceAccountsHandler.DeleteServiceAccount).
@@ -204,6 +328,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1ServiceAccountsRouter.Use(requireIssuer)
 	apiV1ServiceAccountsRouter.Use(requireOrgID)
 	apiV1ServiceAccountsRouter.Use(authorizeMiddleware)
+	apiV1ServiceAccountsRouter.Use(recordMutations)
 
 	//  /cloud_providers
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -235,6 +360,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	clusterHandler := handlers.NewClusterHandler(s.KasFleetshardOperatorAddon, s.ClusterService, s.ProviderFactory, s.KafkaConfig)
 	clusterRouter := apiV1Router.PathPrefix("/clusters").Subrouter()
 	clusterRouter.Use(s.EnterpriseClustersAccessControlMiddleware.Authorize)
+	clusterRouter.Use(recordMutations)
 	clusterRouter.HandleFunc("", clusterHandler.RegisterEnterpriseCluster).
 		Name(logger.NewLogEvent("register-enterprise-cluster", "register enterprise data plane cluster").ToString()).
 		Methods(http.MethodPost)
@@ -282,8 +408,14 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	adminKafkaHandler := handlers.NewAdminKafkaHandler(s.Kafka, s.AccountService, s.ProviderConfig, s.ClusterService, s.KafkaConfig, s.KafkaTLSCertificateManagementService)
 	adminRouter := apiV1Router.PathPrefix("/admin").Subrouter()
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.Keycloak.GetConfig().AdminAPISSORealm.ValidIssuerURI}, errors.ErrorNotFound))
-	adminRouter.Use(auth.NewRolesAuthzMiddleware(s.AdminRoleAuthZConfig).RequireRolesForMethods(errors.ErrorNotFound))
+	adminRouter.Use(auth.NewRolesAuthzMiddleware(s.AdminRoleAuthZConfig,
+		handlers.NewKafkaResourceAttributesLoader(s.Kafka, s.ClusterService, "admin-get-kafka", "admin-delete-kafka", "admin-update-kafka",
+			"admin-kafka-tls-certificate-revocation", "admin-move-kafka"),
+		handlers.NewClusterResourceAttributesLoader(s.ClusterService, "admin-get-cluster", "admin-drain-cluster", "admin-cordon-cluster", "admin-uncordon-cluster",
+			"admin-deprovision-cluster", "admin-retry-cluster"),
+	).RequireRolesForMethods(errors.ErrorNotFound))
 	adminRouter.Use(auth.NewAuditLogMiddleware().AuditLog(errors.ErrorNotFound))
+	adminRouter.Use(recordMutations)
 	adminRouter.HandleFunc("/kafkas", adminKafkaHandler.List).
 		Name(logger.NewLogEvent("admin-list-kafkas", "[admin] list all kafkas").ToString()).
 		Methods(http.MethodGet)
@@ -300,6 +432,123 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		Name(logger.NewLogEvent("admin-kafka-tls-certificate-revocation", "[admin] revoke the TLS certificate of a kafka by id").ToString()).
 		Methods(http.MethodPost)
 
+	// /api/kafkas_mgmt/v1/admin/kafkas/placement_explain
+	adminKafkaPlacementHandler := handlers.NewAdminKafkaPlacementHandler(s.KafkaPlacementExplainService, s.KafkaConfig, s.ProviderConfig)
+	adminRouter.HandleFunc("/kafkas/placement_explain", adminKafkaPlacementHandler.Explain).
+		Name(logger.NewLogEvent("admin-explain-kafka-placement", "[admin] explain the placement of a kafka without creating it").ToString()).
+		Methods(http.MethodPost)
+
+	// /api/kafkas_mgmt/v1/admin/dataplane_scale_up_forecasts
+	adminDataPlaneScaleUpForecastHandler := handlers.NewAdminDataPlaneScaleUpForecastHandler(s.DataPlaneScaleUpForecastService, s.DataplaneClusterConfig)
+	adminRouter.HandleFunc("/dataplane_scale_up_forecasts", adminDataPlaneScaleUpForecastHandler.List).
+		Name(logger.NewLogEvent("admin-list-dataplane-scale-up-forecasts", "[admin] forecast the demand of streaming units and the data plane cluster scale ups without creating any cluster").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/dataplane_consolidation_plan
+	adminClusterConsolidationHandler := handlers.NewAdminClusterConsolidationHandler(s.ClusterConsolidationService, s.DataplaneClusterConfig)
+	adminRouter.HandleFunc("/dataplane_consolidation_plan", adminClusterConsolidationHandler.Get).
+		Name(logger.NewLogEvent("admin-get-dataplane-consolidation-plan", "[admin] plan the kafka moves emptying the underutilised data plane clusters without moving any kafka").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/kafkas/{id}/move and /api/kafkas_mgmt/v1/admin/clusters/{id}/drain
+	adminKafkaMigrationHandler := handlers.NewAdminKafkaMigrationHandler(s.KafkaMigrationService, s.AccountService)
+	adminRouter.HandleFunc("/kafkas/{id}/move", adminKafkaMigrationHandler.Move).
+		Name(logger.NewLogEvent("admin-move-kafka", "[admin] move kafka by id to another data plane cluster").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{id}/drain", adminKafkaMigrationHandler.Drain).
+		Name(logger.NewLogEvent("admin-drain-cluster", "[admin] move all the kafkas of a data plane cluster to the other clusters of its region").ToString()).
+		Methods(http.MethodPost)
+
+	// /api/kafkas_mgmt/v1/admin/clusters
+	adminDataPlaneClusterHandler := handlers.NewAdminDataPlaneClusterHandler(s.ClusterService, s.DataPlaneClusterAdminService)
+	adminRouter.HandleFunc("/clusters", adminDataPlaneClusterHandler.List).
+		Name(logger.NewLogEvent("admin-list-clusters", "[admin] list all data plane clusters").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/{id}", adminDataPlaneClusterHandler.Get).
+		Name(logger.NewLogEvent("admin-get-cluster", "[admin] get data plane cluster by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/{id}/deprovision", adminDataPlaneClusterHandler.Deprovision).
+		Name(logger.NewLogEvent("admin-deprovision-cluster", "[admin] force the deprovisioning of an empty data plane cluster").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{id}/retry", adminDataPlaneClusterHandler.Retry).
+		Name(logger.NewLogEvent("admin-retry-cluster", "[admin] replace a failed data plane cluster with a new one").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{id}/cordon", adminDataPlaneClusterHandler.Cordon).
+		Name(logger.NewLogEvent("admin-cordon-cluster", "[admin] stop placing new kafkas on a data plane cluster").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{id}/uncordon", adminDataPlaneClusterHandler.Uncordon).
+		Name(logger.NewLogEvent("admin-uncordon-cluster", "[admin] place new kafkas on a data plane cluster again").ToString()).
+		Methods(http.MethodPost)
+
+	// /api/kafkas_mgmt/v1/admin/upgrade_campaigns
+	adminUpgradeCampaignHandler := handlers.NewAdminKafkaUpgradeCampaignHandler(s.KafkaUpgradeCampaignService)
+	adminRouter.HandleFunc("/upgrade_campaigns", adminUpgradeCampaignHandler.Create).
+		Name(logger.NewLogEvent("admin-create-upgrade-campaign", "[admin] create kafka upgrade campaign").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/upgrade_campaigns", adminUpgradeCampaignHandler.List).
+		Name(logger.NewLogEvent("admin-list-upgrade-campaigns", "[admin] list all kafka upgrade campaigns").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/upgrade_campaigns/{id}", adminUpgradeCampaignHandler.Get).
+		Name(logger.NewLogEvent("admin-get-upgrade-campaign", "[admin] get kafka upgrade campaign by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/upgrade_campaigns/{id}", adminUpgradeCampaignHandler.Update).
+		Name(logger.NewLogEvent("admin-update-upgrade-campaign", "[admin] pause or resume kafka upgrade campaign by id").ToString()).
+		Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/upgrade_campaigns/{id}/kafkas", adminUpgradeCampaignHandler.ListKafkas).
+		Name(logger.NewLogEvent("admin-list-upgrade-campaign-kafkas", "[admin] list the kafkas of a kafka upgrade campaign").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/kafka_routes
+	adminKafkaRoutesHandler := handlers.NewAdminKafkaRoutesHandler(s.KafkaRoutesExportService)
+	adminRouter.HandleFunc("/kafka_routes/zone", adminKafkaRoutesHandler.GetZoneFile).
+		Name(logger.NewLogEvent("admin-export-kafka-routes-zone", "[admin] export the kafka routes as a zone file").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafka_routes/hosts", adminKafkaRoutesHandler.GetHostsFile).
+		Name(logger.NewLogEvent("admin-export-kafka-routes-hosts", "[admin] export the kafka routes as a hosts file").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/audit_events
+	adminAuditEventHandler := handlers.NewAdminAuditEventHandler(s.AuditEventService)
+	adminRouter.HandleFunc("/audit_events", adminAuditEventHandler.List).
+		Name(logger.NewLogEvent("admin-list-audit-events", "[admin] list the recorded audit events").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/access_control_list_entries
+	adminAccessControlListEntryHandler := handlers.NewAdminAccessControlListEntryHandler(s.AccessControlListEntryService)
+	adminRouter.HandleFunc("/access_control_list_entries", adminAccessControlListEntryHandler.List).
+		Name(logger.NewLogEvent("admin-list-access-control-list-entries", "[admin] list the entries of the deny list and of the access list").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/access_control_list_entries", adminAccessControlListEntryHandler.Create).
+		Name(logger.NewLogEvent("admin-create-access-control-list-entry", "[admin] add an entry to the deny list or to the access list").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/access_control_list_entries/{id}", adminAccessControlListEntryHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-access-control-list-entry", "[admin] remove an entry from the deny list or from the access list").ToString()).
+		Methods(http.MethodDelete)
+
+	// /api/kafkas_mgmt/v1/admin/quota_management_list_entries
+	adminQuotaManagementListEntryHandler := handlers.NewAdminQuotaManagementListEntryHandler(s.QuotaManagementListEntryService)
+	adminRouter.HandleFunc("/quota_management_list_entries", adminQuotaManagementListEntryHandler.List).
+		Name(logger.NewLogEvent("admin-list-quota-management-list-entries", "[admin] list the entries of the quota management list").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/quota_management_list_entries", adminQuotaManagementListEntryHandler.Create).
+		Name(logger.NewLogEvent("admin-create-quota-management-list-entry", "[admin] add an organisation or a service account to the quota management list").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/quota_management_list_entries/{id}", adminQuotaManagementListEntryHandler.Get).
+		Name(logger.NewLogEvent("admin-get-quota-management-list-entry", "[admin] get an entry of the quota management list").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/quota_management_list_entries/{id}", adminQuotaManagementListEntryHandler.Update).
+		Name(logger.NewLogEvent("admin-update-quota-management-list-entry", "[admin] replace the definition of an entry of the quota management list").ToString()).
+		Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/quota_management_list_entries/{id}", adminQuotaManagementListEntryHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-quota-management-list-entry", "[admin] remove an entry from the quota management list").ToString()).
+		Methods(http.MethodDelete)
+
+	// /api/kafkas_mgmt/v1/admin/authz/who_can
+	adminAuthzHandler := handlers.NewAdminAuthzHandler(s.AdminRoleAuthZConfig, adminRouter)
+	adminRouter.HandleFunc("/authz/who_can", adminAuthzHandler.WhoCan).
+		Name(logger.NewLogEvent("admin-authz-who-can", "[admin] list the roles allowed to send requests to an admin route").ToString()).
+		Methods(http.MethodGet)
+
 	// /api/kafkas_mgmt/v1
 	v1Metadata := api.VersionMetadata{
 		ID:          "v1",
@@ -0,0 +1,386 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+// ClusterConsolidationMove is the planned migration of a kafka of a consolidated cluster to another cluster of its region
+type ClusterConsolidationMove struct {
+	Kafka           *dbapi.KafkaRequest
+	TargetClusterID string
+}
+
+// ClusterConsolidation is the consolidation plan of an underutilised data plane cluster
+type ClusterConsolidation struct {
+	ClusterID              string
+	CloudProvider          string
+	Region                 string
+	ConsumedStreamingUnits int
+	MaxStreamingUnits      int
+	// Moves are the migrations emptying the cluster. It is empty when the cluster cannot be emptied
+	Moves []ClusterConsolidationMove
+	// Reason describes why the cluster cannot be emptied, or is empty if all its kafkas can be moved
+	Reason string
+}
+
+func (c ClusterConsolidation) CanBeEmptied() bool {
+	return c.Reason == ""
+}
+
+//go:generate moq -out cluster_consolidation_moq.go . ClusterConsolidationService
+type ClusterConsolidationService interface {
+	// Plan finds the underutilised ready managed data plane clusters and, for each of them, the migrations moving all
+	// their kafkas to the other clusters of their region without triggering a scale up. The least loaded clusters are
+	// planned first and the clusters receiving kafkas of a planned cluster are not emptied themselves.
+	// The capacity of a cluster is the MaxUnits stored in DynamicCapacityInfo, so no cluster is planned unless the
+	// data plane auto scaling is enabled.
+	Plan() ([]ClusterConsolidation, *errors.ServiceError)
+}
+
+var _ ClusterConsolidationService = &clusterConsolidationService{}
+
+type clusterConsolidationService struct {
+	connectionFactory      *db.ConnectionFactory
+	clusterService         ClusterService
+	providerConfig         *config.ProviderConfig
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	kafkaConfig            *config.KafkaConfig
+}
+
+func NewClusterConsolidationService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, providerConfig *config.ProviderConfig,
+	dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) *clusterConsolidationService {
+	return &clusterConsolidationService{
+		connectionFactory:      connectionFactory,
+		clusterService:         clusterService,
+		providerConfig:         providerConfig,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		kafkaConfig:            kafkaConfig,
+	}
+}
+
+// consolidationClusterCapacity is the streaming units capacity of a cluster per instance type
+type consolidationClusterCapacity struct {
+	cluster                *api.Cluster
+	consumedStreamingUnits map[string]int
+	maxStreamingUnits      map[string]int
+}
+
+func (c *consolidationClusterCapacity) total() (consumed int, max int) {
+	for instanceType, maxStreamingUnits := range c.maxStreamingUnits {
+		consumed += c.consumedStreamingUnits[instanceType]
+		max += maxStreamingUnits
+	}
+	return consumed, max
+}
+
+// consolidationPlanner holds the state shared by the consolidation plans of the clusters
+type consolidationPlanner struct {
+	capacities []*consolidationClusterCapacity
+	// migratingClusterIDs are the clusters that are the source or the target of a migration in progress
+	migratingClusterIDs map[string]bool
+	// emptiedClusterIDs are the clusters whose kafkas are planned to be moved
+	emptiedClusterIDs map[string]bool
+	// receivingClusterIDs are the clusters planned to receive the kafkas of an emptied cluster
+	receivingClusterIDs map[string]bool
+}
+
+func (s *clusterConsolidationService) Plan() ([]ClusterConsolidation, *errors.ServiceError) {
+	consolidations := []ClusterConsolidation{}
+	if !s.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
+		return consolidations, nil
+	}
+
+	planner, err := s.newConsolidationPlanner()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := planner.findUnderutilisedClusters(s.dataplaneClusterConfig.GetDynamicScalingConfig().Consolidation.MaxUtilisationPercentage)
+	if len(candidates) == 0 {
+		return consolidations, nil
+	}
+
+	candidateClusterIDs := arrays.Map(candidates, func(c *consolidationClusterCapacity) string { return c.cluster.ClusterID })
+	var kafkas []*dbapi.KafkaRequest
+	if err := s.connectionFactory.New().
+		Where("cluster_id in (?) AND status not in (?)", candidateClusterIDs, kafkaStatusesThatNoLongerConsumeResourcesInTheDataPlane).
+		Order("created_at").
+		Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the kafkas of the underutilised clusters")
+	}
+	kafkasPerCluster := map[string][]*dbapi.KafkaRequest{}
+	for _, kafka := range kafkas {
+		kafkasPerCluster[kafka.ClusterID] = append(kafkasPerCluster[kafka.ClusterID], kafka)
+	}
+
+	for _, candidate := range candidates {
+		consolidation, planErr := s.planClusterConsolidation(planner, candidate, kafkasPerCluster[candidate.cluster.ClusterID])
+		if planErr != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, planErr, "failed to plan the consolidation of cluster %q", candidate.cluster.ClusterID)
+		}
+		consolidations = append(consolidations, consolidation)
+	}
+
+	return consolidations, nil
+}
+
+// newConsolidationPlanner loads the capacity of the ready managed clusters. The kafkas being provisioned on the target
+// cluster of their migration are accounted on the target cluster as they are only assigned to it once their routes are switched.
+func (s *clusterConsolidationService) newConsolidationPlanner() (*consolidationPlanner, *errors.ServiceError) {
+	clusters, err := s.clusterService.FindAllClusters(FindClusterCriteria{Status: api.ClusterReady})
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the ready clusters")
+	}
+	streamingUnitCounts, err := s.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get the count of streaming units by cluster and instance type")
+	}
+
+	planner := &consolidationPlanner{
+		migratingClusterIDs: map[string]bool{},
+		emptiedClusterIDs:   map[string]bool{},
+		receivingClusterIDs: map[string]bool{},
+	}
+	capacitiesByClusterID := map[string]*consolidationClusterCapacity{}
+	for _, cluster := range clusters {
+		if cluster.ClusterType != api.ManagedDataPlaneClusterType.String() {
+			continue
+		}
+		capacity := &consolidationClusterCapacity{
+			cluster:                cluster,
+			consumedStreamingUnits: map[string]int{},
+			maxStreamingUnits:      map[string]int{},
+		}
+		for instanceType, capacityInfo := range cluster.RetrieveDynamicCapacityInfo() {
+			capacity.maxStreamingUnits[instanceType] = int(capacityInfo.MaxUnits)
+			capacity.consumedStreamingUnits[instanceType] = streamingUnitCounts.GetStreamingUnitCountForClusterAndInstanceType(cluster.ClusterID, instanceType)
+		}
+		planner.capacities = append(planner.capacities, capacity)
+		capacitiesByClusterID[cluster.ClusterID] = capacity
+	}
+
+	migrationStatuses := []string{
+		dbapi.KafkaMigrationStatusProvisioning.String(), dbapi.KafkaMigrationStatusTargetReady.String(),
+		dbapi.KafkaMigrationStatusDeprovisioningSource.String(), dbapi.KafkaMigrationStatusDeprovisioningTarget.String(),
+	}
+	var migratingKafkas []*dbapi.KafkaRequest
+	if err := s.connectionFactory.New().Where("migration_status in (?)", migrationStatuses).Find(&migratingKafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the migrating kafkas")
+	}
+	for _, kafka := range migratingKafkas {
+		planner.migratingClusterIDs[kafka.MigrationSourceClusterID] = true
+		planner.migratingClusterIDs[kafka.MigrationTargetClusterID] = true
+		if kafka.MigrationStatus != dbapi.KafkaMigrationStatusProvisioning && kafka.MigrationStatus != dbapi.KafkaMigrationStatusTargetReady {
+			continue
+		}
+		target, ok := capacitiesByClusterID[kafka.MigrationTargetClusterID]
+		if !ok {
+			continue
+		}
+		instanceSize, sizeErr := s.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+		if sizeErr != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, sizeErr, "failed to get the size of kafka %q", kafka.ID)
+		}
+		target.consumedStreamingUnits[kafka.InstanceType] += instanceSize.CapacityConsumed
+	}
+
+	return planner, nil
+}
+
+// findUnderutilisedClusters returns the non empty clusters whose consumed streaming units are at most the given
+// percentage of their capacity, the least loaded first
+func (p *consolidationPlanner) findUnderutilisedClusters(maxUtilisationPercentage int) []*consolidationClusterCapacity {
+	candidates := arrays.Filter(p.capacities, func(c *consolidationClusterCapacity) bool {
+		consumed, max := c.total()
+		return consumed > 0 && max > 0 && consumed*100 <= maxUtilisationPercentage*max
+	})
+	sort.SliceStable(candidates, func(i, j int) bool {
+		consumedI, _ := candidates[i].total()
+		consumedJ, _ := candidates[j].total()
+		return consumedI < consumedJ
+	})
+	return candidates
+}
+
+// planClusterConsolidation moves every kafka of the cluster to the most loaded cluster of its region able to host it, so that
+// the kafkas are packed on the fewest clusters. The moves are only planned if the region keeps enough capacity for each
+// instance type of the cluster once it is removed, i.e. if the dynamic scale down would remove the emptied cluster.
+func (s *clusterConsolidationService) planClusterConsolidation(planner *consolidationPlanner, candidate *consolidationClusterCapacity,
+	kafkas []*dbapi.KafkaRequest) (ClusterConsolidation, error) {
+	cluster := candidate.cluster
+	consumed, max := candidate.total()
+	consolidation := ClusterConsolidation{
+		ClusterID:              cluster.ClusterID,
+		CloudProvider:          cluster.CloudProvider,
+		Region:                 cluster.Region,
+		ConsumedStreamingUnits: consumed,
+		MaxStreamingUnits:      max,
+		Moves:                  []ClusterConsolidationMove{},
+	}
+
+	switch {
+	case cluster.Cordoned:
+		consolidation.Reason = "the cluster is cordoned"
+		return consolidation, nil
+	case planner.migratingClusterIDs[cluster.ClusterID]:
+		consolidation.Reason = "kafkas are being migrated from or to the cluster"
+		return consolidation, nil
+	case planner.receivingClusterIDs[cluster.ClusterID]:
+		consolidation.Reason = "the cluster receives the kafkas of another consolidated cluster"
+		return consolidation, nil
+	}
+
+	// the streaming units planned to be moved to each cluster, per instance type
+	pendingStreamingUnits := map[string]map[string]int{}
+	moves := []ClusterConsolidationMove{}
+	for _, kafka := range kafkas {
+		if reason := getKafkaMigrationRefusal(kafka); reason != "" {
+			consolidation.Reason = fmt.Sprintf("kafka %q cannot be moved: %s", kafka.ID, reason)
+			return consolidation, nil
+		}
+		if kafka.DesiredBillingModelIsEnterprise() {
+			consolidation.Reason = fmt.Sprintf("kafka %q cannot be moved: enterprise kafkas can only be moved to a given cluster", kafka.ID)
+			return consolidation, nil
+		}
+		instanceSize, err := s.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+		if err != nil {
+			return consolidation, err
+		}
+
+		target, err := planner.findTargetCluster(candidate, kafka, instanceSize.CapacityConsumed, pendingStreamingUnits)
+		if err != nil {
+			return consolidation, err
+		}
+		if target == nil {
+			consolidation.Reason = fmt.Sprintf("no other cluster of the region can host kafka %q", kafka.ID)
+			return consolidation, nil
+		}
+
+		if pendingStreamingUnits[target.cluster.ClusterID] == nil {
+			pendingStreamingUnits[target.cluster.ClusterID] = map[string]int{}
+		}
+		pendingStreamingUnits[target.cluster.ClusterID][kafka.InstanceType] += instanceSize.CapacityConsumed
+		moves = append(moves, ClusterConsolidationMove{Kafka: kafka, TargetClusterID: target.cluster.ClusterID})
+	}
+
+	if reason, err := s.getScaleUpAfterRemovalReason(planner, candidate, pendingStreamingUnits); err != nil || reason != "" {
+		consolidation.Reason = reason
+		return consolidation, err
+	}
+
+	for _, capacity := range planner.capacities {
+		for instanceType, pending := range pendingStreamingUnits[capacity.cluster.ClusterID] {
+			capacity.consumedStreamingUnits[instanceType] += pending
+			planner.receivingClusterIDs[capacity.cluster.ClusterID] = true
+		}
+	}
+	planner.emptiedClusterIDs[cluster.ClusterID] = true
+	consolidation.Moves = moves
+	return consolidation, nil
+}
+
+// findTargetCluster returns the most loaded cluster of the region of the candidate, other than the emptied and the cordoned
+// ones, able to host the kafka once the streaming units planned to be moved to it are accounted for
+func (p *consolidationPlanner) findTargetCluster(candidate *consolidationClusterCapacity, kafka *dbapi.KafkaRequest, streamingUnits int,
+	pendingStreamingUnits map[string]map[string]int) (*consolidationClusterCapacity, error) {
+	var mostLoaded *consolidationClusterCapacity
+	mostLoadedConsumed := -1
+	for _, capacity := range p.capacities {
+		if !p.isInRegionOf(capacity, candidate) || capacity.cluster.Cordoned || capacity.cluster.MultiAZ != kafka.MultiAZ {
+			continue
+		}
+		consumed := capacity.consumedStreamingUnits[kafka.InstanceType] + pendingStreamingUnits[capacity.cluster.ClusterID][kafka.InstanceType]
+		if capacity.maxStreamingUnits[kafka.InstanceType]-consumed < streamingUnits {
+			continue
+		}
+		if available, err := isStrimziVersionAvailable(capacity.cluster, kafka.DesiredStrimziVersion); err != nil {
+			return nil, err
+		} else if !available {
+			continue
+		}
+		if consumed > mostLoadedConsumed {
+			mostLoaded = capacity
+			mostLoadedConsumed = consumed
+		}
+	}
+	return mostLoaded, nil
+}
+
+// getScaleUpAfterRemovalReason returns why the dynamic scale up would be triggered once the candidate is removed, or an
+// empty string if it would not. A scale up is triggered for an instance type when the biggest instance size does not fit
+// in any cluster of the region or when the free streaming units of the region are below the configured capacity slack.
+// The free streaming units of the cordoned clusters are not counted as they don't accept new kafkas.
+func (s *clusterConsolidationService) getScaleUpAfterRemovalReason(planner *consolidationPlanner, candidate *consolidationClusterCapacity,
+	pendingStreamingUnits map[string]map[string]int) (string, error) {
+	regionInstanceTypes := s.findRegionInstanceTypeConfiguration(candidate.cluster.CloudProvider, candidate.cluster.Region)
+
+	instanceTypes := make([]string, 0, len(candidate.maxStreamingUnits))
+	for instanceType := range candidate.maxStreamingUnits {
+		instanceTypes = append(instanceTypes, instanceType)
+	}
+	sort.Strings(instanceTypes)
+
+	for _, instanceType := range instanceTypes {
+		instanceTypeConfig, ok := regionInstanceTypes[instanceType]
+		if !ok {
+			continue
+		}
+		kafkaInstanceType, err := s.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
+		if err != nil {
+			return "", err
+		}
+		biggestInstanceSize := kafkaInstanceType.GetBiggestCapacityConsumedSize()
+
+		freeStreamingUnits := 0
+		biggestInstanceSizeFits := false
+		for _, capacity := range planner.capacities {
+			if !planner.isInRegionOf(capacity, candidate) || capacity.cluster.Cordoned {
+				continue
+			}
+			free := capacity.maxStreamingUnits[instanceType] - capacity.consumedStreamingUnits[instanceType] -
+				pendingStreamingUnits[capacity.cluster.ClusterID][instanceType]
+			freeStreamingUnits += free
+			if biggestInstanceSize != nil && free >= biggestInstanceSize.CapacityConsumed {
+				biggestInstanceSizeFits = true
+			}
+		}
+
+		if !biggestInstanceSizeFits {
+			return fmt.Sprintf("the biggest size of instance type %q would not fit in any cluster of the region once the cluster is removed", instanceType), nil
+		}
+		if freeStreamingUnits < instanceTypeConfig.MinAvailableCapacitySlackStreamingUnits {
+			return fmt.Sprintf("the region would have %d free streaming units of instance type %q once the cluster is removed, less than the capacity slack of %d",
+				freeStreamingUnits, instanceType, instanceTypeConfig.MinAvailableCapacitySlackStreamingUnits), nil
+		}
+	}
+
+	return "", nil
+}
+
+// isInRegionOf returns whether the cluster is another cluster of the region of the candidate that is not emptied
+func (p *consolidationPlanner) isInRegionOf(capacity *consolidationClusterCapacity, candidate *consolidationClusterCapacity) bool {
+	return capacity.cluster.ClusterID != candidate.cluster.ClusterID &&
+		!p.emptiedClusterIDs[capacity.cluster.ClusterID] &&
+		capacity.cluster.CloudProvider == candidate.cluster.CloudProvider &&
+		capacity.cluster.Region == candidate.cluster.Region
+}
+
+func (s *clusterConsolidationService) findRegionInstanceTypeConfiguration(cloudProvider string, region string) config.InstanceTypeMap {
+	provider, ok := s.providerConfig.ProvidersConfig.SupportedProviders.GetByName(cloudProvider)
+	if !ok {
+		return nil
+	}
+	providerRegion, ok := provider.Regions.GetByName(region)
+	if !ok {
+		return nil
+	}
+	return providerRegion.SupportedInstanceTypes
+}
@@ -0,0 +1,68 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that ClusterConsolidationServiceMock does implement ClusterConsolidationService.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterConsolidationService = &ClusterConsolidationServiceMock{}
+
+// ClusterConsolidationServiceMock is a mock implementation of ClusterConsolidationService.
+//
+//	func TestSomethingThatUsesClusterConsolidationService(t *testing.T) {
+//
+//		// make and configure a mocked ClusterConsolidationService
+//		mockedClusterConsolidationService := &ClusterConsolidationServiceMock{
+//			PlanFunc: func() ([]ClusterConsolidation, *serviceError.ServiceError) {
+//				panic("mock out the Plan method")
+//			},
+//		}
+//
+//		// use mockedClusterConsolidationService in code that requires ClusterConsolidationService
+//		// and then make assertions.
+//
+//	}
+type ClusterConsolidationServiceMock struct {
+	// PlanFunc mocks the Plan method.
+	PlanFunc func() ([]ClusterConsolidation, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Plan holds details about calls to the Plan method.
+		Plan []struct {
+		}
+	}
+	lockPlan sync.RWMutex
+}
+
+// Plan calls PlanFunc.
+func (mock *ClusterConsolidationServiceMock) Plan() ([]ClusterConsolidation, *serviceError.ServiceError) {
+	if mock.PlanFunc == nil {
+		panic("ClusterConsolidationServiceMock.PlanFunc: method is nil but ClusterConsolidationService.Plan was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockPlan.Lock()
+	mock.calls.Plan = append(mock.calls.Plan, callInfo)
+	mock.lockPlan.Unlock()
+	return mock.PlanFunc()
+}
+
+// PlanCalls gets all the calls that were made to Plan.
+// Check the length with:
+//
+//	len(mockedClusterConsolidationService.PlanCalls())
+func (mock *ClusterConsolidationServiceMock) PlanCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockPlan.RLock()
+	calls = mock.calls.Plan
+	mock.lockPlan.RUnlock()
+	return calls
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_clusterConsolidationService_Plan(t *testing.T) {
+	providerConfig := &config.ProviderConfig{
+		ProvidersConfig: config.ProviderConfiguration{
+			SupportedProviders: config.ProviderList{
+				{
+					Name: "aws",
+					Regions: config.RegionList{
+						{Name: "us-east-1", SupportedInstanceTypes: config.InstanceTypeMap{"standard": {MinAvailableCapacitySlackStreamingUnits: 2}}},
+					},
+				},
+			},
+		},
+	}
+	kafkaConfig := &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{Id: "standard", Sizes: []config.KafkaInstanceSize{{Id: "x1", CapacityConsumed: 1}, {Id: "x2", CapacityConsumed: 2}}},
+				},
+			},
+		},
+	}
+	newCluster := func(clusterID string) *api.Cluster {
+		return &api.Cluster{
+			ClusterID:             clusterID,
+			CloudProvider:         "aws",
+			Region:                "us-east-1",
+			MultiAZ:               true,
+			Status:                api.ClusterReady,
+			ClusterType:           api.ManagedDataPlaneClusterType.String(),
+			SupportedInstanceType: "standard",
+			DynamicCapacityInfo:   api.JSON([]byte(`{"standard":{"max_nodes":3,"max_units":10,"remaining_units":10}}`)),
+		}
+	}
+	newKafka := func(id string, clusterID string, sizeID string) map[string]interface{} {
+		return map[string]interface{}{
+			"id": id, "cluster_id": clusterID, "cloud_provider": "aws", "region": "us-east-1", "multi_az": true,
+			"instance_type": "standard", "size_id": sizeID, "status": constants.KafkaRequestStatusReady.String(),
+		}
+	}
+
+	tests := []struct {
+		name              string
+		scalingType       string
+		migratingKafkas   []map[string]interface{}
+		cordonedClusterID string
+		want              []ClusterConsolidation
+	}{
+		{
+			name:        "should not plan any consolidation when the data plane auto scaling is disabled",
+			scalingType: config.ManualScaling,
+			want:        []ClusterConsolidation{},
+		},
+		{
+			name:        "should move the kafkas of the least loaded clusters to the most loaded cluster of the region",
+			scalingType: config.AutoScaling,
+			want: []ClusterConsolidation{
+				{ClusterID: "cluster-1", CloudProvider: "aws", Region: "us-east-1", ConsumedStreamingUnits: 1, MaxStreamingUnits: 10,
+					Moves: []ClusterConsolidationMove{{TargetClusterID: "cluster-2"}}},
+				{ClusterID: "cluster-3", CloudProvider: "aws", Region: "us-east-1", ConsumedStreamingUnits: 2, MaxStreamingUnits: 10,
+					Moves:  []ClusterConsolidationMove{},
+					Reason: `the biggest size of instance type "standard" would not fit in any cluster of the region once the cluster is removed`},
+			},
+		},
+		{
+			name:        "should not move the kafkas of a cluster with a migration in progress",
+			scalingType: config.AutoScaling,
+			migratingKafkas: []map[string]interface{}{
+				{"id": "migrating", "migration_status": "provisioning", "migration_source_cluster_id": "cluster-1", "migration_target_cluster_id": "cluster-4",
+					"instance_type": "standard", "size_id": "x1"},
+			},
+			want: []ClusterConsolidation{
+				{ClusterID: "cluster-1", CloudProvider: "aws", Region: "us-east-1", ConsumedStreamingUnits: 1, MaxStreamingUnits: 10,
+					Moves: []ClusterConsolidationMove{}, Reason: "kafkas are being migrated from or to the cluster"},
+				{ClusterID: "cluster-3", CloudProvider: "aws", Region: "us-east-1", ConsumedStreamingUnits: 2, MaxStreamingUnits: 10,
+					Moves: []ClusterConsolidationMove{{TargetClusterID: "cluster-2"}}},
+			},
+		},
+		{
+			name:              "should not move the kafkas to a cordoned cluster",
+			scalingType:       config.AutoScaling,
+			cordonedClusterID: "cluster-2",
+			want: []ClusterConsolidation{
+				{ClusterID: "cluster-1", CloudProvider: "aws", Region: "us-east-1", ConsumedStreamingUnits: 1, MaxStreamingUnits: 10,
+					Moves: []ClusterConsolidationMove{{TargetClusterID: "cluster-3"}}},
+				{ClusterID: "cluster-3", CloudProvider: "aws", Region: "us-east-1", ConsumedStreamingUnits: 3, MaxStreamingUnits: 10,
+					Moves: []ClusterConsolidationMove{}, Reason: "the cluster receives the kafkas of another consolidated cluster"},
+			},
+		},
+		{
+			name:              "should not move the kafkas of a cordoned cluster",
+			scalingType:       config.AutoScaling,
+			cordonedClusterID: "cluster-3",
+			want: []ClusterConsolidation{
+				{ClusterID: "cluster-1", CloudProvider: "aws", Region: "us-east-1", ConsumedStreamingUnits: 1, MaxStreamingUnits: 10,
+					Moves: []ClusterConsolidationMove{{TargetClusterID: "cluster-2"}}},
+				{ClusterID: "cluster-3", CloudProvider: "aws", Region: "us-east-1", ConsumedStreamingUnits: 2, MaxStreamingUnits: 10,
+					Moves: []ClusterConsolidationMove{}, Reason: "the cluster is cordoned"},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`WHERE migration_status in`).WithReply(tt.migratingKafkas)
+			mocket.Catcher.NewMock().WithQuery(`WHERE (cluster_id in`).WithReply([]map[string]interface{}{
+				newKafka("kafka-1", "cluster-1", "x1"),
+				newKafka("kafka-3", "cluster-3", "x2"),
+			})
+
+			clusterService := &ClusterServiceMock{
+				FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+					g.Expect(criteria.Status).To(gomega.Equal(api.ClusterReady))
+					clusters := []*api.Cluster{newCluster("cluster-1"), newCluster("cluster-2"), newCluster("cluster-3")}
+					for _, cluster := range clusters {
+						cluster.Cordoned = cluster.ClusterID == tt.cordonedClusterID
+					}
+					return clusters, nil
+				},
+				FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+					return KafkaStreamingUnitCountPerClusterList{
+						{ClusterId: "cluster-1", InstanceType: "standard", Count: 1, MaxUnits: 10},
+						{ClusterId: "cluster-2", InstanceType: "standard", Count: 6, MaxUnits: 10},
+						{ClusterId: "cluster-3", InstanceType: "standard", Count: 2, MaxUnits: 10},
+					}, nil
+				},
+			}
+			dataplaneClusterConfig := &config.DataplaneClusterConfig{
+				DataPlaneClusterScalingType: tt.scalingType,
+				DynamicScalingConfig:        config.NewDynamicScalingConfig(),
+			}
+
+			s := NewClusterConsolidationService(db.NewMockConnectionFactory(nil), clusterService, providerConfig, dataplaneClusterConfig, kafkaConfig)
+			consolidations, err := s.Plan()
+			g.Expect(err).To(gomega.BeNil())
+			// the kafkas of the moves are only checked to be on the consolidated cluster
+			for i := range consolidations {
+				for j := range consolidations[i].Moves {
+					g.Expect(consolidations[i].Moves[j].Kafka.ClusterID).To(gomega.Equal(consolidations[i].ClusterID))
+					consolidations[i].Moves[j].Kafka = nil
+				}
+			}
+			g.Expect(consolidations).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+//go:generate moq -out cluster_creation_retry_moq.go . ClusterCreationRetryService
+type ClusterCreationRetryService interface {
+	// RecordFailure records the failed creation of the cluster, if it is not recorded yet, and returns the attempt.
+	// The attempt number follows the attempt of the cluster replaced by the failed cluster, if any
+	RecordFailure(cluster *api.Cluster) (*dbapi.ClusterCreationAttempt, *apiErrors.ServiceError)
+	// Retry replaces the failed cluster: the failed cluster is moved to deprovisioning so that it is deleted from its
+	// provider and a new cluster with the same attributes is accepted for the ClusterManager to create.
+	// The new cluster is returned
+	Retry(cluster *api.Cluster) (*api.Cluster, *apiErrors.ServiceError)
+	// GiveUp moves the failed cluster to deprovisioning without replacing it, so that it is deleted from its provider
+	// and cleaned up
+	GiveUp(cluster *api.Cluster) *apiErrors.ServiceError
+}
+
+var _ ClusterCreationRetryService = &clusterCreationRetryService{}
+
+type clusterCreationRetryService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewClusterCreationRetryService(connectionFactory *db.ConnectionFactory) *clusterCreationRetryService {
+	return &clusterCreationRetryService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *clusterCreationRetryService) RecordFailure(cluster *api.Cluster) (*dbapi.ClusterCreationAttempt, *apiErrors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+
+	var attempt dbapi.ClusterCreationAttempt
+	err := dbConn.Where("failed_cluster_id = ?", cluster.ID).First(&attempt).Error
+	if err == nil {
+		return &attempt, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find the creation attempt of cluster %q", cluster.ID)
+	}
+
+	attempt = dbapi.ClusterCreationAttempt{
+		FailedClusterID: cluster.ID,
+		Attempt:         1,
+		Status:          dbapi.ClusterCreationAttemptWaitingForRetry,
+	}
+
+	var replacedAttempt dbapi.ClusterCreationAttempt
+	err = dbConn.Where("replacement_cluster_id = ?", cluster.ID).First(&replacedAttempt).Error
+	switch {
+	case err == nil:
+		attempt.Attempt = replacedAttempt.Attempt + 1
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find the creation attempt replaced by cluster %q", cluster.ID)
+	}
+
+	if err := dbConn.Create(&attempt).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to record the creation attempt of cluster %q", cluster.ID)
+	}
+	metrics.IncreaseClusterCreationRetryCountMetric(cluster.CloudProvider, cluster.Region, metrics.ClusterCreationRetryStepFailed)
+
+	return &attempt, nil
+}
+
+func (s *clusterCreationRetryService) Retry(cluster *api.Cluster) (*api.Cluster, *apiErrors.ServiceError) {
+	attempt, serviceErr := s.RecordFailure(cluster)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	newCluster := &api.Cluster{
+		CloudProvider:                 cluster.CloudProvider,
+		Region:                        cluster.Region,
+		MultiAZ:                       cluster.MultiAZ,
+		SupportedInstanceType:         cluster.SupportedInstanceType,
+		ProviderType:                  cluster.ProviderType,
+		ClusterType:                   cluster.ClusterType,
+		AccessKafkasViaPrivateNetwork: cluster.AccessKafkasViaPrivateNetwork,
+		Status:                        api.ClusterAccepted,
+	}
+
+	err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := deprovisionFailedCluster(tx, cluster); err != nil {
+			return err
+		}
+		if err := tx.Create(newCluster).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		return tx.Model(attempt).Updates(&dbapi.ClusterCreationAttempt{
+			Status:               dbapi.ClusterCreationAttemptRetried,
+			ReplacementClusterID: newCluster.ID,
+			RetriedAt:            &now,
+		}).Error
+	})
+	if err != nil {
+		return nil, toClusterCreationRetryError(err, "failed to retry cluster %q", cluster.ID)
+	}
+	metrics.IncreaseClusterCreationRetryCountMetric(cluster.CloudProvider, cluster.Region, metrics.ClusterCreationRetryStepRetried)
+
+	return newCluster, nil
+}
+
+func (s *clusterCreationRetryService) GiveUp(cluster *api.Cluster) *apiErrors.ServiceError {
+	attempt, serviceErr := s.RecordFailure(cluster)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := deprovisionFailedCluster(tx, cluster); err != nil {
+			return err
+		}
+		return tx.Model(attempt).Update("status", dbapi.ClusterCreationAttemptExhausted).Error
+	})
+	if err != nil {
+		return toClusterCreationRetryError(err, "failed to deprovision failed cluster %q", cluster.ID)
+	}
+	metrics.IncreaseClusterCreationRetryCountMetric(cluster.CloudProvider, cluster.Region, metrics.ClusterCreationRetryStepExhausted)
+
+	return nil
+}
+
+// deprovisionFailedCluster moves the failed cluster to deprovisioning. The status is checked again so that a cluster
+// retried concurrently is only replaced once
+func deprovisionFailedCluster(tx *gorm.DB, cluster *api.Cluster) error {
+	update := tx.Model(&api.Cluster{}).
+		Where("id = ? AND status = ?", cluster.ID, api.ClusterFailed).
+		Update("status", api.ClusterDeprovisioning)
+	if update.Error != nil {
+		return update.Error
+	}
+	if update.RowsAffected == 0 {
+		return apiErrors.Conflict("cluster %q is no longer in %q status", cluster.ID, api.ClusterFailed)
+	}
+	return nil
+}
+
+func toClusterCreationRetryError(err error, reason string, values ...interface{}) *apiErrors.ServiceError {
+	var serviceErr *apiErrors.ServiceError
+	if errors.As(err, &serviceErr) {
+		return serviceErr
+	}
+	return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, reason, values...)
+}
@@ -0,0 +1,165 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that ClusterCreationRetryServiceMock does implement ClusterCreationRetryService.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterCreationRetryService = &ClusterCreationRetryServiceMock{}
+
+// ClusterCreationRetryServiceMock is a mock implementation of ClusterCreationRetryService.
+//
+//	func TestSomethingThatUsesClusterCreationRetryService(t *testing.T) {
+//
+//		// make and configure a mocked ClusterCreationRetryService
+//		mockedClusterCreationRetryService := &ClusterCreationRetryServiceMock{
+//			GiveUpFunc: func(cluster *api.Cluster) *serviceError.ServiceError {
+//				panic("mock out the GiveUp method")
+//			},
+//			RecordFailureFunc: func(cluster *api.Cluster) (*dbapi.ClusterCreationAttempt, *serviceError.ServiceError) {
+//				panic("mock out the RecordFailure method")
+//			},
+//			RetryFunc: func(cluster *api.Cluster) (*api.Cluster, *serviceError.ServiceError) {
+//				panic("mock out the Retry method")
+//			},
+//		}
+//
+//		// use mockedClusterCreationRetryService in code that requires ClusterCreationRetryService
+//		// and then make assertions.
+//
+//	}
+type ClusterCreationRetryServiceMock struct {
+	// GiveUpFunc mocks the GiveUp method.
+	GiveUpFunc func(cluster *api.Cluster) *serviceError.ServiceError
+
+	// RecordFailureFunc mocks the RecordFailure method.
+	RecordFailureFunc func(cluster *api.Cluster) (*dbapi.ClusterCreationAttempt, *serviceError.ServiceError)
+
+	// RetryFunc mocks the Retry method.
+	RetryFunc func(cluster *api.Cluster) (*api.Cluster, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GiveUp holds details about calls to the GiveUp method.
+		GiveUp []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
+		// RecordFailure holds details about calls to the RecordFailure method.
+		RecordFailure []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
+		// Retry holds details about calls to the Retry method.
+		Retry []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
+	}
+	lockGiveUp        sync.RWMutex
+	lockRecordFailure sync.RWMutex
+	lockRetry         sync.RWMutex
+}
+
+// GiveUp calls GiveUpFunc.
+func (mock *ClusterCreationRetryServiceMock) GiveUp(cluster *api.Cluster) *serviceError.ServiceError {
+	if mock.GiveUpFunc == nil {
+		panic("ClusterCreationRetryServiceMock.GiveUpFunc: method is nil but ClusterCreationRetryService.GiveUp was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockGiveUp.Lock()
+	mock.calls.GiveUp = append(mock.calls.GiveUp, callInfo)
+	mock.lockGiveUp.Unlock()
+	return mock.GiveUpFunc(cluster)
+}
+
+// GiveUpCalls gets all the calls that were made to GiveUp.
+// Check the length with:
+//
+//	len(mockedClusterCreationRetryService.GiveUpCalls())
+func (mock *ClusterCreationRetryServiceMock) GiveUpCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockGiveUp.RLock()
+	calls = mock.calls.GiveUp
+	mock.lockGiveUp.RUnlock()
+	return calls
+}
+
+// RecordFailure calls RecordFailureFunc.
+func (mock *ClusterCreationRetryServiceMock) RecordFailure(cluster *api.Cluster) (*dbapi.ClusterCreationAttempt, *serviceError.ServiceError) {
+	if mock.RecordFailureFunc == nil {
+		panic("ClusterCreationRetryServiceMock.RecordFailureFunc: method is nil but ClusterCreationRetryService.RecordFailure was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockRecordFailure.Lock()
+	mock.calls.RecordFailure = append(mock.calls.RecordFailure, callInfo)
+	mock.lockRecordFailure.Unlock()
+	return mock.RecordFailureFunc(cluster)
+}
+
+// RecordFailureCalls gets all the calls that were made to RecordFailure.
+// Check the length with:
+//
+//	len(mockedClusterCreationRetryService.RecordFailureCalls())
+func (mock *ClusterCreationRetryServiceMock) RecordFailureCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockRecordFailure.RLock()
+	calls = mock.calls.RecordFailure
+	mock.lockRecordFailure.RUnlock()
+	return calls
+}
+
+// Retry calls RetryFunc.
+func (mock *ClusterCreationRetryServiceMock) Retry(cluster *api.Cluster) (*api.Cluster, *serviceError.ServiceError) {
+	if mock.RetryFunc == nil {
+		panic("ClusterCreationRetryServiceMock.RetryFunc: method is nil but ClusterCreationRetryService.Retry was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockRetry.Lock()
+	mock.calls.Retry = append(mock.calls.Retry, callInfo)
+	mock.lockRetry.Unlock()
+	return mock.RetryFunc(cluster)
+}
+
+// RetryCalls gets all the calls that were made to Retry.
+// Check the length with:
+//
+//	len(mockedClusterCreationRetryService.RetryCalls())
+func (mock *ClusterCreationRetryServiceMock) RetryCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockRetry.RLock()
+	calls = mock.calls.Retry
+	mock.lockRetry.RUnlock()
+	return calls
+}
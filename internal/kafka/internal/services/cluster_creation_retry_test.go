@@ -0,0 +1,154 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+var failedCluster = &api.Cluster{Meta: api.Meta{ID: "id"}, ClusterID: "cluster-id", Status: api.ClusterFailed, CloudProvider: "aws",
+	Region: "us-east-1", MultiAZ: true, SupportedInstanceType: "standard", ProviderType: api.ClusterProviderOCM,
+	ClusterType: api.ManagedDataPlaneClusterType.String()}
+
+func Test_clusterCreationRetryService_RecordFailure(t *testing.T) {
+	tests := []struct {
+		name            string
+		recordedAttempt []map[string]interface{}
+		replacedAttempt []map[string]interface{}
+		wantAttempt     int
+		wantStatus      dbapi.ClusterCreationAttemptStatus
+		wantInsert      bool
+	}{
+		{
+			name:        "should record the first attempt of a cluster which is not a replacement",
+			wantAttempt: 1,
+			wantStatus:  dbapi.ClusterCreationAttemptWaitingForRetry,
+			wantInsert:  true,
+		},
+		{
+			name:            "should record the attempt following the attempt of the replaced cluster",
+			replacedAttempt: []map[string]interface{}{{"id": "attempt-1", "failed_cluster_id": "replaced-id", "attempt": 2, "status": "retried", "replacement_cluster_id": "id"}},
+			wantAttempt:     3,
+			wantStatus:      dbapi.ClusterCreationAttemptWaitingForRetry,
+			wantInsert:      true,
+		},
+		{
+			name:            "should return the attempt already recorded",
+			recordedAttempt: []map[string]interface{}{{"id": "attempt-2", "failed_cluster_id": "id", "attempt": 2, "status": "waiting_for_retry"}},
+			wantAttempt:     2,
+			wantStatus:      dbapi.ClusterCreationAttemptWaitingForRetry,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`WHERE failed_cluster_id = $1`).WithReply(tt.recordedAttempt)
+			mocket.Catcher.NewMock().WithQuery(`WHERE replacement_cluster_id = $1`).WithReply(tt.replacedAttempt)
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "cluster_creation_attempts"`)
+
+			s := NewClusterCreationRetryService(db.NewMockConnectionFactory(nil))
+			attempt, err := s.RecordFailure(failedCluster)
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(attempt.FailedClusterID).To(gomega.Equal(failedCluster.ID))
+			g.Expect(attempt.Attempt).To(gomega.Equal(tt.wantAttempt))
+			g.Expect(attempt.Status).To(gomega.Equal(tt.wantStatus))
+			g.Expect(insert.Triggered).To(gomega.Equal(tt.wantInsert))
+		})
+	}
+}
+
+func Test_clusterCreationRetryService_Retry(t *testing.T) {
+	tests := []struct {
+		name        string
+		rowsUpdated int64
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name:        "should accept a new cluster replacing the failed cluster",
+			rowsUpdated: 1,
+		},
+		{
+			name:        "should not retry a cluster that is no longer failed",
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`WHERE failed_cluster_id = $1`).WithReply([]map[string]interface{}{{"id": "attempt-1", "failed_cluster_id": "id", "attempt": 1, "status": "waiting_for_retry"}})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "clusters" SET "status"=$1`).WithRowsNum(tt.rowsUpdated)
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "clusters"`)
+			attemptUpdate := mocket.Catcher.NewMock().WithQuery(`UPDATE "cluster_creation_attempts" SET`)
+
+			s := NewClusterCreationRetryService(db.NewMockConnectionFactory(nil))
+			newCluster, err := s.Retry(failedCluster)
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+				g.Expect(insert.Triggered).To(gomega.BeFalse())
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(insert.Triggered).To(gomega.BeTrue())
+			g.Expect(attemptUpdate.Triggered).To(gomega.BeTrue())
+			g.Expect(newCluster.ID).ToNot(gomega.Equal(failedCluster.ID))
+			g.Expect(newCluster.Status).To(gomega.Equal(api.ClusterAccepted))
+			g.Expect(newCluster.ClusterID).To(gomega.BeEmpty())
+			g.Expect(newCluster.CloudProvider).To(gomega.Equal(failedCluster.CloudProvider))
+			g.Expect(newCluster.Region).To(gomega.Equal(failedCluster.Region))
+			g.Expect(newCluster.MultiAZ).To(gomega.Equal(failedCluster.MultiAZ))
+			g.Expect(newCluster.SupportedInstanceType).To(gomega.Equal(failedCluster.SupportedInstanceType))
+			g.Expect(newCluster.ProviderType).To(gomega.Equal(failedCluster.ProviderType))
+			g.Expect(newCluster.ClusterType).To(gomega.Equal(failedCluster.ClusterType))
+		})
+	}
+}
+
+func Test_clusterCreationRetryService_GiveUp(t *testing.T) {
+	tests := []struct {
+		name        string
+		rowsUpdated int64
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name:        "should deprovision the failed cluster",
+			rowsUpdated: 1,
+		},
+		{
+			name:        "should not deprovision a cluster that is no longer failed",
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`WHERE failed_cluster_id = $1`).WithReply([]map[string]interface{}{{"id": "attempt-4", "failed_cluster_id": "id", "attempt": 4, "status": "waiting_for_retry"}})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "clusters" SET "status"=$1`).WithRowsNum(tt.rowsUpdated)
+			attemptUpdate := mocket.Catcher.NewMock().WithQuery(`UPDATE "cluster_creation_attempts" SET "status"=$1,"updated_at"=$2 WHERE "id" = $3`)
+
+			s := NewClusterCreationRetryService(db.NewMockConnectionFactory(nil))
+			err := s.GiveUp(failedCluster)
+			g.Expect(attemptUpdate.Triggered).To(gomega.Equal(tt.wantErrCode == 0))
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+		})
+	}
+}
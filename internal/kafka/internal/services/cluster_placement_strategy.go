@@ -16,18 +16,41 @@ type ClusterPlacementStrategy interface {
 	FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error)
 }
 
-// NewClusterPlacementStrategy return a concrete strategy impl. depends on the placement configuration
+// NewClusterPlacementStrategy returns the strategy selecting the cluster of the kafkas. The concrete strategy impl. depends on
+// the placement configuration and is chosen on each FindCluster call, as the placement configuration can be reloaded.
 func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) ClusterPlacementStrategy {
-	var clusterSelection ClusterPlacementStrategy
-	switch {
-	case dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
-		clusterSelection = &FirstSchedulableWithinLimit{dataplaneClusterConfig, clusterService, kafkaConfig}
-	case dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
-		clusterSelection = &FirstReadyWithCapacity{clusterService, kafkaConfig}
+	return &configuredClusterPlacementStrategy{
+		clusterService:         clusterService,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		kafkaConfig:            kafkaConfig,
+	}
+}
+
+// configuredClusterPlacementStrategy delegates to the strategy impl. of the current placement configuration
+type configuredClusterPlacementStrategy struct {
+	clusterService         ClusterService
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	kafkaConfig            *config.KafkaConfig
+}
+
+func (c *configuredClusterPlacementStrategy) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+	return c.strategy().FindCluster(kafka)
+}
+
+// strategy returns the concrete strategy impl. depending on the current placement configuration
+func (c *configuredClusterPlacementStrategy) strategy() ClusterPlacementStrategy {
+	switch placementStrategy := c.dataplaneClusterConfig.GetClusterPlacementStrategy(); {
+	case placementStrategy == config.LeastLoadedPlacementStrategy:
+		return &LeastLoadedCluster{loadAwarePlacement{c.dataplaneClusterConfig, c.clusterService, c.kafkaConfig}}
+	case placementStrategy == config.BestFitPlacementStrategy:
+		return &BestFitCluster{loadAwarePlacement{c.dataplaneClusterConfig, c.clusterService, c.kafkaConfig}}
+	case c.dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
+		return &FirstSchedulableWithinLimit{c.dataplaneClusterConfig, c.clusterService, c.kafkaConfig}
+	case c.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
+		return &FirstReadyWithCapacity{c.clusterService, c.kafkaConfig}
 	default:
-		clusterSelection = &FirstReadyCluster{clusterService, kafkaConfig}
+		return &FirstReadyCluster{c.clusterService, c.kafkaConfig}
 	}
-	return clusterSelection
 }
 
 // findDataPlaneClusterByIdIfItHasCapacityAvailable finds and returns the desired data plane cluster if
@@ -42,7 +65,8 @@ type findDataPlaneClusterByIdIfItHasCapacityAvailable struct {
 // Once the cluster is found, it has to match the following rules:
 // 1. The cluster has to be in ready state.
 // 2. It also also has to be in the same organization as the kafka request.
-// 3. It must have remaining capacity to receive the Kafka.
+// 3. It must not be cordoned.
+// 4. It must have remaining capacity to receive the Kafka.
 // Capacity capacity is evaluated based on the MaxUnits stored in DynamicCapacityInfo and the actual used capacity.
 func (f *findDataPlaneClusterByIdIfItHasCapacityAvailable) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
 	cluster, err := f.clusterService.FindClusterByID(kafka.ClusterID)
@@ -58,6 +82,10 @@ func (f *findDataPlaneClusterByIdIfItHasCapacityAvailable) FindCluster(kafka *db
 		return nil, apiErrors.BadRequest("cluster with id: %s is not ready to accept kafkas", kafka.ClusterID)
 	}
 
+	if cluster.Cordoned {
+		return nil, apiErrors.BadRequest("cluster with id: %s is cordoned and does not accept new kafkas", kafka.ClusterID)
+	}
+
 	kafkaSizeConsumption, sizeErr := f.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
 	if sizeErr != nil {
 		return nil, sizeErr
@@ -104,6 +132,7 @@ func (f *FirstReadyCluster) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster
 		MultiAZ:               kafka.MultiAZ,
 		Status:                api.ClusterReady,
 		SupportedInstanceType: kafka.InstanceType,
+		ExcludeCordoned:       true,
 	}
 
 	cluster, err := f.ClusterService.FindCluster(criteria)
@@ -137,6 +166,7 @@ func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*a
 		MultiAZ:               kafka.MultiAZ,
 		Status:                api.ClusterReady,
 		SupportedInstanceType: kafka.InstanceType,
+		ExcludeCordoned:       true,
 	}
 
 	kafkaInstanceSize, e := f.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
@@ -167,7 +197,7 @@ func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*a
 	for _, clusterID := range clusterIDs {
 		currentStreamingUnitConsumption := consumedStreamingUnitPerClusterID[clusterID]
 		futureStreamingUnitConsumptionInTheCluster := currentStreamingUnitConsumption + kafkaInstanceSize.CapacityConsumed
-		numberOfKafkaIsWithinLimit := f.dataplaneClusterConfig.ClusterConfig.IsNumberOfStreamingUnitsWithinClusterLimit(clusterID, futureStreamingUnitConsumptionInTheCluster)
+		numberOfKafkaIsWithinLimit := f.dataplaneClusterConfig.GetClusterConfig().IsNumberOfStreamingUnitsWithinClusterLimit(clusterID, futureStreamingUnitConsumptionInTheCluster)
 		if numberOfKafkaIsWithinLimit {
 			return searchForClusterFromClustersList(clusters, clusterID), nil
 		}
@@ -185,7 +215,7 @@ func (f *FirstSchedulableWithinLimit) findClusterIDsOfManagedClustersThatAreSche
 			continue
 		}
 
-		isSchedulable := f.dataplaneClusterConfig.ClusterConfig.IsClusterSchedulable(cluster.ClusterID)
+		isSchedulable := f.dataplaneClusterConfig.GetClusterConfig().IsClusterSchedulable(cluster.ClusterID)
 		if isSchedulable {
 			clusterSchIds = append(clusterSchIds, cluster.ClusterID)
 		}
@@ -237,6 +267,7 @@ func (f *FirstReadyWithCapacity) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cl
 		MultiAZ:               kafka.MultiAZ,
 		Status:                api.ClusterReady,
 		SupportedInstanceType: kafka.InstanceType,
+		ExcludeCordoned:       true,
 	}
 
 	clusters, findAllClusterErr := f.clusterService.FindAllClusters(criteria)
@@ -276,3 +307,167 @@ func (f *FirstReadyWithCapacity) isManagedClusterNotFull(cluster *api.Cluster, s
 
 	return currentStreamingUnitsUsed+instanceSize.CapacityConsumed <= int(maxStreamingUnits)
 }
+
+// clusterLoad is the load of a cluster able to host a kafka
+type clusterLoad struct {
+	cluster *api.Cluster
+	// consumedStreamingUnits is the number of streaming units consumed on the cluster before placing the kafka
+	consumedStreamingUnits int
+	// remainingStreamingUnits is the number of streaming units remaining once the kafka is placed, or -1 when the cluster capacity is unlimited
+	remainingStreamingUnits int
+}
+
+// loadAwarePlacement holds the logic shared by the placement strategies comparing the load of all the clusters able to host a kafka.
+// The capacity of a cluster depends on the scaling type:
+// 1. With the manual scaling, the cluster has to be schedulable and its streaming unit limit is shared by all the instance types.
+// 2. With the auto scaling, the capacity of each instance type is the MaxUnits stored in DynamicCapacityInfo.
+// 3. Otherwise, the capacity is unlimited and the load counts the streaming units of all the instance types.
+type loadAwarePlacement struct {
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	clusterService         ClusterService
+	kafkaConfig            *config.KafkaConfig
+}
+
+// findCandidateClusters returns the load of the ready managed clusters matching the kafka criteria that have enough capacity to host it
+func (p *loadAwarePlacement) findCandidateClusters(kafka *dbapi.KafkaRequest) ([]clusterLoad, error) {
+	criteria := FindClusterCriteria{
+		Provider:              kafka.CloudProvider,
+		Region:                kafka.Region,
+		MultiAZ:               kafka.MultiAZ,
+		Status:                api.ClusterReady,
+		SupportedInstanceType: kafka.InstanceType,
+		ExcludeCordoned:       true,
+	}
+
+	clusters, err := p.clusterService.FindAllClusters(criteria)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find all clusters with criteria '%v'", criteria)
+	}
+	if len(clusters) == 0 {
+		return nil, nil
+	}
+
+	instanceSize, err := p.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get kafka instance size for cluster with criteria '%v'", criteria)
+	}
+
+	streamingUnitCountPerClusterList, err := p.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get count of streaming units by cluster and instance type for criteria '%v'", criteria)
+	}
+
+	candidates := []clusterLoad{}
+	for _, cluster := range clusters {
+		if cluster.ClusterType != api.ManagedDataPlaneClusterType.String() {
+			continue
+		}
+
+		load, canHostKafka := p.computeClusterLoad(cluster, kafka, instanceSize, streamingUnitCountPerClusterList)
+		if canHostKafka {
+			candidates = append(candidates, load)
+		}
+	}
+
+	return candidates, nil
+}
+
+func (p *loadAwarePlacement) computeClusterLoad(cluster *api.Cluster, kafka *dbapi.KafkaRequest, instanceSize *config.KafkaInstanceSize,
+	streamingUnitCountPerClusterList KafkaStreamingUnitCountPerClusterList) (clusterLoad, bool) {
+	load := clusterLoad{cluster: cluster, remainingStreamingUnits: -1}
+	switch {
+	case p.dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
+		if !p.dataplaneClusterConfig.GetClusterConfig().IsClusterSchedulable(cluster.ClusterID) {
+			return load, false
+		}
+		load.consumedStreamingUnits = streamingUnitCountPerClusterList.GetStreamingUnitCountForCluster(cluster.ClusterID)
+		if limit := p.dataplaneClusterConfig.GetClusterConfig().GetClusterStreamingUnitLimit(cluster.ClusterID); limit != -1 {
+			load.remainingStreamingUnits = limit - (load.consumedStreamingUnits + instanceSize.CapacityConsumed)
+			return load, load.remainingStreamingUnits >= 0
+		}
+	case p.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
+		load.consumedStreamingUnits = streamingUnitCountPerClusterList.GetStreamingUnitCountForClusterAndInstanceType(cluster.ClusterID, kafka.InstanceType)
+		maxStreamingUnits := cluster.RetrieveDynamicCapacityInfo()[kafka.InstanceType].MaxUnits
+		load.remainingStreamingUnits = int(maxStreamingUnits) - (load.consumedStreamingUnits + instanceSize.CapacityConsumed)
+		return load, load.remainingStreamingUnits >= 0
+	default:
+		load.consumedStreamingUnits = streamingUnitCountPerClusterList.GetStreamingUnitCountForCluster(cluster.ClusterID)
+	}
+
+	return load, true
+}
+
+// LeastLoadedCluster finds and returns the cluster with the fewest consumed streaming units among the clusters able to host the kafka.
+// This spreads the kafkas across the clusters. Ties are broken by keeping the first cluster.
+type LeastLoadedCluster struct {
+	loadAwarePlacement
+}
+
+func (l *LeastLoadedCluster) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+	if kafka.DesiredBillingModelIsEnterprise() {
+		enterpriseKafkaPlacementStrategy := findDataPlaneClusterByIdIfItHasCapacityAvailable{
+			clusterService: l.clusterService,
+			kafkaConfig:    l.kafkaConfig,
+		}
+		return enterpriseKafkaPlacementStrategy.FindCluster(kafka)
+	}
+
+	candidates, err := l.findCandidateClusters(kafka)
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+
+	leastLoaded := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if candidate.consumedStreamingUnits < leastLoaded.consumedStreamingUnits {
+			leastLoaded = candidate
+		}
+	}
+
+	return leastLoaded.cluster, nil
+}
+
+// BestFitCluster finds and returns the cluster with the fewest remaining streaming units once the kafka is placed, among the clusters able
+// to host the kafka. This packs the kafkas so that the other clusters can be emptied and scaled down. The clusters with an unlimited capacity
+// are only used when no cluster with a limited capacity can host the kafka, the most loaded one first. Ties are broken by keeping the first cluster.
+type BestFitCluster struct {
+	loadAwarePlacement
+}
+
+func (b *BestFitCluster) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+	if kafka.DesiredBillingModelIsEnterprise() {
+		enterpriseKafkaPlacementStrategy := findDataPlaneClusterByIdIfItHasCapacityAvailable{
+			clusterService: b.clusterService,
+			kafkaConfig:    b.kafkaConfig,
+		}
+		return enterpriseKafkaPlacementStrategy.FindCluster(kafka)
+	}
+
+	candidates, err := b.findCandidateClusters(kafka)
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+
+	bestFit := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if isTighterFit(candidate, bestFit) {
+			bestFit = candidate
+		}
+	}
+
+	return bestFit.cluster, nil
+}
+
+// isTighterFit returns whether the candidate leaves fewer streaming units unused than the current best fit
+func isTighterFit(candidate, bestFit clusterLoad) bool {
+	candidateUnlimited := candidate.remainingStreamingUnits == -1
+	bestFitUnlimited := bestFit.remainingStreamingUnits == -1
+	switch {
+	case candidateUnlimited && bestFitUnlimited:
+		return candidate.consumedStreamingUnits > bestFit.consumedStreamingUnits
+	case candidateUnlimited != bestFitUnlimited:
+		return bestFitUnlimited
+	default:
+		return candidate.remainingStreamingUnits < bestFit.remainingStreamingUnits
+	}
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
@@ -9,7 +11,9 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	mockkafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/goava/di"
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 )
@@ -309,8 +313,9 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 			},
 			want: nil,
 			wantErr: errors.Wrapf(errors.New("failed to find clusters"), fmt.Sprintf("failed to find all clusters with criteria '%v'", FindClusterCriteria{
-				MultiAZ: mockkafkas.BuildKafkaRequest().MultiAZ,
-				Status:  api.ClusterReady,
+				MultiAZ:         mockkafkas.BuildKafkaRequest().MultiAZ,
+				Status:          api.ClusterReady,
+				ExcludeCordoned: true,
 			})),
 		},
 		{
@@ -335,8 +340,9 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 			},
 			want: nil,
 			wantErr: errors.Wrapf(errors.New("failed to retrieve streaming unit count per region and instance type"), fmt.Sprintf("failed to get count of streaming units by cluster and instance type for criteria '%v'", FindClusterCriteria{
-				MultiAZ: mockkafkas.BuildKafkaRequest().MultiAZ,
-				Status:  api.ClusterReady,
+				MultiAZ:         mockkafkas.BuildKafkaRequest().MultiAZ,
+				Status:          api.ClusterReady,
+				ExcludeCordoned: true,
 			})),
 		},
 		{
@@ -375,6 +381,7 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 				MultiAZ:               mockkafkas.BuildKafkaRequest().MultiAZ,
 				Status:                api.ClusterReady,
 				SupportedInstanceType: "unsupported",
+				ExcludeCordoned:       true,
 			})),
 		},
 		{
@@ -631,6 +638,24 @@ func Test_findDesiredDataPlaneClusterIfItHasCapacityAvailable_FindCluster(t *tes
 			},
 			wantErr: true,
 		},
+		{
+			name: "return an error if cluster is cordoned",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{
+							OrganizationID: "some-org-id",
+							Status:         api.ClusterReady,
+							Cordoned:       true,
+						}, nil
+					},
+				},
+			},
+			args: args{
+				kafka: buildKafkaRequest(mockkafkas.With(mockkafkas.ORGANISATION_ID, "some-org-id")),
+			},
+			wantErr: true,
+		},
 		{
 			name: "return an error if computing used streaming unit for the given cluster fails",
 			fields: fields{
@@ -804,3 +829,361 @@ func Test_findDesiredDataPlaneClusterIfItHasCapacityAvailable_FindCluster(t *tes
 		})
 	}
 }
+
+func buildLoadAwarePlacementClusterService(clusters []*api.Cluster, streamingUnitCountPerClusterList KafkaStreamingUnitCountPerClusterList) *ClusterServiceMock {
+	return &ClusterServiceMock{
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+			return clusters, nil
+		},
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+			return streamingUnitCountPerClusterList, nil
+		},
+	}
+}
+
+func buildStreamingUnitCount(clusterID string, instanceType types.KafkaInstanceType, count int32) KafkaStreamingUnitCountPerCluster {
+	return KafkaStreamingUnitCountPerCluster{
+		ClusterId:    clusterID,
+		InstanceType: instanceType.String(),
+		Count:        count,
+	}
+}
+
+func TestLeastLoadedCluster_FindCluster(t *testing.T) {
+	managedClusters := []*api.Cluster{
+		{ClusterID: "cluster-1", ClusterType: api.ManagedDataPlaneClusterType.String(), DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_units":10}}`))},
+		{ClusterID: "cluster-2", ClusterType: api.ManagedDataPlaneClusterType.String(), DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_units":10}}`))},
+		{ClusterID: "cluster-3", ClusterType: api.ManagedDataPlaneClusterType.String(), DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_units":4}}`))},
+	}
+
+	type fields struct {
+		DataplaneClusterConfig *config.DataplaneClusterConfig
+		ClusterService         ClusterService
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    *api.Cluster
+		wantErr bool
+	}{
+		{
+			name: "should return an error if getting clusters that matches the given criteria fails",
+			fields: fields{
+				DataplaneClusterConfig: config.NewDataplaneClusterConfig(),
+				ClusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return nil, errors.New("failed to find clusters")
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "should return nil if no clusters matches the given criteria",
+			fields: fields{
+				DataplaneClusterConfig: config.NewDataplaneClusterConfig(),
+				ClusterService:         buildLoadAwarePlacementClusterService(nil, nil),
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "should return the schedulable cluster with the fewest consumed streaming units when manual scaling is enabled",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.ManualScaling,
+					ClusterConfig: config.NewClusterConfig(config.ClusterList{
+						config.ManualCluster{ClusterId: "cluster-1", Schedulable: true, KafkaInstanceLimit: 5},
+						config.ManualCluster{ClusterId: "cluster-2", Schedulable: true, KafkaInstanceLimit: 5},
+						config.ManualCluster{ClusterId: "cluster-3", Schedulable: false, KafkaInstanceLimit: 5},
+					}),
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 3),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 1),
+					buildStreamingUnitCount("cluster-2", types.DEVELOPER, 1),
+				}),
+			},
+			want:    managedClusters[1],
+			wantErr: false,
+		},
+		{
+			name: "should skip the clusters that have reached their streaming unit limit when manual scaling is enabled",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.ManualScaling,
+					ClusterConfig: config.NewClusterConfig(config.ClusterList{
+						config.ManualCluster{ClusterId: "cluster-1", Schedulable: true, KafkaInstanceLimit: 5},
+						config.ManualCluster{ClusterId: "cluster-2", Schedulable: true, KafkaInstanceLimit: 1},
+						config.ManualCluster{ClusterId: "cluster-3", Schedulable: true, KafkaInstanceLimit: 2},
+					}),
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 3),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 1),
+					buildStreamingUnitCount("cluster-3", types.STANDARD, 2),
+				}),
+			},
+			want:    managedClusters[0],
+			wantErr: false,
+		},
+		{
+			name: "should return the cluster with the fewest consumed streaming units of the instance type when auto scaling is enabled",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.AutoScaling,
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 5),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 2),
+					buildStreamingUnitCount("cluster-2", types.DEVELOPER, 6),
+					buildStreamingUnitCount("cluster-3", types.STANDARD, 4),
+				}),
+			},
+			want:    managedClusters[1],
+			wantErr: false,
+		},
+		{
+			name: "should keep the first cluster when several clusters have the same load",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.NoScaling,
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 2),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 1),
+					buildStreamingUnitCount("cluster-3", types.STANDARD, 1),
+				}),
+			},
+			want:    managedClusters[1],
+			wantErr: false,
+		},
+		{
+			name: "should ignore the enterprise clusters",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.NoScaling,
+				},
+				ClusterService: buildLoadAwarePlacementClusterService([]*api.Cluster{
+					{ClusterID: "enterprise-cluster", ClusterType: api.EnterpriseDataPlaneClusterType.String()},
+					managedClusters[0],
+				}, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 2),
+				}),
+			},
+			want:    managedClusters[0],
+			wantErr: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			l := &LeastLoadedCluster{loadAwarePlacement{
+				dataplaneClusterConfig: tt.fields.DataplaneClusterConfig,
+				clusterService:         tt.fields.ClusterService,
+				kafkaConfig:            &defaultKafkaConf,
+			}}
+
+			got, err := l.FindCluster(&dbapi.KafkaRequest{SizeId: "x1", InstanceType: types.STANDARD.String()})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestBestFitCluster_FindCluster(t *testing.T) {
+	managedClusters := []*api.Cluster{
+		{ClusterID: "cluster-1", ClusterType: api.ManagedDataPlaneClusterType.String(), DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_units":10}}`))},
+		{ClusterID: "cluster-2", ClusterType: api.ManagedDataPlaneClusterType.String(), DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_units":10}}`))},
+		{ClusterID: "cluster-3", ClusterType: api.ManagedDataPlaneClusterType.String(), DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_units":4}}`))},
+	}
+
+	type fields struct {
+		DataplaneClusterConfig *config.DataplaneClusterConfig
+		ClusterService         ClusterService
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    *api.Cluster
+		wantErr bool
+	}{
+		{
+			name: "should return an error if getting streaming unit count per cluster and instance type fails",
+			fields: fields{
+				DataplaneClusterConfig: config.NewDataplaneClusterConfig(),
+				ClusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return managedClusters, nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+						return nil, errors.New("failed to retrieve streaming unit count per cluster and instance type")
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "should return the schedulable cluster with the fewest remaining streaming units when manual scaling is enabled",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.ManualScaling,
+					ClusterConfig: config.NewClusterConfig(config.ClusterList{
+						config.ManualCluster{ClusterId: "cluster-1", Schedulable: true, KafkaInstanceLimit: 5},
+						config.ManualCluster{ClusterId: "cluster-2", Schedulable: true, KafkaInstanceLimit: 5},
+						config.ManualCluster{ClusterId: "cluster-3", Schedulable: false, KafkaInstanceLimit: 5},
+					}),
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 1),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 2),
+					buildStreamingUnitCount("cluster-2", types.DEVELOPER, 1),
+					buildStreamingUnitCount("cluster-3", types.STANDARD, 4),
+				}),
+			},
+			want:    managedClusters[1],
+			wantErr: false,
+		},
+		{
+			name: "should return nil when no cluster has enough remaining streaming units",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.ManualScaling,
+					ClusterConfig: config.NewClusterConfig(config.ClusterList{
+						config.ManualCluster{ClusterId: "cluster-1", Schedulable: true, KafkaInstanceLimit: 1},
+						config.ManualCluster{ClusterId: "cluster-2", Schedulable: true, KafkaInstanceLimit: 1},
+						config.ManualCluster{ClusterId: "cluster-3", Schedulable: true, KafkaInstanceLimit: 1},
+					}),
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 1),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 1),
+					buildStreamingUnitCount("cluster-3", types.DEVELOPER, 2),
+				}),
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "should prefer the clusters with a streaming unit limit over the clusters missing from the manual cluster list",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.ManualScaling,
+					ClusterConfig: config.NewClusterConfig(config.ClusterList{
+						config.ManualCluster{ClusterId: "cluster-2", Schedulable: true, KafkaInstanceLimit: 10},
+					}),
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters[:2], KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 8),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 1),
+				}),
+			},
+			want:    managedClusters[1],
+			wantErr: false,
+		},
+		{
+			name: "should return the cluster with the fewest remaining streaming units of the instance type when auto scaling is enabled",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.AutoScaling,
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 5),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 7),
+					buildStreamingUnitCount("cluster-3", types.STANDARD, 4),
+				}),
+			},
+			want:    managedClusters[1],
+			wantErr: false,
+		},
+		{
+			name: "should return the most loaded cluster when the capacity of the clusters is unlimited",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.NoScaling,
+				},
+				ClusterService: buildLoadAwarePlacementClusterService(managedClusters, KafkaStreamingUnitCountPerClusterList{
+					buildStreamingUnitCount("cluster-1", types.STANDARD, 2),
+					buildStreamingUnitCount("cluster-2", types.STANDARD, 1),
+					buildStreamingUnitCount("cluster-3", types.STANDARD, 2),
+				}),
+			},
+			want:    managedClusters[0],
+			wantErr: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			b := &BestFitCluster{loadAwarePlacement{
+				dataplaneClusterConfig: tt.fields.DataplaneClusterConfig,
+				clusterService:         tt.fields.ClusterService,
+				kafkaConfig:            &defaultKafkaConf,
+			}}
+
+			got, err := b.FindCluster(&dbapi.KafkaRequest{SizeId: "x1", InstanceType: types.STANDARD.String()})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_configuredClusterPlacementStrategy_strategy(t *testing.T) {
+	tests := []struct {
+		name                    string
+		scalingType             string
+		reloadedPlacementFile   string
+		wantStrategy            ClusterPlacementStrategy
+		wantStrategyAfterReload ClusterPlacementStrategy
+	}{
+		{
+			name:                    "should switch from the first fit to the least loaded strategy once the placement strategy is reloaded",
+			scalingType:             config.ManualScaling,
+			reloadedPlacementFile:   "placement_strategy: least-loaded\nclusters: []\n",
+			wantStrategy:            &FirstSchedulableWithinLimit{},
+			wantStrategyAfterReload: &LeastLoadedCluster{},
+		},
+		{
+			name:                    "should switch from the best fit to the first fit strategy once the placement strategy is reloaded",
+			scalingType:             config.AutoScaling,
+			reloadedPlacementFile:   "placement_strategy: first-fit\n",
+			wantStrategy:            &BestFitCluster{},
+			wantStrategyAfterReload: &FirstReadyWithCapacity{},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaConfig := config.NewKafkaConfig()
+			g.Expect(kafkaConfig.ReadFiles()).To(gomega.Succeed())
+			env, err := environments.New(environments.TestingEnv, di.ProvideValue(kafkaConfig))
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			dir := t.TempDir()
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.DataPlaneClusterScalingType = tt.scalingType
+			dataplaneClusterConfig.DataPlaneClusterConfigFile = filepath.Join(dir, "dataplane-cluster-configuration.yaml")
+			if tt.scalingType == config.AutoScaling {
+				dataplaneClusterConfig.ClusterPlacementStrategy = config.BestFitPlacementStrategy
+			}
+			strategy := NewClusterPlacementStrategy(&ClusterServiceMock{}, dataplaneClusterConfig, kafkaConfig).(*configuredClusterPlacementStrategy)
+			g.Expect(strategy.strategy()).To(gomega.BeAssignableToTypeOf(tt.wantStrategy))
+
+			g.Expect(os.WriteFile(dataplaneClusterConfig.DataPlaneClusterConfigFile, []byte(tt.reloadedPlacementFile), 0600)).To(gomega.Succeed())
+			g.Expect(dataplaneClusterConfig.Reload(env)).To(gomega.Succeed())
+			g.Expect(strategy.strategy()).To(gomega.BeAssignableToTypeOf(tt.wantStrategyAfterReload))
+		})
+	}
+}
@@ -42,6 +42,9 @@ type ClusterService interface {
 	// Update updates a Cluster. Only fields whose value is different than the
 	// zero-value of their corresponding type will be updated
 	Update(cluster api.Cluster) *apiErrors.ServiceError
+	// UpdateCordoned cordons or uncordons the cluster corresponding to the provided clusterID.
+	// No new kafka is placed on a cordoned cluster
+	UpdateCordoned(clusterID string, cordoned bool) *apiErrors.ServiceError
 	FindCluster(criteria FindClusterCriteria) (*api.Cluster, error)
 	// FindClusterByID returns the cluster corresponding to the provided clusterID.
 	// If the cluster has not been found nil is returned. If there has been an issue
@@ -258,6 +261,21 @@ func (c clusterService) Update(cluster api.Cluster) *apiErrors.ServiceError {
 	return nil
 }
 
+func (c clusterService) UpdateCordoned(clusterID string, cordoned bool) *apiErrors.ServiceError {
+	if clusterID == "" {
+		return apiErrors.Validation("id is undefined")
+	}
+
+	dbConn := c.connectionFactory.New()
+
+	// a map is used so that the false value is updated too
+	if err := dbConn.Model(&api.Cluster{}).Where("cluster_id = ?", clusterID).Updates(map[string]interface{}{"cordoned": cordoned}).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update cordoned flag of cluster %q", clusterID)
+	}
+
+	return nil
+}
+
 func (c clusterService) UpdateStatus(cluster api.Cluster, status api.ClusterStatus) error {
 	if status.String() == "" {
 		return apiErrors.Validation("status is undefined")
@@ -325,6 +343,8 @@ type FindClusterCriteria struct {
 	Status                api.ClusterStatus
 	SupportedInstanceType string
 	ExternalID            string
+	// ExcludeCordoned excludes the clusters cordoned by an administrator
+	ExcludeCordoned bool
 }
 
 func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster, error) {
@@ -345,6 +365,10 @@ func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster,
 		dbConn = dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
 
+	if criteria.ExcludeCordoned {
+		dbConn = dbConn.Where("cordoned = ?", false)
+	}
+
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -538,6 +562,10 @@ func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cl
 	if criteria.SupportedInstanceType != "" {
 		dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
+
+	if criteria.ExcludeCordoned {
+		dbConn.Where("cordoned = ?", false)
+	}
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -777,6 +805,7 @@ type KafkaStreamingUnitCountPerCluster struct {
 	MaxUnits      int32
 	Status        string
 	ClusterType   string
+	Cordoned      bool
 }
 
 func (k KafkaStreamingUnitCountPerCluster) isSame(kafkaPerRegionFromDB *KafkaPerClusterCount) bool {
@@ -810,6 +839,18 @@ func (kafkaStreamingUnitCountPerClusterList KafkaStreamingUnitCountPerClusterLis
 	return 0
 }
 
+// GetStreamingUnitCountForCluster returns the number of streaming units consumed on the cluster by the kafkas of all the instance types
+func (kafkaStreamingUnitCountPerClusterList KafkaStreamingUnitCountPerClusterList) GetStreamingUnitCountForCluster(clusterId string) int {
+	count := 0
+	for _, KafkaStreamingUnitCountPerCluster := range kafkaStreamingUnitCountPerClusterList {
+		if KafkaStreamingUnitCountPerCluster.ClusterId == clusterId {
+			count += int(KafkaStreamingUnitCountPerCluster.Count)
+		}
+	}
+
+	return count
+}
+
 // KafkaPerClusterCount is a struct used to query the database using a "group by" clause
 type KafkaPerClusterCount struct {
 	Region        string
@@ -829,6 +870,7 @@ type ClusterSelection struct {
 	DynamicCapacityInfo   api.JSON
 	Status                string
 	ClusterType           string
+	Cordoned              bool
 }
 
 func (c *clusterService) FindStreamingUnitCountByClusterAndInstanceType() (KafkaStreamingUnitCountPerClusterList, error) {
@@ -874,6 +916,7 @@ func (c *clusterService) FindStreamingUnitCountByClusterAndInstanceType() (Kafka
 				MaxUnits:      maxUnits,
 				Status:        clusterSelection.Status,
 				ClusterType:   clusterSelection.ClusterType,
+				Cordoned:      clusterSelection.Cordoned,
 			})
 		}
 	}
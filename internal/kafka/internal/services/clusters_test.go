@@ -484,6 +484,19 @@ func Test_FindCluster(t *testing.T) {
 				))
 			},
 		},
+		{
+			name: "successful retrieval of a cluster that is not cordoned",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				criteria: FindClusterCriteria{Status: testStatus, ExcludeCordoned: true},
+			},
+			want: mocks.BuildCluster(nil),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters" WHERE (cordoned = $1)`).WithReply(converters.ConvertCluster(mocks.BuildCluster(nil)))
+			},
+		},
 	}
 
 	for _, testcase := range tests {
@@ -686,6 +699,88 @@ func Test_clusterService_Update(t *testing.T) {
 	}
 }
 
+func Test_clusterService_UpdateCordoned(t *testing.T) {
+	type fields struct {
+		connectionFactory *db.ConnectionFactory
+	}
+	type args struct {
+		clusterID string
+		cordoned  bool
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setupFn func()
+	}{
+		{
+			name: "error when id is undefined",
+			args: args{
+				cordoned: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error when database update returns an error",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				clusterID: testID,
+				cordoned:  true,
+			},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithExecException()
+			},
+		},
+		{
+			name: "successful cordon of the cluster",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				clusterID: testID,
+				cordoned:  true,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET "cordoned"=$1,"updated_at"=$2 WHERE cluster_id = $3`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "successful uncordon of the cluster",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				clusterID: testID,
+				cordoned:  false,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET "cordoned"=$1,"updated_at"=$2 WHERE cluster_id = $3`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			c := &clusterService{
+				connectionFactory: tt.fields.connectionFactory,
+			}
+			err := c.UpdateCordoned(tt.args.clusterID, tt.args.cordoned)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
 func Test_UpdateStatus(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -1417,6 +1512,19 @@ func Test_clusterService_FindAllClusters(t *testing.T) {
 				}))
 			},
 		},
+		{
+			name: "successful retrieval of the clusters that are not cordoned",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				criteria: FindClusterCriteria{Status: api.ClusterReady, ExcludeCordoned: true},
+			},
+			want: []*api.Cluster{mocks.BuildCluster(nil)},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters" WHERE (cordoned = $1)`).WithReply(converters.ConvertClusters([]*api.Cluster{mocks.BuildCluster(nil)}))
+			},
+		},
 	}
 
 	for _, testcase := range tests {
@@ -1435,6 +1543,7 @@ func Test_clusterService_FindAllClusters(t *testing.T) {
 				t.Errorf("FindAllClusters() error = %v, wantErr = %v", err, tt.wantErr)
 				return
 			}
+			g.Expect(got).To(gomega.HaveLen(len(tt.want)))
 			for i, res := range got {
 				g.Expect(*res).To(gomega.Equal(*tt.want[i]))
 			}
@@ -112,6 +112,9 @@ var _ ClusterService = &ClusterServiceMock{}
 //			UpdateFunc: func(cluster api.Cluster) *serviceError.ServiceError {
 //				panic("mock out the Update method")
 //			},
+//			UpdateCordonedFunc: func(clusterID string, cordoned bool) *serviceError.ServiceError {
+//				panic("mock out the UpdateCordoned method")
+//			},
 //			UpdateMultiClusterStatusFunc: func(clusterIDs []string, status api.ClusterStatus) *serviceError.ServiceError {
 //				panic("mock out the UpdateMultiClusterStatus method")
 //			},
@@ -215,6 +218,9 @@ type ClusterServiceMock struct {
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(cluster api.Cluster) *serviceError.ServiceError
 
+	// UpdateCordonedFunc mocks the UpdateCordoned method.
+	UpdateCordonedFunc func(clusterID string, cordoned bool) *serviceError.ServiceError
+
 	// UpdateMultiClusterStatusFunc mocks the UpdateMultiClusterStatus method.
 	UpdateMultiClusterStatusFunc func(clusterIDs []string, status api.ClusterStatus) *serviceError.ServiceError
 
@@ -389,6 +395,13 @@ type ClusterServiceMock struct {
 			// Cluster is the cluster argument value.
 			Cluster api.Cluster
 		}
+		// UpdateCordoned holds details about calls to the UpdateCordoned method.
+		UpdateCordoned []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// Cordoned is the cordoned argument value.
+			Cordoned bool
+		}
 		// UpdateMultiClusterStatus holds details about calls to the UpdateMultiClusterStatus method.
 		UpdateMultiClusterStatus []struct {
 			// ClusterIDs is the clusterIDs argument value.
@@ -434,6 +447,7 @@ type ClusterServiceMock struct {
 	lockRegisterClusterJob                               sync.RWMutex
 	lockRemoveResources                                  sync.RWMutex
 	lockUpdate                                           sync.RWMutex
+	lockUpdateCordoned                                   sync.RWMutex
 	lockUpdateMultiClusterStatus                         sync.RWMutex
 	lockUpdateStatus                                     sync.RWMutex
 }
@@ -1428,6 +1442,42 @@ func (mock *ClusterServiceMock) UpdateCalls() []struct {
 	return calls
 }
 
+// UpdateCordoned calls UpdateCordonedFunc.
+func (mock *ClusterServiceMock) UpdateCordoned(clusterID string, cordoned bool) *serviceError.ServiceError {
+	if mock.UpdateCordonedFunc == nil {
+		panic("ClusterServiceMock.UpdateCordonedFunc: method is nil but ClusterService.UpdateCordoned was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+		Cordoned  bool
+	}{
+		ClusterID: clusterID,
+		Cordoned:  cordoned,
+	}
+	mock.lockUpdateCordoned.Lock()
+	mock.calls.UpdateCordoned = append(mock.calls.UpdateCordoned, callInfo)
+	mock.lockUpdateCordoned.Unlock()
+	return mock.UpdateCordonedFunc(clusterID, cordoned)
+}
+
+// UpdateCordonedCalls gets all the calls that were made to UpdateCordoned.
+// Check the length with:
+//
+//	len(mockedClusterService.UpdateCordonedCalls())
+func (mock *ClusterServiceMock) UpdateCordonedCalls() []struct {
+	ClusterID string
+	Cordoned  bool
+} {
+	var calls []struct {
+		ClusterID string
+		Cordoned  bool
+	}
+	mock.lockUpdateCordoned.RLock()
+	calls = mock.calls.UpdateCordoned
+	mock.lockUpdateCordoned.RUnlock()
+	return calls
+}
+
 // UpdateMultiClusterStatus calls UpdateMultiClusterStatusFunc.
 func (mock *ClusterServiceMock) UpdateMultiClusterStatus(clusterIDs []string, status api.ClusterStatus) *serviceError.ServiceError {
 	if mock.UpdateMultiClusterStatusFunc == nil {
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+// DataPlaneClusterFilter filters the data plane clusters listed by the admin API. Empty fields are not filtered on
+type DataPlaneClusterFilter struct {
+	Status        string
+	CloudProvider string
+	Region        string
+	ClusterType   string
+}
+
+// DataPlaneClusterDetails is a data plane cluster along with the streaming units consumed by the kafkas placed on it
+type DataPlaneClusterDetails struct {
+	Cluster                *api.Cluster
+	ConsumedStreamingUnits StreamingUnitCountPerInstanceType
+	// Kafkas are the kafkas placed on the cluster. They are only set when a single cluster is retrieved
+	Kafkas dbapi.KafkaList
+}
+
+//go:generate moq -out data_plane_cluster_admin_moq.go . DataPlaneClusterAdminService
+type DataPlaneClusterAdminService interface {
+	// List returns the page of data plane clusters matching the filter, ordered by creation time
+	List(filter DataPlaneClusterFilter, listArgs *services.ListArguments) ([]DataPlaneClusterDetails, *api.PagingMeta, *errors.ServiceError)
+	// Get returns the data plane cluster corresponding to the provided clusterID along with the kafkas placed on it
+	Get(clusterID string) (*DataPlaneClusterDetails, *errors.ServiceError)
+	// ForceDeprovision moves an empty data plane cluster to deprovisioning, whatever the scaling decisions. The
+	// ClusterManager then deletes it from its provider and cleans it up
+	ForceDeprovision(clusterID string) (*api.Cluster, *errors.ServiceError)
+	// Retry replaces a failed data plane cluster through the ClusterCreationRetryService, whatever the retries left.
+	// The enterprise clusters can not be retried. The new cluster is returned
+	Retry(clusterID string) (*api.Cluster, *errors.ServiceError)
+}
+
+var _ DataPlaneClusterAdminService = &dataPlaneClusterAdminService{}
+
+type dataPlaneClusterAdminService struct {
+	connectionFactory           *db.ConnectionFactory
+	clusterService              ClusterService
+	clusterCreationRetryService ClusterCreationRetryService
+}
+
+func NewDataPlaneClusterAdminService(connectionFactory *db.ConnectionFactory, clusterService ClusterService,
+	clusterCreationRetryService ClusterCreationRetryService) *dataPlaneClusterAdminService {
+	return &dataPlaneClusterAdminService{
+		connectionFactory:           connectionFactory,
+		clusterService:              clusterService,
+		clusterCreationRetryService: clusterCreationRetryService,
+	}
+}
+
+func (s *dataPlaneClusterAdminService) List(filter DataPlaneClusterFilter, listArgs *services.ListArguments) ([]DataPlaneClusterDetails, *api.PagingMeta, *errors.ServiceError) {
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn := s.connectionFactory.New().Model(&api.Cluster{}).Where(&api.Cluster{
+		Status:        api.ClusterStatus(filter.Status),
+		CloudProvider: filter.CloudProvider,
+		Region:        filter.Region,
+		ClusterType:   filter.ClusterType,
+	})
+
+	var total int64
+	if err := dbConn.Count(&total).Error; err != nil {
+		return nil, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count data plane clusters")
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	var clusters []*api.Cluster
+	if err := dbConn.Order("created_at asc").Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size).Find(&clusters).Error; err != nil {
+		return nil, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list data plane clusters")
+	}
+
+	details := make([]DataPlaneClusterDetails, 0, len(clusters))
+	for _, cluster := range clusters {
+		consumed, err := s.consumedStreamingUnits(cluster)
+		if err != nil {
+			return nil, pagingMeta, err
+		}
+		details = append(details, DataPlaneClusterDetails{Cluster: cluster, ConsumedStreamingUnits: consumed})
+	}
+
+	return details, pagingMeta, nil
+}
+
+func (s *dataPlaneClusterAdminService) Get(clusterID string) (*DataPlaneClusterDetails, *errors.ServiceError) {
+	cluster, err := s.findCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	consumed, err := s.consumedStreamingUnits(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var kafkas dbapi.KafkaList
+	if err := s.connectionFactory.New().Where("cluster_id = ?", cluster.ClusterID).Order("created_at asc").Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the kafkas of data plane cluster %q", clusterID)
+	}
+
+	return &DataPlaneClusterDetails{
+		Cluster:                cluster,
+		ConsumedStreamingUnits: consumed,
+		Kafkas:                 kafkas,
+	}, nil
+}
+
+func (s *dataPlaneClusterAdminService) ForceDeprovision(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, err := s.findCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Status == api.ClusterDeprovisioning || cluster.Status == api.ClusterCleanup {
+		return nil, errors.Conflict("data plane cluster %q is already being deprovisioned", clusterID)
+	}
+
+	// a non empty cluster would be set back to ready by the deprovisioning clusters manager
+	nonEmptyCluster, err := s.clusterService.FindNonEmptyClusterByID(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if nonEmptyCluster != nil {
+		return nil, errors.BadRequest("data plane cluster %q has kafkas placed on it. The cluster has to be drained before it can be deprovisioned", clusterID)
+	}
+
+	if err := s.clusterService.UpdateStatus(*cluster, api.ClusterDeprovisioning); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision data plane cluster %q", clusterID)
+	}
+	cluster.Status = api.ClusterDeprovisioning
+
+	return cluster, nil
+}
+
+func (s *dataPlaneClusterAdminService) Retry(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, err := s.findCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Status != api.ClusterFailed {
+		return nil, errors.BadRequest("data plane cluster %q is in %q status. Only the clusters in %q status can be retried", clusterID, cluster.Status, api.ClusterFailed)
+	}
+	// enterprise clusters are not created by their provider, so a replacement cluster would be created without an organisation
+	if cluster.ClusterType == api.EnterpriseDataPlaneClusterType.String() {
+		return nil, errors.BadRequest("data plane cluster %q is an %q cluster. Only the clusters created by their provider can be retried", clusterID, api.EnterpriseDataPlaneClusterType)
+	}
+
+	return s.clusterCreationRetryService.Retry(cluster)
+}
+
+func (s *dataPlaneClusterAdminService) findCluster(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, err := s.clusterService.FindClusterByID(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, errors.NotFound("data plane cluster %q not found", clusterID)
+	}
+	return cluster, nil
+}
+
+func (s *dataPlaneClusterAdminService) consumedStreamingUnits(cluster *api.Cluster) (StreamingUnitCountPerInstanceType, *errors.ServiceError) {
+	consumed, err := s.clusterService.ComputeConsumedStreamingUnitCountPerInstanceType(cluster.ClusterID)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to compute the streaming units consumed on data plane cluster %q", cluster.ClusterID)
+	}
+	return consumed, nil
+}
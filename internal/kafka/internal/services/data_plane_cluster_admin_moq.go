@@ -0,0 +1,215 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"sync"
+)
+
+// Ensure, that DataPlaneClusterAdminServiceMock does implement DataPlaneClusterAdminService.
+// If this is not the case, regenerate this file with moq.
+var _ DataPlaneClusterAdminService = &DataPlaneClusterAdminServiceMock{}
+
+// DataPlaneClusterAdminServiceMock is a mock implementation of DataPlaneClusterAdminService.
+//
+//	func TestSomethingThatUsesDataPlaneClusterAdminService(t *testing.T) {
+//
+//		// make and configure a mocked DataPlaneClusterAdminService
+//		mockedDataPlaneClusterAdminService := &DataPlaneClusterAdminServiceMock{
+//			ForceDeprovisionFunc: func(clusterID string) (*api.Cluster, *serviceError.ServiceError) {
+//				panic("mock out the ForceDeprovision method")
+//			},
+//			GetFunc: func(clusterID string) (*DataPlaneClusterDetails, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func(filter DataPlaneClusterFilter, listArgs *services.ListArguments) ([]DataPlaneClusterDetails, *api.PagingMeta, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			RetryFunc: func(clusterID string) (*api.Cluster, *serviceError.ServiceError) {
+//				panic("mock out the Retry method")
+//			},
+//		}
+//
+//		// use mockedDataPlaneClusterAdminService in code that requires DataPlaneClusterAdminService
+//		// and then make assertions.
+//
+//	}
+type DataPlaneClusterAdminServiceMock struct {
+	// ForceDeprovisionFunc mocks the ForceDeprovision method.
+	ForceDeprovisionFunc func(clusterID string) (*api.Cluster, *serviceError.ServiceError)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(clusterID string) (*DataPlaneClusterDetails, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func(filter DataPlaneClusterFilter, listArgs *services.ListArguments) ([]DataPlaneClusterDetails, *api.PagingMeta, *serviceError.ServiceError)
+
+	// RetryFunc mocks the Retry method.
+	RetryFunc func(clusterID string) (*api.Cluster, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ForceDeprovision holds details about calls to the ForceDeprovision method.
+		ForceDeprovision []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Filter is the filter argument value.
+			Filter DataPlaneClusterFilter
+			// ListArgs is the listArgs argument value.
+			ListArgs *services.ListArguments
+		}
+		// Retry holds details about calls to the Retry method.
+		Retry []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+	}
+	lockForceDeprovision sync.RWMutex
+	lockGet              sync.RWMutex
+	lockList             sync.RWMutex
+	lockRetry            sync.RWMutex
+}
+
+// ForceDeprovision calls ForceDeprovisionFunc.
+func (mock *DataPlaneClusterAdminServiceMock) ForceDeprovision(clusterID string) (*api.Cluster, *serviceError.ServiceError) {
+	if mock.ForceDeprovisionFunc == nil {
+		panic("DataPlaneClusterAdminServiceMock.ForceDeprovisionFunc: method is nil but DataPlaneClusterAdminService.ForceDeprovision was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockForceDeprovision.Lock()
+	mock.calls.ForceDeprovision = append(mock.calls.ForceDeprovision, callInfo)
+	mock.lockForceDeprovision.Unlock()
+	return mock.ForceDeprovisionFunc(clusterID)
+}
+
+// ForceDeprovisionCalls gets all the calls that were made to ForceDeprovision.
+// Check the length with:
+//
+//	len(mockedDataPlaneClusterAdminService.ForceDeprovisionCalls())
+func (mock *DataPlaneClusterAdminServiceMock) ForceDeprovisionCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockForceDeprovision.RLock()
+	calls = mock.calls.ForceDeprovision
+	mock.lockForceDeprovision.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *DataPlaneClusterAdminServiceMock) Get(clusterID string) (*DataPlaneClusterDetails, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("DataPlaneClusterAdminServiceMock.GetFunc: method is nil but DataPlaneClusterAdminService.Get was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(clusterID)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedDataPlaneClusterAdminService.GetCalls())
+func (mock *DataPlaneClusterAdminServiceMock) GetCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *DataPlaneClusterAdminServiceMock) List(filter DataPlaneClusterFilter, listArgs *services.ListArguments) ([]DataPlaneClusterDetails, *api.PagingMeta, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("DataPlaneClusterAdminServiceMock.ListFunc: method is nil but DataPlaneClusterAdminService.List was just called")
+	}
+	callInfo := struct {
+		Filter   DataPlaneClusterFilter
+		ListArgs *services.ListArguments
+	}{
+		Filter:   filter,
+		ListArgs: listArgs,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(filter, listArgs)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedDataPlaneClusterAdminService.ListCalls())
+func (mock *DataPlaneClusterAdminServiceMock) ListCalls() []struct {
+	Filter   DataPlaneClusterFilter
+	ListArgs *services.ListArguments
+} {
+	var calls []struct {
+		Filter   DataPlaneClusterFilter
+		ListArgs *services.ListArguments
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// Retry calls RetryFunc.
+func (mock *DataPlaneClusterAdminServiceMock) Retry(clusterID string) (*api.Cluster, *serviceError.ServiceError) {
+	if mock.RetryFunc == nil {
+		panic("DataPlaneClusterAdminServiceMock.RetryFunc: method is nil but DataPlaneClusterAdminService.Retry was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockRetry.Lock()
+	mock.calls.Retry = append(mock.calls.Retry, callInfo)
+	mock.lockRetry.Unlock()
+	return mock.RetryFunc(clusterID)
+}
+
+// RetryCalls gets all the calls that were made to Retry.
+// Check the length with:
+//
+//	len(mockedDataPlaneClusterAdminService.RetryCalls())
+func (mock *DataPlaneClusterAdminServiceMock) RetryCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockRetry.RLock()
+	calls = mock.calls.Retry
+	mock.lockRetry.RUnlock()
+	return calls
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_dataPlaneClusterAdminService_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     DataPlaneClusterFilter
+		wantQuery  string
+		consumeErr error
+		wantErr    bool
+	}{
+		{
+			name:      "should list the clusters matching the filter along with their consumed streaming units",
+			filter:    DataPlaneClusterFilter{Status: "ready", CloudProvider: "aws"},
+			wantQuery: `WHERE "clusters"."cloud_provider" = $1 AND "clusters"."status" = $2`,
+		},
+		{
+			name:       "should return an error when the consumed streaming units cannot be computed",
+			wantQuery:  `SELECT * FROM "clusters"`,
+			consumeErr: errors.GeneralError("failed to count streaming units"),
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "clusters"`).WithReply([]map[string]interface{}{{"count": 2}})
+			mocket.Catcher.NewMock().WithQuery(tt.wantQuery).WithReply([]map[string]interface{}{
+				{"cluster_id": "cluster-1", "status": "ready"},
+				{"cluster_id": "cluster-2", "status": "ready"},
+			})
+
+			clusterService := &ClusterServiceMock{
+				ComputeConsumedStreamingUnitCountPerInstanceTypeFunc: func(clusterID string) (StreamingUnitCountPerInstanceType, error) {
+					return StreamingUnitCountPerInstanceType{types.STANDARD: 3}, tt.consumeErr
+				},
+			}
+			s := NewDataPlaneClusterAdminService(db.NewMockConnectionFactory(nil), clusterService, &ClusterCreationRetryServiceMock{})
+			clusters, paging, err := s.List(tt.filter, &services.ListArguments{Page: 1, Size: 100})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			g.Expect(paging).To(gomega.Equal(&api.PagingMeta{Page: 1, Size: 2, Total: 2}))
+			g.Expect(clusters).To(gomega.HaveLen(2))
+			g.Expect(clusters[0].Cluster.ClusterID).To(gomega.Equal("cluster-1"))
+			g.Expect(clusters[0].ConsumedStreamingUnits).To(gomega.Equal(StreamingUnitCountPerInstanceType{types.STANDARD: 3}))
+			g.Expect(clusters[0].Kafkas).To(gomega.BeNil())
+		})
+	}
+}
+
+func Test_dataPlaneClusterAdminService_ForceDeprovision(t *testing.T) {
+	tests := []struct {
+		name                  string
+		cluster               *api.Cluster
+		nonEmpty              bool
+		wantErrCode           errors.ServiceErrorCode
+		wantUpdateStatusCalls int
+	}{
+		{
+			name:                  "should deprovision an empty cluster",
+			cluster:               &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady},
+			wantUpdateStatusCalls: 1,
+		},
+		{
+			name:                  "should deprovision a failed cluster",
+			cluster:               &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterFailed},
+			wantUpdateStatusCalls: 1,
+		},
+		{
+			name:        "should not deprovision a cluster that does not exist",
+			wantErrCode: errors.ErrorNotFound,
+		},
+		{
+			name:        "should not deprovision a cluster with kafkas placed on it",
+			cluster:     &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady},
+			nonEmpty:    true,
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:        "should not deprovision a cluster that is already being deprovisioned",
+			cluster:     &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterCleanup},
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return tt.cluster, nil
+				},
+				FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					if tt.nonEmpty {
+						return tt.cluster, nil
+					}
+					return nil, nil
+				},
+				UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+					g.Expect(status).To(gomega.Equal(api.ClusterDeprovisioning))
+					return nil
+				},
+			}
+			s := NewDataPlaneClusterAdminService(db.NewMockConnectionFactory(nil), clusterService, &ClusterCreationRetryServiceMock{})
+			cluster, err := s.ForceDeprovision("cluster-id")
+			g.Expect(clusterService.UpdateStatusCalls()).To(gomega.HaveLen(tt.wantUpdateStatusCalls))
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(cluster.Status).To(gomega.Equal(api.ClusterDeprovisioning))
+		})
+	}
+}
+
+func Test_dataPlaneClusterAdminService_Retry(t *testing.T) {
+	tests := []struct {
+		name           string
+		cluster        *api.Cluster
+		wantErrCode    errors.ServiceErrorCode
+		wantRetryCalls int
+	}{
+		{
+			name:           "should retry the failed cluster",
+			cluster:        &api.Cluster{Meta: api.Meta{ID: "id"}, ClusterID: "cluster-id", Status: api.ClusterFailed},
+			wantRetryCalls: 1,
+		},
+		{
+			name:        "should not retry a cluster that does not exist",
+			wantErrCode: errors.ErrorNotFound,
+		},
+		{
+			name:        "should not retry a cluster that is not failed",
+			cluster:     &api.Cluster{Meta: api.Meta{ID: "id"}, ClusterID: "cluster-id", Status: api.ClusterReady},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:        "should not retry an enterprise cluster",
+			cluster:     &api.Cluster{Meta: api.Meta{ID: "id"}, ClusterID: "cluster-id", Status: api.ClusterFailed, ClusterType: api.EnterpriseDataPlaneClusterType.String()},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clusterService := &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return tt.cluster, nil
+				},
+			}
+			retryService := &ClusterCreationRetryServiceMock{
+				RetryFunc: func(cluster *api.Cluster) (*api.Cluster, *errors.ServiceError) {
+					g.Expect(cluster).To(gomega.Equal(tt.cluster))
+					return &api.Cluster{Status: api.ClusterAccepted}, nil
+				},
+			}
+			s := NewDataPlaneClusterAdminService(db.NewMockConnectionFactory(nil), clusterService, retryService)
+			newCluster, err := s.Retry("cluster-id")
+			g.Expect(retryService.RetryCalls()).To(gomega.HaveLen(tt.wantRetryCalls))
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(newCluster.Status).To(gomega.Equal(api.ClusterAccepted))
+		})
+	}
+}
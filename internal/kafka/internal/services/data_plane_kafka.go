@@ -147,6 +147,14 @@ func (d *dataPlaneKafkaService) processRealKafkaDeployment(ks *dbapi.DataPlaneKa
 		glog.Error(errors.Wrapf(getErr, "failed to get kafka request by kafka ID %q", ks.KafkaClusterId))
 		return
 	}
+	if kafka.ClusterID != cluster.ClusterID && kafka.MigrationStatus.InProgress() &&
+		(kafka.MigrationSourceClusterID == cluster.ClusterID || kafka.MigrationTargetClusterID == cluster.ClusterID) {
+		// the status reported by the other cluster of a migration only drives the migration
+		if e := d.processMigratingKafkaDeployment(kafka, ks, cluster); e != nil {
+			log.Error(errors.Wrapf(e, "Error updating migration status of kafka %q", ks.KafkaClusterId))
+		}
+		return
+	}
 	if kafka.ClusterID != cluster.ClusterID {
 		log.Warningf("kafka with ID %q does not match cluster's ClusterID. kafka ClusterID = %q, cluster's ClusterID = %q", kafka.ID, kafka.ClusterID, cluster.ClusterID)
 		return
@@ -219,6 +227,83 @@ func (d *dataPlaneKafkaService) processRealKafkaDeployment(ks *dbapi.DataPlaneKa
 	}
 }
 
+// processMigratingKafkaDeployment moves the migration of the kafka forward according to the status reported by the source
+// or the target cluster of the migration. See dbapi.KafkaMigrationStatus for the migration states.
+func (d *dataPlaneKafkaService) processMigratingKafkaDeployment(kafka *dbapi.KafkaRequest, ks *dbapi.DataPlaneKafkaStatus, cluster *api.Cluster) *serviceError.ServiceError {
+	status := d.getManagedKafkaStatus(ks)
+	switch {
+	case cluster.ClusterID == kafka.MigrationTargetClusterID && kafka.MigrationStatus == dbapi.KafkaMigrationStatusProvisioning:
+		switch status {
+		case statusReady:
+			return d.setKafkaMigrationTargetReady(kafka, ks, cluster)
+		case statusError, statusRejected, statusRejectedClusterFull, statusDeleted:
+			details := fmt.Sprintf("kafka reported as %q by the target cluster %q", status, cluster.ClusterID)
+			if readyCondition, ok := ks.GetReadyCondition(); ok && readyCondition.Message != "" {
+				details = fmt.Sprintf("%s: %s", details, readyCondition.Message)
+			}
+			logger.Logger.Infof("migration of kafka %q to cluster %q failed, removing it from the target cluster: %s", kafka.ID, cluster.ClusterID, details)
+			return d.updateKafkaMigration(kafka, map[string]interface{}{
+				"migration_status":  dbapi.KafkaMigrationStatusDeprovisioningTarget.String(),
+				"migration_details": details,
+			})
+		}
+	case cluster.ClusterID == kafka.MigrationTargetClusterID && kafka.MigrationStatus == dbapi.KafkaMigrationStatusDeprovisioningTarget:
+		if status == statusDeleted {
+			logger.Logger.Infof("kafka %q removed from the target cluster %q of its failed migration", kafka.ID, cluster.ClusterID)
+			return d.updateKafkaMigration(kafka, map[string]interface{}{
+				"migration_status": dbapi.KafkaMigrationStatusFailed.String(),
+				"migration_routes": nil,
+			})
+		}
+	case cluster.ClusterID == kafka.MigrationSourceClusterID && kafka.MigrationStatus == dbapi.KafkaMigrationStatusDeprovisioningSource:
+		if status == statusDeleted {
+			logger.Logger.Infof("kafka %q removed from the source cluster %q of its migration", kafka.ID, cluster.ClusterID)
+			return d.updateKafkaMigration(kafka, map[string]interface{}{
+				"migration_status":  dbapi.KafkaMigrationStatusNoMigration.String(),
+				"migration_details": fmt.Sprintf("migrated from cluster %q to cluster %q", kafka.MigrationSourceClusterID, kafka.MigrationTargetClusterID),
+			})
+		}
+	}
+
+	return nil
+}
+
+// setKafkaMigrationTargetReady stores the routes of the kafka on the target cluster of its migration so that the kafka
+// routes can be switched to them
+func (d *dataPlaneKafkaService) setKafkaMigrationTargetReady(kafka *dbapi.KafkaRequest, kafkaStatus *dbapi.DataPlaneKafkaStatus, cluster *api.Cluster) *serviceError.ServiceError {
+	if len(kafkaStatus.Routes) < 1 {
+		logger.Logger.V(10).Infof("skip switching kafka %q to its migration target cluster %q as its routes are not available", kafka.ID, cluster.ClusterID)
+		return nil
+	}
+
+	clusterDNS, err := d.clusterService.GetClusterDNS(cluster.ClusterID)
+	if err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to get DNS entry for ClusterID %q", cluster.ClusterID)
+	}
+
+	baseClusterDomain := strings.TrimPrefix(clusterDNS, fmt.Sprintf("%s.", constants.DefaultIngressDnsNamePrefix))
+	routes, routesErr := d.buildKafkaRoutes(kafkaStatus.Routes, kafka, baseClusterDomain)
+	if routesErr != nil {
+		return serviceError.NewWithCause(serviceError.ErrorBadRequest, routesErr, "routes are not valid")
+	}
+	if err := kafka.SetMigrationRoutes(routes); err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set migration routes for kafka %q", kafka.ID)
+	}
+
+	logger.Logger.Infof("kafka %q is ready on its migration target cluster %q", kafka.ID, cluster.ClusterID)
+	return d.updateKafkaMigration(kafka, map[string]interface{}{
+		"migration_status": dbapi.KafkaMigrationStatusTargetReady.String(),
+		"migration_routes": kafka.MigrationRoutes,
+	})
+}
+
+func (d *dataPlaneKafkaService) updateKafkaMigration(kafka *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError {
+	if err := d.kafkaService.Updates(kafka, values); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update migration of kafka %q", kafka.ID)
+	}
+	return nil
+}
+
 func (d *dataPlaneKafkaService) setKafkaClusterReady(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if !kafka.RoutesCreated {
 		logger.Logger.V(10).Infof("routes for kafka %q are not created", kafka.ID)
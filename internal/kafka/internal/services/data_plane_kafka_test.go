@@ -1736,3 +1736,117 @@ func Test_dataPlaneKafkaService_processReservedKafkaDeployment(t *testing.T) {
 		})
 	}
 }
+
+func Test_dataPlaneKafkaService_processMigratingKafkaDeployment(t *testing.T) {
+	migratingKafka := func(status dbapi.KafkaMigrationStatus, clusterID string) *dbapi.KafkaRequest {
+		return &dbapi.KafkaRequest{
+			Meta:                     api.Meta{ID: "kafka-id"},
+			Status:                   constants.KafkaRequestStatusReady.String(),
+			BootstrapServerHost:      "kafka.example.com",
+			ClusterID:                clusterID,
+			MigrationStatus:          status,
+			MigrationSourceClusterID: "source",
+			MigrationTargetClusterID: "target",
+		}
+	}
+	kafkaStatus := func(reason string, status string) *dbapi.DataPlaneKafkaStatus {
+		return &dbapi.DataPlaneKafkaStatus{
+			KafkaClusterId: "kafka-id",
+			Conditions:     []dbapi.DataPlaneKafkaStatusCondition{{Type: "Ready", Reason: reason, Status: status, Message: "some message"}},
+			Routes:         []dbapi.DataPlaneKafkaRouteRequest{{Name: "bootstrap", Router: "router.target.example.com"}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		clusterID  string
+		kafka      *dbapi.KafkaRequest
+		status     *dbapi.DataPlaneKafkaStatus
+		wantValues map[string]interface{}
+	}{
+		{
+			name:      "should store the routes of the target cluster when the kafka is ready on it",
+			clusterID: "target",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusProvisioning, "source"),
+			status:    kafkaStatus("", "True"),
+			wantValues: map[string]interface{}{
+				"migration_status": dbapi.KafkaMigrationStatusTargetReady.String(),
+				"migration_routes": api.JSON(`[{"Domain":"kafka.example.com","Router":"router.target.example.com"}]`),
+			},
+		},
+		{
+			name:      "should remove the kafka from the target cluster when the target cluster rejects it",
+			clusterID: "target",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusProvisioning, "source"),
+			status:    kafkaStatus("Rejected", "False"),
+			wantValues: map[string]interface{}{
+				"migration_status":  dbapi.KafkaMigrationStatusDeprovisioningTarget.String(),
+				"migration_details": `kafka reported as "rejected" by the target cluster "target": some message`,
+			},
+		},
+		{
+			name:      "should fail the migration once the kafka is removed from the target cluster",
+			clusterID: "target",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusDeprovisioningTarget, "source"),
+			status:    kafkaStatus("Deleted", "False"),
+			wantValues: map[string]interface{}{
+				"migration_status": dbapi.KafkaMigrationStatusFailed.String(),
+				"migration_routes": nil,
+			},
+		},
+		{
+			name:      "should complete the migration once the kafka is removed from the source cluster",
+			clusterID: "source",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusDeprovisioningSource, "target"),
+			status:    kafkaStatus("Deleted", "False"),
+			wantValues: map[string]interface{}{
+				"migration_status":  dbapi.KafkaMigrationStatusNoMigration.String(),
+				"migration_details": `migrated from cluster "source" to cluster "target"`,
+			},
+		},
+		{
+			name:      "should ignore the kafka still running on the source cluster",
+			clusterID: "source",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusDeprovisioningSource, "target"),
+			status:    kafkaStatus("", "True"),
+		},
+		{
+			name:      "should ignore the kafka still installing on the target cluster",
+			clusterID: "target",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusProvisioning, "source"),
+			status:    kafkaStatus("Installing", "False"),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &KafkaServiceMock{
+				GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return tt.kafka, nil
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+			}
+			clusterService := &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID}, nil
+				},
+				GetClusterDNSFunc: func(clusterID string) (string, *errors.ServiceError) {
+					return fmt.Sprintf("apps.%s.example.com", clusterID), nil
+				},
+			}
+			d := NewDataPlaneKafkaService(kafkaService, clusterService, &config.KafkaConfig{})
+			err := d.UpdateDataPlaneKafkaService(context.TODO(), tt.clusterID, []*dbapi.DataPlaneKafkaStatus{tt.status})
+			g.Expect(err).To(gomega.BeNil())
+			if tt.wantValues == nil {
+				g.Expect(kafkaService.UpdatesCalls()).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(kafkaService.UpdatesCalls()).To(gomega.HaveLen(1))
+			g.Expect(kafkaService.UpdatesCalls()[0].Values).To(gomega.Equal(tt.wantValues))
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+const week = 7 * 24 * time.Hour
+
+// DataPlaneScaleUpForecast is the demand of streaming units forecast for an instance type in a cloud provider's region,
+// and whether a data plane cluster should be created ahead of it
+type DataPlaneScaleUpForecast struct {
+	CloudProvider string
+	Region        string
+	InstanceType  string
+	// FreeStreamingUnits is the capacity left on the clusters of the region accepting kafkas of the instance type
+	FreeStreamingUnits int
+	// HistoricalDemandStreamingUnits is the demand forecast from the kafkas created during the lookback period
+	HistoricalDemandStreamingUnits int
+	// ScheduledDemandStreamingUnits is the demand of the expected demand schedules overlapping the forecast horizon
+	ScheduledDemandStreamingUnits           int
+	MinAvailableCapacitySlackStreamingUnits int
+	// OngoingScaleUp is whether a cluster supporting the instance type is being created in the region
+	OngoingScaleUp bool
+	// RegionLimitReached is whether the streaming units limit of the instance type in the region is reached
+	RegionLimitReached bool
+	// ScaleUpNeeded is whether a cluster should be created ahead of the demand. It is true when the free streaming units
+	// left once the forecast demand is met are below the capacity slack, no cluster is being created and the region
+	// limit is not reached.
+	ScaleUpNeeded bool
+}
+
+// PredictedDemandStreamingUnits returns the total demand of streaming units forecast within the forecast horizon
+func (f DataPlaneScaleUpForecast) PredictedDemandStreamingUnits() int {
+	return f.HistoricalDemandStreamingUnits + f.ScheduledDemandStreamingUnits
+}
+
+//go:generate moq -out data_plane_scale_up_forecast_moq.go . DataPlaneScaleUpForecastService
+type DataPlaneScaleUpForecastService interface {
+	// Forecast returns the forecast of every instance type of every region of the supported cloud providers at the given
+	// time. Nothing is persisted and no cluster is created.
+	Forecast(now time.Time) ([]DataPlaneScaleUpForecast, *errors.ServiceError)
+}
+
+var _ DataPlaneScaleUpForecastService = &dataPlaneScaleUpForecastService{}
+
+type dataPlaneScaleUpForecastService struct {
+	connectionFactory      *db.ConnectionFactory
+	clusterService         ClusterService
+	providerConfig         *config.ProviderConfig
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	kafkaConfig            *config.KafkaConfig
+}
+
+func NewDataPlaneScaleUpForecastService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, providerConfig *config.ProviderConfig,
+	dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) *dataPlaneScaleUpForecastService {
+	return &dataPlaneScaleUpForecastService{
+		connectionFactory:      connectionFactory,
+		clusterService:         clusterService,
+		providerConfig:         providerConfig,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+		kafkaConfig:            kafkaConfig,
+	}
+}
+
+// kafkaCreationCount is the number of kafkas of a size created in a cloud provider's region during an hour
+type kafkaCreationCount struct {
+	CloudProvider string
+	Region        string
+	InstanceType  string
+	SizeId        string
+	CreatedHour   time.Time
+	Count         int32
+}
+
+// demandLocator identifies an instance type in a cloud provider's region
+type demandLocator struct {
+	cloudProvider string
+	region        string
+	instanceType  string
+}
+
+func (s *dataPlaneScaleUpForecastService) Forecast(now time.Time) ([]DataPlaneScaleUpForecast, *errors.ServiceError) {
+	predictiveScaleUpConfig := s.dataplaneClusterConfig.GetDynamicScalingConfig().PredictiveScaleUp
+
+	streamingUnitCounts, err := s.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to find the streaming units count per cluster")
+	}
+
+	hourlyDemand, svcErr := s.findHourlyDemand(now.Add(-predictiveScaleUpConfig.LookbackPeriod))
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var forecasts []DataPlaneScaleUpForecast
+	for _, provider := range s.providerConfig.ProvidersConfig.SupportedProviders {
+		for _, region := range provider.Regions {
+			instanceTypes := make([]string, 0, len(region.SupportedInstanceTypes))
+			for instanceType := range region.SupportedInstanceTypes {
+				instanceTypes = append(instanceTypes, instanceType)
+			}
+			sort.Strings(instanceTypes)
+
+			for _, instanceType := range instanceTypes {
+				instanceTypeConfig := region.SupportedInstanceTypes[instanceType]
+				locator := demandLocator{cloudProvider: provider.Name, region: region.Name, instanceType: instanceType}
+				forecast := DataPlaneScaleUpForecast{
+					CloudProvider:                           provider.Name,
+					Region:                                  region.Name,
+					InstanceType:                            instanceType,
+					HistoricalDemandStreamingUnits:          forecastHistoricalDemand(hourlyDemand[locator], now, predictiveScaleUpConfig.LookbackPeriod, predictiveScaleUpConfig.ForecastHorizon),
+					ScheduledDemandStreamingUnits:           forecastScheduledDemand(predictiveScaleUpConfig.ExpectedDemandSchedules, locator, now, predictiveScaleUpConfig.ForecastHorizon),
+					MinAvailableCapacitySlackStreamingUnits: instanceTypeConfig.MinAvailableCapacitySlackStreamingUnits,
+				}
+
+				var consumedStreamingUnits int
+				forecast.FreeStreamingUnits, consumedStreamingUnits, forecast.OngoingScaleUp = summarizeStreamingUnitCounts(streamingUnitCounts, locator)
+				forecast.RegionLimitReached = instanceTypeConfig.Limit != nil && consumedStreamingUnits >= *instanceTypeConfig.Limit
+				forecast.ScaleUpNeeded = !forecast.OngoingScaleUp && !forecast.RegionLimitReached &&
+					forecast.PredictedDemandStreamingUnits() > 0 &&
+					forecast.FreeStreamingUnits-forecast.PredictedDemandStreamingUnits() < forecast.MinAvailableCapacitySlackStreamingUnits
+
+				forecasts = append(forecasts, forecast)
+			}
+		}
+	}
+
+	return forecasts, nil
+}
+
+// findHourlyDemand returns the streaming units of the kafkas created since the given time per instance type, cloud provider's
+// region and hour of creation. The deleted kafkas are counted as they were part of the demand. Enterprise kafkas are not
+// counted as they are not placed on the clusters created by the dynamic scaling.
+func (s *dataPlaneScaleUpForecastService) findHourlyDemand(since time.Time) (map[demandLocator]map[time.Time]int, *errors.ServiceError) {
+	var creationCounts []kafkaCreationCount
+	if err := s.connectionFactory.New().Unscoped().
+		Model(&dbapi.KafkaRequest{}).
+		Select("cloud_provider, region, instance_type, size_id, date_trunc('hour', created_at) as created_hour, count(1) as count").
+		Where("created_at >= ?", since).
+		Where("desired_kafka_billing_model <> ?", constants.BillingModelEnterprise.String()).
+		Group("cloud_provider, region, instance_type, size_id, created_hour").
+		Scan(&creationCounts).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count the kafkas created since %s", since)
+	}
+
+	hourlyDemand := map[demandLocator]map[time.Time]int{}
+	for _, creationCount := range creationCounts {
+		instanceSize, err := s.kafkaConfig.GetKafkaInstanceSize(creationCount.InstanceType, creationCount.SizeId)
+		if err != nil {
+			// the size is no longer supported. Its kafkas do not tell anything about the demand of the supported sizes
+			continue
+		}
+
+		locator := demandLocator{cloudProvider: creationCount.CloudProvider, region: creationCount.Region, instanceType: creationCount.InstanceType}
+		if _, ok := hourlyDemand[locator]; !ok {
+			hourlyDemand[locator] = map[time.Time]int{}
+		}
+		hourlyDemand[locator][creationCount.CreatedHour.UTC()] += instanceSize.CapacityConsumed * int(creationCount.Count)
+	}
+
+	return hourlyDemand, nil
+}
+
+// forecastHistoricalDemand returns the streaming units expected to be created within the forecast horizon. It is the
+// highest of:
+//   - the average demand over the lookback period, scaled to the forecast horizon
+//   - the average demand of the same period of the previous weeks of the lookback period, to follow the weekly
+//     seasonality of the demand, e.g. the working hours
+func forecastHistoricalDemand(hourlyDemand map[time.Time]int, now time.Time, lookbackPeriod time.Duration, forecastHorizon time.Duration) int {
+	if len(hourlyDemand) == 0 {
+		return 0
+	}
+
+	totalDemand := 0
+	for _, demand := range hourlyDemand {
+		totalDemand += demand
+	}
+	averageDemand := int(math.Ceil(float64(totalDemand) * forecastHorizon.Hours() / lookbackPeriod.Hours()))
+
+	weeks := int(lookbackPeriod / week)
+	if weeks == 0 {
+		return averageDemand
+	}
+
+	seasonalDemand := 0
+	for w := 1; w <= weeks; w++ {
+		from := now.Add(-time.Duration(w) * week).UTC().Truncate(time.Hour)
+		to := now.Add(-time.Duration(w) * week).Add(forecastHorizon).UTC()
+		for hour, demand := range hourlyDemand {
+			if !hour.Before(from) && hour.Before(to) {
+				seasonalDemand += demand
+			}
+		}
+	}
+	weeklyAverageDemand := int(math.Ceil(float64(seasonalDemand) / float64(weeks)))
+
+	if weeklyAverageDemand > averageDemand {
+		return weeklyAverageDemand
+	}
+	return averageDemand
+}
+
+// forecastScheduledDemand returns the sum of the expected streaming units of the schedules of the instance type in the
+// cloud provider's region overlapping the forecast horizon
+func forecastScheduledDemand(schedules []config.ExpectedDemandSchedule, locator demandLocator, now time.Time, forecastHorizon time.Duration) int {
+	scheduledDemand := 0
+	for _, schedule := range schedules {
+		if schedule.CloudProvider != locator.cloudProvider || schedule.Region != locator.region || schedule.InstanceType != locator.instanceType {
+			continue
+		}
+		if schedule.Overlaps(now, now.Add(forecastHorizon)) {
+			scheduledDemand += schedule.ExpectedStreamingUnits
+		}
+	}
+	return scheduledDemand
+}
+
+// summarizeStreamingUnitCounts returns the free and the consumed streaming units of the managed clusters of the instance type
+// in the cloud provider's region, and whether one of them is being created. The clusters being deleted are excluded
+// as they no longer accept kafkas, and the free streaming units of the cordoned clusters are not counted.
+func summarizeStreamingUnitCounts(streamingUnitCounts KafkaStreamingUnitCountPerClusterList, locator demandLocator) (freeStreamingUnits int, consumedStreamingUnits int, ongoingScaleUp bool) {
+	clusterStatesTowardReadyState := []string{
+		api.ClusterProvisioning.String(), api.ClusterProvisioned.String(),
+		api.ClusterAccepted.String(), api.ClusterWaitingForKasFleetShardOperator.String(),
+	}
+	clusterStatesTowardDeletion := []string{api.ClusterDeprovisioning.String(), api.ClusterCleanup.String()}
+
+	for _, streamingUnitCount := range streamingUnitCounts {
+		if streamingUnitCount.CloudProvider != locator.cloudProvider || streamingUnitCount.Region != locator.region ||
+			streamingUnitCount.InstanceType != locator.instanceType || streamingUnitCount.ClusterType != api.ManagedDataPlaneClusterType.String() {
+			continue
+		}
+
+		if arrays.Contains(clusterStatesTowardReadyState, streamingUnitCount.Status) {
+			ongoingScaleUp = true
+			continue
+		}
+		if arrays.Contains(clusterStatesTowardDeletion, streamingUnitCount.Status) {
+			continue
+		}
+
+		consumedStreamingUnits += int(streamingUnitCount.Count)
+		// the free streaming units of a cordoned cluster can't be used by new kafkas
+		if !streamingUnitCount.Cordoned {
+			freeStreamingUnits += int(streamingUnitCount.FreeStreamingUnits())
+		}
+	}
+
+	return freeStreamingUnits, consumedStreamingUnits, ongoingScaleUp
+}
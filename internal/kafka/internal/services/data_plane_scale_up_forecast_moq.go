@@ -0,0 +1,76 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Ensure, that DataPlaneScaleUpForecastServiceMock does implement DataPlaneScaleUpForecastService.
+// If this is not the case, regenerate this file with moq.
+var _ DataPlaneScaleUpForecastService = &DataPlaneScaleUpForecastServiceMock{}
+
+// DataPlaneScaleUpForecastServiceMock is a mock implementation of DataPlaneScaleUpForecastService.
+//
+//	func TestSomethingThatUsesDataPlaneScaleUpForecastService(t *testing.T) {
+//
+//		// make and configure a mocked DataPlaneScaleUpForecastService
+//		mockedDataPlaneScaleUpForecastService := &DataPlaneScaleUpForecastServiceMock{
+//			ForecastFunc: func(now time.Time) ([]DataPlaneScaleUpForecast, *serviceError.ServiceError) {
+//				panic("mock out the Forecast method")
+//			},
+//		}
+//
+//		// use mockedDataPlaneScaleUpForecastService in code that requires DataPlaneScaleUpForecastService
+//		// and then make assertions.
+//
+//	}
+type DataPlaneScaleUpForecastServiceMock struct {
+	// ForecastFunc mocks the Forecast method.
+	ForecastFunc func(now time.Time) ([]DataPlaneScaleUpForecast, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Forecast holds details about calls to the Forecast method.
+		Forecast []struct {
+			// Now is the now argument value.
+			Now time.Time
+		}
+	}
+	lockForecast sync.RWMutex
+}
+
+// Forecast calls ForecastFunc.
+func (mock *DataPlaneScaleUpForecastServiceMock) Forecast(now time.Time) ([]DataPlaneScaleUpForecast, *serviceError.ServiceError) {
+	if mock.ForecastFunc == nil {
+		panic("DataPlaneScaleUpForecastServiceMock.ForecastFunc: method is nil but DataPlaneScaleUpForecastService.Forecast was just called")
+	}
+	callInfo := struct {
+		Now time.Time
+	}{
+		Now: now,
+	}
+	mock.lockForecast.Lock()
+	mock.calls.Forecast = append(mock.calls.Forecast, callInfo)
+	mock.lockForecast.Unlock()
+	return mock.ForecastFunc(now)
+}
+
+// ForecastCalls gets all the calls that were made to Forecast.
+// Check the length with:
+//
+//	len(mockedDataPlaneScaleUpForecastService.ForecastCalls())
+func (mock *DataPlaneScaleUpForecastServiceMock) ForecastCalls() []struct {
+	Now time.Time
+} {
+	var calls []struct {
+		Now time.Time
+	}
+	mock.lockForecast.RLock()
+	calls = mock.calls.Forecast
+	mock.lockForecast.RUnlock()
+	return calls
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_forecastHistoricalDemand(t *testing.T) {
+	now := time.Date(2023, time.May, 15, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		hourlyDemand    map[time.Time]int
+		lookbackPeriod  time.Duration
+		forecastHorizon time.Duration
+		want            int
+	}{
+		{
+			name:            "should forecast no demand when no kafka was created",
+			hourlyDemand:    nil,
+			lookbackPeriod:  2 * week,
+			forecastHorizon: time.Hour,
+			want:            0,
+		},
+		{
+			name: "should forecast the average demand scaled to the forecast horizon",
+			hourlyDemand: map[time.Time]int{
+				now.Add(-48 * time.Hour).Truncate(time.Hour): 10,
+				now.Add(-24 * time.Hour).Truncate(time.Hour): 14,
+			},
+			lookbackPeriod:  4 * 24 * time.Hour,
+			forecastHorizon: 8 * time.Hour,
+			want:            2, // 24 streaming units over 96 hours, for 8 hours
+		},
+		{
+			name: "should forecast the demand of the same period of the previous weeks when it is higher than the average",
+			hourlyDemand: map[time.Time]int{
+				now.Add(-week).Truncate(time.Hour):                           6,
+				now.Add(-2 * week).Add(30 * time.Minute).Truncate(time.Hour): 4,
+				// outside of the forecast horizon of the previous weeks
+				now.Add(-week).Add(3 * time.Hour).Truncate(time.Hour): 20,
+			},
+			lookbackPeriod:  2 * week,
+			forecastHorizon: time.Hour,
+			want:            5, // (6 + 4) streaming units over 2 weeks
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			g.Expect(forecastHistoricalDemand(tt.hourlyDemand, now, tt.lookbackPeriod, tt.forecastHorizon)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_forecastScheduledDemand(t *testing.T) {
+	g := gomega.NewWithT(t)
+	now := time.Date(2023, time.May, 15, 9, 0, 0, 0, time.UTC)
+	locator := demandLocator{cloudProvider: "aws", region: "us-east-1", instanceType: "standard"}
+	schedules := []config.ExpectedDemandSchedule{
+		{Name: "starting-within-the-horizon", CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", Start: now.Add(30 * time.Minute), End: now.Add(3 * time.Hour), ExpectedStreamingUnits: 10},
+		{Name: "ongoing", CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", Start: now.Add(-time.Hour), End: now.Add(time.Hour), ExpectedStreamingUnits: 5},
+		{Name: "ended", CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", Start: now.Add(-2 * time.Hour), End: now, ExpectedStreamingUnits: 100},
+		{Name: "after-the-horizon", CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", Start: now.Add(2 * time.Hour), End: now.Add(3 * time.Hour), ExpectedStreamingUnits: 100},
+		{Name: "other-region", CloudProvider: "aws", Region: "eu-west-1", InstanceType: "standard", Start: now, End: now.Add(time.Hour), ExpectedStreamingUnits: 100},
+		{Name: "other-instance-type", CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", Start: now, End: now.Add(time.Hour), ExpectedStreamingUnits: 100},
+	}
+
+	g.Expect(forecastScheduledDemand(schedules, locator, now, time.Hour)).To(gomega.Equal(15))
+}
+
+func Test_dataPlaneScaleUpForecastService_Forecast(t *testing.T) {
+	now := time.Date(2023, time.May, 15, 9, 0, 0, 0, time.UTC)
+	limit := 10
+
+	providerConfig := &config.ProviderConfig{
+		ProvidersConfig: config.ProviderConfiguration{
+			SupportedProviders: config.ProviderList{
+				{
+					Name: "aws",
+					Regions: config.RegionList{
+						{
+							Name: "us-east-1",
+							SupportedInstanceTypes: config.InstanceTypeMap{
+								"standard":  {MinAvailableCapacitySlackStreamingUnits: 2},
+								"developer": {Limit: &limit},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	kafkaConfig := &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{Id: "standard", Sizes: []config.KafkaInstanceSize{{Id: "x1", CapacityConsumed: 1}, {Id: "x2", CapacityConsumed: 2}}},
+					{Id: "developer", Sizes: []config.KafkaInstanceSize{{Id: "x1", CapacityConsumed: 1}}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		streamingUnitCounts KafkaStreamingUnitCountPerClusterList
+		schedules           []config.ExpectedDemandSchedule
+		want                []DataPlaneScaleUpForecast
+	}{
+		{
+			name: "should need a scale up when the predicted demand leaves less free streaming units than the slack",
+			streamingUnitCounts: KafkaStreamingUnitCountPerClusterList{
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", MaxUnits: 10, Count: 4, Status: api.ClusterReady.String(), ClusterType: api.ManagedDataPlaneClusterType.String()},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", MaxUnits: 10, Count: 2, Status: api.ClusterReady.String(), ClusterType: api.ManagedDataPlaneClusterType.String()},
+				// enterprise clusters are not part of the capacity of the dynamic scaling
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", MaxUnits: 100, Status: api.ClusterReady.String(), ClusterType: api.EnterpriseDataPlaneClusterType.String()},
+				// the free streaming units of the cordoned clusters cannot be used by new kafkas
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", MaxUnits: 10, Count: 1, Status: api.ClusterReady.String(), ClusterType: api.ManagedDataPlaneClusterType.String(), Cordoned: true},
+			},
+			schedules: []config.ExpectedDemandSchedule{
+				{Name: "launch", CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", Start: now, End: now.Add(time.Hour), ExpectedStreamingUnits: 2},
+			},
+			want: []DataPlaneScaleUpForecast{
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", FreeStreamingUnits: 8, HistoricalDemandStreamingUnits: 0, ScheduledDemandStreamingUnits: 0},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", FreeStreamingUnits: 6, HistoricalDemandStreamingUnits: 3, ScheduledDemandStreamingUnits: 2,
+					MinAvailableCapacitySlackStreamingUnits: 2, ScaleUpNeeded: true},
+			},
+		},
+		{
+			name: "should not need a scale up when a cluster is being created or the region limit is reached",
+			streamingUnitCounts: KafkaStreamingUnitCountPerClusterList{
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", MaxUnits: 10, Count: 4, Status: api.ClusterReady.String(), ClusterType: api.ManagedDataPlaneClusterType.String()},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", Status: api.ClusterProvisioning.String(), ClusterType: api.ManagedDataPlaneClusterType.String()},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", MaxUnits: 10, Count: 10, Status: api.ClusterReady.String(), ClusterType: api.ManagedDataPlaneClusterType.String()},
+			},
+			schedules: []config.ExpectedDemandSchedule{
+				{Name: "launch", CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", Start: now, End: now.Add(time.Hour), ExpectedStreamingUnits: 5},
+			},
+			want: []DataPlaneScaleUpForecast{
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", FreeStreamingUnits: 0, ScheduledDemandStreamingUnits: 5, RegionLimitReached: true},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", FreeStreamingUnits: 6, HistoricalDemandStreamingUnits: 3,
+					MinAvailableCapacitySlackStreamingUnits: 2, OngoingScaleUp: true},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset().NewMock().
+				WithQuery(`SELECT cloud_provider, region, instance_type, size_id, date_trunc('hour', created_at) as created_hour, count(1) as count FROM "kafka_requests"`).
+				WithReply([]map[string]interface{}{
+					// 3 streaming units created a week ago within the forecast horizon
+					{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x1", "created_hour": now.Add(-week), "count": 2},
+					{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x2", "created_hour": now.Add(-2 * week), "count": 2},
+					// unsupported sizes are ignored
+					{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x9", "created_hour": now.Add(-week), "count": 100},
+				})
+
+			dataplaneClusterConfig := &config.DataplaneClusterConfig{DynamicScalingConfig: config.NewDynamicScalingConfig()}
+			dataplaneClusterConfig.DynamicScalingConfig.PredictiveScaleUp.ExpectedDemandSchedules = tt.schedules
+			clusterService := &ClusterServiceMock{
+				FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+					return tt.streamingUnitCounts, nil
+				},
+			}
+
+			s := NewDataPlaneScaleUpForecastService(db.NewMockConnectionFactory(nil), clusterService, providerConfig, dataplaneClusterConfig, kafkaConfig)
+			forecasts, err := s.Forecast(now)
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(forecasts).To(gomega.Equal(tt.want))
+		})
+	}
+}
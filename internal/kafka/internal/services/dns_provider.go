@@ -0,0 +1,307 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/miekg/dns"
+)
+
+// KafkaCNAMERecordTTL is the TTL in seconds of the CNAME records of the kafka routes
+const KafkaCNAMERecordTTL int64 = 300
+
+// CNameRecordStatusInSync is the status of a change of CNAME records that has been applied by the DNS provider
+const CNameRecordStatusInSync = route53.ChangeStatusInsync
+
+type CNameRecordStatus struct {
+	Id     *string
+	Status *string
+}
+
+// IsInSync returns true when the change of CNAME records has been applied by the DNS provider
+func (s *CNameRecordStatus) IsInSync() bool {
+	return s != nil && s.Status != nil && *s.Status == CNameRecordStatusInSync
+}
+
+//go:generate moq -out dns_provider_moq.go . DNSProvider
+type DNSProvider interface {
+	// ChangeCNAMERecords creates or deletes the CNAME records pointing the domains of the given kafka routes to their router.
+	// The returned status identifies the change so that it can be followed with GetCNAMERecordStatus.
+	ChangeCNAMERecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*CNameRecordStatus, error)
+	// GetCNAMERecordStatus returns the status of the change of CNAME records identified by the RoutesCreationId of the kafka
+	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+}
+
+// NewDNSProvider return a concrete DNS provider impl. depends on the dns configuration
+func NewDNSProvider(dnsConfig *config.DNSConfig, kafkaConfig *config.KafkaConfig, awsConfig *config.AWSConfig, awsClientFactory aws.ClientFactory) DNSProvider {
+	switch dnsConfig.Provider {
+	case config.RFC2136DNSProvider:
+		return NewRFC2136DNSProvider(dnsConfig.RFC2136, kafkaConfig.KafkaDomainName)
+	case config.InMemoryDNSProvider:
+		return NewInMemoryDNSProvider()
+	case config.LocalDNSProvider:
+		return &localDNSProvider{}
+	default:
+		return &route53DNSProvider{
+			awsConfig:        awsConfig,
+			awsClientFactory: awsClientFactory,
+			domainName:       kafkaConfig.KafkaDomainName,
+		}
+	}
+}
+
+// route53DNSProvider registers the CNAME records in the AWS Route53 hosted zone of the kafka domain name
+type route53DNSProvider struct {
+	awsConfig        *config.AWSConfig
+	awsClientFactory aws.ClientFactory
+	domainName       string
+}
+
+var _ DNSProvider = &route53DNSProvider{}
+
+func (p *route53DNSProvider) ChangeCNAMERecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*CNameRecordStatus, error) {
+	awsClient, err := p.newClient(kafkaRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(p.domainName, buildKafkaClusterCNAMESRecordBatch(routes, action))
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create domain record sets")
+	}
+
+	status := &CNameRecordStatus{}
+	if changeRecordsOutput != nil && changeRecordsOutput.ChangeInfo != nil {
+		status.Id = changeRecordsOutput.ChangeInfo.Id
+		status.Status = changeRecordsOutput.ChangeInfo.Status
+	}
+
+	return status, nil
+}
+
+func (p *route53DNSProvider) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+	awsClient, err := p.newClient(kafkaRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	changeOutput, err := awsClient.GetChange(kafkaRequest.RoutesCreationId)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get status of Route53 change batch request with ID %q", kafkaRequest.RoutesCreationId)
+	}
+
+	return &CNameRecordStatus{
+		Id:     changeOutput.ChangeInfo.Id,
+		Status: changeOutput.ChangeInfo.Status,
+	}, nil
+}
+
+func (p *route53DNSProvider) newClient(kafkaRequest *dbapi.KafkaRequest) (aws.AWSClient, error) {
+	awsConfig := aws.Config{
+		AccessKeyID:     p.awsConfig.Route53.AccessKey,
+		SecretAccessKey: p.awsConfig.Route53.SecretAccessKey,
+	}
+
+	route53Region, err := getRoute53RegionFromKafkaRequest(kafkaRequest)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "error getting route 53 region from kafka request")
+	}
+
+	awsClient, err := p.awsClientFactory.NewClient(awsConfig, route53Region)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create aws client")
+	}
+
+	return awsClient, nil
+}
+
+func getRoute53RegionFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) (string, error) {
+	switch kafkaRequest.CloudProvider {
+	case cloudproviders.AWS.String():
+		return aws.DefaultAWSRoute53Region, nil
+	case cloudproviders.GCP.String():
+		return aws.DefaultGCPRoute53Region, nil
+	default:
+		return "", errors.GeneralError("unknown cloud provider: %q", kafkaRequest.CloudProvider)
+	}
+}
+
+func buildKafkaClusterCNAMESRecordBatch(routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) *route53.ChangeBatch {
+	var changes []*route53.Change
+	for _, r := range routes {
+		c := buildResourceRecordChange(r.Domain, r.Router, action)
+		changes = append(changes, c)
+	}
+	recordChangeBatch := &route53.ChangeBatch{
+		Changes: changes,
+	}
+
+	return recordChangeBatch
+}
+
+func buildResourceRecordChange(recordName string, clusterIngress string, action KafkaRoutesAction) *route53.Change {
+	recordType := "CNAME"
+	recordTTL := KafkaCNAMERecordTTL
+
+	actionStr := action.String()
+	resourceRecordChange := &route53.Change{
+		Action: &actionStr,
+		ResourceRecordSet: &route53.ResourceRecordSet{
+			Name: &recordName,
+			Type: &recordType,
+			TTL:  &recordTTL,
+			ResourceRecords: []*route53.ResourceRecord{
+				{
+					Value: &clusterIngress,
+				},
+			},
+		},
+	}
+
+	return resourceRecordChange
+}
+
+// rfc2136DNSProvider registers the CNAME records through RFC 2136 dynamic updates, optionally signed with a TSIG key.
+// Dynamic updates are applied synchronously by the nameserver so every accepted change is reported as in sync.
+type rfc2136DNSProvider struct {
+	rfc2136Config config.RFC2136DNSConfig
+	zone          string
+	client        *dns.Client
+}
+
+var _ DNSProvider = &rfc2136DNSProvider{}
+
+func NewRFC2136DNSProvider(rfc2136Config config.RFC2136DNSConfig, kafkaDomainName string) *rfc2136DNSProvider {
+	client := &dns.Client{
+		Net:     "tcp",
+		Timeout: rfc2136Config.Timeout,
+	}
+	if rfc2136Config.TSIGKeyName != "" {
+		client.TsigSecret = map[string]string{dns.Fqdn(rfc2136Config.TSIGKeyName): rfc2136Config.TSIGSecret}
+	}
+
+	return &rfc2136DNSProvider{
+		rfc2136Config: rfc2136Config,
+		zone:          dns.Fqdn(arrays.FirstNonEmptyOrDefault(kafkaDomainName, rfc2136Config.Zone)),
+		client:        client,
+	}
+}
+
+func (p *rfc2136DNSProvider) ChangeCNAMERecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*CNameRecordStatus, error) {
+	msg := buildRFC2136UpdateMessage(p.zone, routes, action, p.rfc2136Config.TTL)
+	if p.rfc2136Config.TSIGKeyName != "" {
+		msg.SetTsig(dns.Fqdn(p.rfc2136Config.TSIGKeyName), p.rfc2136Config.TSIGAlgorithm, 300, time.Now().Unix())
+	}
+
+	reply, _, err := p.client.Exchange(msg, p.rfc2136Config.Nameserver)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to send the dynamic update of zone %q to nameserver %q", p.zone, p.rfc2136Config.Nameserver)
+	}
+	if reply.Rcode != dns.RcodeSuccess {
+		return nil, errors.GeneralError("dynamic update of zone %q rejected by nameserver %q: %s", p.zone, p.rfc2136Config.Nameserver, dns.RcodeToString[reply.Rcode])
+	}
+
+	changeID := api.NewID()
+	status := CNameRecordStatusInSync
+	return &CNameRecordStatus{Id: &changeID, Status: &status}, nil
+}
+
+func (p *rfc2136DNSProvider) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+	changeID := kafkaRequest.RoutesCreationId
+	status := CNameRecordStatusInSync
+	return &CNameRecordStatus{Id: &changeID, Status: &status}, nil
+}
+
+// buildRFC2136UpdateMessage builds the dynamic update of the given zone. The existing CNAME record of every route
+// is always removed so that creating the records is idempotent and replaces a record pointing to an old router.
+func buildRFC2136UpdateMessage(zone string, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction, ttl int64) *dns.Msg {
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(zone))
+
+	for _, r := range routes {
+		header := dns.RR_Header{Name: dns.Fqdn(r.Domain), Rrtype: dns.TypeCNAME, Class: dns.ClassINET}
+		msg.RemoveRRset([]dns.RR{&dns.CNAME{Hdr: header}})
+		if action != KafkaRoutesActionDelete {
+			header.Ttl = uint32(ttl)
+			msg.Insert([]dns.RR{&dns.CNAME{Hdr: header, Target: dns.Fqdn(r.Router)}})
+		}
+	}
+
+	return msg
+}
+
+// localDNSProvider does not register the CNAME records anywhere. The records are exported from the routes of the kafkas
+// by the KafkaRoutesExportService so that operators can load them in their own DNS.
+type localDNSProvider struct{}
+
+var _ DNSProvider = &localDNSProvider{}
+
+func (p *localDNSProvider) ChangeCNAMERecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*CNameRecordStatus, error) {
+	changeID := api.NewID()
+	status := CNameRecordStatusInSync
+	return &CNameRecordStatus{Id: &changeID, Status: &status}, nil
+}
+
+func (p *localDNSProvider) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+	changeID := kafkaRequest.RoutesCreationId
+	status := CNameRecordStatusInSync
+	return &CNameRecordStatus{Id: &changeID, Status: &status}, nil
+}
+
+// InMemoryDNSProvider keeps the CNAME records in memory. It is meant to be used in tests and in environments
+// where no DNS server is available.
+type InMemoryDNSProvider struct {
+	mu      sync.RWMutex
+	records map[string]string
+}
+
+var _ DNSProvider = &InMemoryDNSProvider{}
+
+func NewInMemoryDNSProvider() *InMemoryDNSProvider {
+	return &InMemoryDNSProvider{
+		records: map[string]string{},
+	}
+}
+
+func (p *InMemoryDNSProvider) ChangeCNAMERecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*CNameRecordStatus, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, r := range routes {
+		if action != KafkaRoutesActionDelete {
+			p.records[r.Domain] = r.Router
+		} else {
+			delete(p.records, r.Domain)
+		}
+	}
+
+	changeID := api.NewID()
+	status := CNameRecordStatusInSync
+	return &CNameRecordStatus{Id: &changeID, Status: &status}, nil
+}
+
+func (p *InMemoryDNSProvider) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+	changeID := kafkaRequest.RoutesCreationId
+	status := CNameRecordStatusInSync
+	return &CNameRecordStatus{Id: &changeID, Status: &status}, nil
+}
+
+// Records returns a copy of the registered CNAME records indexed by domain
+func (p *InMemoryDNSProvider) Records() map[string]string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	records := make(map[string]string, len(p.records))
+	for domain, router := range p.records {
+		records[domain] = router
+	}
+	return records
+}
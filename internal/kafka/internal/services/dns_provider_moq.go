@@ -0,0 +1,131 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"sync"
+)
+
+// Ensure, that DNSProviderMock does implement DNSProvider.
+// If this is not the case, regenerate this file with moq.
+var _ DNSProvider = &DNSProviderMock{}
+
+// DNSProviderMock is a mock implementation of DNSProvider.
+//
+//	func TestSomethingThatUsesDNSProvider(t *testing.T) {
+//
+//		// make and configure a mocked DNSProvider
+//		mockedDNSProvider := &DNSProviderMock{
+//			ChangeCNAMERecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*CNameRecordStatus, error) {
+//				panic("mock out the ChangeCNAMERecords method")
+//			},
+//			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+//				panic("mock out the GetCNAMERecordStatus method")
+//			},
+//		}
+//
+//		// use mockedDNSProvider in code that requires DNSProvider
+//		// and then make assertions.
+//
+//	}
+type DNSProviderMock struct {
+	// ChangeCNAMERecordsFunc mocks the ChangeCNAMERecords method.
+	ChangeCNAMERecordsFunc func(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*CNameRecordStatus, error)
+
+	// GetCNAMERecordStatusFunc mocks the GetCNAMERecordStatus method.
+	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ChangeCNAMERecords holds details about calls to the ChangeCNAMERecords method.
+		ChangeCNAMERecords []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// Routes is the routes argument value.
+			Routes []dbapi.DataPlaneKafkaRoute
+			// Action is the action argument value.
+			Action KafkaRoutesAction
+		}
+		// GetCNAMERecordStatus holds details about calls to the GetCNAMERecordStatus method.
+		GetCNAMERecordStatus []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+		}
+	}
+	lockChangeCNAMERecords   sync.RWMutex
+	lockGetCNAMERecordStatus sync.RWMutex
+}
+
+// ChangeCNAMERecords calls ChangeCNAMERecordsFunc.
+func (mock *DNSProviderMock) ChangeCNAMERecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*CNameRecordStatus, error) {
+	if mock.ChangeCNAMERecordsFunc == nil {
+		panic("DNSProviderMock.ChangeCNAMERecordsFunc: method is nil but DNSProvider.ChangeCNAMERecords was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Routes       []dbapi.DataPlaneKafkaRoute
+		Action       KafkaRoutesAction
+	}{
+		KafkaRequest: kafkaRequest,
+		Routes:       routes,
+		Action:       action,
+	}
+	mock.lockChangeCNAMERecords.Lock()
+	mock.calls.ChangeCNAMERecords = append(mock.calls.ChangeCNAMERecords, callInfo)
+	mock.lockChangeCNAMERecords.Unlock()
+	return mock.ChangeCNAMERecordsFunc(kafkaRequest, routes, action)
+}
+
+// ChangeCNAMERecordsCalls gets all the calls that were made to ChangeCNAMERecords.
+// Check the length with:
+//
+//	len(mockedDNSProvider.ChangeCNAMERecordsCalls())
+func (mock *DNSProviderMock) ChangeCNAMERecordsCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	Routes       []dbapi.DataPlaneKafkaRoute
+	Action       KafkaRoutesAction
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Routes       []dbapi.DataPlaneKafkaRoute
+		Action       KafkaRoutesAction
+	}
+	mock.lockChangeCNAMERecords.RLock()
+	calls = mock.calls.ChangeCNAMERecords
+	mock.lockChangeCNAMERecords.RUnlock()
+	return calls
+}
+
+// GetCNAMERecordStatus calls GetCNAMERecordStatusFunc.
+func (mock *DNSProviderMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+	if mock.GetCNAMERecordStatusFunc == nil {
+		panic("DNSProviderMock.GetCNAMERecordStatusFunc: method is nil but DNSProvider.GetCNAMERecordStatus was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}{
+		KafkaRequest: kafkaRequest,
+	}
+	mock.lockGetCNAMERecordStatus.Lock()
+	mock.calls.GetCNAMERecordStatus = append(mock.calls.GetCNAMERecordStatus, callInfo)
+	mock.lockGetCNAMERecordStatus.Unlock()
+	return mock.GetCNAMERecordStatusFunc(kafkaRequest)
+}
+
+// GetCNAMERecordStatusCalls gets all the calls that were made to GetCNAMERecordStatus.
+// Check the length with:
+//
+//	len(mockedDNSProvider.GetCNAMERecordStatusCalls())
+func (mock *DNSProviderMock) GetCNAMERecordStatusCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}
+	mock.lockGetCNAMERecordStatus.RLock()
+	calls = mock.calls.GetCNAMERecordStatus
+	mock.lockGetCNAMERecordStatus.RUnlock()
+	return calls
+}
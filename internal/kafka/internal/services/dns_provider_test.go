@@ -0,0 +1,348 @@
+package services
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/miekg/dns"
+	"github.com/onsi/gomega"
+)
+
+func Test_NewDNSProvider(t *testing.T) {
+	tests := []struct {
+		name      string
+		dnsConfig *config.DNSConfig
+		want      DNSProvider
+	}{
+		{
+			name:      "should return the route53 dns provider",
+			dnsConfig: &config.DNSConfig{Provider: config.Route53DNSProvider},
+			want:      &route53DNSProvider{},
+		},
+		{
+			name:      "should return the rfc2136 dns provider",
+			dnsConfig: &config.DNSConfig{Provider: config.RFC2136DNSProvider},
+			want:      &rfc2136DNSProvider{},
+		},
+		{
+			name:      "should return the in-memory dns provider",
+			dnsConfig: &config.DNSConfig{Provider: config.InMemoryDNSProvider},
+			want:      &InMemoryDNSProvider{},
+		},
+		{
+			name:      "should return the local dns provider",
+			dnsConfig: &config.DNSConfig{Provider: config.LocalDNSProvider},
+			want:      &localDNSProvider{},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got := NewDNSProvider(tt.dnsConfig, &config.KafkaConfig{KafkaDomainName: "kafka.bf2.dev"}, &config.AWSConfig{}, &aws.MockClientFactory{})
+			g.Expect(got).To(gomega.BeAssignableToTypeOf(tt.want))
+		})
+	}
+}
+
+func Test_route53DNSProvider_GetCNAMERecordStatus(t *testing.T) {
+	type fields struct {
+		awsConfig        *config.AWSConfig
+		awsClientFactory aws.ClientFactory
+	}
+
+	CNAME_Id := "CNAME_Id"
+	CNAME_Status := "CNAME_Status"
+
+	awsConfig := &config.AWSConfig{}
+	awsConfig.Route53.AccessKey = "Route53AccessKey"
+	awsConfig.Route53.SecretAccessKey = "Route53SecretAccessKey"
+
+	type args struct {
+		kafkaRequest *dbapi.KafkaRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *CNameRecordStatus
+		wantErr bool
+	}{
+		{
+			name: "should get the CNAME record Status",
+			fields: fields{
+				awsConfig: awsConfig,
+				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
+					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
+						return &route53.GetChangeOutput{
+							ChangeInfo: &route53.ChangeInfo{
+								Id:     &CNAME_Id,
+								Status: &CNAME_Status,
+							},
+						}, nil
+					},
+				}),
+			},
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region:        "us-east-1",
+					CloudProvider: cloudproviders.AWS.String(),
+				},
+			},
+			want: &CNameRecordStatus{
+				Id:     &CNAME_Id,
+				Status: &CNAME_Status,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return error when it fails to get CNAME status",
+			fields: fields{
+				awsConfig: awsConfig,
+				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
+					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
+						return nil, errors.GeneralError("unable to CNAME record status")
+					},
+				}),
+			},
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region: "us-east-1",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			p := &route53DNSProvider{
+				awsConfig:        tt.fields.awsConfig,
+				awsClientFactory: tt.fields.awsClientFactory,
+			}
+			got, err := p.GetCNAMERecordStatus(tt.args.kafkaRequest)
+			g.Expect(got).To(gomega.Equal(tt.want))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_getRoute53RegionFromKafkaRequest(t *testing.T) {
+
+	type args struct {
+		kafkaRequest *dbapi.KafkaRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Route53 region is correctly returned for Kafka instances in AWS",
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region:        "anotherregion",
+					CloudProvider: cloudproviders.AWS.String(),
+				},
+			},
+			want:    aws.DefaultAWSRoute53Region,
+			wantErr: false,
+		},
+		{
+			name: "Route53 region is correctly returned for Kafka instances in GCP",
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region:        "anotherregiontwo",
+					CloudProvider: cloudproviders.GCP.String(),
+				},
+			},
+			want:    aws.DefaultGCPRoute53Region,
+			wantErr: false,
+		},
+		{
+			name: "An error is returned if the Kafka instance has an unknown cloud provider",
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region:        "us-east-1",
+					CloudProvider: "anunknowncloudprovider",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			res, err := getRoute53RegionFromKafkaRequest(tt.args.kafkaRequest)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(res).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_buildRFC2136UpdateMessage(t *testing.T) {
+	routes := []dbapi.DataPlaneKafkaRoute{
+		{Domain: "admin-server-kafka-id.kafka.bf2.dev", Router: "router.cluster.example.com"},
+	}
+
+	tests := []struct {
+		name       string
+		action     KafkaRoutesAction
+		wantUpdate []string
+	}{
+		{
+			name:   "should replace the CNAME records of the routes when creating them",
+			action: KafkaRoutesActionCreate,
+			wantUpdate: []string{
+				"admin-server-kafka-id.kafka.bf2.dev.\t0\tCLASS255\tCNAME\t",
+				"admin-server-kafka-id.kafka.bf2.dev.\t300\tIN\tCNAME\trouter.cluster.example.com.",
+			},
+		},
+		{
+			name:   "should remove the CNAME records of the routes when deleting them",
+			action: KafkaRoutesActionDelete,
+			wantUpdate: []string{
+				"admin-server-kafka-id.kafka.bf2.dev.\t0\tCLASS255\tCNAME\t",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			msg := buildRFC2136UpdateMessage("kafka.bf2.dev", routes, tt.action, 300)
+
+			g.Expect(msg.Opcode).To(gomega.Equal(dns.OpcodeUpdate))
+			g.Expect(msg.Question).To(gomega.Equal([]dns.Question{{Name: "kafka.bf2.dev.", Qtype: dns.TypeSOA, Qclass: dns.ClassINET}}))
+			update := []string{}
+			for _, rr := range msg.Ns {
+				update = append(update, rr.String())
+			}
+			g.Expect(update).To(gomega.Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func Test_rfc2136DNSProvider_ChangeCNAMERecords(t *testing.T) {
+	tsigKeyName := "kas-fleet-manager."
+	tsigSecret := "c2VjcmV0LXVzZWQtdG8tc2lnbi10aGUtdXBkYXRlcw=="
+
+	tests := []struct {
+		name    string
+		rcode   int
+		keyName string
+		wantErr bool
+	}{
+		{
+			name:    "should send the signed dynamic update to the nameserver",
+			rcode:   dns.RcodeSuccess,
+			keyName: tsigKeyName,
+			wantErr: false,
+		},
+		{
+			name:    "should return an error when the nameserver refuses the dynamic update",
+			rcode:   dns.RcodeRefused,
+			keyName: tsigKeyName,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when the dynamic update is signed with an unknown key",
+			rcode:   dns.RcodeSuccess,
+			keyName: "unknown-key.",
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			var received *dns.Msg
+			started := make(chan struct{})
+			server := &dns.Server{
+				Listener:          listener,
+				NotifyStartedFunc: func() { close(started) },
+				MsgAcceptFunc:     func(dh dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+				TsigSecret:        map[string]string{tsigKeyName: tsigSecret},
+				Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+					reply := new(dns.Msg)
+					reply.SetRcode(r, tt.rcode)
+					if w.TsigStatus() != nil {
+						reply.SetRcode(r, dns.RcodeNotAuth)
+					} else {
+						received = r
+					}
+					reply.SetTsig(tsigKeyName, dns.HmacSHA256, 300, time.Now().Unix())
+					_ = w.WriteMsg(reply)
+				}),
+			}
+			go func() { _ = server.ActivateAndServe() }()
+			<-started
+			defer func() { _ = server.Shutdown() }()
+
+			p := NewRFC2136DNSProvider(config.RFC2136DNSConfig{
+				Nameserver:    listener.Addr().String(),
+				TSIGKeyName:   tt.keyName,
+				TSIGAlgorithm: dns.HmacSHA256,
+				TSIGSecret:    tsigSecret,
+				TTL:           300,
+				Timeout:       5 * time.Second,
+			}, "kafka.bf2.dev")
+
+			got, err := p.ChangeCNAMERecords(&dbapi.KafkaRequest{}, []dbapi.DataPlaneKafkaRoute{
+				{Domain: "kafka-id.kafka.bf2.dev", Router: "router.cluster.example.com"},
+			}, KafkaRoutesActionCreate)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr), "%v", err)
+			if !tt.wantErr {
+				g.Expect(got.IsInSync()).To(gomega.BeTrue())
+				g.Expect(received).NotTo(gomega.BeNil())
+				g.Expect(received.Ns).To(gomega.HaveLen(2))
+			}
+		})
+	}
+}
+
+func Test_InMemoryDNSProvider(t *testing.T) {
+	g := gomega.NewWithT(t)
+	routes := []dbapi.DataPlaneKafkaRoute{
+		{Domain: "kafka-id.kafka.bf2.dev", Router: "router.cluster.example.com"},
+		{Domain: "admin-server-kafka-id.kafka.bf2.dev", Router: "router.cluster.example.com"},
+	}
+	p := NewInMemoryDNSProvider()
+
+	status, err := p.ChangeCNAMERecords(&dbapi.KafkaRequest{}, routes, KafkaRoutesActionCreate)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.IsInSync()).To(gomega.BeTrue())
+	g.Expect(p.Records()).To(gomega.Equal(map[string]string{
+		"kafka-id.kafka.bf2.dev":              "router.cluster.example.com",
+		"admin-server-kafka-id.kafka.bf2.dev": "router.cluster.example.com",
+	}))
+
+	status, err = p.GetCNAMERecordStatus(&dbapi.KafkaRequest{RoutesCreationId: *status.Id})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.IsInSync()).To(gomega.BeTrue())
+
+	_, err = p.ChangeCNAMERecords(&dbapi.KafkaRequest{}, routes[:1], KafkaRoutesActionDelete)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(p.Records()).To(gomega.Equal(map[string]string{
+		"admin-server-kafka-id.kafka.bf2.dev": "router.cluster.example.com",
+	}))
+}
@@ -16,7 +16,6 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services/kafkatlscertmgmt"
@@ -34,10 +33,8 @@ import (
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
@@ -54,6 +51,13 @@ var kafkaManagedCRStatuses = []string{
 	constants.KafkaRequestStatusResuming.String(),
 }
 
+// kafkaResizableStatuses are the statuses in which a kafka can be resized
+var kafkaResizableStatuses = []string{
+	constants.KafkaRequestStatusAccepted.String(),
+	constants.KafkaRequestStatusPreparing.String(),
+	constants.KafkaRequestStatusReady.String(),
+}
+
 var statusesOfKafkaThatAreNotActive = []string{
 	constants.KafkaRequestStatusDeleting.String(),
 	constants.KafkaRequestStatusDeprovision.String(),
@@ -69,14 +73,16 @@ func (a KafkaRoutesAction) String() string {
 const (
 	KafkaRoutesActionCreate KafkaRoutesAction = "CREATE"
 	KafkaRoutesActionDelete KafkaRoutesAction = "DELETE"
+	// KafkaRoutesActionUpsert creates the records or replaces the existing ones, e.g. to point them to another data plane cluster
+	KafkaRoutesActionUpsert KafkaRoutesAction = "UPSERT"
 )
 
 const CanaryServiceAccountPrefix = "canary"
 
-type CNameRecordStatus struct {
-	Id     *string
-	Status *string
-}
+// KafkaStatusChangedSignal is the type of the outbox events recorded every time the status of a kafka changes,
+// and so the name of the signal that is notified on the signal bus once the change is committed.
+// The events are recorded by a trigger of the kafka_requests table, their resource version is the resource version of the kafka.
+const KafkaStatusChangedSignal = "kafka.status_changed"
 
 //go:generate moq -out kafkaservice_moq.go . KafkaService
 type KafkaService interface {
@@ -86,6 +92,7 @@ type KafkaService interface {
 	PrepareKafkaRequest(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
 	// Get method will retrieve the kafkaRequest instance that the give ctx has access to from the database.
 	// This should be used when you want to make sure the result is filtered based on the request context.
+	// The role of the user of the context on the kafka is set on the returned kafkaRequest.
 	Get(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError)
 	// GetByID method will retrieve the KafkaRequest instance from the database without checking any permissions.
 	// You should only use this if you are sure permission check is not required.
@@ -93,7 +100,13 @@ type KafkaService interface {
 	// Delete cleans up all dependencies for a Kafka request and soft deletes the Kafka Request record from the database.
 	// The Kafka Request in the database will be updated with a deleted_at timestamp.
 	Delete(*dbapi.KafkaRequest) *errors.ServiceError
+	// List returns the kafkas that the given ctx has access to, with the role of the user of the context on each of them
 	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError)
+	// ListChanges returns the kafkas that the given ctx has access to and whose resource version is greater than gtVersion, ordered by resource version.
+	// Only the changes of the transactions that are over are returned, so that no change with a lower resource version can be committed afterwards.
+	// A gtVersion that has not been reached yet is ignored. The soft deleted kafkas are returned when includeDeleted is set so that their
+	// deletion can be reported. If ids are given, only the kafkas with those ids are returned.
+	ListChanges(ctx context.Context, gtVersion int64, includeDeleted bool, ids ...string) (dbapi.KafkaList, *errors.ServiceError)
 	// Lists all kafkas. As this returns all Kafka requests without need for authentication, this should only be used for internal purposes
 	ListAll() (dbapi.KafkaList, *errors.ServiceError)
 	ListKafkasToBePromoted() ([]*dbapi.KafkaRequest, *errors.ServiceError)
@@ -121,7 +134,13 @@ type KafkaService interface {
 	// Use this only when you want to update the multiple columns that may contain zero-fields, otherwise use the `KafkaService.Update()` method.
 	// See https://gorm.io/docs/update.html#Updates-multiple-columns for more info
 	Updates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError
-	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
+	// Resize changes the size of the given kafka to the size with the given id. The quota reserved for the kafka is updated
+	// to the new size, and its data plane cluster has to be able to host the streaming units it consumes in addition.
+	// When its current cluster cannot, a kafka that has not been provisioned yet is moved to another data plane cluster
+	// and a ready kafka is migrated to another data plane cluster.
+	Resize(kafkaRequest *dbapi.KafkaRequest, sizeId string) *errors.ServiceError
+	// ChangeKafkaCNAMErecords creates or deletes the CNAME records of the routes of the given kafka through the configured DNS provider
+	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*CNameRecordStatus, *errors.ServiceError)
 	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
 	AssignInstanceType(owner string, organisationID string) (types.KafkaInstanceType, *errors.ServiceError)
 	RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
@@ -156,36 +175,40 @@ type kafkaService struct {
 	clusterService                       ClusterService
 	keycloakService                      sso.KeycloakService
 	kafkaConfig                          *config.KafkaConfig
-	awsConfig                            *config.AWSConfig
 	quotaServiceFactory                  QuotaServiceFactory
 	mu                                   sync.Mutex
-	awsClientFactory                     aws.ClientFactory
 	authService                          authorization.Authorization
 	dataplaneClusterConfig               *config.DataplaneClusterConfig
 	providerConfig                       *config.ProviderConfig
 	clusterPlacementStrategy             ClusterPlacementStrategy
 	kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService
+	kafkaMaintenanceWindowService        KafkaMaintenanceWindowService
+	dnsProvider                          DNSProvider
+	roleBindingService                   KafkaRoleBindingService
 }
 
 func NewKafkaService(
 	connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService,
-	kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig,
-	quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization,
+	kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig,
+	quotaServiceFactory QuotaServiceFactory, authorizationService authorization.Authorization,
 	providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy,
-	kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService) *kafkaService {
+	kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService,
+	kafkaMaintenanceWindowService KafkaMaintenanceWindowService, dnsProvider DNSProvider,
+	roleBindingService KafkaRoleBindingService) *kafkaService {
 	return &kafkaService{
 		connectionFactory:                    connectionFactory,
 		clusterService:                       clusterService,
 		keycloakService:                      keycloakService,
 		kafkaConfig:                          kafkaConfig,
-		awsConfig:                            awsConfig,
 		quotaServiceFactory:                  quotaServiceFactory,
-		awsClientFactory:                     awsClientFactory,
 		authService:                          authorizationService,
 		dataplaneClusterConfig:               dataplaneClusterConfig,
 		providerConfig:                       providerConfig,
 		clusterPlacementStrategy:             clusterPlacementStrategy,
 		kafkaTLSCertificateManagementService: kafkaTLSCertificateManagementService,
+		kafkaMaintenanceWindowService:        kafkaMaintenanceWindowService,
+		dnsProvider:                          dnsProvider,
+		roleBindingService:                   roleBindingService,
 	}
 }
 
@@ -260,7 +283,7 @@ func (k *kafkaService) GetAvailableSizesInRegion(criteria *FindClusterCriteria)
 		return nil, err
 	}
 
-	supportedInstanceTypes := k.kafkaConfig.SupportedInstanceTypes.Configuration
+	supportedInstanceTypes := k.kafkaConfig.GetSupportedInstanceTypes()
 	instanceType, err := supportedInstanceTypes.GetKafkaInstanceTypeByID(criteria.SupportedInstanceType)
 	if err != nil {
 		err := errors.InstanceTypeNotSupported("unable to get available sizes in region: %s", err.Error())
@@ -321,7 +344,7 @@ func (k *kafkaService) AssignInstanceType(owner string, organisationId string) (
 		return "", errors.NewWithCause(errors.ErrorGeneral, factoryErr, "unable to check quota")
 	}
 
-	for _, instanceType := range k.kafkaConfig.SupportedInstanceTypes.Configuration.SupportedKafkaInstanceTypes {
+	for _, instanceType := range k.kafkaConfig.GetSupportedInstanceTypes().SupportedKafkaInstanceTypes {
 		if instanceType.Id == types.DEVELOPER.String() {
 			continue
 		}
@@ -342,7 +365,7 @@ func (k *kafkaService) AssignInstanceType(owner string, organisationId string) (
 // reserveQuota - reserves quota for the given kafka request. If a RHOSAK quota has been assigned, it will try to reserve RHOSAK quota, otherwise it will try with RHOSAKTrial
 func (k *kafkaService) reserveQuota(kafkaRequest *dbapi.KafkaRequest) (subscriptionId string, err *errors.ServiceError) {
 	if kafkaRequest.InstanceType == types.DEVELOPER.String() {
-		instType, err := k.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+		instType, err := k.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
 
 		if err != nil {
 			return "", errors.NewWithCause(errors.ErrorGeneral, err, "unable to reserve quota")
@@ -447,7 +470,7 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 	kafkaRequest.Status = constants.KafkaRequestStatusAccepted.String()
 
 	// when creating new kafka - default storage size is assigned
-	instanceType, instanceTypeErr := k.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+	instanceType, instanceTypeErr := k.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
 	if instanceTypeErr != nil {
 		return errors.InstanceTypeNotSupported(instanceTypeErr.Error())
 	}
@@ -654,6 +677,10 @@ func (k *kafkaService) Get(ctx context.Context, id string) (*dbapi.KafkaRequest,
 		}
 		return nil, services.HandleGetError(resourceTypeStr, "id", id, err)
 	}
+
+	if err := k.setUserRoles(ctx, &kafkaRequest); err != nil {
+		return nil, err
+	}
 	return &kafkaRequest, nil
 }
 
@@ -689,6 +716,10 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 	} else if claims.IsOrgAdmin() {
 		orgId, _ := claims.GetOrgId()
 		dbConn = dbConn.Where("id = ?", id).Where("organisation_id = ?", orgId)
+	} else if auth.GetFilterByOrganisationFromContext(ctx) {
+		// the kafka can also be deleted by the members of its organisation who have been bound the owner role on it
+		orgId, _ := claims.GetOrgId()
+		dbConn = dbConn.Where("id = ?", id).Where("organisation_id = ?", orgId)
 	} else {
 		user, _ := claims.GetUsername()
 		dbConn = dbConn.Where("id = ?", id).Where("owner = ? ", user)
@@ -698,6 +729,13 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 	if err := dbConn.First(&kafkaRequest).Error; err != nil {
 		return services.HandleGetError("KafkaResource", "id", id, err)
 	}
+
+	if err := k.setUserRoles(ctx, &kafkaRequest); err != nil {
+		return err
+	}
+	if !kafkaRequest.Role.Includes(dbapi.KafkaRoleOwner) {
+		return errors.New(errors.ErrorUnauthorized, "user not authorized to delete kafka %q", id)
+	}
 	metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationDeprovision)
 
 	deprovisionStatus := constants.KafkaRequestStatusDeprovision
@@ -923,22 +961,140 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 		return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka requests")
 	}
 
+	if err := k.setUserRoles(ctx, kafkaRequestList...); err != nil {
+		return nil, nil, err
+	}
+
 	return kafkaRequestList, pagingMeta, nil
 }
 
+// setUserRoles sets the role of the user of the context on the given kafkas. Admins, the admins of the organisation of
+// a kafka and its owner are owners of the kafka. The other members of its organisation have the role bound to them on
+// the kafka, if any, and are viewers otherwise.
+func (k *kafkaService) setUserRoles(ctx context.Context, kafkas ...*dbapi.KafkaRequest) *errors.ServiceError {
+	if auth.GetIsAdminFromContext(ctx) {
+		for _, kafka := range kafkas {
+			kafka.Role = dbapi.KafkaRoleOwner
+		}
+		return nil
+	}
+
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	user, _ := claims.GetUsername()
+	orgId, _ := claims.GetOrgId()
+	isOrgMember := func(kafka *dbapi.KafkaRequest) bool {
+		return orgId != "" && kafka.OrganisationId == orgId
+	}
+
+	var boundKafkaIDs []string
+	for _, kafka := range kafkas {
+		kafka.Role = ""
+		if (user != "" && kafka.Owner == user) || (claims.IsOrgAdmin() && isOrgMember(kafka)) {
+			kafka.Role = dbapi.KafkaRoleOwner
+		} else if isOrgMember(kafka) {
+			boundKafkaIDs = append(boundKafkaIDs, kafka.ID)
+		}
+	}
+	if len(boundKafkaIDs) == 0 {
+		return nil
+	}
+
+	roles, svcErr := k.roleBindingService.GetRoles(user, boundKafkaIDs...)
+	if svcErr != nil {
+		return svcErr
+	}
+	for _, kafka := range kafkas {
+		if kafka.Role != "" || !isOrgMember(kafka) {
+			continue
+		}
+		if role, ok := roles[kafka.ID]; ok {
+			kafka.Role = role
+		} else {
+			kafka.Role = dbapi.KafkaRoleViewer
+		}
+	}
+	return nil
+}
+
+func (k *kafkaService) ListChanges(ctx context.Context, gtVersion int64, includeDeleted bool, ids ...string) (dbapi.KafkaList, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+
+	// the resource version of a kafka is the id of the transaction that changed it last: the transactions older than
+	// the oldest running one are over, so none of them can commit a change below this high-water mark anymore
+	var highWater int64
+	if err := k.connectionFactory.New().Raw("SELECT txid_snapshot_xmin(txid_current_snapshot()) AS high_water").Scan(&highWater).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the kafka request changes high-water mark")
+	}
+	if gtVersion >= highWater {
+		// the version has not been handed out yet, list the kafkas from the start
+		gtVersion = 0
+		includeDeleted = false
+	}
+
+	dbConn := k.connectionFactory.New().Where("resource_version > ? AND resource_version < ?", gtVersion, highWater)
+	if includeDeleted {
+		// soft deleting a kafka bumps its resource version, the deleted kafkas are listed so that watchers see their deletion
+		dbConn = dbConn.Unscoped()
+	}
+
+	if !auth.GetIsAdminFromContext(ctx) {
+		user, _ := claims.GetUsername()
+		if user == "" {
+			return nil, errors.Unauthenticated("user not authenticated")
+		}
+
+		// filter by organisationId if a user is part of an organisation and is not allowed as a service account
+		if auth.GetFilterByOrganisationFromContext(ctx) {
+			orgId, _ := claims.GetOrgId()
+			dbConn = dbConn.Where("organisation_id = ?", orgId)
+		} else {
+			dbConn = dbConn.Where("owner = ?", user)
+		}
+	}
+
+	if len(ids) > 0 {
+		dbConn = dbConn.Where("id IN (?)", ids)
+	}
+
+	var kafkaRequestList dbapi.KafkaList
+	if err := dbConn.Order("resource_version, id").Find(&kafkaRequestList).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka request changes")
+	}
+
+	return kafkaRequestList, nil
+}
+
 func (k *kafkaService) GetManagedKafkaByClusterID(clusterID string) ([]managedkafka.ManagedKafka, *errors.ServiceError) {
+	// kafkas being migrated are also deployed on the source or target cluster of their migration
 	dbConn := k.connectionFactory.New().
-		Where("cluster_id = ?", clusterID).
+		Where("cluster_id = ? OR migration_source_cluster_id = ? OR migration_target_cluster_id = ?", clusterID, clusterID, clusterID).
 		Where("status IN (?)", kafkaManagedCRStatuses).
 		Where("bootstrap_server_host != ''")
 
-	var kafkaRequestList dbapi.KafkaList
-	if err := dbConn.Find(&kafkaRequestList).Error; err != nil {
+	var kafkaRequests dbapi.KafkaList
+	if err := dbConn.Find(&kafkaRequests).Error; err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka requests")
 	}
 
+	kafkaRequestList := arrays.Filter(kafkaRequests, func(kafkaRequest *dbapi.KafkaRequest) bool {
+		deployed, _ := kafkaRequest.GetPlacementOnCluster(clusterID)
+		return deployed
+	})
+
 	enableKafkaExternalCertificate := k.kafkaTLSCertificateManagementService.IsKafkaExternalCertificateEnabled()
 
+	maintenanceWindows, windowsErr := k.kafkaMaintenanceWindowService.ListByKafkas(kafkaRequestList)
+	if windowsErr != nil {
+		return nil, windowsErr
+	}
+	now := time.Now()
+
 	var res []managedkafka.ManagedKafka
 	// convert kafka requests to managed kafka
 	for _, kafkaRequest := range kafkaRequestList {
@@ -959,10 +1115,19 @@ func (k *kafkaService) GetManagedKafkaByClusterID(clusterID string) ([]managedka
 
 		}
 
-		mk, err := buildManagedKafkaCR(kafkaRequest, k.kafkaConfig, k.keycloakService, certificate, enableKafkaExternalCertificate)
+		// upgrades can only start when the maintenance window of the kafka, if any, is open
+		holdBackUpgrade := false
+		if window, ok := maintenanceWindows[kafkaRequest.ID]; ok {
+			holdBackUpgrade = kafkaRequest.HasPendingUpgrade() && !window.IsOpen(now)
+		}
+
+		mk, err := buildManagedKafkaCR(kafkaRequest, k.kafkaConfig, k.keycloakService, certificate, enableKafkaExternalCertificate, holdBackUpgrade)
 		if err != nil {
 			return nil, err
 		}
+		if _, deleted := kafkaRequest.GetPlacementOnCluster(clusterID); deleted {
+			mk.Spec.Deleted = true
+		}
 
 		res = append(res, *mk)
 	}
@@ -995,7 +1160,7 @@ func (k *kafkaService) GenerateReservedManagedKafkasByClusterID(clusterID string
 	supportedInstanceTypes := cluster.GetSupportedInstanceTypes()
 
 	for _, supportedInstanceType := range supportedInstanceTypes {
-		instanceTypeDynamicScalingConfig, ok := k.dataplaneClusterConfig.NodePrewarmingConfig.ForInstanceType(supportedInstanceType)
+		instanceTypeDynamicScalingConfig, ok := k.dataplaneClusterConfig.GetNodePrewarmingConfig().ForInstanceType(supportedInstanceType)
 		if !ok {
 			continue
 		}
@@ -1039,6 +1204,188 @@ func (k *kafkaService) Updates(kafkaRequest *dbapi.KafkaRequest, fields map[stri
 	return nil
 }
 
+func (k *kafkaService) Resize(kafkaRequest *dbapi.KafkaRequest, sizeId string) *errors.ServiceError {
+	if kafkaRequest.SizeId == sizeId {
+		return nil
+	}
+
+	if !arrays.Contains(kafkaResizableStatuses, kafkaRequest.Status) {
+		return errors.BadRequest("kafka %q cannot be resized while in %q status", kafkaRequest.ID, kafkaRequest.Status)
+	}
+	if kafkaRequest.MigrationStatus.InProgress() {
+		return errors.BadRequest("kafka %q cannot be resized while it is migrated to cluster %q", kafkaRequest.ID, kafkaRequest.MigrationTargetClusterID)
+	}
+
+	currentSize, sizeErr := k.kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, kafkaRequest.SizeId)
+	if sizeErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, sizeErr, "failed to get the current size of kafka %q", kafkaRequest.ID)
+	}
+	size, sizeErr := k.kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, sizeId)
+	if sizeErr != nil {
+		return errors.InstancePlanNotSupported("unsupported size %q for instance type %q", sizeId, kafkaRequest.InstanceType)
+	}
+
+	// capacity and quota checks must not run concurrently with the ones of kafka registrations
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	resizedKafka := *kafkaRequest
+	resizedKafka.SizeId = size.Id
+	resizedKafka.MaxDataRetentionSize = size.MaxDataRetentionSize.String()
+	values := map[string]interface{}{
+		"size_id":                 resizedKafka.SizeId,
+		"max_data_retention_size": resizedKafka.MaxDataRetentionSize,
+	}
+
+	// a kafka without a data plane cluster will be placed with its new size once accepted
+	if resizedKafka.ClusterID != "" {
+		// the current size of the kafka is already consumed on its cluster
+		hasCapacity, err := k.hasCapacityToResizeOnCurrentCluster(kafkaRequest, size.CapacityConsumed-currentSize.CapacityConsumed)
+		if err != nil {
+			return err
+		}
+		if !hasCapacity {
+			if err := k.moveToResize(&resizedKafka, values); err != nil {
+				return err
+			}
+		}
+	}
+
+	quotaService, factoryErr := k.quotaServiceFactory.GetQuotaService(api.QuotaType(k.kafkaConfig.Quota.Type))
+	if factoryErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, factoryErr, "unable to check quota")
+	}
+
+	currentKafka := *kafkaRequest
+	subscriptionId, err := quotaService.UpdateQuota(&currentKafka, size.Id)
+	if err != nil {
+		// the quota of the current size may have been reserved again under another subscription
+		k.updateSubscriptionId(kafkaRequest, currentKafka.SubscriptionId)
+		return err
+	}
+	if subscriptionId != "" {
+		resizedKafka.SubscriptionId = subscriptionId
+	}
+	values["subscription_id"] = resizedKafka.SubscriptionId
+
+	if err := k.Updates(&resizedKafka, values); err != nil {
+		// the kafka keeps its current size, the quota of the new size is given back for the one of the current size
+		restoredKafka := resizedKafka
+		restoredSubscriptionId, restoreErr := quotaService.UpdateQuota(&restoredKafka, kafkaRequest.SizeId)
+		if restoreErr != nil {
+			logger.Logger.Errorf("failed to restore the quota of size %q of kafka %q: %v", kafkaRequest.SizeId, kafkaRequest.ID, restoreErr)
+		}
+		if restoredSubscriptionId == "" {
+			restoredSubscriptionId = restoredKafka.SubscriptionId
+		}
+		k.updateSubscriptionId(kafkaRequest, restoredSubscriptionId)
+		return err
+	}
+
+	*kafkaRequest = resizedKafka
+
+	return nil
+}
+
+// updateSubscriptionId records the subscription the quota of the kafka is reserved under when it has changed. A failure
+// is only logged, as the error of the operation that changed the subscription is the one returned
+func (k *kafkaService) updateSubscriptionId(kafkaRequest *dbapi.KafkaRequest, subscriptionId string) {
+	if subscriptionId == kafkaRequest.SubscriptionId {
+		return
+	}
+	if err := k.Updates(kafkaRequest, map[string]interface{}{"subscription_id": subscriptionId}); err != nil {
+		logger.Logger.Errorf("failed to update the subscription of kafka %q to %q: %v", kafkaRequest.ID, subscriptionId, err)
+		return
+	}
+	kafkaRequest.SubscriptionId = subscriptionId
+}
+
+// hasCapacityToResizeOnCurrentCluster returns whether the data plane cluster of the kafka can host the streaming units
+// the kafka consumes in addition once resized
+func (k *kafkaService) hasCapacityToResizeOnCurrentCluster(kafkaRequest *dbapi.KafkaRequest, additionalStreamingUnits int) (bool, *errors.ServiceError) {
+	if additionalStreamingUnits <= 0 {
+		return true, nil
+	}
+
+	cluster, err := k.clusterService.FindClusterByID(kafkaRequest.ClusterID)
+	if err != nil {
+		return false, errors.NewWithCause(err.Code, err, "failed to find the cluster of kafka %q", kafkaRequest.ID)
+	}
+	if cluster == nil || cluster.Status != api.ClusterReady || cluster.Cordoned {
+		return false, nil
+	}
+
+	// the capacity of an enterprise cluster is always its dynamic capacity, whatever the scaling type
+	if cluster.ClusterType == api.EnterpriseDataPlaneClusterType.String() {
+		streamingUnitCounts, countErr := k.clusterService.ComputeConsumedStreamingUnitCountPerInstanceType(cluster.ClusterID)
+		if countErr != nil {
+			return false, errors.NewWithCause(errors.ErrorGeneral, countErr, "failed to count the streaming units of cluster %q", cluster.ClusterID)
+		}
+		maxStreamingUnits := int64(cluster.RetrieveDynamicCapacityInfo()[kafkaRequest.InstanceType].MaxUnits)
+		usedStreamingUnits := streamingUnitCounts[types.KafkaInstanceType(kafkaRequest.InstanceType)]
+		return maxStreamingUnits-(usedStreamingUnits+int64(additionalStreamingUnits)) >= 0, nil
+	}
+
+	streamingUnitCountPerClusterList, countErr := k.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if countErr != nil {
+		return false, errors.NewWithCause(errors.ErrorGeneral, countErr, "failed to count the streaming units of cluster %q", cluster.ClusterID)
+	}
+	placement := loadAwarePlacement{
+		dataplaneClusterConfig: k.dataplaneClusterConfig,
+		clusterService:         k.clusterService,
+		kafkaConfig:            k.kafkaConfig,
+	}
+	_, canHostKafka := placement.computeClusterLoad(cluster, kafkaRequest, &config.KafkaInstanceSize{CapacityConsumed: additionalStreamingUnits}, streamingUnitCountPerClusterList)
+	return canHostKafka, nil
+}
+
+// moveToResize finds another data plane cluster able to host the resized kafka, as its current cluster cannot. A kafka
+// that is not in the data plane yet is assigned to the other cluster, while a ready kafka is migrated to it.
+func (k *kafkaService) moveToResize(resizedKafka *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+	cannotResizeErr := errors.TooManyKafkaInstancesReached(fmt.Sprintf("cluster %q cannot accept the %q size for kafka %q at this moment", resizedKafka.ClusterID, resizedKafka.SizeId, resizedKafka.ID))
+	// an enterprise kafka stays on the cluster it was created on
+	if resizedKafka.DesiredBillingModelIsEnterprise() {
+		return cannotResizeErr
+	}
+	isInDataPlane := arrays.Contains(kafkaManagedCRStatuses, resizedKafka.Status)
+	if isInDataPlane && getKafkaMigrationRefusal(resizedKafka) != "" {
+		return cannotResizeErr
+	}
+
+	cluster, err := k.findADataPlaneClusterToPlaceTheKafka(resizedKafka)
+	if err != nil {
+		return err
+	}
+	if cluster.ClusterID == resizedKafka.ClusterID {
+		return cannotResizeErr
+	}
+
+	if !isInDataPlane {
+		resizedKafka.ClusterID = cluster.ClusterID
+		values["cluster_id"] = resizedKafka.ClusterID
+		return nil
+	}
+
+	if available, versionErr := isStrimziVersionAvailable(cluster, resizedKafka.DesiredStrimziVersion); versionErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, versionErr, "failed to get the strimzi versions of cluster %q", cluster.ClusterID)
+	} else if !available {
+		return cannotResizeErr
+	}
+
+	logger.Logger.Infof("migrating kafka %q from cluster %q to cluster %q to resize it to size %q", resizedKafka.ID, resizedKafka.ClusterID, cluster.ClusterID, resizedKafka.SizeId)
+	resizedKafka.MigrationStatus = dbapi.KafkaMigrationStatusProvisioning
+	resizedKafka.MigrationSourceClusterID = resizedKafka.ClusterID
+	resizedKafka.MigrationTargetClusterID = cluster.ClusterID
+	resizedKafka.MigrationRoutes = nil
+	resizedKafka.MigrationDetails = ""
+	values["migration_status"] = resizedKafka.MigrationStatus.String()
+	values["migration_source_cluster_id"] = resizedKafka.MigrationSourceClusterID
+	values["migration_target_cluster_id"] = resizedKafka.MigrationTargetClusterID
+	values["migration_routes"] = nil
+	values["migration_details"] = ""
+	return nil
+}
+
 func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 	if !auth.GetIsAdminFromContext(ctx) {
 		return errors.New(errors.ErrorUnauthenticated, "user not authenticated")
@@ -1066,18 +1413,19 @@ func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaReque
 func (k *kafkaService) UpdateStatus(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
 	dbConn := k.connectionFactory.New()
 
-	if kafka, err := k.GetByID(id); err != nil {
+	kafka, err := k.GetByID(id)
+	if err != nil {
 		return true, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status")
-	} else {
-		// only allow to change the status to "deleting" if the cluster is already in "deprovision" status
-		if kafka.Status == constants.KafkaRequestStatusDeprovision.String() && status != constants.KafkaRequestStatusDeleting {
-			return false, errors.GeneralError("failed to update status: cluster is deprovisioning")
-		}
+	}
 
-		if kafka.Status == status.String() {
-			// no update needed
-			return false, errors.GeneralError("failed to update status: the cluster %s is already in %s state", id, status.String())
-		}
+	// only allow to change the status to "deleting" if the cluster is already in "deprovision" status
+	if kafka.Status == constants.KafkaRequestStatusDeprovision.String() && status != constants.KafkaRequestStatusDeleting {
+		return false, errors.GeneralError("failed to update status: cluster is deprovisioning")
+	}
+
+	if kafka.Status == status.String() {
+		// no update needed
+		return false, errors.GeneralError("failed to update status: the cluster %s is already in %s state", id, status.String())
 	}
 
 	if err := dbConn.Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: id}}).Update("status", status).Error; err != nil {
@@ -1087,62 +1435,22 @@ func (k *kafkaService) UpdateStatus(id string, status constants.KafkaStatus) (bo
 	return true, nil
 }
 
-func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*CNameRecordStatus, *errors.ServiceError) {
 	routes, err := kafkaRequest.GetRoutes()
 	if routes == nil || err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes")
 	}
 
-	domainRecordBatch := buildKafkaClusterCNAMESRecordBatch(routes, action)
-
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53.AccessKey,
-		SecretAccessKey: k.awsConfig.Route53.SecretAccessKey,
-	}
-
-	route53Region, err := k.getRoute53RegionFromKafkaRequest(kafkaRequest)
+	recordStatus, err := k.dnsProvider.ChangeCNAMERecords(kafkaRequest, routes, action)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "error getting route 53 region from kafka request")
+		return nil, errors.ToServiceError(err)
 	}
 
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, route53Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create aws client")
-	}
-
-	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(k.kafkaConfig.KafkaDomainName, domainRecordBatch)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create domain record sets")
-	}
-
-	return changeRecordsOutput, nil
+	return recordStatus, nil
 }
 
 func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53.AccessKey,
-		SecretAccessKey: k.awsConfig.Route53.SecretAccessKey,
-	}
-
-	route53Region, err := k.getRoute53RegionFromKafkaRequest(kafkaRequest)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "error getting route 53 region from kafka request")
-	}
-
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, route53Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create aws client")
-	}
-
-	changeOutput, err := awsClient.GetChange(kafkaRequest.RoutesCreationId)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get status of Route53 change batch request with ID %q", kafkaRequest.RoutesCreationId)
-	}
-
-	return &CNameRecordStatus{
-		Id:     changeOutput.ChangeInfo.Id,
-		Status: changeOutput.ChangeInfo.Status,
-	}, nil
+	return k.dnsProvider.GetCNAMERecordStatus(kafkaRequest)
 }
 
 type KafkaStatusCount struct {
@@ -1206,9 +1514,26 @@ func (k *kafkaService) ListKafkasWithRoutesNotCreated() ([]*dbapi.KafkaRequest,
 	return results, nil
 }
 
+// buildManagedKafkaVersions returns the versions to be set in the ManagedKafka CR. When the upgrade is held back,
+// the versions currently running in the data plane are used instead of the desired ones so that the upgrade does not start.
+func buildManagedKafkaVersions(kafkaRequest *dbapi.KafkaRequest, holdBackUpgrade bool) managedkafka.VersionsSpec {
+	versionToApply := func(desired, actual string) string {
+		if holdBackUpgrade && actual != "" {
+			return actual
+		}
+		return desired
+	}
+
+	return managedkafka.VersionsSpec{
+		Kafka:    versionToApply(kafkaRequest.DesiredKafkaVersion, kafkaRequest.ActualKafkaVersion),
+		Strimzi:  versionToApply(kafkaRequest.DesiredStrimziVersion, kafkaRequest.ActualStrimziVersion),
+		KafkaIBP: versionToApply(kafkaRequest.DesiredKafkaIBPVersion, kafkaRequest.ActualKafkaIBPVersion),
+	}
+}
+
 func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.KafkaConfig, keycloakService sso.KeycloakService,
 	certificates kafkatlscertmgmt.Certificate,
-	enableKafkaExternalCertificate bool) (*managedkafka.ManagedKafka, *errors.ServiceError) {
+	enableKafkaExternalCertificate bool, holdBackUpgrade bool) (*managedkafka.ManagedKafka, *errors.ServiceError) {
 	k, err := kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, kafkaRequest.SizeId)
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka request")
@@ -1248,13 +1573,9 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 			Endpoint: managedkafka.EndpointSpec{
 				BootstrapServerHost: kafkaRequest.BootstrapServerHost,
 			},
-			Versions: managedkafka.VersionsSpec{
-				Kafka:    kafkaRequest.DesiredKafkaVersion,
-				Strimzi:  kafkaRequest.DesiredStrimziVersion,
-				KafkaIBP: kafkaRequest.DesiredKafkaIBPVersion,
-			},
-			Deleted: kafkaRequest.Status == constants.KafkaRequestStatusDeprovision.String(),
-			Owners:  buildKafkaOwner(kafkaRequest, kafkaConfig),
+			Versions: buildManagedKafkaVersions(kafkaRequest, holdBackUpgrade),
+			Deleted:  kafkaRequest.Status == constants.KafkaRequestStatusDeprovision.String(),
+			Owners:   buildKafkaOwner(kafkaRequest, kafkaConfig),
 		},
 		Status: managedkafka.ManagedKafkaStatus{},
 	}
@@ -1366,48 +1687,13 @@ func (k *kafkaService) buildReservedManagedKafkaCR(kafkaID, instanceType, stream
 
 func buildKafkaOwner(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.KafkaConfig) []string {
 	if kafkaConfig.EnableKafkaOwnerConfig {
-		return append([]string{kafkaRequest.Owner}, kafkaConfig.KafkaOwnerList...)
+		return append([]string{kafkaRequest.Owner}, kafkaConfig.GetKafkaOwnerList()...)
 	}
 	return []string{
 		kafkaRequest.Owner,
 	}
 }
 
-func buildKafkaClusterCNAMESRecordBatch(routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) *route53.ChangeBatch {
-	var changes []*route53.Change
-	for _, r := range routes {
-		c := buildResourceRecordChange(r.Domain, r.Router, action)
-		changes = append(changes, c)
-	}
-	recordChangeBatch := &route53.ChangeBatch{
-		Changes: changes,
-	}
-
-	return recordChangeBatch
-}
-
-func buildResourceRecordChange(recordName string, clusterIngress string, action KafkaRoutesAction) *route53.Change {
-	recordType := "CNAME"
-	recordTTL := int64(300)
-
-	actionStr := action.String()
-	resourceRecordChange := &route53.Change{
-		Action: &actionStr,
-		ResourceRecordSet: &route53.ResourceRecordSet{
-			Name: &recordName,
-			Type: &recordType,
-			TTL:  &recordTTL,
-			ResourceRecords: []*route53.ResourceRecord{
-				{
-					Value: &clusterIngress,
-				},
-			},
-		},
-	}
-
-	return resourceRecordChange
-}
-
 func (k *kafkaService) AssignBootstrapServerHost(kafkaRequest *dbapi.KafkaRequest) error {
 	truncatedKafkaIdentifier := buildTruncateKafkaIdentifier(kafkaRequest)
 	truncatedKafkaIdentifier, replaceErr := replaceHostSpecialChar(truncatedKafkaIdentifier)
@@ -1500,17 +1786,6 @@ func (k *kafkaService) getKafkaRoutesBaseDomainName(kafkaRequest *dbapi.KafkaReq
 // See: https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/disaster-recovery-resiliency.html
 // If at some point we end up needing Route53 regional functionalities this
 // mechanism should be reevaluated
-func (k *kafkaService) getRoute53RegionFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) (string, error) {
-	switch kafkaRequest.CloudProvider {
-	case cloudproviders.AWS.String():
-		return aws.DefaultAWSRoute53Region, nil
-	case cloudproviders.GCP.String():
-		return aws.DefaultGCPRoute53Region, nil
-	default:
-		return "", errors.GeneralError("unknown cloud provider: %q", kafkaRequest.CloudProvider)
-	}
-}
-
 func (k *kafkaService) IsQuotaEntitlementActive(kafkaRequest *dbapi.KafkaRequest) (bool, error) {
 	quotaService, factoryErr := k.quotaServiceFactory.GetQuotaService(api.QuotaType(k.kafkaConfig.Quota.Type))
 	if factoryErr != nil {
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+//go:generate moq -out kafka_instance_template_moq.go . KafkaInstanceTemplateService
+type KafkaInstanceTemplateService interface {
+	// List returns the instance templates of the organisation, ordered by name
+	List(organisationId string) (dbapi.KafkaInstanceTemplateList, *errors.ServiceError)
+	Get(organisationId string, id string) (*dbapi.KafkaInstanceTemplate, *errors.ServiceError)
+	// Create saves the template. A conflict error is returned when the organisation already has a template with the same name.
+	Create(template *dbapi.KafkaInstanceTemplate) *errors.ServiceError
+	Delete(organisationId string, id string) *errors.ServiceError
+}
+
+var _ KafkaInstanceTemplateService = &kafkaInstanceTemplateService{}
+
+type kafkaInstanceTemplateService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewKafkaInstanceTemplateService(connectionFactory *db.ConnectionFactory) *kafkaInstanceTemplateService {
+	return &kafkaInstanceTemplateService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaInstanceTemplateService) List(organisationId string) (dbapi.KafkaInstanceTemplateList, *errors.ServiceError) {
+	var templates dbapi.KafkaInstanceTemplateList
+	if err := s.connectionFactory.New().Where("organisation_id = ?", organisationId).Order("name").Find(&templates).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the kafka instance templates of organisation %q", organisationId)
+	}
+	return templates, nil
+}
+
+func (s *kafkaInstanceTemplateService) Get(organisationId string, id string) (*dbapi.KafkaInstanceTemplate, *errors.ServiceError) {
+	var template dbapi.KafkaInstanceTemplate
+	if err := s.connectionFactory.New().Where("organisation_id = ? AND id = ?", organisationId, id).First(&template).Error; err != nil {
+		return nil, services.HandleGetError("KafkaInstanceTemplate", "id", id, err)
+	}
+	return &template, nil
+}
+
+func (s *kafkaInstanceTemplateService) Create(template *dbapi.KafkaInstanceTemplate) *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+
+	var count int64
+	if err := dbConn.Model(&dbapi.KafkaInstanceTemplate{}).
+		Where("organisation_id = ? AND name = ?", template.OrganisationId, template.Name).
+		Count(&count).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to check the kafka instance templates of organisation %q", template.OrganisationId)
+	}
+	if count > 0 {
+		return errors.Conflict("kafka instance template %q already exists in organisation %q", template.Name, template.OrganisationId)
+	}
+
+	if err := dbConn.Create(template).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the kafka instance template %q", template.Name)
+	}
+	return nil
+}
+
+func (s *kafkaInstanceTemplateService) Delete(organisationId string, id string) *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+
+	template, err := s.Get(organisationId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := dbConn.Delete(template).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete the kafka instance template %q", id)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that KafkaInstanceTemplateServiceMock does implement KafkaInstanceTemplateService.
+// If this is not the case, regenerate this file with moq.
+var _ KafkaInstanceTemplateService = &KafkaInstanceTemplateServiceMock{}
+
+// KafkaInstanceTemplateServiceMock is a mock implementation of KafkaInstanceTemplateService.
+//
+//	func TestSomethingThatUsesKafkaInstanceTemplateService(t *testing.T) {
+//
+//		// make and configure a mocked KafkaInstanceTemplateService
+//		mockedKafkaInstanceTemplateService := &KafkaInstanceTemplateServiceMock{
+//			CreateFunc: func(template *dbapi.KafkaInstanceTemplate) *serviceError.ServiceError {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(organisationId string, id string) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			GetFunc: func(organisationId string, id string) (*dbapi.KafkaInstanceTemplate, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func(organisationId string) (dbapi.KafkaInstanceTemplateList, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//		}
+//
+//		// use mockedKafkaInstanceTemplateService in code that requires KafkaInstanceTemplateService
+//		// and then make assertions.
+//
+//	}
+type KafkaInstanceTemplateServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(template *dbapi.KafkaInstanceTemplate) *serviceError.ServiceError
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(organisationId string, id string) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(organisationId string, id string) (*dbapi.KafkaInstanceTemplate, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func(organisationId string) (dbapi.KafkaInstanceTemplateList, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Template is the template argument value.
+			Template *dbapi.KafkaInstanceTemplate
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// OrganisationId is the organisationId argument value.
+			OrganisationId string
+			// ID is the id argument value.
+			ID string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// OrganisationId is the organisationId argument value.
+			OrganisationId string
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// OrganisationId is the organisationId argument value.
+			OrganisationId string
+		}
+	}
+	lockCreate sync.RWMutex
+	lockDelete sync.RWMutex
+	lockGet    sync.RWMutex
+	lockList   sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *KafkaInstanceTemplateServiceMock) Create(template *dbapi.KafkaInstanceTemplate) *serviceError.ServiceError {
+	if mock.CreateFunc == nil {
+		panic("KafkaInstanceTemplateServiceMock.CreateFunc: method is nil but KafkaInstanceTemplateService.Create was just called")
+	}
+	callInfo := struct {
+		Template *dbapi.KafkaInstanceTemplate
+	}{
+		Template: template,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(template)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedKafkaInstanceTemplateService.CreateCalls())
+func (mock *KafkaInstanceTemplateServiceMock) CreateCalls() []struct {
+	Template *dbapi.KafkaInstanceTemplate
+} {
+	var calls []struct {
+		Template *dbapi.KafkaInstanceTemplate
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *KafkaInstanceTemplateServiceMock) Delete(organisationId string, id string) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("KafkaInstanceTemplateServiceMock.DeleteFunc: method is nil but KafkaInstanceTemplateService.Delete was just called")
+	}
+	callInfo := struct {
+		OrganisationId string
+		ID             string
+	}{
+		OrganisationId: organisationId,
+		ID:             id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(organisationId, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedKafkaInstanceTemplateService.DeleteCalls())
+func (mock *KafkaInstanceTemplateServiceMock) DeleteCalls() []struct {
+	OrganisationId string
+	ID             string
+} {
+	var calls []struct {
+		OrganisationId string
+		ID             string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *KafkaInstanceTemplateServiceMock) Get(organisationId string, id string) (*dbapi.KafkaInstanceTemplate, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("KafkaInstanceTemplateServiceMock.GetFunc: method is nil but KafkaInstanceTemplateService.Get was just called")
+	}
+	callInfo := struct {
+		OrganisationId string
+		ID             string
+	}{
+		OrganisationId: organisationId,
+		ID:             id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(organisationId, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedKafkaInstanceTemplateService.GetCalls())
+func (mock *KafkaInstanceTemplateServiceMock) GetCalls() []struct {
+	OrganisationId string
+	ID             string
+} {
+	var calls []struct {
+		OrganisationId string
+		ID             string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *KafkaInstanceTemplateServiceMock) List(organisationId string) (dbapi.KafkaInstanceTemplateList, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("KafkaInstanceTemplateServiceMock.ListFunc: method is nil but KafkaInstanceTemplateService.List was just called")
+	}
+	callInfo := struct {
+		OrganisationId string
+	}{
+		OrganisationId: organisationId,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(organisationId)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedKafkaInstanceTemplateService.ListCalls())
+func (mock *KafkaInstanceTemplateServiceMock) ListCalls() []struct {
+	OrganisationId string
+} {
+	var calls []struct {
+		OrganisationId string
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
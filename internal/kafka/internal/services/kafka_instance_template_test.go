@@ -0,0 +1,104 @@
+package services
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaInstanceTemplateService_Create(t *testing.T) {
+	tests := []struct {
+		name         string
+		count        int
+		wantErrCode  errors.ServiceErrorCode
+		wantInserted bool
+	}{
+		{
+			name:         "should create the kafka instance template",
+			count:        0,
+			wantInserted: true,
+		},
+		{
+			name:        "should fail when the organisation already has a template with the same name",
+			count:       1,
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var inserted bool
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT count(1) FROM "kafka_instance_templates" WHERE (organisation_id = $1 AND name = $2)`).
+				WithArgs("org-id", "team-default").
+				WithReply([]map[string]interface{}{{"count": tt.count}})
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_instance_templates"`).WithCallback(func(s string, nv []driver.NamedValue) {
+				inserted = true
+			})
+
+			s := NewKafkaInstanceTemplateService(db.NewMockConnectionFactory(nil))
+			template := &dbapi.KafkaInstanceTemplate{OrganisationId: "org-id", Name: "team-default", CloudProvider: "aws"}
+			err := s.Create(template)
+			if tt.wantErrCode != 0 {
+				g.Expect(err).NotTo(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+				g.Expect(template.ID).NotTo(gomega.BeEmpty())
+			}
+			g.Expect(inserted).To(gomega.Equal(tt.wantInserted))
+		})
+	}
+}
+
+func Test_kafkaInstanceTemplateService_Get(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupFn     func()
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name: "should return the kafka instance template of the organisation",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_instance_templates" WHERE (organisation_id = $1 AND id = $2)`).
+					WithArgs("org-id", "template-id").
+					WithReply([]map[string]interface{}{{"id": "template-id", "organisation_id": "org-id", "name": "team-default"}})
+			},
+		},
+		{
+			name: "should return not found when the template does not belong to the organisation",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_instance_templates"`).
+					WithReply([]map[string]interface{}{})
+			},
+			wantErrCode: errors.ErrorNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewKafkaInstanceTemplateService(db.NewMockConnectionFactory(nil))
+			template, err := s.Get("org-id", "template-id")
+			if tt.wantErrCode != 0 {
+				g.Expect(err).NotTo(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+				g.Expect(template.Name).To(gomega.Equal("team-default"))
+			}
+		})
+	}
+}
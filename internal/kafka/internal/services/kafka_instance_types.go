@@ -38,7 +38,7 @@ func (t *supportedKafkaInstanceTypesService) GetSupportedKafkaInstanceTypesByReg
 	}
 
 	for k := range region.SupportedInstanceTypes {
-		instanceType, err := t.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(k)
+		instanceType, err := t.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(k)
 		if err != nil {
 			return nil, errors.InstanceTypeNotSupported(fmt.Sprintf("instance type '%s' is unsupported", k))
 		}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+//go:generate moq -out kafka_maintenance_window_moq.go . KafkaMaintenanceWindowService
+type KafkaMaintenanceWindowService interface {
+	// Get returns the maintenance window that applies to the given kafka: its own window if it has one,
+	// otherwise the window of its organisation. A not found error is returned when neither exists.
+	Get(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError)
+	// GetForOrganisation returns the maintenance window that applies to all the kafkas of the given organisation
+	GetForOrganisation(organisationID string) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError)
+	// Upsert creates the given maintenance window or replaces the existing one for the same kafka and organisation
+	Upsert(window *dbapi.KafkaMaintenanceWindow) *errors.ServiceError
+	Delete(window *dbapi.KafkaMaintenanceWindow) *errors.ServiceError
+	// ListByKafkas returns the maintenance windows that apply to the given kafkas indexed by kafka id.
+	// Kafkas without a maintenance window are not included in the returned map.
+	ListByKafkas(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *errors.ServiceError)
+}
+
+var _ KafkaMaintenanceWindowService = &kafkaMaintenanceWindowService{}
+
+type kafkaMaintenanceWindowService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewKafkaMaintenanceWindowService(connectionFactory *db.ConnectionFactory) *kafkaMaintenanceWindowService {
+	return &kafkaMaintenanceWindowService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (m *kafkaMaintenanceWindowService) Get(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+	windows, err := m.ListByKafkas(dbapi.KafkaList{kafkaRequest})
+	if err != nil {
+		return nil, err
+	}
+
+	window, ok := windows[kafkaRequest.ID]
+	if !ok {
+		return nil, errors.NotFound("KafkaMaintenanceWindow with kafka_id='%s' not found", kafkaRequest.ID)
+	}
+
+	return window, nil
+}
+
+func (m *kafkaMaintenanceWindowService) GetForOrganisation(organisationID string) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+	if organisationID == "" {
+		return nil, errors.Validation("organisation id is undefined")
+	}
+
+	dbConn := m.connectionFactory.New()
+	var window dbapi.KafkaMaintenanceWindow
+	if err := dbConn.Where("organisation_id = ? AND kafka_id = ''", organisationID).First(&window).Error; err != nil {
+		return nil, services.HandleGetError("KafkaMaintenanceWindow", "organisation_id", organisationID, err)
+	}
+
+	return &window, nil
+}
+
+func (m *kafkaMaintenanceWindowService) Upsert(window *dbapi.KafkaMaintenanceWindow) *errors.ServiceError {
+	dbConn := m.connectionFactory.New()
+
+	var existing dbapi.KafkaMaintenanceWindow
+	err := dbConn.Where("organisation_id = ? AND kafka_id = ?", window.OrganisationId, window.KafkaID).First(&existing).Error
+	switch {
+	case err == nil:
+		window.ID = existing.ID
+		window.CreatedAt = existing.CreatedAt
+		updates := map[string]interface{}{
+			"day_of_week":    window.DayOfWeek,
+			"start_hour":     window.StartHour,
+			"duration_hours": window.DurationHours,
+		}
+		if err := dbConn.Model(&existing).Updates(updates).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update maintenance window")
+		}
+		window.UpdatedAt = existing.UpdatedAt
+	case services.IsRecordNotFoundError(err):
+		if err := dbConn.Create(window).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create maintenance window")
+		}
+	default:
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to find maintenance window")
+	}
+
+	return nil
+}
+
+func (m *kafkaMaintenanceWindowService) Delete(window *dbapi.KafkaMaintenanceWindow) *errors.ServiceError {
+	if window.ID == "" {
+		return errors.Validation("id is undefined")
+	}
+
+	dbConn := m.connectionFactory.New()
+	if err := dbConn.Delete(&dbapi.KafkaMaintenanceWindow{}, "id = ?", window.ID).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete maintenance window %q", window.ID)
+	}
+
+	return nil
+}
+
+func (m *kafkaMaintenanceWindowService) ListByKafkas(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+	res := map[string]*dbapi.KafkaMaintenanceWindow{}
+	if len(kafkas) == 0 {
+		return res, nil
+	}
+
+	var kafkaIDs, organisationIDs []string
+	for _, kafka := range kafkas {
+		kafkaIDs = append(kafkaIDs, kafka.ID)
+		if kafka.OrganisationId != "" && !arrays.Contains(organisationIDs, kafka.OrganisationId) {
+			organisationIDs = append(organisationIDs, kafka.OrganisationId)
+		}
+	}
+
+	dbConn := m.connectionFactory.New().Where("kafka_id IN (?)", kafkaIDs)
+	if len(organisationIDs) > 0 {
+		dbConn = dbConn.Or("kafka_id = '' AND organisation_id IN (?)", organisationIDs)
+	}
+
+	var windows dbapi.KafkaMaintenanceWindowList
+	if err := dbConn.Find(&windows).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list maintenance windows")
+	}
+
+	kafkaWindows := map[string]*dbapi.KafkaMaintenanceWindow{}
+	organisationWindows := map[string]*dbapi.KafkaMaintenanceWindow{}
+	for _, window := range windows {
+		if window.IsOrganisationWide() {
+			organisationWindows[window.OrganisationId] = window
+		} else {
+			kafkaWindows[window.KafkaID] = window
+		}
+	}
+
+	// the window of a kafka takes precedence over the one of its organisation
+	for _, kafka := range kafkas {
+		if window, ok := kafkaWindows[kafka.ID]; ok {
+			res[kafka.ID] = window
+		} else if window, ok := organisationWindows[kafka.OrganisationId]; ok && kafka.OrganisationId != "" {
+			res[kafka.ID] = window
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,252 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that KafkaMaintenanceWindowServiceMock does implement KafkaMaintenanceWindowService.
+// If this is not the case, regenerate this file with moq.
+var _ KafkaMaintenanceWindowService = &KafkaMaintenanceWindowServiceMock{}
+
+// KafkaMaintenanceWindowServiceMock is a mock implementation of KafkaMaintenanceWindowService.
+//
+//	func TestSomethingThatUsesKafkaMaintenanceWindowService(t *testing.T) {
+//
+//		// make and configure a mocked KafkaMaintenanceWindowService
+//		mockedKafkaMaintenanceWindowService := &KafkaMaintenanceWindowServiceMock{
+//			DeleteFunc: func(window *dbapi.KafkaMaintenanceWindow) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			GetFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			GetForOrganisationFunc: func(organisationID string) (*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError) {
+//				panic("mock out the GetForOrganisation method")
+//			},
+//			ListByKafkasFunc: func(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError) {
+//				panic("mock out the ListByKafkas method")
+//			},
+//			UpsertFunc: func(window *dbapi.KafkaMaintenanceWindow) *serviceError.ServiceError {
+//				panic("mock out the Upsert method")
+//			},
+//		}
+//
+//		// use mockedKafkaMaintenanceWindowService in code that requires KafkaMaintenanceWindowService
+//		// and then make assertions.
+//
+//	}
+type KafkaMaintenanceWindowServiceMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(window *dbapi.KafkaMaintenanceWindow) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError)
+
+	// GetForOrganisationFunc mocks the GetForOrganisation method.
+	GetForOrganisationFunc func(organisationID string) (*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError)
+
+	// ListByKafkasFunc mocks the ListByKafkas method.
+	ListByKafkasFunc func(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError)
+
+	// UpsertFunc mocks the Upsert method.
+	UpsertFunc func(window *dbapi.KafkaMaintenanceWindow) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Window is the window argument value.
+			Window *dbapi.KafkaMaintenanceWindow
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+		}
+		// GetForOrganisation holds details about calls to the GetForOrganisation method.
+		GetForOrganisation []struct {
+			// OrganisationID is the organisationID argument value.
+			OrganisationID string
+		}
+		// ListByKafkas holds details about calls to the ListByKafkas method.
+		ListByKafkas []struct {
+			// Kafkas is the kafkas argument value.
+			Kafkas dbapi.KafkaList
+		}
+		// Upsert holds details about calls to the Upsert method.
+		Upsert []struct {
+			// Window is the window argument value.
+			Window *dbapi.KafkaMaintenanceWindow
+		}
+	}
+	lockDelete             sync.RWMutex
+	lockGet                sync.RWMutex
+	lockGetForOrganisation sync.RWMutex
+	lockListByKafkas       sync.RWMutex
+	lockUpsert             sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *KafkaMaintenanceWindowServiceMock) Delete(window *dbapi.KafkaMaintenanceWindow) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("KafkaMaintenanceWindowServiceMock.DeleteFunc: method is nil but KafkaMaintenanceWindowService.Delete was just called")
+	}
+	callInfo := struct {
+		Window *dbapi.KafkaMaintenanceWindow
+	}{
+		Window: window,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(window)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedKafkaMaintenanceWindowService.DeleteCalls())
+func (mock *KafkaMaintenanceWindowServiceMock) DeleteCalls() []struct {
+	Window *dbapi.KafkaMaintenanceWindow
+} {
+	var calls []struct {
+		Window *dbapi.KafkaMaintenanceWindow
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *KafkaMaintenanceWindowServiceMock) Get(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("KafkaMaintenanceWindowServiceMock.GetFunc: method is nil but KafkaMaintenanceWindowService.Get was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}{
+		KafkaRequest: kafkaRequest,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(kafkaRequest)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedKafkaMaintenanceWindowService.GetCalls())
+func (mock *KafkaMaintenanceWindowServiceMock) GetCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetForOrganisation calls GetForOrganisationFunc.
+func (mock *KafkaMaintenanceWindowServiceMock) GetForOrganisation(organisationID string) (*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError) {
+	if mock.GetForOrganisationFunc == nil {
+		panic("KafkaMaintenanceWindowServiceMock.GetForOrganisationFunc: method is nil but KafkaMaintenanceWindowService.GetForOrganisation was just called")
+	}
+	callInfo := struct {
+		OrganisationID string
+	}{
+		OrganisationID: organisationID,
+	}
+	mock.lockGetForOrganisation.Lock()
+	mock.calls.GetForOrganisation = append(mock.calls.GetForOrganisation, callInfo)
+	mock.lockGetForOrganisation.Unlock()
+	return mock.GetForOrganisationFunc(organisationID)
+}
+
+// GetForOrganisationCalls gets all the calls that were made to GetForOrganisation.
+// Check the length with:
+//
+//	len(mockedKafkaMaintenanceWindowService.GetForOrganisationCalls())
+func (mock *KafkaMaintenanceWindowServiceMock) GetForOrganisationCalls() []struct {
+	OrganisationID string
+} {
+	var calls []struct {
+		OrganisationID string
+	}
+	mock.lockGetForOrganisation.RLock()
+	calls = mock.calls.GetForOrganisation
+	mock.lockGetForOrganisation.RUnlock()
+	return calls
+}
+
+// ListByKafkas calls ListByKafkasFunc.
+func (mock *KafkaMaintenanceWindowServiceMock) ListByKafkas(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *serviceError.ServiceError) {
+	if mock.ListByKafkasFunc == nil {
+		panic("KafkaMaintenanceWindowServiceMock.ListByKafkasFunc: method is nil but KafkaMaintenanceWindowService.ListByKafkas was just called")
+	}
+	callInfo := struct {
+		Kafkas dbapi.KafkaList
+	}{
+		Kafkas: kafkas,
+	}
+	mock.lockListByKafkas.Lock()
+	mock.calls.ListByKafkas = append(mock.calls.ListByKafkas, callInfo)
+	mock.lockListByKafkas.Unlock()
+	return mock.ListByKafkasFunc(kafkas)
+}
+
+// ListByKafkasCalls gets all the calls that were made to ListByKafkas.
+// Check the length with:
+//
+//	len(mockedKafkaMaintenanceWindowService.ListByKafkasCalls())
+func (mock *KafkaMaintenanceWindowServiceMock) ListByKafkasCalls() []struct {
+	Kafkas dbapi.KafkaList
+} {
+	var calls []struct {
+		Kafkas dbapi.KafkaList
+	}
+	mock.lockListByKafkas.RLock()
+	calls = mock.calls.ListByKafkas
+	mock.lockListByKafkas.RUnlock()
+	return calls
+}
+
+// Upsert calls UpsertFunc.
+func (mock *KafkaMaintenanceWindowServiceMock) Upsert(window *dbapi.KafkaMaintenanceWindow) *serviceError.ServiceError {
+	if mock.UpsertFunc == nil {
+		panic("KafkaMaintenanceWindowServiceMock.UpsertFunc: method is nil but KafkaMaintenanceWindowService.Upsert was just called")
+	}
+	callInfo := struct {
+		Window *dbapi.KafkaMaintenanceWindow
+	}{
+		Window: window,
+	}
+	mock.lockUpsert.Lock()
+	mock.calls.Upsert = append(mock.calls.Upsert, callInfo)
+	mock.lockUpsert.Unlock()
+	return mock.UpsertFunc(window)
+}
+
+// UpsertCalls gets all the calls that were made to Upsert.
+// Check the length with:
+//
+//	len(mockedKafkaMaintenanceWindowService.UpsertCalls())
+func (mock *KafkaMaintenanceWindowServiceMock) UpsertCalls() []struct {
+	Window *dbapi.KafkaMaintenanceWindow
+} {
+	var calls []struct {
+		Window *dbapi.KafkaMaintenanceWindow
+	}
+	mock.lockUpsert.RLock()
+	calls = mock.calls.Upsert
+	mock.lockUpsert.RUnlock()
+	return calls
+}
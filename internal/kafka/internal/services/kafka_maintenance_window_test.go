@@ -0,0 +1,165 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaMaintenanceWindowService_ListByKafkas(t *testing.T) {
+	kafkaWithOwnWindow := &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, OrganisationId: "org-1"}
+	kafkaWithOrgWindow := &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-2"}, OrganisationId: "org-1"}
+	kafkaWithoutWindow := &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-3"}, OrganisationId: "org-2"}
+
+	windowsReply := []map[string]interface{}{
+		{"id": "window-1", "kafka_id": "kafka-1", "organisation_id": "org-1", "day_of_week": "sunday", "start_hour": 2, "duration_hours": 4},
+		{"id": "window-2", "kafka_id": "", "organisation_id": "org-1", "day_of_week": "monday", "start_hour": 2, "duration_hours": 4},
+	}
+
+	tests := []struct {
+		name    string
+		kafkas  dbapi.KafkaList
+		setupFn func()
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty map when there are no kafkas",
+			kafkas:  dbapi.KafkaList{},
+			setupFn: func() {},
+			want:    map[string]string{},
+		},
+		{
+			name:   "should give precedence to the window of the kafka over the window of its organisation",
+			kafkas: dbapi.KafkaList{kafkaWithOwnWindow, kafkaWithOrgWindow, kafkaWithoutWindow},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_maintenance_windows" WHERE (kafka_id IN ($1,$2,$3) OR (kafka_id = '' AND organisation_id IN ($4,$5)))`).
+					WithReply(windowsReply)
+			},
+			want: map[string]string{
+				"kafka-1": "window-1",
+				"kafka-2": "window-2",
+			},
+		},
+		{
+			name:   "should return an error when the windows cannot be listed",
+			kafkas: dbapi.KafkaList{kafkaWithOwnWindow},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("SELECT").WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			m := NewKafkaMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+			got, err := m.ListByKafkas(tt.kafkas)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			gotIDs := map[string]string{}
+			for kafkaID, window := range got {
+				gotIDs[kafkaID] = window.ID
+			}
+			g.Expect(gotIDs).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaMaintenanceWindowService_Get(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_maintenance_windows"`).WithReply([]map[string]interface{}{})
+
+	m := NewKafkaMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+	_, err := m.Get(&dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, OrganisationId: "org-1"})
+	g.Expect(err).ToNot(gomega.BeNil())
+	g.Expect(err.Code).To(gomega.Equal(errors.ErrorNotFound))
+}
+
+func Test_kafkaMaintenanceWindowService_Upsert(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupFn    func()
+		wantID     string
+		wantErr    bool
+		wantInsert bool
+	}{
+		{
+			name: "should create the window when it does not exist",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_maintenance_windows"`).WithReply([]map[string]interface{}{})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_maintenance_windows"`)
+			},
+			wantInsert: true,
+		},
+		{
+			name: "should update the window when it already exists",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_maintenance_windows"`).
+					WithReply([]map[string]interface{}{{"id": "existing-id", "kafka_id": "kafka-1", "organisation_id": "org-1"}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_maintenance_windows"`).WithRowsNum(1)
+			},
+			wantID: "existing-id",
+		},
+		{
+			name: "should return an error when the window cannot be found",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("SELECT").WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			m := NewKafkaMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+			window := &dbapi.KafkaMaintenanceWindow{
+				KafkaID:        "kafka-1",
+				OrganisationId: "org-1",
+				DayOfWeek:      "sunday",
+				StartHour:      2,
+				DurationHours:  4,
+			}
+			err := m.Upsert(window)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			if tt.wantInsert {
+				g.Expect(window.ID).ToNot(gomega.BeEmpty())
+			} else {
+				g.Expect(window.ID).To(gomega.Equal(tt.wantID))
+			}
+		})
+	}
+}
+
+func Test_kafkaMaintenanceWindowService_Delete(t *testing.T) {
+	g := gomega.NewWithT(t)
+	m := NewKafkaMaintenanceWindowService(db.NewMockConnectionFactory(nil))
+
+	err := m.Delete(&dbapi.KafkaMaintenanceWindow{})
+	g.Expect(err).ToNot(gomega.BeNil())
+	g.Expect(err.Code).To(gomega.Equal(errors.ErrorValidation))
+
+	mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_maintenance_windows" SET "deleted_at"=$1 WHERE id = $2`).WithRowsNum(1)
+	g.Expect(m.Delete(&dbapi.KafkaMaintenanceWindow{Meta: api.Meta{ID: "window-id"}})).To(gomega.BeNil())
+}
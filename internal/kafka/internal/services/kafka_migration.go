@@ -0,0 +1,284 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+// KafkaMigration is the outcome of the request to migrate a kafka when draining a data plane cluster
+type KafkaMigration struct {
+	Kafka *dbapi.KafkaRequest
+	// Reason describes why the kafka is not migrated, or is empty if the migration has started
+	Reason string
+}
+
+//go:generate moq -out kafka_migration_moq.go . KafkaMigrationService
+type KafkaMigrationService interface {
+	// Move starts the live migration of the kafka to the given data plane cluster. The kafka has to be ready and the target
+	// cluster has to be able to host it. See dbapi.KafkaMigrationStatus for the steps of the migration.
+	Move(kafkaID string, targetClusterID string) (*dbapi.KafkaRequest, *errors.ServiceError)
+	// Drain starts the live migration of every ready kafka of the data plane cluster to the least loaded cluster of its region
+	// able to host it. The kafkas that cannot be migrated are returned with the reason why.
+	Drain(clusterID string) ([]KafkaMigration, *errors.ServiceError)
+	ListByMigrationStatus(status dbapi.KafkaMigrationStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError)
+	// SwitchRoutes points the routes of a kafka that is ready on the target cluster of its migration to the target cluster,
+	// assigns the kafka to the target cluster and starts removing it from the source cluster
+	SwitchRoutes(kafka *dbapi.KafkaRequest) *errors.ServiceError
+}
+
+var _ KafkaMigrationService = &kafkaMigrationService{}
+
+type kafkaMigrationService struct {
+	connectionFactory       *db.ConnectionFactory
+	kafkaService            KafkaService
+	clusterService          ClusterService
+	placementExplainService KafkaPlacementExplainService
+	dataplaneClusterConfig  *config.DataplaneClusterConfig
+	kafkaConfig             *config.KafkaConfig
+}
+
+func NewKafkaMigrationService(connectionFactory *db.ConnectionFactory, kafkaService KafkaService, clusterService ClusterService,
+	placementExplainService KafkaPlacementExplainService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) *kafkaMigrationService {
+	return &kafkaMigrationService{
+		connectionFactory:       connectionFactory,
+		kafkaService:            kafkaService,
+		clusterService:          clusterService,
+		placementExplainService: placementExplainService,
+		dataplaneClusterConfig:  dataplaneClusterConfig,
+		kafkaConfig:             kafkaConfig,
+	}
+}
+
+func (s *kafkaMigrationService) Move(kafkaID string, targetClusterID string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+	kafka, err := s.kafkaService.GetByID(kafkaID)
+	if err != nil {
+		return nil, err
+	}
+	if reason := getKafkaMigrationRefusal(kafka); reason != "" {
+		return nil, errors.BadRequest("kafka %q cannot be moved: %s", kafka.ID, reason)
+	}
+	if kafka.ClusterID == targetClusterID {
+		return nil, errors.BadRequest("kafka %q is already on cluster %q", kafka.ID, targetClusterID)
+	}
+
+	candidate, err := s.placementExplainService.ExplainClusterPlacement(kafka, targetClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if candidate == nil {
+		return nil, errors.BadRequest("cluster %q not found in region %q of cloud provider %q", targetClusterID, kafka.Region, kafka.CloudProvider)
+	}
+	if candidate.RejectedBy != "" {
+		return nil, errors.BadRequest("cluster %q cannot host kafka %q: %s", targetClusterID, kafka.ID, candidate.Reason)
+	}
+	if available, versionErr := isStrimziVersionAvailable(candidate.Cluster, kafka.DesiredStrimziVersion); versionErr != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, versionErr, "failed to get the strimzi versions of cluster %q", targetClusterID)
+	} else if !available {
+		return nil, errors.BadRequest("cluster %q cannot host kafka %q: strimzi version %q is not available", targetClusterID, kafka.ID, kafka.DesiredStrimziVersion)
+	}
+
+	if err := s.startMigration(kafka, targetClusterID); err != nil {
+		return nil, err
+	}
+	return kafka, nil
+}
+
+func (s *kafkaMigrationService) Drain(clusterID string) ([]KafkaMigration, *errors.ServiceError) {
+	cluster, err := s.clusterService.FindClusterByID(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, errors.NotFound("cluster %q not found", clusterID)
+	}
+
+	var kafkas []*dbapi.KafkaRequest
+	if err := s.connectionFactory.New().Where("cluster_id = ?", clusterID).Order("created_at").Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the kafkas of cluster %q", clusterID)
+	}
+
+	placement := loadAwarePlacement{
+		dataplaneClusterConfig: s.dataplaneClusterConfig,
+		clusterService:         s.clusterService,
+		kafkaConfig:            s.kafkaConfig,
+	}
+	// the streaming units of the kafkas migrated so far are not accounted on their target cluster until their routes are switched
+	pendingStreamingUnits := map[string]int{}
+	migrations := []KafkaMigration{}
+	for _, kafka := range kafkas {
+		migration := KafkaMigration{Kafka: kafka}
+		if migration.Reason = getKafkaMigrationRefusal(kafka); migration.Reason != "" {
+			migrations = append(migrations, migration)
+			continue
+		}
+		if kafka.DesiredBillingModelIsEnterprise() {
+			migration.Reason = "enterprise kafkas can only be moved to a given cluster"
+			migrations = append(migrations, migration)
+			continue
+		}
+
+		target, findErr := s.findDrainTargetCluster(&placement, kafka, pendingStreamingUnits)
+		if findErr != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, findErr, "failed to find the cluster to migrate kafka %q to", kafka.ID)
+		}
+		if target == nil {
+			migration.Reason = "no other cluster of the region can host the kafka"
+			migrations = append(migrations, migration)
+			continue
+		}
+
+		if err := s.startMigration(kafka, target.cluster.ClusterID); err != nil {
+			return nil, err
+		}
+		instanceSize, _ := s.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+		pendingStreamingUnits[target.cluster.ClusterID] += instanceSize.CapacityConsumed
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
+
+// findDrainTargetCluster returns the least loaded cluster, other than the one of the kafka, able to host the kafka once the
+// streaming units of the migrations started so far are accounted for
+func (s *kafkaMigrationService) findDrainTargetCluster(placement *loadAwarePlacement, kafka *dbapi.KafkaRequest, pendingStreamingUnits map[string]int) (*clusterLoad, error) {
+	candidates, err := placement.findCandidateClusters(kafka)
+	if err != nil {
+		return nil, err
+	}
+
+	var leastLoaded *clusterLoad
+	for i := range candidates {
+		candidate := candidates[i]
+		if candidate.cluster.ClusterID == kafka.ClusterID {
+			continue
+		}
+		pending := pendingStreamingUnits[candidate.cluster.ClusterID]
+		candidate.consumedStreamingUnits += pending
+		if candidate.remainingStreamingUnits != -1 {
+			candidate.remainingStreamingUnits -= pending
+			if candidate.remainingStreamingUnits < 0 {
+				continue
+			}
+		}
+		if available, versionErr := isStrimziVersionAvailable(candidate.cluster, kafka.DesiredStrimziVersion); versionErr != nil {
+			return nil, versionErr
+		} else if !available {
+			continue
+		}
+		if leastLoaded == nil || candidate.consumedStreamingUnits < leastLoaded.consumedStreamingUnits {
+			leastLoaded = &candidate
+		}
+	}
+
+	return leastLoaded, nil
+}
+
+func (s *kafkaMigrationService) startMigration(kafka *dbapi.KafkaRequest, targetClusterID string) *errors.ServiceError {
+	logger.Logger.Infof("starting the migration of kafka %q from cluster %q to cluster %q", kafka.ID, kafka.ClusterID, targetClusterID)
+	kafka.MigrationStatus = dbapi.KafkaMigrationStatusProvisioning
+	kafka.MigrationSourceClusterID = kafka.ClusterID
+	kafka.MigrationTargetClusterID = targetClusterID
+	kafka.MigrationRoutes = nil
+	kafka.MigrationDetails = ""
+	if err := s.kafkaService.Updates(kafka, map[string]interface{}{
+		"migration_status":            kafka.MigrationStatus.String(),
+		"migration_source_cluster_id": kafka.MigrationSourceClusterID,
+		"migration_target_cluster_id": kafka.MigrationTargetClusterID,
+		"migration_routes":            nil,
+		"migration_details":           "",
+	}); err != nil {
+		return errors.NewWithCause(err.Code, err, "failed to start the migration of kafka %q", kafka.ID)
+	}
+	return nil
+}
+
+func (s *kafkaMigrationService) ListByMigrationStatus(status dbapi.KafkaMigrationStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+	var kafkas []*dbapi.KafkaRequest
+	if err := s.connectionFactory.New().Where("migration_status = ?", status.String()).Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafkas by migration status")
+	}
+	return kafkas, nil
+}
+
+func (s *kafkaMigrationService) SwitchRoutes(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+	migrationRoutes, routesErr := kafka.GetMigrationRoutes()
+	if routesErr != nil || len(migrationRoutes) == 0 {
+		return errors.NewWithCause(errors.ErrorGeneral, routesErr, "failed to get the migration routes of kafka %q", kafka.ID)
+	}
+
+	values := map[string]interface{}{
+		"cluster_id":       kafka.MigrationTargetClusterID,
+		"routes":           kafka.MigrationRoutes,
+		"routes_created":   true,
+		"migration_status": dbapi.KafkaMigrationStatusDeprovisioningSource.String(),
+		"migration_routes": nil,
+	}
+
+	if s.kafkaConfig.EnableKafkaCNAMERegistration {
+		previousRoutes, _ := kafka.GetRoutes()
+		migratedKafka := *kafka
+		migratedKafka.Routes = kafka.MigrationRoutes
+		recordStatus, err := s.kafkaService.ChangeKafkaCNAMErecords(&migratedKafka, KafkaRoutesActionUpsert)
+		if err != nil {
+			return errors.NewWithCause(err.Code, err, "failed to point the CNAME records of kafka %q to cluster %q", kafka.ID, kafka.MigrationTargetClusterID)
+		}
+		values["routes_creation_id"] = *recordStatus.Id
+		values["routes_created"] = recordStatus.IsInSync()
+
+		// the routes of the source cluster that the target cluster does not expose anymore are removed
+		staleRoutes := arrays.Filter(previousRoutes, func(previousRoute dbapi.DataPlaneKafkaRoute) bool {
+			return !arrays.AnyMatch(migrationRoutes, func(route dbapi.DataPlaneKafkaRoute) bool {
+				return route.Domain == previousRoute.Domain
+			})
+		})
+		if len(staleRoutes) > 0 {
+			staleRoutesKafka := *kafka
+			if err := staleRoutesKafka.SetRoutes(staleRoutes); err != nil {
+				return errors.NewWithCause(errors.ErrorGeneral, err, "failed to set the stale routes of kafka %q", kafka.ID)
+			}
+			if _, err := s.kafkaService.ChangeKafkaCNAMErecords(&staleRoutesKafka, KafkaRoutesActionDelete); err != nil {
+				return errors.NewWithCause(err.Code, err, "failed to delete the stale CNAME records of kafka %q", kafka.ID)
+			}
+		}
+	}
+
+	logger.Logger.Infof("switching the routes of kafka %q from cluster %q to cluster %q", kafka.ID, kafka.MigrationSourceClusterID, kafka.MigrationTargetClusterID)
+	if err := s.kafkaService.Updates(kafka, values); err != nil {
+		return errors.NewWithCause(err.Code, err, "failed to switch kafka %q to cluster %q", kafka.ID, kafka.MigrationTargetClusterID)
+	}
+	return nil
+}
+
+// getKafkaMigrationRefusal returns why the kafka cannot be migrated, or an empty string if it can
+func getKafkaMigrationRefusal(kafka *dbapi.KafkaRequest) string {
+	if kafka.Status != constants.KafkaRequestStatusReady.String() {
+		return fmt.Sprintf("kafka status is %q", kafka.Status)
+	}
+	if kafka.MigrationStatus.InProgress() {
+		return fmt.Sprintf("kafka is already being migrated to cluster %q", kafka.MigrationTargetClusterID)
+	}
+	return ""
+}
+
+// isStrimziVersionAvailable returns whether the strimzi version is available and ready on the cluster. Any cluster is
+// accepted when the kafka has no desired strimzi version.
+func isStrimziVersionAvailable(cluster *api.Cluster, strimziVersion string) (bool, error) {
+	if strimziVersion == "" {
+		return true, nil
+	}
+	versions, err := cluster.GetAvailableAndReadyStrimziVersions()
+	if err != nil {
+		return false, err
+	}
+	return arrays.AnyMatch(versions, func(version api.StrimziVersion) bool {
+		return version.Version == strimziVersion
+	}), nil
+}
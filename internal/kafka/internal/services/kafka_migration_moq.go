@@ -0,0 +1,214 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that KafkaMigrationServiceMock does implement KafkaMigrationService.
+// If this is not the case, regenerate this file with moq.
+var _ KafkaMigrationService = &KafkaMigrationServiceMock{}
+
+// KafkaMigrationServiceMock is a mock implementation of KafkaMigrationService.
+//
+//	func TestSomethingThatUsesKafkaMigrationService(t *testing.T) {
+//
+//		// make and configure a mocked KafkaMigrationService
+//		mockedKafkaMigrationService := &KafkaMigrationServiceMock{
+//			DrainFunc: func(clusterID string) ([]KafkaMigration, *serviceError.ServiceError) {
+//				panic("mock out the Drain method")
+//			},
+//			ListByMigrationStatusFunc: func(status dbapi.KafkaMigrationStatus) ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListByMigrationStatus method")
+//			},
+//			MoveFunc: func(kafkaID string, targetClusterID string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+//				panic("mock out the Move method")
+//			},
+//			SwitchRoutesFunc: func(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
+//				panic("mock out the SwitchRoutes method")
+//			},
+//		}
+//
+//		// use mockedKafkaMigrationService in code that requires KafkaMigrationService
+//		// and then make assertions.
+//
+//	}
+type KafkaMigrationServiceMock struct {
+	// DrainFunc mocks the Drain method.
+	DrainFunc func(clusterID string) ([]KafkaMigration, *serviceError.ServiceError)
+
+	// ListByMigrationStatusFunc mocks the ListByMigrationStatus method.
+	ListByMigrationStatusFunc func(status dbapi.KafkaMigrationStatus) ([]*dbapi.KafkaRequest, *serviceError.ServiceError)
+
+	// MoveFunc mocks the Move method.
+	MoveFunc func(kafkaID string, targetClusterID string) (*dbapi.KafkaRequest, *serviceError.ServiceError)
+
+	// SwitchRoutesFunc mocks the SwitchRoutes method.
+	SwitchRoutesFunc func(kafka *dbapi.KafkaRequest) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Drain holds details about calls to the Drain method.
+		Drain []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// ListByMigrationStatus holds details about calls to the ListByMigrationStatus method.
+		ListByMigrationStatus []struct {
+			// Status is the status argument value.
+			Status dbapi.KafkaMigrationStatus
+		}
+		// Move holds details about calls to the Move method.
+		Move []struct {
+			// KafkaID is the kafkaID argument value.
+			KafkaID string
+			// TargetClusterID is the targetClusterID argument value.
+			TargetClusterID string
+		}
+		// SwitchRoutes holds details about calls to the SwitchRoutes method.
+		SwitchRoutes []struct {
+			// Kafka is the kafka argument value.
+			Kafka *dbapi.KafkaRequest
+		}
+	}
+	lockDrain                 sync.RWMutex
+	lockListByMigrationStatus sync.RWMutex
+	lockMove                  sync.RWMutex
+	lockSwitchRoutes          sync.RWMutex
+}
+
+// Drain calls DrainFunc.
+func (mock *KafkaMigrationServiceMock) Drain(clusterID string) ([]KafkaMigration, *serviceError.ServiceError) {
+	if mock.DrainFunc == nil {
+		panic("KafkaMigrationServiceMock.DrainFunc: method is nil but KafkaMigrationService.Drain was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockDrain.Lock()
+	mock.calls.Drain = append(mock.calls.Drain, callInfo)
+	mock.lockDrain.Unlock()
+	return mock.DrainFunc(clusterID)
+}
+
+// DrainCalls gets all the calls that were made to Drain.
+// Check the length with:
+//
+//	len(mockedKafkaMigrationService.DrainCalls())
+func (mock *KafkaMigrationServiceMock) DrainCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockDrain.RLock()
+	calls = mock.calls.Drain
+	mock.lockDrain.RUnlock()
+	return calls
+}
+
+// ListByMigrationStatus calls ListByMigrationStatusFunc.
+func (mock *KafkaMigrationServiceMock) ListByMigrationStatus(status dbapi.KafkaMigrationStatus) ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
+	if mock.ListByMigrationStatusFunc == nil {
+		panic("KafkaMigrationServiceMock.ListByMigrationStatusFunc: method is nil but KafkaMigrationService.ListByMigrationStatus was just called")
+	}
+	callInfo := struct {
+		Status dbapi.KafkaMigrationStatus
+	}{
+		Status: status,
+	}
+	mock.lockListByMigrationStatus.Lock()
+	mock.calls.ListByMigrationStatus = append(mock.calls.ListByMigrationStatus, callInfo)
+	mock.lockListByMigrationStatus.Unlock()
+	return mock.ListByMigrationStatusFunc(status)
+}
+
+// ListByMigrationStatusCalls gets all the calls that were made to ListByMigrationStatus.
+// Check the length with:
+//
+//	len(mockedKafkaMigrationService.ListByMigrationStatusCalls())
+func (mock *KafkaMigrationServiceMock) ListByMigrationStatusCalls() []struct {
+	Status dbapi.KafkaMigrationStatus
+} {
+	var calls []struct {
+		Status dbapi.KafkaMigrationStatus
+	}
+	mock.lockListByMigrationStatus.RLock()
+	calls = mock.calls.ListByMigrationStatus
+	mock.lockListByMigrationStatus.RUnlock()
+	return calls
+}
+
+// Move calls MoveFunc.
+func (mock *KafkaMigrationServiceMock) Move(kafkaID string, targetClusterID string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+	if mock.MoveFunc == nil {
+		panic("KafkaMigrationServiceMock.MoveFunc: method is nil but KafkaMigrationService.Move was just called")
+	}
+	callInfo := struct {
+		KafkaID         string
+		TargetClusterID string
+	}{
+		KafkaID:         kafkaID,
+		TargetClusterID: targetClusterID,
+	}
+	mock.lockMove.Lock()
+	mock.calls.Move = append(mock.calls.Move, callInfo)
+	mock.lockMove.Unlock()
+	return mock.MoveFunc(kafkaID, targetClusterID)
+}
+
+// MoveCalls gets all the calls that were made to Move.
+// Check the length with:
+//
+//	len(mockedKafkaMigrationService.MoveCalls())
+func (mock *KafkaMigrationServiceMock) MoveCalls() []struct {
+	KafkaID         string
+	TargetClusterID string
+} {
+	var calls []struct {
+		KafkaID         string
+		TargetClusterID string
+	}
+	mock.lockMove.RLock()
+	calls = mock.calls.Move
+	mock.lockMove.RUnlock()
+	return calls
+}
+
+// SwitchRoutes calls SwitchRoutesFunc.
+func (mock *KafkaMigrationServiceMock) SwitchRoutes(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
+	if mock.SwitchRoutesFunc == nil {
+		panic("KafkaMigrationServiceMock.SwitchRoutesFunc: method is nil but KafkaMigrationService.SwitchRoutes was just called")
+	}
+	callInfo := struct {
+		Kafka *dbapi.KafkaRequest
+	}{
+		Kafka: kafka,
+	}
+	mock.lockSwitchRoutes.Lock()
+	mock.calls.SwitchRoutes = append(mock.calls.SwitchRoutes, callInfo)
+	mock.lockSwitchRoutes.Unlock()
+	return mock.SwitchRoutesFunc(kafka)
+}
+
+// SwitchRoutesCalls gets all the calls that were made to SwitchRoutes.
+// Check the length with:
+//
+//	len(mockedKafkaMigrationService.SwitchRoutesCalls())
+func (mock *KafkaMigrationServiceMock) SwitchRoutesCalls() []struct {
+	Kafka *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		Kafka *dbapi.KafkaRequest
+	}
+	mock.lockSwitchRoutes.RLock()
+	calls = mock.calls.SwitchRoutes
+	mock.lockSwitchRoutes.RUnlock()
+	return calls
+}
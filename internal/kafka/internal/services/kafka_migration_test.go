@@ -0,0 +1,331 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaMigrationService_Move(t *testing.T) {
+	readyKafka := func() *dbapi.KafkaRequest {
+		return &dbapi.KafkaRequest{
+			Meta:                  api.Meta{ID: "kafka-id"},
+			Status:                constants.KafkaRequestStatusReady.String(),
+			ClusterID:             "source",
+			InstanceType:          types.STANDARD.String(),
+			SizeId:                "x1",
+			DesiredStrimziVersion: "strimzi-cluster-operator.v0.23.0-0",
+		}
+	}
+	targetCluster := &api.Cluster{ClusterID: "target", AvailableStrimziVersions: api.JSON(`[{"version":"strimzi-cluster-operator.v0.23.0-0","ready":true}]`)}
+
+	tests := []struct {
+		name        string
+		kafka       *dbapi.KafkaRequest
+		target      string
+		candidate   *KafkaPlacementCandidate
+		wantErr     bool
+		wantUpdates map[string]interface{}
+	}{
+		{
+			name:      "should start the migration of the kafka to the target cluster",
+			kafka:     readyKafka(),
+			target:    "target",
+			candidate: &KafkaPlacementCandidate{Cluster: targetCluster},
+			wantUpdates: map[string]interface{}{
+				"migration_status":            dbapi.KafkaMigrationStatusProvisioning.String(),
+				"migration_source_cluster_id": "source",
+				"migration_target_cluster_id": "target",
+				"migration_routes":            nil,
+				"migration_details":           "",
+			},
+		},
+		{
+			name: "should restart the migration of a kafka whose previous migration failed",
+			kafka: func() *dbapi.KafkaRequest {
+				kafka := readyKafka()
+				kafka.MigrationStatus = dbapi.KafkaMigrationStatusFailed
+				kafka.MigrationDetails = "rejected"
+				return kafka
+			}(),
+			target:    "target",
+			candidate: &KafkaPlacementCandidate{Cluster: targetCluster},
+			wantUpdates: map[string]interface{}{
+				"migration_status":            dbapi.KafkaMigrationStatusProvisioning.String(),
+				"migration_source_cluster_id": "source",
+				"migration_target_cluster_id": "target",
+				"migration_routes":            nil,
+				"migration_details":           "",
+			},
+		},
+		{
+			name: "should refuse to move a kafka that is not ready",
+			kafka: func() *dbapi.KafkaRequest {
+				kafka := readyKafka()
+				kafka.Status = constants.KafkaRequestStatusSuspended.String()
+				return kafka
+			}(),
+			target:  "target",
+			wantErr: true,
+		},
+		{
+			name: "should refuse to move a kafka already being migrated",
+			kafka: func() *dbapi.KafkaRequest {
+				kafka := readyKafka()
+				kafka.MigrationStatus = dbapi.KafkaMigrationStatusProvisioning
+				return kafka
+			}(),
+			target:  "target",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse to move a kafka to its own cluster",
+			kafka:   readyKafka(),
+			target:  "source",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse to move a kafka to a cluster outside of its region",
+			kafka:   readyKafka(),
+			target:  "target",
+			wantErr: true,
+		},
+		{
+			name:      "should refuse to move a kafka to a cluster that cannot host it",
+			kafka:     readyKafka(),
+			target:    "target",
+			candidate: &KafkaPlacementCandidate{Cluster: targetCluster, RejectedBy: KafkaPlacementFilterCapacity, Reason: "no capacity"},
+			wantErr:   true,
+		},
+		{
+			name:      "should refuse to move a kafka to a cluster without its strimzi version",
+			kafka:     readyKafka(),
+			target:    "target",
+			candidate: &KafkaPlacementCandidate{Cluster: &api.Cluster{ClusterID: "target", AvailableStrimziVersions: api.JSON(`[]`)}},
+			wantErr:   true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &KafkaServiceMock{
+				GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return tt.kafka, nil
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+			}
+			placementExplainService := &KafkaPlacementExplainServiceMock{
+				ExplainClusterPlacementFunc: func(kafka *dbapi.KafkaRequest, clusterID string) (*KafkaPlacementCandidate, *errors.ServiceError) {
+					return tt.candidate, nil
+				},
+			}
+			s := NewKafkaMigrationService(nil, kafkaService, nil, placementExplainService, nil, &defaultKafkaConf)
+			got, err := s.Move("kafka-id", tt.target)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(kafkaService.UpdatesCalls()).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(got.MigrationStatus).To(gomega.Equal(dbapi.KafkaMigrationStatusProvisioning))
+			g.Expect(kafkaService.UpdatesCalls()).To(gomega.HaveLen(1))
+			g.Expect(kafkaService.UpdatesCalls()[0].Values).To(gomega.Equal(tt.wantUpdates))
+		})
+	}
+}
+
+func Test_kafkaMigrationService_Drain(t *testing.T) {
+	sourceCluster := &api.Cluster{ClusterID: "source", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard"}
+	smallCluster := &api.Cluster{ClusterID: "small", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard"}
+	largeCluster := &api.Cluster{ClusterID: "large", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard"}
+
+	dataplaneClusterConfig := &config.DataplaneClusterConfig{
+		DataPlaneClusterScalingType: config.ManualScaling,
+		ClusterConfig: config.NewClusterConfig(config.ClusterList{
+			config.ManualCluster{ClusterId: "source", Schedulable: true, KafkaInstanceLimit: 5},
+			config.ManualCluster{ClusterId: "small", Schedulable: true, KafkaInstanceLimit: 1},
+			config.ManualCluster{ClusterId: "large", Schedulable: true, KafkaInstanceLimit: 5},
+		}),
+	}
+	clusterService := &ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return sourceCluster, nil
+		},
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+			return []*api.Cluster{sourceCluster, smallCluster, largeCluster}, nil
+		},
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+			return KafkaStreamingUnitCountPerClusterList{
+				{ClusterId: "source", InstanceType: types.STANDARD.String(), Count: 4},
+				{ClusterId: "large", InstanceType: types.STANDARD.String(), Count: 2},
+			}, nil
+		},
+	}
+	kafkaService := &KafkaServiceMock{
+		UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+			return nil
+		},
+	}
+
+	mocket.Catcher.Reset()
+	kafkaRow := func(id string, status string, billingModel string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                          id,
+			"status":                      status,
+			"cluster_id":                  "source",
+			"instance_type":               types.STANDARD.String(),
+			"size_id":                     "x1",
+			"multi_az":                    true,
+			"desired_kafka_billing_model": billingModel,
+		}
+	}
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE cluster_id = $1`).WithReply([]map[string]interface{}{
+		kafkaRow("kafka-1", constants.KafkaRequestStatusReady.String(), "standard"),
+		kafkaRow("kafka-2", constants.KafkaRequestStatusReady.String(), "standard"),
+		kafkaRow("kafka-3", constants.KafkaRequestStatusSuspended.String(), "standard"),
+		kafkaRow("kafka-4", constants.KafkaRequestStatusReady.String(), constants.BillingModelEnterprise.String()),
+	})
+	mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+	g := gomega.NewWithT(t)
+	s := NewKafkaMigrationService(db.NewMockConnectionFactory(nil), kafkaService, clusterService, nil, dataplaneClusterConfig, &defaultKafkaConf)
+	migrations, err := s.Drain("source")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(migrations).To(gomega.HaveLen(4))
+
+	type result struct {
+		kafkaID string
+		target  string
+		reason  string
+	}
+	results := []result{}
+	for _, migration := range migrations {
+		results = append(results, result{kafkaID: migration.Kafka.ID, target: migration.Kafka.MigrationTargetClusterID, reason: migration.Reason})
+	}
+	g.Expect(results).To(gomega.Equal([]result{
+		// the least loaded cluster is used until it has no capacity left
+		{kafkaID: "kafka-1", target: "small"},
+		{kafkaID: "kafka-2", target: "large"},
+		{kafkaID: "kafka-3", reason: `kafka status is "suspended"`},
+		{kafkaID: "kafka-4", reason: "enterprise kafkas can only be moved to a given cluster"},
+	}))
+	g.Expect(kafkaService.UpdatesCalls()).To(gomega.HaveLen(2))
+}
+
+func Test_kafkaMigrationService_SwitchRoutes(t *testing.T) {
+	migratingKafka := func() *dbapi.KafkaRequest {
+		kafka := &dbapi.KafkaRequest{
+			Meta:                     api.Meta{ID: "kafka-id"},
+			ClusterID:                "source",
+			MigrationStatus:          dbapi.KafkaMigrationStatusTargetReady,
+			MigrationSourceClusterID: "source",
+			MigrationTargetClusterID: "target",
+		}
+		_ = kafka.SetRoutes([]dbapi.DataPlaneKafkaRoute{
+			{Domain: "kafka.example.com", Router: "router.source.example.com"},
+			{Domain: "admin-kafka.example.com", Router: "router.source.example.com"},
+		})
+		_ = kafka.SetMigrationRoutes([]dbapi.DataPlaneKafkaRoute{
+			{Domain: "kafka.example.com", Router: "router.target.example.com"},
+		})
+		return kafka
+	}
+	recordStatusID := "change-id"
+	inSync := CNameRecordStatusInSync
+
+	tests := []struct {
+		name                string
+		kafka               *dbapi.KafkaRequest
+		enableCNAME         bool
+		changeErr           *errors.ServiceError
+		wantErr             bool
+		wantChangedRoutes   []string
+		wantRoutesCreatedID interface{}
+	}{
+		{
+			name:                "should upsert the CNAME records to the target cluster and delete the routes it does not expose",
+			kafka:               migratingKafka(),
+			enableCNAME:         true,
+			wantChangedRoutes:   []string{"UPSERT kafka.example.com", "DELETE admin-kafka.example.com"},
+			wantRoutesCreatedID: recordStatusID,
+		},
+		{
+			name:  "should switch the routes without changing CNAME records when their registration is disabled",
+			kafka: migratingKafka(),
+		},
+		{
+			name:        "should not switch the kafka when the CNAME records cannot be changed",
+			kafka:       migratingKafka(),
+			enableCNAME: true,
+			changeErr:   errors.GeneralError("failed to change the CNAME records"),
+			wantErr:     true,
+		},
+		{
+			name: "should return an error when the kafka has no migration routes",
+			kafka: func() *dbapi.KafkaRequest {
+				kafka := migratingKafka()
+				kafka.MigrationRoutes = nil
+				return kafka
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			changedRoutes := []string{}
+			kafkaService := &KafkaServiceMock{
+				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*CNameRecordStatus, *errors.ServiceError) {
+					if tt.changeErr != nil {
+						return nil, tt.changeErr
+					}
+					routes, _ := kafkaRequest.GetRoutes()
+					for _, route := range routes {
+						changedRoutes = append(changedRoutes, action.String()+" "+route.Domain)
+					}
+					return &CNameRecordStatus{Id: &recordStatusID, Status: &inSync}, nil
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+			}
+			s := NewKafkaMigrationService(nil, kafkaService, nil, nil, nil, &config.KafkaConfig{EnableKafkaCNAMERegistration: tt.enableCNAME})
+			err := s.SwitchRoutes(tt.kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(kafkaService.UpdatesCalls()).To(gomega.BeEmpty())
+				return
+			}
+
+			if tt.enableCNAME {
+				g.Expect(changedRoutes).To(gomega.Equal(tt.wantChangedRoutes))
+			} else {
+				g.Expect(kafkaService.ChangeKafkaCNAMErecordsCalls()).To(gomega.BeEmpty())
+			}
+			g.Expect(kafkaService.UpdatesCalls()).To(gomega.HaveLen(1))
+			values := kafkaService.UpdatesCalls()[0].Values
+			g.Expect(values["cluster_id"]).To(gomega.Equal("target"))
+			g.Expect(values["routes"]).To(gomega.Equal(tt.kafka.MigrationRoutes))
+			g.Expect(values["routes_created"]).To(gomega.BeTrue())
+			if tt.enableCNAME {
+				g.Expect(values["routes_creation_id"]).To(gomega.Equal(tt.wantRoutesCreatedID))
+			} else {
+				g.Expect(values).NotTo(gomega.HaveKey("routes_creation_id"))
+			}
+			g.Expect(values["migration_status"]).To(gomega.Equal(dbapi.KafkaMigrationStatusDeprovisioningSource.String()))
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+// KafkaPlacementFilter is the filter rejecting a cluster when placing a kafka
+type KafkaPlacementFilter string
+
+const (
+	// KafkaPlacementFilterOrganisation rejects the clusters the kafka cannot be placed on because of the organisation owning them
+	KafkaPlacementFilterOrganisation KafkaPlacementFilter = "organisation"
+	// KafkaPlacementFilterStatus rejects the clusters that are not ready, not schedulable or cordoned
+	KafkaPlacementFilterStatus KafkaPlacementFilter = "status"
+	// KafkaPlacementFilterMultiAZ rejects the single AZ clusters when the kafka is multi AZ
+	KafkaPlacementFilterMultiAZ KafkaPlacementFilter = "multi_az"
+	// KafkaPlacementFilterInstanceType rejects the clusters not supporting the instance type of the kafka
+	KafkaPlacementFilterInstanceType KafkaPlacementFilter = "instance_type"
+	// KafkaPlacementFilterCapacity rejects the clusters not having enough remaining streaming units to host the kafka
+	KafkaPlacementFilterCapacity KafkaPlacementFilter = "capacity"
+)
+
+func (f KafkaPlacementFilter) String() string {
+	return string(f)
+}
+
+// KafkaPlacementCandidate is a cluster considered when placing a kafka
+type KafkaPlacementCandidate struct {
+	Cluster *api.Cluster
+	// RejectedBy is the filter that rejected the cluster, or empty if the cluster can host the kafka
+	RejectedBy KafkaPlacementFilter
+	// Reason describes why the cluster was rejected
+	Reason string
+}
+
+// KafkaPlacementExplanation describes how a kafka would be placed
+type KafkaPlacementExplanation struct {
+	// RegionCapacityAvailable is whether the region limit of the instance type would accept the kafka. It is always true for enterprise kafkas.
+	RegionCapacityAvailable bool
+	Candidates              []KafkaPlacementCandidate
+	// ChosenCluster is the cluster the kafka would be placed on, or nil if the kafka cannot be placed
+	ChosenCluster *api.Cluster
+}
+
+//go:generate moq -out kafka_placement_explain_moq.go . KafkaPlacementExplainService
+type KafkaPlacementExplainService interface {
+	// ExplainPlacement evaluates every cluster the kafka could be placed on without persisting anything. The rejected clusters
+	// are returned with the filter that rejected them alongside the cluster the placement strategy would choose.
+	ExplainPlacement(kafka *dbapi.KafkaRequest) (*KafkaPlacementExplanation, *errors.ServiceError)
+	// ExplainClusterPlacement evaluates whether the kafka could be placed on the given cluster. The cluster has to be in the
+	// cloud provider and region of the kafka. nil is returned if the cluster is not found there.
+	ExplainClusterPlacement(kafka *dbapi.KafkaRequest, clusterID string) (*KafkaPlacementCandidate, *errors.ServiceError)
+}
+
+var _ KafkaPlacementExplainService = &kafkaPlacementExplainService{}
+
+type kafkaPlacementExplainService struct {
+	kafkaService             KafkaService
+	clusterService           ClusterService
+	clusterPlacementStrategy ClusterPlacementStrategy
+	dataplaneClusterConfig   *config.DataplaneClusterConfig
+	kafkaConfig              *config.KafkaConfig
+}
+
+func NewKafkaPlacementExplainService(kafkaService KafkaService, clusterService ClusterService, clusterPlacementStrategy ClusterPlacementStrategy,
+	dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) *kafkaPlacementExplainService {
+	return &kafkaPlacementExplainService{
+		kafkaService:             kafkaService,
+		clusterService:           clusterService,
+		clusterPlacementStrategy: clusterPlacementStrategy,
+		dataplaneClusterConfig:   dataplaneClusterConfig,
+		kafkaConfig:              kafkaConfig,
+	}
+}
+
+func (s *kafkaPlacementExplainService) ExplainPlacement(kafka *dbapi.KafkaRequest) (*KafkaPlacementExplanation, *errors.ServiceError) {
+	// The Instance Type determines the MultiAZ attribute in the same way as when registering the kafka
+	switch kafka.InstanceType {
+	case types.STANDARD.String():
+		kafka.MultiAZ = true
+	case types.DEVELOPER.String():
+		kafka.MultiAZ = false
+	}
+
+	instanceSize, sizeErr := s.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+	if sizeErr != nil {
+		return nil, errors.InstancePlanNotSupported(sizeErr.Error())
+	}
+
+	explanation := &KafkaPlacementExplanation{RegionCapacityAvailable: true}
+	var err *errors.ServiceError
+	if kafka.DesiredBillingModelIsEnterprise() {
+		explanation.Candidates, err = s.explainEnterprisePlacement(kafka, instanceSize)
+	} else {
+		explanation.RegionCapacityAvailable, err = s.kafkaService.HasAvailableCapacityInRegion(kafka)
+		if err != nil {
+			return nil, err
+		}
+		explanation.Candidates, err = s.explainPlacement(kafka, instanceSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hasAcceptedCandidate := arrays.AnyMatch(explanation.Candidates, func(candidate KafkaPlacementCandidate) bool {
+		return candidate.RejectedBy == ""
+	})
+	if !explanation.RegionCapacityAvailable || !hasAcceptedCandidate {
+		return explanation, nil
+	}
+
+	chosenCluster, findErr := s.clusterPlacementStrategy.FindCluster(kafka)
+	if findErr != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, findErr, "failed to find the cluster the kafka would be placed on")
+	}
+	explanation.ChosenCluster = chosenCluster
+
+	return explanation, nil
+}
+
+func (s *kafkaPlacementExplainService) ExplainClusterPlacement(kafka *dbapi.KafkaRequest, clusterID string) (*KafkaPlacementCandidate, *errors.ServiceError) {
+	instanceSize, sizeErr := s.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+	if sizeErr != nil {
+		return nil, errors.InstancePlanNotSupported(sizeErr.Error())
+	}
+
+	var candidates []KafkaPlacementCandidate
+	var err *errors.ServiceError
+	if kafka.DesiredBillingModelIsEnterprise() {
+		enterpriseKafka := *kafka
+		enterpriseKafka.ClusterID = clusterID
+		candidates, err = s.explainEnterprisePlacement(&enterpriseKafka, instanceSize)
+	} else {
+		candidates, err = s.explainPlacement(kafka, instanceSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range candidates {
+		candidate := candidates[i]
+		if candidate.Cluster.ClusterID == clusterID && candidate.Cluster.CloudProvider == kafka.CloudProvider && candidate.Cluster.Region == kafka.Region {
+			return &candidate, nil
+		}
+	}
+	return nil, nil
+}
+
+// explainEnterprisePlacement evaluates the cluster the enterprise kafka is requested on
+func (s *kafkaPlacementExplainService) explainEnterprisePlacement(kafka *dbapi.KafkaRequest, instanceSize *config.KafkaInstanceSize) ([]KafkaPlacementCandidate, *errors.ServiceError) {
+	cluster, err := s.clusterService.FindClusterByID(kafka.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return []KafkaPlacementCandidate{}, nil
+	}
+
+	candidate := KafkaPlacementCandidate{Cluster: cluster}
+	capacityInfo, instanceTypeSupported := cluster.RetrieveDynamicCapacityInfo()[kafka.InstanceType]
+	switch {
+	case cluster.OrganizationID != kafka.OrganisationId:
+		candidate.RejectedBy = KafkaPlacementFilterOrganisation
+		candidate.Reason = fmt.Sprintf("cluster belongs to organisation %q", cluster.OrganizationID)
+	case cluster.Status != api.ClusterReady:
+		candidate.RejectedBy = KafkaPlacementFilterStatus
+		candidate.Reason = fmt.Sprintf("cluster status is %q", cluster.Status)
+	case cluster.Cordoned:
+		candidate.RejectedBy = KafkaPlacementFilterStatus
+		candidate.Reason = "cluster is cordoned"
+	case !instanceTypeSupported:
+		candidate.RejectedBy = KafkaPlacementFilterInstanceType
+		candidate.Reason = fmt.Sprintf("cluster does not support instance type %q", kafka.InstanceType)
+	default:
+		streamingUnitCounts, computeErr := s.clusterService.ComputeConsumedStreamingUnitCountPerInstanceType(cluster.ClusterID)
+		if computeErr != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, computeErr, "failed to compute the streaming units consumed on cluster %q", cluster.ClusterID)
+		}
+		consumedStreamingUnits := streamingUnitCounts[types.KafkaInstanceType(kafka.InstanceType)]
+		if consumedStreamingUnits+int64(instanceSize.CapacityConsumed) > int64(capacityInfo.MaxUnits) {
+			candidate.RejectedBy = KafkaPlacementFilterCapacity
+			candidate.Reason = fmt.Sprintf("%d out of %d streaming units consumed, %d required", consumedStreamingUnits, capacityInfo.MaxUnits, instanceSize.CapacityConsumed)
+		}
+	}
+
+	return []KafkaPlacementCandidate{candidate}, nil
+}
+
+// explainPlacement evaluates every cluster of the cloud provider and region of the kafka
+func (s *kafkaPlacementExplainService) explainPlacement(kafka *dbapi.KafkaRequest, instanceSize *config.KafkaInstanceSize) ([]KafkaPlacementCandidate, *errors.ServiceError) {
+	clusters, err := s.clusterService.FindAllClusters(FindClusterCriteria{
+		Provider: kafka.CloudProvider,
+		Region:   kafka.Region,
+	})
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to find the clusters of region %q", kafka.Region)
+	}
+
+	streamingUnitCountPerClusterList, err := s.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get count of streaming units by cluster and instance type")
+	}
+
+	placement := loadAwarePlacement{
+		dataplaneClusterConfig: s.dataplaneClusterConfig,
+		clusterService:         s.clusterService,
+		kafkaConfig:            s.kafkaConfig,
+	}
+	candidates := []KafkaPlacementCandidate{}
+	for _, cluster := range clusters {
+		candidate := KafkaPlacementCandidate{Cluster: cluster}
+		switch {
+		case cluster.ClusterType == api.EnterpriseDataPlaneClusterType.String():
+			candidate.RejectedBy = KafkaPlacementFilterOrganisation
+			candidate.Reason = fmt.Sprintf("cluster is an enterprise cluster belonging to organisation %q", cluster.OrganizationID)
+		case cluster.Status != api.ClusterReady:
+			candidate.RejectedBy = KafkaPlacementFilterStatus
+			candidate.Reason = fmt.Sprintf("cluster status is %q", cluster.Status)
+		case cluster.Cordoned:
+			candidate.RejectedBy = KafkaPlacementFilterStatus
+			candidate.Reason = "cluster is cordoned"
+		case s.dataplaneClusterConfig.IsDataPlaneManualScalingEnabled() && !s.dataplaneClusterConfig.GetClusterConfig().IsClusterSchedulable(cluster.ClusterID):
+			candidate.RejectedBy = KafkaPlacementFilterStatus
+			candidate.Reason = "cluster is not schedulable"
+		case kafka.MultiAZ && !cluster.MultiAZ:
+			candidate.RejectedBy = KafkaPlacementFilterMultiAZ
+			candidate.Reason = "cluster is not multi AZ"
+		case !arrays.Contains(cluster.GetSupportedInstanceTypes(), kafka.InstanceType):
+			candidate.RejectedBy = KafkaPlacementFilterInstanceType
+			candidate.Reason = fmt.Sprintf("cluster does not support instance type %q", kafka.InstanceType)
+		default:
+			load, canHostKafka := placement.computeClusterLoad(cluster, kafka, instanceSize, streamingUnitCountPerClusterList)
+			if !canHostKafka {
+				candidate.RejectedBy = KafkaPlacementFilterCapacity
+				candidate.Reason = fmt.Sprintf("%d streaming units consumed, %d required, %d missing", load.consumedStreamingUnits, instanceSize.CapacityConsumed, -load.remainingStreamingUnits)
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
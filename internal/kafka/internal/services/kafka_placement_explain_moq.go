@@ -0,0 +1,126 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that KafkaPlacementExplainServiceMock does implement KafkaPlacementExplainService.
+// If this is not the case, regenerate this file with moq.
+var _ KafkaPlacementExplainService = &KafkaPlacementExplainServiceMock{}
+
+// KafkaPlacementExplainServiceMock is a mock implementation of KafkaPlacementExplainService.
+//
+//	func TestSomethingThatUsesKafkaPlacementExplainService(t *testing.T) {
+//
+//		// make and configure a mocked KafkaPlacementExplainService
+//		mockedKafkaPlacementExplainService := &KafkaPlacementExplainServiceMock{
+//			ExplainClusterPlacementFunc: func(kafka *dbapi.KafkaRequest, clusterID string) (*KafkaPlacementCandidate, *serviceError.ServiceError) {
+//				panic("mock out the ExplainClusterPlacement method")
+//			},
+//			ExplainPlacementFunc: func(kafka *dbapi.KafkaRequest) (*KafkaPlacementExplanation, *serviceError.ServiceError) {
+//				panic("mock out the ExplainPlacement method")
+//			},
+//		}
+//
+//		// use mockedKafkaPlacementExplainService in code that requires KafkaPlacementExplainService
+//		// and then make assertions.
+//
+//	}
+type KafkaPlacementExplainServiceMock struct {
+	// ExplainClusterPlacementFunc mocks the ExplainClusterPlacement method.
+	ExplainClusterPlacementFunc func(kafka *dbapi.KafkaRequest, clusterID string) (*KafkaPlacementCandidate, *serviceError.ServiceError)
+
+	// ExplainPlacementFunc mocks the ExplainPlacement method.
+	ExplainPlacementFunc func(kafka *dbapi.KafkaRequest) (*KafkaPlacementExplanation, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ExplainClusterPlacement holds details about calls to the ExplainClusterPlacement method.
+		ExplainClusterPlacement []struct {
+			// Kafka is the kafka argument value.
+			Kafka *dbapi.KafkaRequest
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// ExplainPlacement holds details about calls to the ExplainPlacement method.
+		ExplainPlacement []struct {
+			// Kafka is the kafka argument value.
+			Kafka *dbapi.KafkaRequest
+		}
+	}
+	lockExplainClusterPlacement sync.RWMutex
+	lockExplainPlacement        sync.RWMutex
+}
+
+// ExplainClusterPlacement calls ExplainClusterPlacementFunc.
+func (mock *KafkaPlacementExplainServiceMock) ExplainClusterPlacement(kafka *dbapi.KafkaRequest, clusterID string) (*KafkaPlacementCandidate, *serviceError.ServiceError) {
+	if mock.ExplainClusterPlacementFunc == nil {
+		panic("KafkaPlacementExplainServiceMock.ExplainClusterPlacementFunc: method is nil but KafkaPlacementExplainService.ExplainClusterPlacement was just called")
+	}
+	callInfo := struct {
+		Kafka     *dbapi.KafkaRequest
+		ClusterID string
+	}{
+		Kafka:     kafka,
+		ClusterID: clusterID,
+	}
+	mock.lockExplainClusterPlacement.Lock()
+	mock.calls.ExplainClusterPlacement = append(mock.calls.ExplainClusterPlacement, callInfo)
+	mock.lockExplainClusterPlacement.Unlock()
+	return mock.ExplainClusterPlacementFunc(kafka, clusterID)
+}
+
+// ExplainClusterPlacementCalls gets all the calls that were made to ExplainClusterPlacement.
+// Check the length with:
+//
+//	len(mockedKafkaPlacementExplainService.ExplainClusterPlacementCalls())
+func (mock *KafkaPlacementExplainServiceMock) ExplainClusterPlacementCalls() []struct {
+	Kafka     *dbapi.KafkaRequest
+	ClusterID string
+} {
+	var calls []struct {
+		Kafka     *dbapi.KafkaRequest
+		ClusterID string
+	}
+	mock.lockExplainClusterPlacement.RLock()
+	calls = mock.calls.ExplainClusterPlacement
+	mock.lockExplainClusterPlacement.RUnlock()
+	return calls
+}
+
+// ExplainPlacement calls ExplainPlacementFunc.
+func (mock *KafkaPlacementExplainServiceMock) ExplainPlacement(kafka *dbapi.KafkaRequest) (*KafkaPlacementExplanation, *serviceError.ServiceError) {
+	if mock.ExplainPlacementFunc == nil {
+		panic("KafkaPlacementExplainServiceMock.ExplainPlacementFunc: method is nil but KafkaPlacementExplainService.ExplainPlacement was just called")
+	}
+	callInfo := struct {
+		Kafka *dbapi.KafkaRequest
+	}{
+		Kafka: kafka,
+	}
+	mock.lockExplainPlacement.Lock()
+	mock.calls.ExplainPlacement = append(mock.calls.ExplainPlacement, callInfo)
+	mock.lockExplainPlacement.Unlock()
+	return mock.ExplainPlacementFunc(kafka)
+}
+
+// ExplainPlacementCalls gets all the calls that were made to ExplainPlacement.
+// Check the length with:
+//
+//	len(mockedKafkaPlacementExplainService.ExplainPlacementCalls())
+func (mock *KafkaPlacementExplainServiceMock) ExplainPlacementCalls() []struct {
+	Kafka *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		Kafka *dbapi.KafkaRequest
+	}
+	mock.lockExplainPlacement.RLock()
+	calls = mock.calls.ExplainPlacement
+	mock.lockExplainPlacement.RUnlock()
+	return calls
+}
@@ -0,0 +1,239 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_kafkaPlacementExplainService_ExplainPlacement(t *testing.T) {
+	readyCluster := &api.Cluster{ClusterID: "ready", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard,developer"}
+	enterpriseCluster := &api.Cluster{ClusterID: "enterprise", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.EnterpriseDataPlaneClusterType.String(), OrganizationID: "org-id",
+		DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_units":2}}`))}
+	provisioningCluster := &api.Cluster{ClusterID: "provisioning", Status: api.ClusterProvisioning, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard,developer"}
+	unschedulableCluster := &api.Cluster{ClusterID: "unschedulable", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard,developer"}
+	cordonedCluster := &api.Cluster{ClusterID: "cordoned", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard,developer", Cordoned: true}
+	singleAZCluster := &api.Cluster{ClusterID: "single-az", Status: api.ClusterReady, MultiAZ: false, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard,developer"}
+	developerCluster := &api.Cluster{ClusterID: "developer", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "developer"}
+	fullCluster := &api.Cluster{ClusterID: "full", Status: api.ClusterReady, MultiAZ: true, ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard,developer"}
+
+	dataplaneClusterConfig := &config.DataplaneClusterConfig{
+		DataPlaneClusterScalingType: config.ManualScaling,
+		ClusterConfig: config.NewClusterConfig(config.ClusterList{
+			config.ManualCluster{ClusterId: "ready", Schedulable: true, KafkaInstanceLimit: 5},
+			config.ManualCluster{ClusterId: "unschedulable", Schedulable: false, KafkaInstanceLimit: 5},
+			config.ManualCluster{ClusterId: "full", Schedulable: true, KafkaInstanceLimit: 1},
+		}),
+	}
+
+	clusterService := &ClusterServiceMock{
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+			return []*api.Cluster{enterpriseCluster, provisioningCluster, unschedulableCluster, cordonedCluster, singleAZCluster, developerCluster, fullCluster, readyCluster}, nil
+		},
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+			return KafkaStreamingUnitCountPerClusterList{
+				{ClusterId: "full", InstanceType: types.STANDARD.String(), Count: 1},
+				{ClusterId: "ready", InstanceType: types.STANDARD.String(), Count: 2},
+			}, nil
+		},
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return enterpriseCluster, nil
+		},
+		ComputeConsumedStreamingUnitCountPerInstanceTypeFunc: func(clusterID string) (StreamingUnitCountPerInstanceType, error) {
+			return StreamingUnitCountPerInstanceType{types.STANDARD: 2}, nil
+		},
+	}
+
+	type fields struct {
+		kafkaService             KafkaService
+		clusterPlacementStrategy ClusterPlacementStrategy
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		kafka   *dbapi.KafkaRequest
+		want    *KafkaPlacementExplanation
+		wantErr bool
+	}{
+		{
+			name: "should return the filter rejecting each cluster of the region and the cluster chosen by the placement strategy",
+			fields: fields{
+				kafkaService: &KafkaServiceMock{
+					HasAvailableCapacityInRegionFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+				},
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{
+					FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+						return readyCluster, nil
+					},
+				},
+			},
+			kafka: &dbapi.KafkaRequest{InstanceType: types.STANDARD.String(), SizeId: "x1"},
+			want: &KafkaPlacementExplanation{
+				RegionCapacityAvailable: true,
+				Candidates: []KafkaPlacementCandidate{
+					{Cluster: enterpriseCluster, RejectedBy: KafkaPlacementFilterOrganisation, Reason: `cluster is an enterprise cluster belonging to organisation "org-id"`},
+					{Cluster: provisioningCluster, RejectedBy: KafkaPlacementFilterStatus, Reason: `cluster status is "cluster_provisioning"`},
+					{Cluster: unschedulableCluster, RejectedBy: KafkaPlacementFilterStatus, Reason: "cluster is not schedulable"},
+					{Cluster: cordonedCluster, RejectedBy: KafkaPlacementFilterStatus, Reason: "cluster is cordoned"},
+					{Cluster: singleAZCluster, RejectedBy: KafkaPlacementFilterMultiAZ, Reason: "cluster is not multi AZ"},
+					{Cluster: developerCluster, RejectedBy: KafkaPlacementFilterInstanceType, Reason: `cluster does not support instance type "standard"`},
+					{Cluster: fullCluster, RejectedBy: KafkaPlacementFilterCapacity, Reason: "1 streaming units consumed, 1 required, 1 missing"},
+					{Cluster: readyCluster},
+				},
+				ChosenCluster: readyCluster,
+			},
+		},
+		{
+			name: "should not choose any cluster when the region has no capacity left for the instance type",
+			fields: fields{
+				kafkaService: &KafkaServiceMock{
+					HasAvailableCapacityInRegionFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return false, nil
+					},
+				},
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
+			},
+			kafka: &dbapi.KafkaRequest{InstanceType: types.DEVELOPER.String(), SizeId: "x1"},
+			want: &KafkaPlacementExplanation{
+				RegionCapacityAvailable: false,
+				Candidates: []KafkaPlacementCandidate{
+					{Cluster: enterpriseCluster, RejectedBy: KafkaPlacementFilterOrganisation, Reason: `cluster is an enterprise cluster belonging to organisation "org-id"`},
+					{Cluster: provisioningCluster, RejectedBy: KafkaPlacementFilterStatus, Reason: `cluster status is "cluster_provisioning"`},
+					{Cluster: unschedulableCluster, RejectedBy: KafkaPlacementFilterStatus, Reason: "cluster is not schedulable"},
+					{Cluster: cordonedCluster, RejectedBy: KafkaPlacementFilterStatus, Reason: "cluster is cordoned"},
+					{Cluster: singleAZCluster},
+					{Cluster: developerCluster},
+					{Cluster: fullCluster, RejectedBy: KafkaPlacementFilterCapacity, Reason: "1 streaming units consumed, 2 required, 2 missing"},
+					{Cluster: readyCluster},
+				},
+			},
+		},
+		{
+			name: "should reject the cluster of another organisation for an enterprise kafka",
+			fields: fields{
+				kafkaService:             &KafkaServiceMock{},
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
+			},
+			kafka: &dbapi.KafkaRequest{InstanceType: types.STANDARD.String(), SizeId: "x1", ClusterID: "enterprise", OrganisationId: "other-org-id",
+				DesiredKafkaBillingModel: constants.BillingModelEnterprise.String()},
+			want: &KafkaPlacementExplanation{
+				RegionCapacityAvailable: true,
+				Candidates: []KafkaPlacementCandidate{
+					{Cluster: enterpriseCluster, RejectedBy: KafkaPlacementFilterOrganisation, Reason: `cluster belongs to organisation "org-id"`},
+				},
+			},
+		},
+		{
+			name: "should reject the enterprise cluster without remaining streaming units",
+			fields: fields{
+				kafkaService:             &KafkaServiceMock{},
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
+			},
+			kafka: &dbapi.KafkaRequest{InstanceType: types.STANDARD.String(), SizeId: "x1", ClusterID: "enterprise", OrganisationId: "org-id",
+				DesiredKafkaBillingModel: constants.BillingModelEnterprise.String()},
+			want: &KafkaPlacementExplanation{
+				RegionCapacityAvailable: true,
+				Candidates: []KafkaPlacementCandidate{
+					{Cluster: enterpriseCluster, RejectedBy: KafkaPlacementFilterCapacity, Reason: "2 out of 2 streaming units consumed, 1 required"},
+				},
+			},
+		},
+		{
+			name: "should return an error when the placement strategy fails",
+			fields: fields{
+				kafkaService: &KafkaServiceMock{
+					HasAvailableCapacityInRegionFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+				},
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{
+					FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+						return nil, errors.GeneralError("failed to find clusters")
+					},
+				},
+			},
+			kafka:   &dbapi.KafkaRequest{InstanceType: types.STANDARD.String(), SizeId: "x1"},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the plan is not supported",
+			fields: fields{
+				kafkaService:             &KafkaServiceMock{},
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
+			},
+			kafka:   &dbapi.KafkaRequest{InstanceType: types.STANDARD.String(), SizeId: "x9"},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			s := NewKafkaPlacementExplainService(tt.fields.kafkaService, clusterService, tt.fields.clusterPlacementStrategy, dataplaneClusterConfig, &defaultKafkaConf)
+			got, err := s.ExplainPlacement(tt.kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaPlacementExplainService_ExplainClusterPlacement(t *testing.T) {
+	readyCluster := &api.Cluster{ClusterID: "ready", CloudProvider: "aws", Region: "us-east-1", Status: api.ClusterReady, MultiAZ: true,
+		ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard"}
+	otherRegionCluster := &api.Cluster{ClusterID: "other-region", CloudProvider: "aws", Region: "eu-west-1", Status: api.ClusterReady, MultiAZ: true,
+		ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard"}
+	singleAZCluster := &api.Cluster{ClusterID: "single-az", CloudProvider: "aws", Region: "us-east-1", Status: api.ClusterReady, MultiAZ: false,
+		ClusterType: api.ManagedDataPlaneClusterType.String(), SupportedInstanceType: "standard"}
+
+	clusterService := &ClusterServiceMock{
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+			return []*api.Cluster{readyCluster, otherRegionCluster, singleAZCluster}, nil
+		},
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+			return KafkaStreamingUnitCountPerClusterList{}, nil
+		},
+	}
+	kafka := &dbapi.KafkaRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: types.STANDARD.String(), SizeId: "x1", MultiAZ: true}
+
+	tests := []struct {
+		name      string
+		clusterID string
+		want      *KafkaPlacementCandidate
+	}{
+		{
+			name:      "should accept a cluster able to host the kafka",
+			clusterID: "ready",
+			want:      &KafkaPlacementCandidate{Cluster: readyCluster},
+		},
+		{
+			name:      "should return the filter rejecting the cluster",
+			clusterID: "single-az",
+			want:      &KafkaPlacementCandidate{Cluster: singleAZCluster, RejectedBy: KafkaPlacementFilterMultiAZ, Reason: "cluster is not multi AZ"},
+		},
+		{
+			name:      "should not return a cluster outside of the region of the kafka",
+			clusterID: "other-region",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			s := NewKafkaPlacementExplainService(&KafkaServiceMock{}, clusterService, &ClusterPlacementStrategyMock{}, &config.DataplaneClusterConfig{}, &defaultKafkaConf)
+			got, err := s.ExplainClusterPlacement(kafka, tt.clusterID)
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+//go:generate moq -out kafka_role_binding_moq.go . KafkaRoleBindingService
+type KafkaRoleBindingService interface {
+	// List returns the role bindings of the given kafka, oldest first
+	List(kafkaID string) (dbapi.KafkaRoleBindingList, *errors.ServiceError)
+	// Create binds the role to the subject of the binding on its kafka. A conflict error is returned when
+	// the subject already has a role on the kafka.
+	Create(binding *dbapi.KafkaRoleBinding) *errors.ServiceError
+	Delete(kafkaID string, id string) *errors.ServiceError
+	// GetRoles returns the roles bound to the subject on the given kafkas indexed by kafka id.
+	// Kafkas on which the subject has no role are not included in the returned map.
+	GetRoles(subject string, kafkaIDs ...string) (map[string]dbapi.KafkaRole, *errors.ServiceError)
+}
+
+var _ KafkaRoleBindingService = &kafkaRoleBindingService{}
+
+type kafkaRoleBindingService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewKafkaRoleBindingService(connectionFactory *db.ConnectionFactory) *kafkaRoleBindingService {
+	return &kafkaRoleBindingService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaRoleBindingService) List(kafkaID string) (dbapi.KafkaRoleBindingList, *errors.ServiceError) {
+	var bindings dbapi.KafkaRoleBindingList
+	if err := s.connectionFactory.New().Where("kafka_id = ?", kafkaID).Order("created_at").Find(&bindings).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the role bindings of kafka %q", kafkaID)
+	}
+	return bindings, nil
+}
+
+func (s *kafkaRoleBindingService) Create(binding *dbapi.KafkaRoleBinding) *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+
+	var count int64
+	if err := dbConn.Model(&dbapi.KafkaRoleBinding{}).
+		Where("kafka_id = ? AND subject = ?", binding.KafkaID, binding.Subject).
+		Count(&count).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to check the role bindings of kafka %q", binding.KafkaID)
+	}
+	if count > 0 {
+		return errors.Conflict("user %q already has a role on kafka %q", binding.Subject, binding.KafkaID)
+	}
+
+	if err := dbConn.Create(binding).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the role binding of kafka %q", binding.KafkaID)
+	}
+	return nil
+}
+
+func (s *kafkaRoleBindingService) Delete(kafkaID string, id string) *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+
+	var binding dbapi.KafkaRoleBinding
+	if err := dbConn.Where("kafka_id = ? AND id = ?", kafkaID, id).First(&binding).Error; err != nil {
+		return services.HandleGetError("KafkaRoleBinding", "id", id, err)
+	}
+
+	if err := dbConn.Delete(&binding).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete the role binding %q of kafka %q", id, kafkaID)
+	}
+	return nil
+}
+
+func (s *kafkaRoleBindingService) GetRoles(subject string, kafkaIDs ...string) (map[string]dbapi.KafkaRole, *errors.ServiceError) {
+	roles := map[string]dbapi.KafkaRole{}
+	if subject == "" || len(kafkaIDs) == 0 {
+		return roles, nil
+	}
+
+	var bindings dbapi.KafkaRoleBindingList
+	if err := s.connectionFactory.New().
+		Where("subject = ? AND kafka_id IN (?)", subject, kafkaIDs).
+		Find(&bindings).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get the roles of user %q", subject)
+	}
+
+	for _, binding := range bindings {
+		roles[binding.KafkaID] = binding.Role
+	}
+	return roles, nil
+}
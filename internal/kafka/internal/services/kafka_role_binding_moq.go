@@ -0,0 +1,220 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that KafkaRoleBindingServiceMock does implement KafkaRoleBindingService.
+// If this is not the case, regenerate this file with moq.
+var _ KafkaRoleBindingService = &KafkaRoleBindingServiceMock{}
+
+// KafkaRoleBindingServiceMock is a mock implementation of KafkaRoleBindingService.
+//
+//	func TestSomethingThatUsesKafkaRoleBindingService(t *testing.T) {
+//
+//		// make and configure a mocked KafkaRoleBindingService
+//		mockedKafkaRoleBindingService := &KafkaRoleBindingServiceMock{
+//			CreateFunc: func(binding *dbapi.KafkaRoleBinding) *serviceError.ServiceError {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(kafkaID string, id string) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			GetRolesFunc: func(subject string, kafkaIDs ...string) (map[string]dbapi.KafkaRole, *serviceError.ServiceError) {
+//				panic("mock out the GetRoles method")
+//			},
+//			ListFunc: func(kafkaID string) (dbapi.KafkaRoleBindingList, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//		}
+//
+//		// use mockedKafkaRoleBindingService in code that requires KafkaRoleBindingService
+//		// and then make assertions.
+//
+//	}
+type KafkaRoleBindingServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(binding *dbapi.KafkaRoleBinding) *serviceError.ServiceError
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(kafkaID string, id string) *serviceError.ServiceError
+
+	// GetRolesFunc mocks the GetRoles method.
+	GetRolesFunc func(subject string, kafkaIDs ...string) (map[string]dbapi.KafkaRole, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func(kafkaID string) (dbapi.KafkaRoleBindingList, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Binding is the binding argument value.
+			Binding *dbapi.KafkaRoleBinding
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// KafkaID is the kafkaID argument value.
+			KafkaID string
+			// ID is the id argument value.
+			ID string
+		}
+		// GetRoles holds details about calls to the GetRoles method.
+		GetRoles []struct {
+			// Subject is the subject argument value.
+			Subject string
+			// KafkaIDs is the kafkaIDs argument value.
+			KafkaIDs []string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// KafkaID is the kafkaID argument value.
+			KafkaID string
+		}
+	}
+	lockCreate   sync.RWMutex
+	lockDelete   sync.RWMutex
+	lockGetRoles sync.RWMutex
+	lockList     sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *KafkaRoleBindingServiceMock) Create(binding *dbapi.KafkaRoleBinding) *serviceError.ServiceError {
+	if mock.CreateFunc == nil {
+		panic("KafkaRoleBindingServiceMock.CreateFunc: method is nil but KafkaRoleBindingService.Create was just called")
+	}
+	callInfo := struct {
+		Binding *dbapi.KafkaRoleBinding
+	}{
+		Binding: binding,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(binding)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedKafkaRoleBindingService.CreateCalls())
+func (mock *KafkaRoleBindingServiceMock) CreateCalls() []struct {
+	Binding *dbapi.KafkaRoleBinding
+} {
+	var calls []struct {
+		Binding *dbapi.KafkaRoleBinding
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *KafkaRoleBindingServiceMock) Delete(kafkaID string, id string) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("KafkaRoleBindingServiceMock.DeleteFunc: method is nil but KafkaRoleBindingService.Delete was just called")
+	}
+	callInfo := struct {
+		KafkaID string
+		ID      string
+	}{
+		KafkaID: kafkaID,
+		ID:      id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(kafkaID, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedKafkaRoleBindingService.DeleteCalls())
+func (mock *KafkaRoleBindingServiceMock) DeleteCalls() []struct {
+	KafkaID string
+	ID      string
+} {
+	var calls []struct {
+		KafkaID string
+		ID      string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// GetRoles calls GetRolesFunc.
+func (mock *KafkaRoleBindingServiceMock) GetRoles(subject string, kafkaIDs ...string) (map[string]dbapi.KafkaRole, *serviceError.ServiceError) {
+	if mock.GetRolesFunc == nil {
+		panic("KafkaRoleBindingServiceMock.GetRolesFunc: method is nil but KafkaRoleBindingService.GetRoles was just called")
+	}
+	callInfo := struct {
+		Subject  string
+		KafkaIDs []string
+	}{
+		Subject:  subject,
+		KafkaIDs: kafkaIDs,
+	}
+	mock.lockGetRoles.Lock()
+	mock.calls.GetRoles = append(mock.calls.GetRoles, callInfo)
+	mock.lockGetRoles.Unlock()
+	return mock.GetRolesFunc(subject, kafkaIDs...)
+}
+
+// GetRolesCalls gets all the calls that were made to GetRoles.
+// Check the length with:
+//
+//	len(mockedKafkaRoleBindingService.GetRolesCalls())
+func (mock *KafkaRoleBindingServiceMock) GetRolesCalls() []struct {
+	Subject  string
+	KafkaIDs []string
+} {
+	var calls []struct {
+		Subject  string
+		KafkaIDs []string
+	}
+	mock.lockGetRoles.RLock()
+	calls = mock.calls.GetRoles
+	mock.lockGetRoles.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *KafkaRoleBindingServiceMock) List(kafkaID string) (dbapi.KafkaRoleBindingList, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("KafkaRoleBindingServiceMock.ListFunc: method is nil but KafkaRoleBindingService.List was just called")
+	}
+	callInfo := struct {
+		KafkaID string
+	}{
+		KafkaID: kafkaID,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(kafkaID)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedKafkaRoleBindingService.ListCalls())
+func (mock *KafkaRoleBindingServiceMock) ListCalls() []struct {
+	KafkaID string
+} {
+	var calls []struct {
+		KafkaID string
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
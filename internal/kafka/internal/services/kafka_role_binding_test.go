@@ -0,0 +1,109 @@
+package services
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaRoleBindingService_Create(t *testing.T) {
+	tests := []struct {
+		name         string
+		count        int
+		wantErrCode  errors.ServiceErrorCode
+		wantInserted bool
+	}{
+		{
+			name:         "should bind the role to the user",
+			count:        0,
+			wantInserted: true,
+		},
+		{
+			name:        "should fail when the user already has a role on the kafka",
+			count:       1,
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var inserted bool
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT count(1) FROM "kafka_role_bindings" WHERE (kafka_id = $1 AND subject = $2)`).
+				WithArgs("kafka-id", "another-user").
+				WithReply([]map[string]interface{}{{"count": tt.count}})
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_role_bindings"`).WithCallback(func(s string, nv []driver.NamedValue) {
+				inserted = true
+			})
+
+			s := NewKafkaRoleBindingService(db.NewMockConnectionFactory(nil))
+			binding := &dbapi.KafkaRoleBinding{KafkaID: "kafka-id", Subject: "another-user", Role: dbapi.KafkaRoleEditor}
+			err := s.Create(binding)
+			if tt.wantErrCode != 0 {
+				g.Expect(err).NotTo(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+				g.Expect(binding.ID).NotTo(gomega.BeEmpty())
+			}
+			g.Expect(inserted).To(gomega.Equal(tt.wantInserted))
+		})
+	}
+}
+
+func Test_kafkaRoleBindingService_GetRoles(t *testing.T) {
+	tests := []struct {
+		name     string
+		kafkaIDs []string
+		setupFn  func()
+		want     map[string]dbapi.KafkaRole
+		wantErr  bool
+	}{
+		{
+			name:     "should not query the role bindings when no kafka is given",
+			kafkaIDs: []string{},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("SELECT").WithQueryException()
+			},
+			want: map[string]dbapi.KafkaRole{},
+		},
+		{
+			name:     "should return the roles bound to the user indexed by kafka id",
+			kafkaIDs: []string{"kafka-1", "kafka-2"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_role_bindings" WHERE (subject = $1 AND kafka_id IN ($2,$3))`).
+					WithReply([]map[string]interface{}{{"id": "binding-id", "kafka_id": "kafka-1", "subject": "another-user", "role": "editor"}})
+			},
+			want: map[string]dbapi.KafkaRole{"kafka-1": dbapi.KafkaRoleEditor},
+		},
+		{
+			name:     "should return an error when the role bindings cannot be listed",
+			kafkaIDs: []string{"kafka-1"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("SELECT").WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewKafkaRoleBindingService(db.NewMockConnectionFactory(nil))
+			got, err := s.GetRoles("another-user", tt.kafkaIDs...)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/miekg/dns"
+)
+
+const kafkaRoutesExportHeader = "Kafka routes exported by kas-fleet-manager"
+
+// routerLookupTimeout is the maximum time spent resolving the address of a router when exporting the hosts file
+const routerLookupTimeout = 5 * time.Second
+
+//go:generate moq -out kafka_routes_export_moq.go . KafkaRoutesExportService
+type KafkaRoutesExportService interface {
+	// ListRoutes returns the routes of all the kafkas that are not being deleted, sorted by domain
+	ListRoutes() ([]dbapi.DataPlaneKafkaRoute, *errors.ServiceError)
+	// ZoneFile returns the CNAME records of the kafka routes in the BIND zone file format, or address records for the
+	// routers given as IP addresses. The records use absolute names so that the file can be included in an existing zone.
+	ZoneFile() (string, *errors.ServiceError)
+	// HostsFile returns the kafka routes in the /etc/hosts format. The routers are resolved to their IP addresses,
+	// routes whose router cannot be resolved are written as comments.
+	HostsFile() (string, *errors.ServiceError)
+}
+
+var _ KafkaRoutesExportService = &kafkaRoutesExportService{}
+
+type kafkaRoutesExportService struct {
+	connectionFactory *db.ConnectionFactory
+	kafkaConfig       *config.KafkaConfig
+	lookupHost        func(ctx context.Context, host string) ([]string, error)
+}
+
+func NewKafkaRoutesExportService(connectionFactory *db.ConnectionFactory, kafkaConfig *config.KafkaConfig) *kafkaRoutesExportService {
+	return &kafkaRoutesExportService{
+		connectionFactory: connectionFactory,
+		kafkaConfig:       kafkaConfig,
+		lookupHost:        net.DefaultResolver.LookupHost,
+	}
+}
+
+func (s *kafkaRoutesExportService) ListRoutes() ([]dbapi.DataPlaneKafkaRoute, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+	var kafkas dbapi.KafkaList
+	if err := dbConn.Where("routes IS NOT NULL").Where("status NOT IN (?)", kafkaDeletionStatuses).Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafka requests")
+	}
+
+	routes := []dbapi.DataPlaneKafkaRoute{}
+	for _, kafka := range kafkas {
+		kafkaRoutes, err := kafka.GetRoutes()
+		if err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes of kafka %q", kafka.ID)
+		}
+		routes = append(routes, kafkaRoutes...)
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].Domain < routes[j].Domain
+	})
+
+	return routes, nil
+}
+
+func (s *kafkaRoutesExportService) ZoneFile() (string, *errors.ServiceError) {
+	routes, err := s.ListRoutes()
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "; %s\n", kafkaRoutesExportHeader)
+	fmt.Fprintf(&b, "$ORIGIN %s\n", dns.Fqdn(s.kafkaConfig.KafkaDomainName))
+	fmt.Fprintf(&b, "$TTL %d\n", KafkaCNAMERecordTTL)
+	for _, r := range routes {
+		fmt.Fprintln(&b, buildKafkaRouteRecord(r).String())
+	}
+
+	return b.String(), nil
+}
+
+// buildKafkaRouteRecord returns the CNAME record of the route, or an address record when the router is an IP address
+func buildKafkaRouteRecord(route dbapi.DataPlaneKafkaRoute) dns.RR {
+	header := dns.RR_Header{Name: dns.Fqdn(route.Domain), Class: dns.ClassINET, Ttl: uint32(KafkaCNAMERecordTTL)}
+	ip := net.ParseIP(route.Router)
+	switch {
+	case ip == nil:
+		header.Rrtype = dns.TypeCNAME
+		return &dns.CNAME{Hdr: header, Target: dns.Fqdn(route.Router)}
+	case ip.To4() != nil:
+		header.Rrtype = dns.TypeA
+		return &dns.A{Hdr: header, A: ip}
+	default:
+		header.Rrtype = dns.TypeAAAA
+		return &dns.AAAA{Hdr: header, AAAA: ip}
+	}
+}
+
+func (s *kafkaRoutesExportService) HostsFile() (string, *errors.ServiceError) {
+	routes, err := s.ListRoutes()
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", kafkaRoutesExportHeader)
+	addresses := map[string][]string{}
+	for _, r := range routes {
+		routerAddresses, resolved := addresses[r.Router]
+		if !resolved {
+			routerAddresses = s.resolveRouter(r.Router)
+			addresses[r.Router] = routerAddresses
+		}
+
+		if len(routerAddresses) == 0 {
+			fmt.Fprintf(&b, "# unable to resolve the router %q of %s\n", r.Router, r.Domain)
+			continue
+		}
+		for _, address := range routerAddresses {
+			fmt.Fprintf(&b, "%s\t%s\n", address, r.Domain)
+		}
+	}
+
+	return b.String(), nil
+}
+
+func (s *kafkaRoutesExportService) resolveRouter(router string) []string {
+	if ip := net.ParseIP(router); ip != nil {
+		return []string{ip.String()}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), routerLookupTimeout)
+	defer cancel()
+	routerAddresses, err := s.lookupHost(ctx, router)
+	if err != nil {
+		logger.Logger.Warningf("failed to resolve the address of the kafka router %q: %v", router, err)
+		return nil
+	}
+
+	sort.Strings(routerAddresses)
+	return routerAddresses
+}
@@ -0,0 +1,143 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that KafkaRoutesExportServiceMock does implement KafkaRoutesExportService.
+// If this is not the case, regenerate this file with moq.
+var _ KafkaRoutesExportService = &KafkaRoutesExportServiceMock{}
+
+// KafkaRoutesExportServiceMock is a mock implementation of KafkaRoutesExportService.
+//
+//	func TestSomethingThatUsesKafkaRoutesExportService(t *testing.T) {
+//
+//		// make and configure a mocked KafkaRoutesExportService
+//		mockedKafkaRoutesExportService := &KafkaRoutesExportServiceMock{
+//			HostsFileFunc: func() (string, *serviceError.ServiceError) {
+//				panic("mock out the HostsFile method")
+//			},
+//			ListRoutesFunc: func() ([]dbapi.DataPlaneKafkaRoute, *serviceError.ServiceError) {
+//				panic("mock out the ListRoutes method")
+//			},
+//			ZoneFileFunc: func() (string, *serviceError.ServiceError) {
+//				panic("mock out the ZoneFile method")
+//			},
+//		}
+//
+//		// use mockedKafkaRoutesExportService in code that requires KafkaRoutesExportService
+//		// and then make assertions.
+//
+//	}
+type KafkaRoutesExportServiceMock struct {
+	// HostsFileFunc mocks the HostsFile method.
+	HostsFileFunc func() (string, *serviceError.ServiceError)
+
+	// ListRoutesFunc mocks the ListRoutes method.
+	ListRoutesFunc func() ([]dbapi.DataPlaneKafkaRoute, *serviceError.ServiceError)
+
+	// ZoneFileFunc mocks the ZoneFile method.
+	ZoneFileFunc func() (string, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// HostsFile holds details about calls to the HostsFile method.
+		HostsFile []struct {
+		}
+		// ListRoutes holds details about calls to the ListRoutes method.
+		ListRoutes []struct {
+		}
+		// ZoneFile holds details about calls to the ZoneFile method.
+		ZoneFile []struct {
+		}
+	}
+	lockHostsFile  sync.RWMutex
+	lockListRoutes sync.RWMutex
+	lockZoneFile   sync.RWMutex
+}
+
+// HostsFile calls HostsFileFunc.
+func (mock *KafkaRoutesExportServiceMock) HostsFile() (string, *serviceError.ServiceError) {
+	if mock.HostsFileFunc == nil {
+		panic("KafkaRoutesExportServiceMock.HostsFileFunc: method is nil but KafkaRoutesExportService.HostsFile was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockHostsFile.Lock()
+	mock.calls.HostsFile = append(mock.calls.HostsFile, callInfo)
+	mock.lockHostsFile.Unlock()
+	return mock.HostsFileFunc()
+}
+
+// HostsFileCalls gets all the calls that were made to HostsFile.
+// Check the length with:
+//
+//	len(mockedKafkaRoutesExportService.HostsFileCalls())
+func (mock *KafkaRoutesExportServiceMock) HostsFileCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockHostsFile.RLock()
+	calls = mock.calls.HostsFile
+	mock.lockHostsFile.RUnlock()
+	return calls
+}
+
+// ListRoutes calls ListRoutesFunc.
+func (mock *KafkaRoutesExportServiceMock) ListRoutes() ([]dbapi.DataPlaneKafkaRoute, *serviceError.ServiceError) {
+	if mock.ListRoutesFunc == nil {
+		panic("KafkaRoutesExportServiceMock.ListRoutesFunc: method is nil but KafkaRoutesExportService.ListRoutes was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListRoutes.Lock()
+	mock.calls.ListRoutes = append(mock.calls.ListRoutes, callInfo)
+	mock.lockListRoutes.Unlock()
+	return mock.ListRoutesFunc()
+}
+
+// ListRoutesCalls gets all the calls that were made to ListRoutes.
+// Check the length with:
+//
+//	len(mockedKafkaRoutesExportService.ListRoutesCalls())
+func (mock *KafkaRoutesExportServiceMock) ListRoutesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListRoutes.RLock()
+	calls = mock.calls.ListRoutes
+	mock.lockListRoutes.RUnlock()
+	return calls
+}
+
+// ZoneFile calls ZoneFileFunc.
+func (mock *KafkaRoutesExportServiceMock) ZoneFile() (string, *serviceError.ServiceError) {
+	if mock.ZoneFileFunc == nil {
+		panic("KafkaRoutesExportServiceMock.ZoneFileFunc: method is nil but KafkaRoutesExportService.ZoneFile was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockZoneFile.Lock()
+	mock.calls.ZoneFile = append(mock.calls.ZoneFile, callInfo)
+	mock.lockZoneFile.Unlock()
+	return mock.ZoneFileFunc()
+}
+
+// ZoneFileCalls gets all the calls that were made to ZoneFile.
+// Check the length with:
+//
+//	len(mockedKafkaRoutesExportService.ZoneFileCalls())
+func (mock *KafkaRoutesExportServiceMock) ZoneFileCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockZoneFile.RLock()
+	calls = mock.calls.ZoneFile
+	mock.lockZoneFile.RUnlock()
+	return calls
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	goerrors "github.com/pkg/errors"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaRoutesExportService(t *testing.T) {
+	kafkasReply := []map[string]interface{}{
+		{"id": "kafka-2", "routes": []byte(`[{"domain": "kafka-2.kafka.bf2.dev", "router": "router.unknown.example.com"}]`)},
+		{"id": "kafka-1", "routes": []byte(`[{"domain": "kafka-1.kafka.bf2.dev", "router": "router.cluster.example.com"}, {"domain": "admin-server-kafka-1.kafka.bf2.dev", "router": "10.0.0.2"}]`)},
+	}
+	lookupHost := func(ctx context.Context, host string) ([]string, error) {
+		if host == "router.cluster.example.com" {
+			return []string{"10.0.0.1"}, nil
+		}
+		return nil, goerrors.Errorf("no such host")
+	}
+
+	tests := []struct {
+		name    string
+		export  func(s *kafkaRoutesExportService) (string, error)
+		setupFn func()
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "should export the routes of the kafkas as a zone file",
+			export: func(s *kafkaRoutesExportService) (string, error) {
+				file, err := s.ZoneFile()
+				if err != nil {
+					return "", err
+				}
+				return file, nil
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE routes IS NOT NULL AND status NOT IN ($1,$2)`).
+					WithReply(kafkasReply)
+			},
+			want: "; Kafka routes exported by kas-fleet-manager\n" +
+				"$ORIGIN kafka.bf2.dev.\n" +
+				"$TTL 300\n" +
+				"admin-server-kafka-1.kafka.bf2.dev.\t300\tIN\tA\t10.0.0.2\n" +
+				"kafka-1.kafka.bf2.dev.\t300\tIN\tCNAME\trouter.cluster.example.com.\n" +
+				"kafka-2.kafka.bf2.dev.\t300\tIN\tCNAME\trouter.unknown.example.com.\n",
+		},
+		{
+			name: "should export the routes of the kafkas as a hosts file",
+			export: func(s *kafkaRoutesExportService) (string, error) {
+				file, err := s.HostsFile()
+				if err != nil {
+					return "", err
+				}
+				return file, nil
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE routes IS NOT NULL AND status NOT IN ($1,$2)`).
+					WithReply(kafkasReply)
+			},
+			want: "# Kafka routes exported by kas-fleet-manager\n" +
+				"10.0.0.2\tadmin-server-kafka-1.kafka.bf2.dev\n" +
+				"10.0.0.1\tkafka-1.kafka.bf2.dev\n" +
+				"# unable to resolve the router \"router.unknown.example.com\" of kafka-2.kafka.bf2.dev\n",
+		},
+		{
+			name: "should return an error when the kafkas cannot be listed",
+			export: func(s *kafkaRoutesExportService) (string, error) {
+				file, err := s.ZoneFile()
+				if err != nil {
+					return "", err
+				}
+				return file, nil
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("SELECT").WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewKafkaRoutesExportService(db.NewMockConnectionFactory(nil), &config.KafkaConfig{KafkaDomainName: "kafka.bf2.dev"})
+			s.lookupHost = lookupHost
+			got, err := tt.export(s)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"io/fs"
@@ -31,6 +32,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/onsi/gomega"
 	goerrors "github.com/pkg/errors"
 	mocket "github.com/selvatico/go-mocket"
@@ -326,7 +328,9 @@ func Test_kafkaService_Get(t *testing.T) {
 				ctx: authenticatedCtx,
 				id:  testID,
 			},
-			want: buildKafkaRequest(nil),
+			want: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Role = dbapi.KafkaRoleOwner
+			}),
 			setupFn: func() {
 				mocket.Catcher.Reset().
 					NewMock().
@@ -777,7 +781,6 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 				clusterService:                       tt.fields.clusterService,
 				keycloakService:                      tt.fields.keycloakService,
 				kafkaConfig:                          tt.fields.kafkaConfig,
-				awsConfig:                            config.NewAWSConfig(),
 				kafkaTLSCertificateManagementService: tt.fields.kafkaTLSCertificateManagementService,
 			}
 
@@ -873,7 +876,6 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.RegisterKafkaDeprovisionJob(context.TODO(), tt.args.kafkaRequest.ID)
 			if (err != nil) != tt.wantErr {
@@ -1161,7 +1163,6 @@ func Test_kafkaService_Delete(t *testing.T) {
 				clusterService:                       tt.fields.clusterService,
 				keycloakService:                      tt.fields.keycloakService,
 				kafkaConfig:                          tt.fields.kafkaConfig,
-				awsConfig:                            config.NewAWSConfig(),
 				kafkaTLSCertificateManagementService: tt.fields.kafkaTLSCertificateManagementService,
 			}
 			err := k.Delete(tt.args.kafkaRequest)
@@ -1936,7 +1937,6 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				connectionFactory:        tt.fields.connectionFactory,
 				clusterService:           tt.fields.clusterService,
 				kafkaConfig:              &tt.fields.kafkaConfig,
-				awsConfig:                config.NewAWSConfig(),
 				providerConfig:           tt.fields.providerConfig,
 				clusterPlacementStrategy: tt.fields.clusterPlmtStrategy,
 				dataplaneClusterConfig:   tt.fields.dataplaneClusterConfig,
@@ -2115,6 +2115,7 @@ func Test_kafkaService_List(t *testing.T) {
 						Name:          "dummy-cluster-name",
 						Status:        "accepted",
 						Owner:         testUser,
+						Role:          dbapi.KafkaRoleOwner,
 						Meta: api.Meta{
 							CreatedAt: time.Now(),
 							UpdatedAt: time.Now(),
@@ -2129,6 +2130,7 @@ func Test_kafkaService_List(t *testing.T) {
 						Name:          "dummy-cluster-name2",
 						Status:        "accepted",
 						Owner:         testUser,
+						Role:          dbapi.KafkaRoleOwner,
 						Meta: api.Meta{
 							CreatedAt: time.Now(),
 							UpdatedAt: time.Now(),
@@ -2179,6 +2181,7 @@ func Test_kafkaService_List(t *testing.T) {
 						Name:          "dummy-cluster-name",
 						Status:        "accepted",
 						Owner:         testUser,
+						Role:          dbapi.KafkaRoleOwner,
 						Meta: api.Meta{
 							CreatedAt: time.Now(),
 							UpdatedAt: time.Now(),
@@ -2193,6 +2196,7 @@ func Test_kafkaService_List(t *testing.T) {
 						Name:          "dummy-cluster-name2",
 						Status:        "accepted",
 						Owner:         testUser,
+						Role:          dbapi.KafkaRoleOwner,
 						Meta: api.Meta{
 							CreatedAt: time.Now(),
 							UpdatedAt: time.Now(),
@@ -2243,6 +2247,7 @@ func Test_kafkaService_List(t *testing.T) {
 						Name:          "dummy-cluster-name",
 						Status:        "accepted",
 						Owner:         testUser,
+						Role:          dbapi.KafkaRoleOwner,
 						Meta: api.Meta{
 							CreatedAt: time.Now(),
 							UpdatedAt: time.Now(),
@@ -2374,7 +2379,6 @@ func Test_kafkaService_List(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			result, pagingMeta, err := k.List(tt.args.ctx, tt.args.listArgs)
@@ -2400,6 +2404,211 @@ func Test_kafkaService_List(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_ListChanges(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		gtVersion      int64
+		includeDeleted bool
+		ids            []string
+	}
+
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+
+	kafkaList := dbapi.KafkaList{
+		&dbapi.KafkaRequest{
+			Name:            "dummy-cluster-name",
+			Status:          "accepted",
+			Owner:           testUser,
+			ResourceVersion: 2,
+		},
+		&dbapi.KafkaRequest{
+			Name:            "dummy-cluster-name2",
+			Status:          "ready",
+			Owner:           testUser,
+			ResourceVersion: 3,
+		},
+		&dbapi.KafkaRequest{
+			Meta: api.Meta{
+				DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true},
+			},
+			Name:            "dummy-cluster-name3",
+			Status:          "deleting",
+			Owner:           testUser,
+			ResourceVersion: 4,
+		},
+	}
+
+	highWaterReply := []map[string]interface{}{{"high_water": int64(10)}}
+
+	tests := []struct {
+		name      string
+		args      args
+		want      dbapi.KafkaList
+		wantQuery string
+		wantArgs  []driver.NamedValue
+		wantErr   bool
+		setupFn   func()
+	}{
+		{
+			name: "should return the kafkas changed since the given version including the soft deleted ones",
+			args: args{
+				ctx:            authenticatedCtx,
+				gtVersion:      1,
+				includeDeleted: true,
+			},
+			want:      kafkaList,
+			wantQuery: `SELECT * FROM "kafka_requests" WHERE (resource_version > $1 AND resource_version < $2) AND owner = $3 ORDER BY resource_version, id`,
+			wantArgs: []driver.NamedValue{
+				{Ordinal: 1, Value: int64(1)},
+				{Ordinal: 2, Value: int64(10)},
+				{Ordinal: 3, Value: testUser},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT txid_snapshot_xmin(txid_current_snapshot())`).WithReply(highWaterReply)
+			},
+		},
+		{
+			name: "should leave the soft deleted kafkas out of the initial list",
+			args: args{
+				ctx: authenticatedCtx,
+			},
+			want:      kafkaList[:2],
+			wantQuery: `SELECT * FROM "kafka_requests" WHERE (resource_version > $1 AND resource_version < $2) AND owner = $3 AND "kafka_requests"."deleted_at" IS NULL ORDER BY resource_version, id`,
+			wantArgs: []driver.NamedValue{
+				{Ordinal: 1, Value: int64(0)},
+				{Ordinal: 2, Value: int64(10)},
+				{Ordinal: 3, Value: testUser},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT txid_snapshot_xmin(txid_current_snapshot())`).WithReply(highWaterReply)
+			},
+		},
+		{
+			name: "should list the kafkas from the start when the given version has not been reached yet",
+			args: args{
+				ctx:            authenticatedCtx,
+				gtVersion:      10,
+				includeDeleted: true,
+			},
+			want:      kafkaList[:2],
+			wantQuery: `SELECT * FROM "kafka_requests" WHERE (resource_version > $1 AND resource_version < $2) AND owner = $3 AND "kafka_requests"."deleted_at" IS NULL ORDER BY resource_version, id`,
+			wantArgs: []driver.NamedValue{
+				{Ordinal: 1, Value: int64(0)},
+				{Ordinal: 2, Value: int64(10)},
+				{Ordinal: 3, Value: testUser},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT txid_snapshot_xmin(txid_current_snapshot())`).WithReply(highWaterReply)
+			},
+		},
+		{
+			name: "should only return the kafkas with the given ids",
+			args: args{
+				ctx:            authenticatedCtx,
+				gtVersion:      1,
+				includeDeleted: true,
+				ids:            []string{testID},
+			},
+			want:      kafkaList[:1],
+			wantQuery: `SELECT * FROM "kafka_requests" WHERE (resource_version > $1 AND resource_version < $2) AND owner = $3 AND id IN ($4) ORDER BY resource_version, id`,
+			wantArgs: []driver.NamedValue{
+				{Ordinal: 1, Value: int64(1)},
+				{Ordinal: 2, Value: int64(10)},
+				{Ordinal: 3, Value: testUser},
+				{Ordinal: 4, Value: testID},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT txid_snapshot_xmin(txid_current_snapshot())`).WithReply(highWaterReply)
+			},
+		},
+		{
+			name: "should return an error if the user credentials are not available in the context",
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+		},
+		{
+			name: "should return an error if the high-water mark cannot be read",
+			args: args{
+				ctx: authenticatedCtx,
+			},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT txid_snapshot_xmin`).WithQueryException()
+			},
+		},
+		{
+			name: "should return an error if the database returns an error",
+			args: args{
+				ctx: authenticatedCtx,
+			},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT txid_snapshot_xmin(txid_current_snapshot())`).WithReply(highWaterReply)
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithQueryException()
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			var gotQuery string
+			var gotArgs []driver.NamedValue
+			if tt.wantQuery != "" {
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithCallback(func(s string, nv []driver.NamedValue) {
+						gotQuery, gotArgs = s, nv
+					}).
+					WithReply(converters.ConvertKafkaRequestList(tt.want))
+			}
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+
+			got, err := k.ListChanges(tt.args.ctx, tt.args.gtVersion, tt.args.includeDeleted, tt.args.ids...)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantQuery != "" {
+				g.Expect(gotQuery).To(gomega.HavePrefix(tt.wantQuery))
+				g.Expect(gotArgs).To(gomega.Equal(tt.wantArgs))
+			}
+			g.Expect(len(got)).To(gomega.Equal(len(tt.want)))
+			for i := range got {
+				g.Expect(got[i].Name).To(gomega.Equal(tt.want[i].Name))
+				g.Expect(got[i].Status).To(gomega.Equal(tt.want[i].Status))
+				g.Expect(got[i].ResourceVersion).To(gomega.Equal(tt.want[i].ResourceVersion))
+			}
+		})
+	}
+}
+
 func Test_kafkaService_ListAll(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -2479,7 +2688,6 @@ func Test_kafkaService_ListAll(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			result, err := k.ListAll()
@@ -2548,7 +2756,6 @@ func Test_kafkaService_ListByStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			got, err := k.ListByStatus(tt.args.status)
 			if (err != nil) != tt.wantErr {
@@ -2651,6 +2858,26 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 				id: testID,
 			},
 		},
+		{
+			name:         "should update the status of the kafka",
+			wantExecuted: true,
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1`).
+					WithArgs(testID).
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+						kafkaRequest.Status = constants.KafkaRequestStatusProvisioning.String()
+					})))
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`)
+			},
+			args: args{
+				id:     testID,
+				status: constants.KafkaRequestStatusReady,
+			},
+		},
 	}
 	for _, testcase := range tests {
 		tt := testcase
@@ -2661,7 +2888,6 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			executed, err := k.UpdateStatus(tt.args.id, tt.args.status)
 			if executed != tt.wantExecuted {
@@ -2728,7 +2954,6 @@ func Test_kafkaService_Update(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Update(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -2791,7 +3016,6 @@ func Test_kafkaService_Updates(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Updates(tt.args.kafkaRequest, map[string]interface{}{
 				"id":    "idsds",
@@ -3191,10 +3415,10 @@ func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 			awsConfig.Route53.SecretAccessKey = "test-route-53-secret-key"
 
 			kafkaService := &kafkaService{
-				awsClientFactory: aws.NewMockClientFactory(tt.fields.awsClient),
-				awsConfig:        awsConfig,
-				kafkaConfig: &config.KafkaConfig{
-					KafkaDomainName: "rhcloud.com",
+				dnsProvider: &route53DNSProvider{
+					awsClientFactory: aws.NewMockClientFactory(tt.fields.awsClient),
+					awsConfig:        awsConfig,
+					domainName:       "rhcloud.com",
 				},
 			}
 
@@ -3618,6 +3842,7 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 		clusterService                       ClusterService
 		kafkaConfig                          *config.KafkaConfig
 		kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService
+		kafkaMaintenanceWindowService        KafkaMaintenanceWindowService
 	}
 	type args struct {
 		clusterID string
@@ -3648,7 +3873,7 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 			GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
 				return &keycloak.KeycloakRealmConfig{}
 			},
-		}, kafkatlscertmgmt.Certificate{}, false)
+		}, kafkatlscertmgmt.Certificate{}, false, false)
 
 	managedkafkaCRWithCert, _ := buildManagedKafkaCR(
 		&dbapi.KafkaRequest{
@@ -3669,7 +3894,32 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 			GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
 				return &keycloak.KeycloakRealmConfig{}
 			},
-		}, kafkatlscertmgmt.Certificate{TLSCert: "crt-cert", TLSKey: "key-cert"}, true)
+		}, kafkatlscertmgmt.Certificate{TLSCert: "crt-cert", TLSKey: "key-cert"}, true, false)
+
+	migratedKafka := &dbapi.KafkaRequest{
+		ClusterID:                "other-cluster-id",
+		InstanceType:             "developer",
+		SizeId:                   "x1",
+		MigrationStatus:          dbapi.KafkaMigrationStatusDeprovisioningSource,
+		MigrationSourceClusterID: testClusterID,
+		MigrationTargetClusterID: "other-cluster-id",
+	}
+	deletedManagedKafkaCR, _ := buildManagedKafkaCR(migratedKafka,
+		&config.KafkaConfig{
+			EnableKafkaCNAMERegistration: true,
+			SupportedInstanceTypes:       &kafkaSupportedInstanceTypesConfig,
+		},
+		&sso.KeycloakServiceMock{
+			GetConfigFunc: func() *keycloak.KeycloakConfig {
+				return &keycloak.KeycloakConfig{
+					EnableAuthenticationOnKafka: true,
+				}
+			},
+			GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
+				return &keycloak.KeycloakRealmConfig{}
+			},
+		}, kafkatlscertmgmt.Certificate{}, false, false)
+	deletedManagedKafkaCR.Spec.Deleted = true
 
 	tests := []struct {
 		name    string
@@ -3679,6 +3929,65 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 		wantErr bool
 		setupFn func()
 	}{
+		{
+			name: "should return the kafka migrated away from the cluster as deleted and skip the kafka whose migration to the cluster failed",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaTLSCertificateManagementService: &kafkatlscertmgmt.KafkaTLSCertificateManagementServiceMock{
+					IsKafkaExternalCertificateEnabledFunc: func() bool {
+						return false
+					},
+				},
+				keycloakService: &sso.KeycloakServiceMock{
+					GetConfigFunc: func() *keycloak.KeycloakConfig {
+						return &keycloak.KeycloakConfig{
+							EnableAuthenticationOnKafka: true,
+						}
+					},
+					GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
+						return &keycloak.KeycloakRealmConfig{}
+					},
+				},
+				kafkaConfig: &config.KafkaConfig{
+					EnableKafkaCNAMERegistration: true,
+					SupportedInstanceTypes:       &kafkaSupportedInstanceTypesConfig,
+				},
+				kafkaMaintenanceWindowService: &KafkaMaintenanceWindowServiceMock{
+					ListByKafkasFunc: func(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+						return map[string]*dbapi.KafkaMaintenanceWindow{}, nil
+					},
+				},
+			},
+			args: args{
+				clusterID: testClusterID,
+			},
+			wantErr: false,
+			want:    []managedkafka.ManagedKafka{*deletedManagedKafkaCR},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				query := fmt.Sprintf(`SELECT * FROM "%s"`, kafkaRequestTableName)
+				response := []map[string]interface{}{
+					{
+						"cluster_id":                  migratedKafka.ClusterID,
+						"instance_type":               migratedKafka.InstanceType,
+						"size_id":                     migratedKafka.SizeId,
+						"migration_status":            migratedKafka.MigrationStatus,
+						"migration_source_cluster_id": migratedKafka.MigrationSourceClusterID,
+						"migration_target_cluster_id": migratedKafka.MigrationTargetClusterID,
+					},
+					{
+						"cluster_id":                  "other-cluster-id",
+						"instance_type":               "developer",
+						"size_id":                     "x1",
+						"migration_status":            dbapi.KafkaMigrationStatusFailed,
+						"migration_source_cluster_id": "other-cluster-id",
+						"migration_target_cluster_id": testClusterID,
+					},
+				}
+				mocket.Catcher.NewMock().WithQuery(query).WithReply(response)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
 		{
 			name: "should return the kafka by cluster id when external certificate is disabled",
 			fields: fields{
@@ -3703,6 +4012,11 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 					EnableKafkaCNAMERegistration: true,
 					SupportedInstanceTypes:       &kafkaSupportedInstanceTypesConfig,
 				},
+				kafkaMaintenanceWindowService: &KafkaMaintenanceWindowServiceMock{
+					ListByKafkasFunc: func(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+						return map[string]*dbapi.KafkaMaintenanceWindow{}, nil
+					},
+				},
 				clusterService: &ClusterServiceMock{
 					FindClusterByIDFunc: nil, // setting to nil as it should never be called
 				},
@@ -3749,6 +4063,11 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 					EnableKafkaCNAMERegistration: true,
 					SupportedInstanceTypes:       &kafkaSupportedInstanceTypesConfig,
 				},
+				kafkaMaintenanceWindowService: &KafkaMaintenanceWindowServiceMock{
+					ListByKafkasFunc: func(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+						return map[string]*dbapi.KafkaMaintenanceWindow{}, nil
+					},
+				},
 				clusterService: &ClusterServiceMock{
 					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
 						return &api.Cluster{ClusterID: clusterID}, nil
@@ -3794,6 +4113,11 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 					EnableKafkaCNAMERegistration: true,
 					SupportedInstanceTypes:       &kafkaSupportedInstanceTypesConfig,
 				},
+				kafkaMaintenanceWindowService: &KafkaMaintenanceWindowServiceMock{
+					ListByKafkasFunc: func(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+						return map[string]*dbapi.KafkaMaintenanceWindow{}, nil
+					},
+				},
 				clusterService: &ClusterServiceMock{
 					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
 						return &api.Cluster{ClusterID: clusterID}, nil
@@ -3813,6 +4137,34 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
+		{
+			name: "should return an error when listing the maintenance windows fails",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaTLSCertificateManagementService: &kafkatlscertmgmt.KafkaTLSCertificateManagementServiceMock{
+					IsKafkaExternalCertificateEnabledFunc: func() bool {
+						return false
+					},
+				},
+				kafkaMaintenanceWindowService: &KafkaMaintenanceWindowServiceMock{
+					ListByKafkasFunc: func(kafkas dbapi.KafkaList) (map[string]*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+						return nil, errors.GeneralError("failed to list maintenance windows")
+					},
+				},
+			},
+			args: args{
+				clusterID: testClusterID,
+			},
+			wantErr: true,
+			want:    nil,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				query := fmt.Sprintf(`SELECT * FROM "%s"`, kafkaRequestTableName)
+				response := converters.ConvertKafkaRequestList(kafkaRequestList)
+				mocket.Catcher.NewMock().WithQuery(query).WithReply(response)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
 	}
 
 	for _, testcase := range tests {
@@ -3826,6 +4178,7 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 				kafkaConfig:                          tt.fields.kafkaConfig,
 				kafkaTLSCertificateManagementService: tt.fields.kafkaTLSCertificateManagementService,
 				clusterService:                       tt.fields.clusterService,
+				kafkaMaintenanceWindowService:        tt.fields.kafkaMaintenanceWindowService,
 			}
 			got, err := k.GetManagedKafkaByClusterID(tt.args.clusterID)
 			g.Expect(got).To(gomega.Equal(tt.want))
@@ -4415,124 +4768,42 @@ func Test_kafkaService_VerifyAndUpdateKafkaAdmin(t *testing.T) {
 	}
 }
 
-func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
-	type fields struct {
-		awsConfig        *config.AWSConfig
-		awsClientFactory aws.ClientFactory
-	}
-
-	CNAME_Id := "CNAME_Id"
-	CNAME_Status := "CNAME_Status"
-
-	awsConfig := &config.AWSConfig{}
-	awsConfig.Route53.AccessKey = "Route53AccessKey"
-	awsConfig.Route53.SecretAccessKey = "Route53SecretAccessKey"
-
+func Test_NewKafkaService(t *testing.T) {
 	type args struct {
-		kafkaRequest *dbapi.KafkaRequest
+		connectionFactory                    *db.ConnectionFactory
+		clusterService                       ClusterService
+		keycloakService                      sso.KafkaKeycloakService
+		kafkaConfig                          *config.KafkaConfig
+		dataplaneClusterConfig               *config.DataplaneClusterConfig
+		quotaServiceFactory                  QuotaServiceFactory
+		authorizationService                 authorization.Authorization
+		providerConfig                       *config.ProviderConfig
+		clusterPlacementStrategy             ClusterPlacementStrategy
+		kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService
+		kafkaMaintenanceWindowService        KafkaMaintenanceWindowService
+		dnsProvider                          DNSProvider
+		roleBindingService                   KafkaRoleBindingService
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    *CNameRecordStatus
-		wantErr bool
+		name string
+		args args
+		want *kafkaService
 	}{
 		{
-			name: "should get the CNAME record Status",
-			fields: fields{
-				awsConfig: awsConfig,
-				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
-					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
-						return &route53.GetChangeOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &CNAME_Id,
-								Status: &CNAME_Status,
-							},
-						}, nil
-					},
-				}),
-			},
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "us-east-1",
-					CloudProvider: cloudproviders.AWS.String(),
-				},
-			},
-			want: &CNameRecordStatus{
-				Id:     &CNAME_Id,
-				Status: &CNAME_Status,
-			},
-			wantErr: false,
-		},
-		{
-			name: "should return error when it fails to get CNAME status",
-			fields: fields{
-				awsConfig: awsConfig,
-				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
-					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
-						return nil, errors.GeneralError("unable to CNAME record status")
-					},
-				}),
-			},
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region: "us-east-1",
-				},
-			},
-			wantErr: true,
-		},
-	}
-
-	for _, testcase := range tests {
-		tt := testcase
-		t.Run(tt.name, func(t *testing.T) {
-			g := gomega.NewWithT(t)
-			k := &kafkaService{
-				awsConfig:        tt.fields.awsConfig,
-				awsClientFactory: tt.fields.awsClientFactory,
-			}
-			got, err := k.GetCNAMERecordStatus(tt.args.kafkaRequest)
-			g.Expect(got).To(gomega.Equal(tt.want))
-			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
-		})
-	}
-}
-
-func Test_NewKafkaService(t *testing.T) {
-	type args struct {
-		connectionFactory                    *db.ConnectionFactory
-		clusterService                       ClusterService
-		keycloakService                      sso.KafkaKeycloakService
-		kafkaConfig                          *config.KafkaConfig
-		dataplaneClusterConfig               *config.DataplaneClusterConfig
-		awsConfig                            *config.AWSConfig
-		quotaServiceFactory                  QuotaServiceFactory
-		awsClientFactory                     aws.ClientFactory
-		authorizationService                 authorization.Authorization
-		providerConfig                       *config.ProviderConfig
-		clusterPlacementStrategy             ClusterPlacementStrategy
-		kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService
-	}
-	tests := []struct {
-		name string
-		args args
-		want *kafkaService
-	}{
-		{
-			name: "should return the kafka service",
+			name: "should return the kafka service",
 			args: args{
 				connectionFactory:                    &db.ConnectionFactory{},
 				clusterService:                       &ClusterServiceMock{},
 				keycloakService:                      &sso.KeycloakServiceMock{},
 				kafkaConfig:                          &config.KafkaConfig{},
 				dataplaneClusterConfig:               &config.DataplaneClusterConfig{},
-				awsConfig:                            &config.AWSConfig{},
 				quotaServiceFactory:                  &QuotaServiceFactoryMock{},
-				awsClientFactory:                     &aws.MockClientFactory{},
 				providerConfig:                       &config.ProviderConfig{},
 				clusterPlacementStrategy:             &ClusterPlacementStrategyMock{},
 				kafkaTLSCertificateManagementService: &kafkatlscertmgmt.KafkaTLSCertificateManagementServiceMock{},
+				kafkaMaintenanceWindowService:        &KafkaMaintenanceWindowServiceMock{},
+				dnsProvider:                          &DNSProviderMock{},
+				roleBindingService:                   &KafkaRoleBindingServiceMock{},
 			},
 			want: &kafkaService{
 				connectionFactory:                    &db.ConnectionFactory{},
@@ -4540,12 +4811,13 @@ func Test_NewKafkaService(t *testing.T) {
 				keycloakService:                      &sso.KeycloakServiceMock{},
 				kafkaConfig:                          &config.KafkaConfig{},
 				dataplaneClusterConfig:               &config.DataplaneClusterConfig{},
-				awsConfig:                            &config.AWSConfig{},
 				quotaServiceFactory:                  &QuotaServiceFactoryMock{},
-				awsClientFactory:                     &aws.MockClientFactory{},
 				providerConfig:                       &config.ProviderConfig{},
 				clusterPlacementStrategy:             &ClusterPlacementStrategyMock{},
 				kafkaTLSCertificateManagementService: &kafkatlscertmgmt.KafkaTLSCertificateManagementServiceMock{},
+				kafkaMaintenanceWindowService:        &KafkaMaintenanceWindowServiceMock{},
+				dnsProvider:                          &DNSProviderMock{},
+				roleBindingService:                   &KafkaRoleBindingServiceMock{},
 			},
 		},
 	}
@@ -4559,13 +4831,14 @@ func Test_NewKafkaService(t *testing.T) {
 			tt.args.keycloakService,
 			tt.args.kafkaConfig,
 			tt.args.dataplaneClusterConfig,
-			tt.args.awsConfig,
 			tt.args.quotaServiceFactory,
-			tt.args.awsClientFactory,
 			tt.args.authorizationService,
 			tt.args.providerConfig,
 			tt.args.clusterPlacementStrategy,
-			tt.args.kafkaTLSCertificateManagementService)).To(gomega.Equal(tt.want))
+			tt.args.kafkaTLSCertificateManagementService,
+			tt.args.kafkaMaintenanceWindowService,
+			tt.args.dnsProvider,
+			tt.args.roleBindingService)).To(gomega.Equal(tt.want))
 	}
 }
 
@@ -4707,64 +4980,6 @@ func Test_kafkaService_AssignBootstrapServerHost(t *testing.T) {
 	}
 }
 
-func Test_kafkaService_getRoute53RegionFromKafkaRequest(t *testing.T) {
-
-	type args struct {
-		kafkaRequest *dbapi.KafkaRequest
-	}
-
-	tests := []struct {
-		name    string
-		args    args
-		want    string
-		wantErr bool
-	}{
-		{
-			name: "Route53 region is correctly returned for Kafka instances in AWS",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "anotherregion",
-					CloudProvider: cloudproviders.AWS.String(),
-				},
-			},
-			want:    aws.DefaultAWSRoute53Region,
-			wantErr: false,
-		},
-		{
-			name: "Route53 region is correctly returned for Kafka instances in GCP",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "anotherregiontwo",
-					CloudProvider: cloudproviders.GCP.String(),
-				},
-			},
-			want:    aws.DefaultGCPRoute53Region,
-			wantErr: false,
-		},
-		{
-			name: "An error is returned if the Kafka instance has an unknown cloud provider",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "us-east-1",
-					CloudProvider: "anunknowncloudprovider",
-				},
-			},
-			wantErr: true,
-		},
-	}
-
-	for _, testcase := range tests {
-		tt := testcase
-		t.Run(tt.name, func(t *testing.T) {
-			g := gomega.NewWithT(t)
-			k := &kafkaService{}
-			res, err := k.getRoute53RegionFromKafkaRequest(tt.args.kafkaRequest)
-			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
-			g.Expect(res).To(gomega.Equal(tt.want))
-		})
-	}
-}
-
 func Test_kafkaService_ManagedKafkasRoutesTLSCertificate(t *testing.T) {
 	g := gomega.NewWithT(t)
 	type fields struct {
@@ -5027,3 +5242,476 @@ func Test_kafkaService_ManagedKafkasRoutesTLSCertificate(t *testing.T) {
 		})
 	}
 }
+
+func Test_kafkaService_Resize(t *testing.T) {
+	x2Size := supportedKafkaSizeStandard[0]
+	x2Size.Id = "x2"
+	x2Size.MaxDataRetentionSize = "200Gi"
+	x2Size.QuotaConsumed = 2
+	x2Size.CapacityConsumed = 2
+	kafkaConfig := config.KafkaConfig{
+		Quota: config.NewKafkaQuotaConfig(),
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id:                     types.STANDARD.String(),
+						SupportedBillingModels: testSupportedKafkaBillingModelsStandard,
+						Sizes:                  append([]config.KafkaInstanceSize{x2Size}, supportedKafkaSizeStandard...),
+					},
+				},
+			},
+		},
+	}
+
+	// the current cluster hosts 3 streaming units at most, 2 of them being consumed by the x1 kafka and another one
+	currentCluster := buildManualCluster(3, types.STANDARD.String(), testKafkaRequestRegion)
+	currentCluster.ClusterId = testClusterID
+	dataplaneClusterConfig := buildDataplaneClusterConfig(config.ClusterList{currentCluster})
+	clusterService := func(consumedStreamingUnits int32) ClusterService {
+		return &ClusterServiceMock{
+			FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+				return &api.Cluster{ClusterID: clusterID, Status: api.ClusterReady, ClusterType: api.ManagedDataPlaneClusterType.String()}, nil
+			},
+			FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+				return KafkaStreamingUnitCountPerClusterList{{ClusterId: testClusterID, InstanceType: types.STANDARD.String(), Count: consumedStreamingUnits}}, nil
+			},
+		}
+	}
+	readyKafka := func(modifyFn func(kafkaRequest *dbapi.KafkaRequest)) *dbapi.KafkaRequest {
+		return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.InstanceType = types.STANDARD.String()
+			kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+			kafkaRequest.SubscriptionId = "subscription-id"
+			if modifyFn != nil {
+				modifyFn(kafkaRequest)
+			}
+		})
+	}
+
+	type fields struct {
+		clusterPlacementStrategy ClusterPlacementStrategy
+		quotaService             QuotaService
+		clusterService           ClusterService
+	}
+
+	type args struct {
+		kafkaRequest *dbapi.KafkaRequest
+		sizeId       string
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *dbapi.KafkaRequest
+		wantErr bool
+		setupFn func()
+	}{
+		{
+			name: "should do nothing when the size does not change",
+			args: args{
+				kafkaRequest: buildKafkaRequest(nil),
+				sizeId:       "x1",
+			},
+			want: buildKafkaRequest(nil),
+		},
+		{
+			name: "should return an error when the kafka is not in a resizable status",
+			args: args{
+				kafkaRequest: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusDeprovision.String()
+				}),
+				sizeId: "x2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the kafka is being migrated",
+			args: args{
+				kafkaRequest: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.MigrationStatus = dbapi.KafkaMigrationStatusProvisioning
+					kafkaRequest.MigrationTargetClusterID = "another-cluster-id"
+				}),
+				sizeId: "x2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the size is not supported",
+			args: args{
+				kafkaRequest: readyKafka(nil),
+				sizeId:       "x9",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the quota of the new size cannot be reserved",
+			fields: fields{
+				clusterService: clusterService(2),
+				quotaService: &QuotaServiceMock{
+					UpdateQuotaFunc: func(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError) {
+						return "", errors.InsufficientQuotaError("insufficient quota")
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: readyKafka(nil),
+				sizeId:       "x2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should resize a ready kafka on its current cluster when it has room for the additional streaming units only",
+			fields: fields{
+				// the placement strategy is not used as the current cluster can host the resized kafka
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
+				clusterService:           clusterService(2),
+				quotaService: &QuotaServiceMock{
+					UpdateQuotaFunc: func(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError) {
+						if kafka.SizeId != "x1" || kafka.SubscriptionId != "subscription-id" || sizeId != "x2" {
+							return "", errors.GeneralError("unexpected quota update")
+						}
+						return "updated-subscription-id", nil
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: readyKafka(nil),
+				sizeId:       "x2",
+			},
+			want: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.SizeId = "x2"
+				kafkaRequest.MaxDataRetentionSize = "200Gi"
+				kafkaRequest.SubscriptionId = "updated-subscription-id"
+			}),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "max_data_retention_size"=$1,"size_id"=$2,"subscription_id"=$3`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should restore the quota of the current size when the resized kafka cannot be updated",
+			fields: fields{
+				clusterService: clusterService(2),
+				quotaService: &QuotaServiceMock{
+					UpdateQuotaFunc: func(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError) {
+						switch {
+						case kafka.SizeId == "x1" && kafka.SubscriptionId == "subscription-id" && sizeId == "x2":
+							return "updated-subscription-id", nil
+						case kafka.SizeId == "x2" && kafka.SubscriptionId == "updated-subscription-id" && sizeId == "x1":
+							return "restored-subscription-id", nil
+						default:
+							return "", errors.GeneralError("unexpected quota update")
+						}
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: readyKafka(nil),
+				sizeId:       "x2",
+			},
+			want: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.SubscriptionId = "restored-subscription-id"
+			}),
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "subscription_id"=$1`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should downsize a ready kafka without checking the capacity of its cluster",
+			fields: fields{
+				quotaService: &QuotaServiceMock{
+					UpdateQuotaFunc: func(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError) {
+						return "", nil
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.SizeId = "x2"
+				}),
+				sizeId: "x1",
+			},
+			want: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.MaxDataRetentionSize = "100Gi"
+			}),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "max_data_retention_size"=$1,"size_id"=$2,"subscription_id"=$3`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should migrate a ready kafka to another cluster when its current one has no room for the additional streaming units",
+			fields: fields{
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{
+					FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+						return &api.Cluster{ClusterID: "another-cluster-id"}, nil
+					},
+				},
+				clusterService: clusterService(3),
+				quotaService: &QuotaServiceMock{
+					UpdateQuotaFunc: func(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError) {
+						return "subscription-id", nil
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: readyKafka(nil),
+				sizeId:       "x2",
+			},
+			want: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.SizeId = "x2"
+				kafkaRequest.MaxDataRetentionSize = "200Gi"
+				kafkaRequest.MigrationStatus = dbapi.KafkaMigrationStatusProvisioning
+				kafkaRequest.MigrationSourceClusterID = testClusterID
+				kafkaRequest.MigrationTargetClusterID = "another-cluster-id"
+			}),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "max_data_retention_size"=$1,"migration_details"=$2,"migration_routes"=$3,"migration_source_cluster_id"=$4,"migration_status"=$5,"migration_target_cluster_id"=$6,"size_id"=$7,"subscription_id"=$8`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return an error when no other cluster can host a ready kafka its current cluster has no room for",
+			fields: fields{
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{
+					FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+						return nil, nil
+					},
+				},
+				clusterService: clusterService(3),
+			},
+			args: args{
+				kafkaRequest: readyKafka(nil),
+				sizeId:       "x2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the cluster of an enterprise kafka has no room for the additional streaming units",
+			fields: fields{
+				clusterService: clusterService(3),
+			},
+			args: args{
+				kafkaRequest: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.DesiredKafkaBillingModel = constants.BillingModelEnterprise.String()
+				}),
+				sizeId: "x2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should move an accepted kafka to another cluster when its current one has no room for the additional streaming units",
+			fields: fields{
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{
+					FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+						return &api.Cluster{ClusterID: "another-cluster-id"}, nil
+					},
+				},
+				clusterService: clusterService(3),
+				quotaService: &QuotaServiceMock{
+					UpdateQuotaFunc: func(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError) {
+						return "", nil
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusAccepted.String()
+				}),
+				sizeId: "x2",
+			},
+			want: readyKafka(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Status = constants.KafkaRequestStatusAccepted.String()
+				kafkaRequest.SizeId = "x2"
+				kafkaRequest.MaxDataRetentionSize = "200Gi"
+				kafkaRequest.ClusterID = "another-cluster-id"
+			}),
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "cluster_id"=$1,"max_data_retention_size"=$2,"size_id"=$3,"subscription_id"=$4`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			k := &kafkaService{
+				connectionFactory:        db.NewMockConnectionFactory(nil),
+				kafkaConfig:              &kafkaConfig,
+				dataplaneClusterConfig:   dataplaneClusterConfig,
+				clusterService:           tt.fields.clusterService,
+				clusterPlacementStrategy: tt.fields.clusterPlacementStrategy,
+				quotaServiceFactory: &QuotaServiceFactoryMock{
+					GetQuotaServiceFunc: func(quotaType api.QuotaType) (QuotaService, *errors.ServiceError) {
+						return tt.fields.quotaService, nil
+					},
+				},
+			}
+
+			err := k.Resize(tt.args.kafkaRequest, tt.args.sizeId)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.want != nil {
+				g.Expect(tt.args.kafkaRequest.SizeId).To(gomega.Equal(tt.want.SizeId))
+				g.Expect(tt.args.kafkaRequest.MaxDataRetentionSize).To(gomega.Equal(tt.want.MaxDataRetentionSize))
+				g.Expect(tt.args.kafkaRequest.ClusterID).To(gomega.Equal(tt.want.ClusterID))
+				g.Expect(tt.args.kafkaRequest.SubscriptionId).To(gomega.Equal(tt.want.SubscriptionId))
+				g.Expect(tt.args.kafkaRequest.MigrationStatus).To(gomega.Equal(tt.want.MigrationStatus))
+				g.Expect(tt.args.kafkaRequest.MigrationSourceClusterID).To(gomega.Equal(tt.want.MigrationSourceClusterID))
+				g.Expect(tt.args.kafkaRequest.MigrationTargetClusterID).To(gomega.Equal(tt.want.MigrationTargetClusterID))
+			}
+		})
+	}
+}
+
+func Test_buildManagedKafkaVersions(t *testing.T) {
+	kafkaRequest := &dbapi.KafkaRequest{
+		DesiredStrimziVersion:  "strimzi-2",
+		ActualStrimziVersion:   "strimzi-1",
+		DesiredKafkaVersion:    "3.3.2",
+		ActualKafkaVersion:     "3.3.1",
+		DesiredKafkaIBPVersion: "3.3",
+		ActualKafkaIBPVersion:  "",
+	}
+
+	tests := []struct {
+		name            string
+		holdBackUpgrade bool
+		want            managedkafka.VersionsSpec
+	}{
+		{
+			name:            "should use the desired versions when the upgrade is not held back",
+			holdBackUpgrade: false,
+			want: managedkafka.VersionsSpec{
+				Strimzi:  "strimzi-2",
+				Kafka:    "3.3.2",
+				KafkaIBP: "3.3",
+			},
+		},
+		{
+			name:            "should use the actual versions that are known when the upgrade is held back",
+			holdBackUpgrade: true,
+			want: managedkafka.VersionsSpec{
+				Strimzi:  "strimzi-1",
+				Kafka:    "3.3.1",
+				KafkaIBP: "3.3",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(buildManagedKafkaVersions(kafkaRequest, tt.holdBackUpgrade)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaService_setUserRoles(t *testing.T) {
+	userCtx := func(isOrgAdmin bool) context.Context {
+		return auth.SetTokenInContext(context.TODO(), &jwt.Token{
+			Claims: jwt.MapClaims{
+				"username":     testUser,
+				"org_id":       "org-id",
+				"is_org_admin": isOrgAdmin,
+			},
+		})
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		kafka        *dbapi.KafkaRequest
+		boundRoles   map[string]dbapi.KafkaRole
+		getRolesErr  *errors.ServiceError
+		wantRole     dbapi.KafkaRole
+		wantGetRoles bool
+		wantErr      bool
+	}{
+		{
+			name:     "admins are owners of all the kafkas",
+			ctx:      auth.SetIsAdminContext(context.TODO(), true),
+			kafka:    &dbapi.KafkaRequest{Meta: api.Meta{ID: testID}, Owner: "another-user", OrganisationId: "another-org"},
+			wantRole: dbapi.KafkaRoleOwner,
+		},
+		{
+			name:     "the owner of the kafka is an owner",
+			ctx:      userCtx(false),
+			kafka:    &dbapi.KafkaRequest{Meta: api.Meta{ID: testID}, Owner: testUser, OrganisationId: "org-id"},
+			wantRole: dbapi.KafkaRoleOwner,
+		},
+		{
+			name:     "the admins of the organisation of the kafka are owners",
+			ctx:      userCtx(true),
+			kafka:    &dbapi.KafkaRequest{Meta: api.Meta{ID: testID}, Owner: "another-user", OrganisationId: "org-id"},
+			wantRole: dbapi.KafkaRoleOwner,
+		},
+		{
+			name:         "the members of the organisation of the kafka have the role bound to them",
+			ctx:          userCtx(false),
+			kafka:        &dbapi.KafkaRequest{Meta: api.Meta{ID: testID}, Owner: "another-user", OrganisationId: "org-id"},
+			boundRoles:   map[string]dbapi.KafkaRole{testID: dbapi.KafkaRoleEditor},
+			wantRole:     dbapi.KafkaRoleEditor,
+			wantGetRoles: true,
+		},
+		{
+			name:         "the members of the organisation of the kafka without role binding are viewers",
+			ctx:          userCtx(false),
+			kafka:        &dbapi.KafkaRequest{Meta: api.Meta{ID: testID}, Owner: "another-user", OrganisationId: "org-id"},
+			wantRole:     dbapi.KafkaRoleViewer,
+			wantGetRoles: true,
+		},
+		{
+			name:     "the users outside of the organisation of the kafka have no role",
+			ctx:      userCtx(true),
+			kafka:    &dbapi.KafkaRequest{Meta: api.Meta{ID: testID}, Owner: "another-user", OrganisationId: "another-org"},
+			wantRole: "",
+		},
+		{
+			name:         "should return an error when the role bindings cannot be retrieved",
+			ctx:          userCtx(false),
+			kafka:        &dbapi.KafkaRequest{Meta: api.Meta{ID: testID}, Owner: "another-user", OrganisationId: "org-id"},
+			getRolesErr:  errors.GeneralError("db down"),
+			wantGetRoles: true,
+			wantErr:      true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			roleBindingService := &KafkaRoleBindingServiceMock{
+				GetRolesFunc: func(subject string, kafkaIDs ...string) (map[string]dbapi.KafkaRole, *errors.ServiceError) {
+					g.Expect(subject).To(gomega.Equal(testUser))
+					g.Expect(kafkaIDs).To(gomega.Equal([]string{testID}))
+					return tt.boundRoles, tt.getRolesErr
+				},
+			}
+			k := &kafkaService{
+				roleBindingService: roleBindingService,
+			}
+			err := k.setUserRoles(tt.ctx, tt.kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(roleBindingService.GetRolesCalls()).To(gomega.HaveLen(map[bool]int{true: 1, false: 0}[tt.wantGetRoles]))
+			if !tt.wantErr {
+				g.Expect(tt.kafka.Role).To(gomega.Equal(tt.wantRole))
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/queryparser"
+)
+
+//go:generate moq -out kafka_upgrade_campaign_moq.go . KafkaUpgradeCampaignService
+type KafkaUpgradeCampaignService interface {
+	// Create resolves the kafkas matching the selector of the given campaign and creates the campaign
+	// along with a pending item for each of them
+	Create(campaign *dbapi.KafkaUpgradeCampaign) *errors.ServiceError
+	Get(id string) (*dbapi.KafkaUpgradeCampaign, *errors.ServiceError)
+	// List returns all the campaigns, the most recent first
+	List() (dbapi.KafkaUpgradeCampaignList, *errors.ServiceError)
+	ListByStatus(status dbapi.KafkaUpgradeCampaignStatus) (dbapi.KafkaUpgradeCampaignList, *errors.ServiceError)
+	Updates(campaign *dbapi.KafkaUpgradeCampaign, fields map[string]interface{}) *errors.ServiceError
+	// ListItems returns the items of the given campaign in the order their kafkas are to be upgraded
+	ListItems(campaignID string) (dbapi.KafkaUpgradeCampaignItemList, *errors.ServiceError)
+	UpdateItem(item *dbapi.KafkaUpgradeCampaignItem, fields map[string]interface{}) *errors.ServiceError
+	// GetProgress returns the progress of the given campaigns indexed by campaign id
+	GetProgress(campaignIDs ...string) (map[string]dbapi.KafkaUpgradeCampaignProgress, *errors.ServiceError)
+}
+
+var _ KafkaUpgradeCampaignService = &kafkaUpgradeCampaignService{}
+
+type kafkaUpgradeCampaignService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewKafkaUpgradeCampaignService(connectionFactory *db.ConnectionFactory) *kafkaUpgradeCampaignService {
+	return &kafkaUpgradeCampaignService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (u *kafkaUpgradeCampaignService) Create(campaign *dbapi.KafkaUpgradeCampaign) *errors.ServiceError {
+	dbConn := u.connectionFactory.New()
+
+	kafkaQuery := dbConn.Model(&dbapi.KafkaRequest{}).Where("status NOT IN (?)", kafkaDeletionStatuses)
+	selectors := []struct{ column, value string }{
+		{"cluster_id", campaign.ClusterID},
+		{"cloud_provider", campaign.CloudProvider},
+		{"region", campaign.Region},
+		{"instance_type", campaign.InstanceType},
+	}
+	for _, selector := range selectors {
+		if selector.value != "" {
+			kafkaQuery = kafkaQuery.Where(selector.column+" = ?", selector.value)
+		}
+	}
+	if campaign.Search != "" {
+		searchDbQuery, err := queryparser.NewQueryParser().Parse(campaign.Search)
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to resolve the kafkas of the upgrade campaign: %s", err.Error())
+		}
+		kafkaQuery = kafkaQuery.Where(searchDbQuery.Query, searchDbQuery.Values...)
+	}
+
+	var kafkaIDs []string
+	if err := kafkaQuery.Order("created_at").Pluck("id", &kafkaIDs).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to resolve the kafkas of the upgrade campaign")
+	}
+
+	if campaign.Status == "" {
+		campaign.Status = dbapi.KafkaUpgradeCampaignStatusRunning
+	}
+	if err := dbConn.Create(campaign).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create upgrade campaign")
+	}
+
+	if len(kafkaIDs) == 0 {
+		return nil
+	}
+
+	items := make(dbapi.KafkaUpgradeCampaignItemList, 0, len(kafkaIDs))
+	for _, kafkaID := range kafkaIDs {
+		items = append(items, &dbapi.KafkaUpgradeCampaignItem{
+			CampaignID: campaign.ID,
+			KafkaID:    kafkaID,
+			State:      dbapi.KafkaUpgradeCampaignItemStatePending,
+		})
+	}
+	if err := dbConn.Create(&items).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the items of upgrade campaign %q", campaign.ID)
+	}
+
+	return nil
+}
+
+func (u *kafkaUpgradeCampaignService) Get(id string) (*dbapi.KafkaUpgradeCampaign, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	dbConn := u.connectionFactory.New()
+	var campaign dbapi.KafkaUpgradeCampaign
+	if err := dbConn.Where("id = ?", id).First(&campaign).Error; err != nil {
+		return nil, services.HandleGetError("KafkaUpgradeCampaign", "id", id, err)
+	}
+
+	return &campaign, nil
+}
+
+func (u *kafkaUpgradeCampaignService) List() (dbapi.KafkaUpgradeCampaignList, *errors.ServiceError) {
+	dbConn := u.connectionFactory.New()
+	var campaigns dbapi.KafkaUpgradeCampaignList
+	if err := dbConn.Order("created_at desc").Find(&campaigns).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list upgrade campaigns")
+	}
+
+	return campaigns, nil
+}
+
+func (u *kafkaUpgradeCampaignService) ListByStatus(status dbapi.KafkaUpgradeCampaignStatus) (dbapi.KafkaUpgradeCampaignList, *errors.ServiceError) {
+	dbConn := u.connectionFactory.New()
+	var campaigns dbapi.KafkaUpgradeCampaignList
+	if err := dbConn.Where("status = ?", status.String()).Order("created_at").Find(&campaigns).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list %q upgrade campaigns", status)
+	}
+
+	return campaigns, nil
+}
+
+func (u *kafkaUpgradeCampaignService) Updates(campaign *dbapi.KafkaUpgradeCampaign, fields map[string]interface{}) *errors.ServiceError {
+	dbConn := u.connectionFactory.New()
+	if err := dbConn.Model(campaign).Updates(fields).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update upgrade campaign %q", campaign.ID)
+	}
+
+	return nil
+}
+
+func (u *kafkaUpgradeCampaignService) ListItems(campaignID string) (dbapi.KafkaUpgradeCampaignItemList, *errors.ServiceError) {
+	dbConn := u.connectionFactory.New()
+	var items dbapi.KafkaUpgradeCampaignItemList
+	if err := dbConn.Where("campaign_id = ?", campaignID).Order("created_at").Order("id").Find(&items).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the items of upgrade campaign %q", campaignID)
+	}
+
+	return items, nil
+}
+
+func (u *kafkaUpgradeCampaignService) UpdateItem(item *dbapi.KafkaUpgradeCampaignItem, fields map[string]interface{}) *errors.ServiceError {
+	dbConn := u.connectionFactory.New()
+	if err := dbConn.Model(item).Updates(fields).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the upgrade of kafka %q in campaign %q", item.KafkaID, item.CampaignID)
+	}
+
+	return nil
+}
+
+func (u *kafkaUpgradeCampaignService) GetProgress(campaignIDs ...string) (map[string]dbapi.KafkaUpgradeCampaignProgress, *errors.ServiceError) {
+	res := map[string]dbapi.KafkaUpgradeCampaignProgress{}
+	if len(campaignIDs) == 0 {
+		return res, nil
+	}
+
+	type stateCount struct {
+		CampaignID string
+		State      dbapi.KafkaUpgradeCampaignItemState
+		Count      int
+	}
+	var counts []stateCount
+
+	dbConn := u.connectionFactory.New()
+	if err := dbConn.Model(&dbapi.KafkaUpgradeCampaignItem{}).
+		Select("campaign_id, state, count(*) as count").
+		Where("campaign_id IN (?)", campaignIDs).
+		Group("campaign_id, state").
+		Scan(&counts).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count the items of the upgrade campaigns")
+	}
+
+	for _, campaignID := range campaignIDs {
+		res[campaignID] = dbapi.KafkaUpgradeCampaignProgress{}
+	}
+	for _, c := range counts {
+		progress := res[c.CampaignID]
+		progress.Add(c.State, c.Count)
+		res[c.CampaignID] = progress
+	}
+
+	return res, nil
+}
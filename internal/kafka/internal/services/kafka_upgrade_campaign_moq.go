@@ -0,0 +1,389 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that KafkaUpgradeCampaignServiceMock does implement KafkaUpgradeCampaignService.
+// If this is not the case, regenerate this file with moq.
+var _ KafkaUpgradeCampaignService = &KafkaUpgradeCampaignServiceMock{}
+
+// KafkaUpgradeCampaignServiceMock is a mock implementation of KafkaUpgradeCampaignService.
+//
+//	func TestSomethingThatUsesKafkaUpgradeCampaignService(t *testing.T) {
+//
+//		// make and configure a mocked KafkaUpgradeCampaignService
+//		mockedKafkaUpgradeCampaignService := &KafkaUpgradeCampaignServiceMock{
+//			CreateFunc: func(campaign *dbapi.KafkaUpgradeCampaign) *serviceError.ServiceError {
+//				panic("mock out the Create method")
+//			},
+//			GetFunc: func(id string) (*dbapi.KafkaUpgradeCampaign, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			GetProgressFunc: func(campaignIDs ...string) (map[string]dbapi.KafkaUpgradeCampaignProgress, *serviceError.ServiceError) {
+//				panic("mock out the GetProgress method")
+//			},
+//			ListFunc: func() (dbapi.KafkaUpgradeCampaignList, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ListByStatusFunc: func(status dbapi.KafkaUpgradeCampaignStatus) (dbapi.KafkaUpgradeCampaignList, *serviceError.ServiceError) {
+//				panic("mock out the ListByStatus method")
+//			},
+//			ListItemsFunc: func(campaignID string) (dbapi.KafkaUpgradeCampaignItemList, *serviceError.ServiceError) {
+//				panic("mock out the ListItems method")
+//			},
+//			UpdateItemFunc: func(item *dbapi.KafkaUpgradeCampaignItem, fields map[string]interface{}) *serviceError.ServiceError {
+//				panic("mock out the UpdateItem method")
+//			},
+//			UpdatesFunc: func(campaign *dbapi.KafkaUpgradeCampaign, fields map[string]interface{}) *serviceError.ServiceError {
+//				panic("mock out the Updates method")
+//			},
+//		}
+//
+//		// use mockedKafkaUpgradeCampaignService in code that requires KafkaUpgradeCampaignService
+//		// and then make assertions.
+//
+//	}
+type KafkaUpgradeCampaignServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(campaign *dbapi.KafkaUpgradeCampaign) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(id string) (*dbapi.KafkaUpgradeCampaign, *serviceError.ServiceError)
+
+	// GetProgressFunc mocks the GetProgress method.
+	GetProgressFunc func(campaignIDs ...string) (map[string]dbapi.KafkaUpgradeCampaignProgress, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func() (dbapi.KafkaUpgradeCampaignList, *serviceError.ServiceError)
+
+	// ListByStatusFunc mocks the ListByStatus method.
+	ListByStatusFunc func(status dbapi.KafkaUpgradeCampaignStatus) (dbapi.KafkaUpgradeCampaignList, *serviceError.ServiceError)
+
+	// ListItemsFunc mocks the ListItems method.
+	ListItemsFunc func(campaignID string) (dbapi.KafkaUpgradeCampaignItemList, *serviceError.ServiceError)
+
+	// UpdateItemFunc mocks the UpdateItem method.
+	UpdateItemFunc func(item *dbapi.KafkaUpgradeCampaignItem, fields map[string]interface{}) *serviceError.ServiceError
+
+	// UpdatesFunc mocks the Updates method.
+	UpdatesFunc func(campaign *dbapi.KafkaUpgradeCampaign, fields map[string]interface{}) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Campaign is the campaign argument value.
+			Campaign *dbapi.KafkaUpgradeCampaign
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// GetProgress holds details about calls to the GetProgress method.
+		GetProgress []struct {
+			// CampaignIDs is the campaignIDs argument value.
+			CampaignIDs []string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+		}
+		// ListByStatus holds details about calls to the ListByStatus method.
+		ListByStatus []struct {
+			// Status is the status argument value.
+			Status dbapi.KafkaUpgradeCampaignStatus
+		}
+		// ListItems holds details about calls to the ListItems method.
+		ListItems []struct {
+			// CampaignID is the campaignID argument value.
+			CampaignID string
+		}
+		// UpdateItem holds details about calls to the UpdateItem method.
+		UpdateItem []struct {
+			// Item is the item argument value.
+			Item *dbapi.KafkaUpgradeCampaignItem
+			// Fields is the fields argument value.
+			Fields map[string]interface{}
+		}
+		// Updates holds details about calls to the Updates method.
+		Updates []struct {
+			// Campaign is the campaign argument value.
+			Campaign *dbapi.KafkaUpgradeCampaign
+			// Fields is the fields argument value.
+			Fields map[string]interface{}
+		}
+	}
+	lockCreate       sync.RWMutex
+	lockGet          sync.RWMutex
+	lockGetProgress  sync.RWMutex
+	lockList         sync.RWMutex
+	lockListByStatus sync.RWMutex
+	lockListItems    sync.RWMutex
+	lockUpdateItem   sync.RWMutex
+	lockUpdates      sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *KafkaUpgradeCampaignServiceMock) Create(campaign *dbapi.KafkaUpgradeCampaign) *serviceError.ServiceError {
+	if mock.CreateFunc == nil {
+		panic("KafkaUpgradeCampaignServiceMock.CreateFunc: method is nil but KafkaUpgradeCampaignService.Create was just called")
+	}
+	callInfo := struct {
+		Campaign *dbapi.KafkaUpgradeCampaign
+	}{
+		Campaign: campaign,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(campaign)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedKafkaUpgradeCampaignService.CreateCalls())
+func (mock *KafkaUpgradeCampaignServiceMock) CreateCalls() []struct {
+	Campaign *dbapi.KafkaUpgradeCampaign
+} {
+	var calls []struct {
+		Campaign *dbapi.KafkaUpgradeCampaign
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *KafkaUpgradeCampaignServiceMock) Get(id string) (*dbapi.KafkaUpgradeCampaign, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("KafkaUpgradeCampaignServiceMock.GetFunc: method is nil but KafkaUpgradeCampaignService.Get was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedKafkaUpgradeCampaignService.GetCalls())
+func (mock *KafkaUpgradeCampaignServiceMock) GetCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetProgress calls GetProgressFunc.
+func (mock *KafkaUpgradeCampaignServiceMock) GetProgress(campaignIDs ...string) (map[string]dbapi.KafkaUpgradeCampaignProgress, *serviceError.ServiceError) {
+	if mock.GetProgressFunc == nil {
+		panic("KafkaUpgradeCampaignServiceMock.GetProgressFunc: method is nil but KafkaUpgradeCampaignService.GetProgress was just called")
+	}
+	callInfo := struct {
+		CampaignIDs []string
+	}{
+		CampaignIDs: campaignIDs,
+	}
+	mock.lockGetProgress.Lock()
+	mock.calls.GetProgress = append(mock.calls.GetProgress, callInfo)
+	mock.lockGetProgress.Unlock()
+	return mock.GetProgressFunc(campaignIDs...)
+}
+
+// GetProgressCalls gets all the calls that were made to GetProgress.
+// Check the length with:
+//
+//	len(mockedKafkaUpgradeCampaignService.GetProgressCalls())
+func (mock *KafkaUpgradeCampaignServiceMock) GetProgressCalls() []struct {
+	CampaignIDs []string
+} {
+	var calls []struct {
+		CampaignIDs []string
+	}
+	mock.lockGetProgress.RLock()
+	calls = mock.calls.GetProgress
+	mock.lockGetProgress.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *KafkaUpgradeCampaignServiceMock) List() (dbapi.KafkaUpgradeCampaignList, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("KafkaUpgradeCampaignServiceMock.ListFunc: method is nil but KafkaUpgradeCampaignService.List was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc()
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedKafkaUpgradeCampaignService.ListCalls())
+func (mock *KafkaUpgradeCampaignServiceMock) ListCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListByStatus calls ListByStatusFunc.
+func (mock *KafkaUpgradeCampaignServiceMock) ListByStatus(status dbapi.KafkaUpgradeCampaignStatus) (dbapi.KafkaUpgradeCampaignList, *serviceError.ServiceError) {
+	if mock.ListByStatusFunc == nil {
+		panic("KafkaUpgradeCampaignServiceMock.ListByStatusFunc: method is nil but KafkaUpgradeCampaignService.ListByStatus was just called")
+	}
+	callInfo := struct {
+		Status dbapi.KafkaUpgradeCampaignStatus
+	}{
+		Status: status,
+	}
+	mock.lockListByStatus.Lock()
+	mock.calls.ListByStatus = append(mock.calls.ListByStatus, callInfo)
+	mock.lockListByStatus.Unlock()
+	return mock.ListByStatusFunc(status)
+}
+
+// ListByStatusCalls gets all the calls that were made to ListByStatus.
+// Check the length with:
+//
+//	len(mockedKafkaUpgradeCampaignService.ListByStatusCalls())
+func (mock *KafkaUpgradeCampaignServiceMock) ListByStatusCalls() []struct {
+	Status dbapi.KafkaUpgradeCampaignStatus
+} {
+	var calls []struct {
+		Status dbapi.KafkaUpgradeCampaignStatus
+	}
+	mock.lockListByStatus.RLock()
+	calls = mock.calls.ListByStatus
+	mock.lockListByStatus.RUnlock()
+	return calls
+}
+
+// ListItems calls ListItemsFunc.
+func (mock *KafkaUpgradeCampaignServiceMock) ListItems(campaignID string) (dbapi.KafkaUpgradeCampaignItemList, *serviceError.ServiceError) {
+	if mock.ListItemsFunc == nil {
+		panic("KafkaUpgradeCampaignServiceMock.ListItemsFunc: method is nil but KafkaUpgradeCampaignService.ListItems was just called")
+	}
+	callInfo := struct {
+		CampaignID string
+	}{
+		CampaignID: campaignID,
+	}
+	mock.lockListItems.Lock()
+	mock.calls.ListItems = append(mock.calls.ListItems, callInfo)
+	mock.lockListItems.Unlock()
+	return mock.ListItemsFunc(campaignID)
+}
+
+// ListItemsCalls gets all the calls that were made to ListItems.
+// Check the length with:
+//
+//	len(mockedKafkaUpgradeCampaignService.ListItemsCalls())
+func (mock *KafkaUpgradeCampaignServiceMock) ListItemsCalls() []struct {
+	CampaignID string
+} {
+	var calls []struct {
+		CampaignID string
+	}
+	mock.lockListItems.RLock()
+	calls = mock.calls.ListItems
+	mock.lockListItems.RUnlock()
+	return calls
+}
+
+// UpdateItem calls UpdateItemFunc.
+func (mock *KafkaUpgradeCampaignServiceMock) UpdateItem(item *dbapi.KafkaUpgradeCampaignItem, fields map[string]interface{}) *serviceError.ServiceError {
+	if mock.UpdateItemFunc == nil {
+		panic("KafkaUpgradeCampaignServiceMock.UpdateItemFunc: method is nil but KafkaUpgradeCampaignService.UpdateItem was just called")
+	}
+	callInfo := struct {
+		Item   *dbapi.KafkaUpgradeCampaignItem
+		Fields map[string]interface{}
+	}{
+		Item:   item,
+		Fields: fields,
+	}
+	mock.lockUpdateItem.Lock()
+	mock.calls.UpdateItem = append(mock.calls.UpdateItem, callInfo)
+	mock.lockUpdateItem.Unlock()
+	return mock.UpdateItemFunc(item, fields)
+}
+
+// UpdateItemCalls gets all the calls that were made to UpdateItem.
+// Check the length with:
+//
+//	len(mockedKafkaUpgradeCampaignService.UpdateItemCalls())
+func (mock *KafkaUpgradeCampaignServiceMock) UpdateItemCalls() []struct {
+	Item   *dbapi.KafkaUpgradeCampaignItem
+	Fields map[string]interface{}
+} {
+	var calls []struct {
+		Item   *dbapi.KafkaUpgradeCampaignItem
+		Fields map[string]interface{}
+	}
+	mock.lockUpdateItem.RLock()
+	calls = mock.calls.UpdateItem
+	mock.lockUpdateItem.RUnlock()
+	return calls
+}
+
+// Updates calls UpdatesFunc.
+func (mock *KafkaUpgradeCampaignServiceMock) Updates(campaign *dbapi.KafkaUpgradeCampaign, fields map[string]interface{}) *serviceError.ServiceError {
+	if mock.UpdatesFunc == nil {
+		panic("KafkaUpgradeCampaignServiceMock.UpdatesFunc: method is nil but KafkaUpgradeCampaignService.Updates was just called")
+	}
+	callInfo := struct {
+		Campaign *dbapi.KafkaUpgradeCampaign
+		Fields   map[string]interface{}
+	}{
+		Campaign: campaign,
+		Fields:   fields,
+	}
+	mock.lockUpdates.Lock()
+	mock.calls.Updates = append(mock.calls.Updates, callInfo)
+	mock.lockUpdates.Unlock()
+	return mock.UpdatesFunc(campaign, fields)
+}
+
+// UpdatesCalls gets all the calls that were made to Updates.
+// Check the length with:
+//
+//	len(mockedKafkaUpgradeCampaignService.UpdatesCalls())
+func (mock *KafkaUpgradeCampaignServiceMock) UpdatesCalls() []struct {
+	Campaign *dbapi.KafkaUpgradeCampaign
+	Fields   map[string]interface{}
+} {
+	var calls []struct {
+		Campaign *dbapi.KafkaUpgradeCampaign
+		Fields   map[string]interface{}
+	}
+	mock.lockUpdates.RLock()
+	calls = mock.calls.Updates
+	mock.lockUpdates.RUnlock()
+	return calls
+}
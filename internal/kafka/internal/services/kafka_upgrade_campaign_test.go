@@ -0,0 +1,87 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaUpgradeCampaignService_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		campaign *dbapi.KafkaUpgradeCampaign
+		setupFn  func()
+		wantErr  *errors.ServiceError
+	}{
+		{
+			name:     "should create the campaign and an item for each of the matching kafkas",
+			campaign: &dbapi.KafkaUpgradeCampaign{ClusterID: "cluster-id", KafkaVersion: "2.8.1"},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT "id" FROM "kafka_requests" WHERE status NOT IN ($1,$2) AND cluster_id = $3`).
+					WithReply([]map[string]interface{}{{"id": "kafka-1"}, {"id": "kafka-2"}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_upgrade_campaigns"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_upgrade_campaign_items"`)
+			},
+		},
+		{
+			name:     "should return an error when the search query cannot be parsed",
+			campaign: &dbapi.KafkaUpgradeCampaign{Search: "name = "},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: errors.New(errors.ErrorFailedToParseSearch, ""),
+		},
+		{
+			name:     "should return an error when the kafkas cannot be resolved",
+			campaign: &dbapi.KafkaUpgradeCampaign{},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id" FROM "kafka_requests"`).WithQueryException()
+			},
+			wantErr: errors.GeneralError(""),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			u := NewKafkaUpgradeCampaignService(db.NewMockConnectionFactory(nil))
+			err := u.Create(tt.campaign)
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+				return
+			}
+
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(tt.campaign.ID).ToNot(gomega.BeEmpty())
+			g.Expect(tt.campaign.Status).To(gomega.Equal(dbapi.KafkaUpgradeCampaignStatusRunning))
+		})
+	}
+}
+
+func Test_kafkaUpgradeCampaignService_GetProgress(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().
+		WithQuery(`SELECT campaign_id, state, count(*) as count FROM "kafka_upgrade_campaign_items"`).
+		WithReply([]map[string]interface{}{
+			{"campaign_id": "campaign-1", "state": "succeeded", "count": 3},
+			{"campaign_id": "campaign-1", "state": "failed", "count": 1},
+			{"campaign_id": "campaign-1", "state": "pending", "count": 2},
+		})
+
+	u := NewKafkaUpgradeCampaignService(db.NewMockConnectionFactory(nil))
+	got, err := u.GetProgress("campaign-1", "campaign-2")
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(got).To(gomega.Equal(map[string]dbapi.KafkaUpgradeCampaignProgress{
+		"campaign-1": {Total: 6, Pending: 2, Succeeded: 3, Failed: 1},
+		"campaign-2": {},
+	}))
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
+)
+
+// kafkaStatusEventTypes maps the kafka statuses the webhook subscriptions can be notified of to their event type
+var kafkaStatusEventTypes = map[constants.KafkaStatus]string{
+	constants.KafkaRequestStatusReady:     webhook.EventTypeKafkaReady,
+	constants.KafkaRequestStatusFailed:    webhook.EventTypeKafkaFailed,
+	constants.KafkaRequestStatusSuspended: webhook.EventTypeKafkaSuspended,
+}
+
+// KafkaEventData is the content of the "data" field of the kafka webhook events
+type KafkaEventData struct {
+	Id            string     `json:"id"`
+	Name          string     `json:"name"`
+	Status        string     `json:"status"`
+	CloudProvider string     `json:"cloud_provider"`
+	Region        string     `json:"region"`
+	InstanceType  string     `json:"instance_type"`
+	FailedReason  string     `json:"failed_reason,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// NewKafkaWebhookEvent returns the webhook event of the given type for the kafka
+func NewKafkaWebhookEvent(eventType string, kafka *dbapi.KafkaRequest) webhook.Event {
+	data := KafkaEventData{
+		Id:            kafka.ID,
+		Name:          kafka.Name,
+		Status:        kafka.Status,
+		CloudProvider: kafka.CloudProvider,
+		Region:        kafka.Region,
+		InstanceType:  kafka.InstanceType,
+		FailedReason:  kafka.FailedReason,
+	}
+	if kafka.ExpiresAt.Valid {
+		data.ExpiresAt = &kafka.ExpiresAt.Time
+	}
+	return webhook.Event{
+		Type:           eventType,
+		OrganisationId: kafka.OrganisationId,
+		ResourceId:     kafka.ID,
+		Data:           data,
+	}
+}
+
+// KafkaStatusChangedPayload is the payload of the kafka.status_changed outbox events, built by the trigger of the kafka_requests table
+type KafkaStatusChangedPayload struct {
+	Status         string     `json:"status"`
+	OrganisationId string     `json:"organisation_id"`
+	Owner          string     `json:"owner"`
+	Name           string     `json:"name"`
+	CloudProvider  string     `json:"cloud_provider"`
+	Region         string     `json:"region"`
+	InstanceType   string     `json:"instance_type"`
+	FailedReason   string     `json:"failed_reason"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+}
+
+var _ webhook.OutboxEventConverter = &kafkaOutboxEventConverter{}
+
+// kafkaOutboxEventConverter converts the status changes of the kafkas recorded in the outbox into the kafka webhook events
+type kafkaOutboxEventConverter struct{}
+
+func NewKafkaOutboxEventConverter() *kafkaOutboxEventConverter {
+	return &kafkaOutboxEventConverter{}
+}
+
+func (c *kafkaOutboxEventConverter) GetOutboxEventTypes() []string {
+	return []string{KafkaStatusChangedSignal}
+}
+
+func (c *kafkaOutboxEventConverter) Convert(event *api.OutboxEvent) (*webhook.Event, *errors.ServiceError) {
+	var payload KafkaStatusChangedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to unmarshal the payload of outbox event %d", event.Sequence)
+	}
+	eventType, ok := kafkaStatusEventTypes[constants.KafkaStatus(payload.Status)]
+	if !ok {
+		return nil, nil
+	}
+	return &webhook.Event{
+		Type:           eventType,
+		OrganisationId: payload.OrganisationId,
+		ResourceId:     event.ResourceId,
+		Data: KafkaEventData{
+			Id:            event.ResourceId,
+			Name:          payload.Name,
+			Status:        payload.Status,
+			CloudProvider: payload.CloudProvider,
+			Region:        payload.Region,
+			InstanceType:  payload.InstanceType,
+			FailedReason:  payload.FailedReason,
+			ExpiresAt:     payload.ExpiresAt,
+		},
+	}, nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
+	"github.com/onsi/gomega"
+)
+
+func Test_kafkaOutboxEventConverter_Convert(t *testing.T) {
+	expiresAt := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		payload string
+		want    *webhook.Event
+		wantErr bool
+	}{
+		{
+			name:    "should convert the change to a status the subscriptions are notified of",
+			payload: `{"status":"ready","organisation_id":"org","owner":"owner","name":"my-kafka","cloud_provider":"aws","region":"us-east-1","instance_type":"developer","failed_reason":"","expires_at":"2023-05-01T12:00:00+00:00"}`,
+			want: &webhook.Event{
+				Type:           webhook.EventTypeKafkaReady,
+				OrganisationId: "org",
+				ResourceId:     testID,
+				Data: KafkaEventData{
+					Id:            testID,
+					Name:          "my-kafka",
+					Status:        "ready",
+					CloudProvider: "aws",
+					Region:        "us-east-1",
+					InstanceType:  "developer",
+					ExpiresAt:     &expiresAt,
+				},
+			},
+		},
+		{
+			name:    "should keep the failed reason of the kafka at the time of the change",
+			payload: `{"status":"failed","organisation_id":"org","name":"my-kafka","failed_reason":"reported as failed","expires_at":null}`,
+			want: &webhook.Event{
+				Type:           webhook.EventTypeKafkaFailed,
+				OrganisationId: "org",
+				ResourceId:     testID,
+				Data: KafkaEventData{
+					Id:           testID,
+					Name:         "my-kafka",
+					Status:       "failed",
+					FailedReason: "reported as failed",
+				},
+			},
+		},
+		{
+			name:    "should not convert the change to a status the subscriptions are not notified of",
+			payload: `{"status":"provisioning","organisation_id":"org"}`,
+		},
+		{
+			name:    "should return an error when the payload is invalid",
+			payload: `{"status":`,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewKafkaOutboxEventConverter()
+			g.Expect(c.GetOutboxEventTypes()).To(gomega.Equal([]string{KafkaStatusChangedSignal}))
+			got, err := c.Convert(&api.OutboxEvent{
+				Sequence:   1,
+				EventType:  KafkaStatusChangedSignal,
+				ResourceId: testID,
+				Payload:    api.JSON(tt.payload),
+			})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.want == nil {
+				g.Expect(got).To(gomega.BeNil())
+				return
+			}
+			g.Expect(got.Type).To(gomega.Equal(tt.want.Type))
+			g.Expect(got.OrganisationId).To(gomega.Equal(tt.want.OrganisationId))
+			g.Expect(got.ResourceId).To(gomega.Equal(tt.want.ResourceId))
+			data := got.Data.(KafkaEventData)
+			wantData := tt.want.Data.(KafkaEventData)
+			g.Expect(data.ExpiresAt == nil).To(gomega.Equal(wantData.ExpiresAt == nil))
+			if wantData.ExpiresAt != nil {
+				g.Expect(data.ExpiresAt.Equal(*wantData.ExpiresAt)).To(gomega.BeTrue())
+			}
+			data.ExpiresAt, wantData.ExpiresAt = nil, nil
+			g.Expect(data).To(gomega.Equal(wantData))
+		})
+	}
+}
@@ -5,7 +5,6 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	kafkaTypes "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
@@ -32,7 +31,7 @@ var _ KafkaService = &KafkaServiceMock{}
 //			AssignInstanceTypeFunc: func(owner string, organisationID string) (kafkaTypes.KafkaInstanceType, *serviceError.ServiceError) {
 //				panic("mock out the AssignInstanceType method")
 //			},
-//			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+//			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*CNameRecordStatus, *serviceError.ServiceError) {
 //				panic("mock out the ChangeKafkaCNAMErecords method")
 //			},
 //			CountByStatusFunc: func(status []constants.KafkaStatus) ([]KafkaStatusCount, error) {
@@ -80,6 +79,9 @@ var _ KafkaService = &KafkaServiceMock{}
 //			ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
 //				panic("mock out the ListByStatus method")
 //			},
+//			ListChangesFunc: func(ctx context.Context, gtVersion int64, includeDeleted bool, ids ...string) (dbapi.KafkaList, *serviceError.ServiceError) {
+//				panic("mock out the ListChanges method")
+//			},
 //			ListComponentVersionsFunc: func() ([]KafkaComponentVersions, error) {
 //				panic("mock out the ListComponentVersions method")
 //			},
@@ -101,6 +103,9 @@ var _ KafkaService = &KafkaServiceMock{}
 //			RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 //				panic("mock out the RegisterKafkaJob method")
 //			},
+//			ResizeFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeId string) *serviceError.ServiceError {
+//				panic("mock out the Resize method")
+//			},
 //			UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 //				panic("mock out the Update method")
 //			},
@@ -130,7 +135,7 @@ type KafkaServiceMock struct {
 	AssignInstanceTypeFunc func(owner string, organisationID string) (kafkaTypes.KafkaInstanceType, *serviceError.ServiceError)
 
 	// ChangeKafkaCNAMErecordsFunc mocks the ChangeKafkaCNAMErecords method.
-	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError)
+	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*CNameRecordStatus, *serviceError.ServiceError)
 
 	// CountByStatusFunc mocks the CountByStatus method.
 	CountByStatusFunc func(status []constants.KafkaStatus) ([]KafkaStatusCount, error)
@@ -177,6 +182,9 @@ type KafkaServiceMock struct {
 	// ListByStatusFunc mocks the ListByStatus method.
 	ListByStatusFunc func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *serviceError.ServiceError)
 
+	// ListChangesFunc mocks the ListChanges method.
+	ListChangesFunc func(ctx context.Context, gtVersion int64, includeDeleted bool, ids ...string) (dbapi.KafkaList, *serviceError.ServiceError)
+
 	// ListComponentVersionsFunc mocks the ListComponentVersions method.
 	ListComponentVersionsFunc func() ([]KafkaComponentVersions, error)
 
@@ -198,6 +206,9 @@ type KafkaServiceMock struct {
 	// RegisterKafkaJobFunc mocks the RegisterKafkaJob method.
 	RegisterKafkaJobFunc func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
+	// ResizeFunc mocks the Resize method.
+	ResizeFunc func(kafkaRequest *dbapi.KafkaRequest, sizeId string) *serviceError.ServiceError
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
@@ -309,6 +320,17 @@ type KafkaServiceMock struct {
 			// Status is the status argument value.
 			Status []constants.KafkaStatus
 		}
+		// ListChanges holds details about calls to the ListChanges method.
+		ListChanges []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GtVersion is the gtVersion argument value.
+			GtVersion int64
+			// IncludeDeleted is the includeDeleted argument value.
+			IncludeDeleted bool
+			// Ids is the ids argument value.
+			Ids []string
+		}
 		// ListComponentVersions holds details about calls to the ListComponentVersions method.
 		ListComponentVersions []struct {
 		}
@@ -340,6 +362,13 @@ type KafkaServiceMock struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// Resize holds details about calls to the Resize method.
+		Resize []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// SizeId is the sizeId argument value.
+			SizeId string
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// KafkaRequest is the kafkaRequest argument value.
@@ -398,6 +427,7 @@ type KafkaServiceMock struct {
 	lockList                                     sync.RWMutex
 	lockListAll                                  sync.RWMutex
 	lockListByStatus                             sync.RWMutex
+	lockListChanges                              sync.RWMutex
 	lockListComponentVersions                    sync.RWMutex
 	lockListKafkasToBePromoted                   sync.RWMutex
 	lockListKafkasWithRoutesNotCreated           sync.RWMutex
@@ -405,6 +435,7 @@ type KafkaServiceMock struct {
 	lockPrepareKafkaRequest                      sync.RWMutex
 	lockRegisterKafkaDeprovisionJob              sync.RWMutex
 	lockRegisterKafkaJob                         sync.RWMutex
+	lockResize                                   sync.RWMutex
 	lockUpdate                                   sync.RWMutex
 	lockUpdateStatus                             sync.RWMutex
 	lockUpdates                                  sync.RWMutex
@@ -481,7 +512,7 @@ func (mock *KafkaServiceMock) AssignInstanceTypeCalls() []struct {
 }
 
 // ChangeKafkaCNAMErecords calls ChangeKafkaCNAMErecordsFunc.
-func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*CNameRecordStatus, *serviceError.ServiceError) {
 	if mock.ChangeKafkaCNAMErecordsFunc == nil {
 		panic("KafkaServiceMock.ChangeKafkaCNAMErecordsFunc: method is nil but KafkaService.ChangeKafkaCNAMErecords was just called")
 	}
@@ -994,6 +1025,50 @@ func (mock *KafkaServiceMock) ListByStatusCalls() []struct {
 	return calls
 }
 
+// ListChanges calls ListChangesFunc.
+func (mock *KafkaServiceMock) ListChanges(ctx context.Context, gtVersion int64, includeDeleted bool, ids ...string) (dbapi.KafkaList, *serviceError.ServiceError) {
+	if mock.ListChangesFunc == nil {
+		panic("KafkaServiceMock.ListChangesFunc: method is nil but KafkaService.ListChanges was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		GtVersion      int64
+		IncludeDeleted bool
+		Ids            []string
+	}{
+		Ctx:            ctx,
+		GtVersion:      gtVersion,
+		IncludeDeleted: includeDeleted,
+		Ids:            ids,
+	}
+	mock.lockListChanges.Lock()
+	mock.calls.ListChanges = append(mock.calls.ListChanges, callInfo)
+	mock.lockListChanges.Unlock()
+	return mock.ListChangesFunc(ctx, gtVersion, includeDeleted, ids...)
+}
+
+// ListChangesCalls gets all the calls that were made to ListChanges.
+// Check the length with:
+//
+//	len(mockedKafkaService.ListChangesCalls())
+func (mock *KafkaServiceMock) ListChangesCalls() []struct {
+	Ctx            context.Context
+	GtVersion      int64
+	IncludeDeleted bool
+	Ids            []string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		GtVersion      int64
+		IncludeDeleted bool
+		Ids            []string
+	}
+	mock.lockListChanges.RLock()
+	calls = mock.calls.ListChanges
+	mock.lockListChanges.RUnlock()
+	return calls
+}
+
 // ListComponentVersions calls ListComponentVersionsFunc.
 func (mock *KafkaServiceMock) ListComponentVersions() ([]KafkaComponentVersions, error) {
 	if mock.ListComponentVersionsFunc == nil {
@@ -1207,6 +1282,42 @@ func (mock *KafkaServiceMock) RegisterKafkaJobCalls() []struct {
 	return calls
 }
 
+// Resize calls ResizeFunc.
+func (mock *KafkaServiceMock) Resize(kafkaRequest *dbapi.KafkaRequest, sizeId string) *serviceError.ServiceError {
+	if mock.ResizeFunc == nil {
+		panic("KafkaServiceMock.ResizeFunc: method is nil but KafkaService.Resize was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		SizeId       string
+	}{
+		KafkaRequest: kafkaRequest,
+		SizeId:       sizeId,
+	}
+	mock.lockResize.Lock()
+	mock.calls.Resize = append(mock.calls.Resize, callInfo)
+	mock.lockResize.Unlock()
+	return mock.ResizeFunc(kafkaRequest, sizeId)
+}
+
+// ResizeCalls gets all the calls that were made to Resize.
+// Check the length with:
+//
+//	len(mockedKafkaService.ResizeCalls())
+func (mock *KafkaServiceMock) ResizeCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	SizeId       string
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		SizeId       string
+	}
+	mock.lockResize.RLock()
+	calls = mock.calls.Resize
+	mock.lockResize.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *KafkaServiceMock) Update(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if mock.UpdateFunc == nil {
@@ -16,6 +16,10 @@ type QuotaService interface {
 	// ReserveQuotaIfNotAlreadyReserved reserves a quota for the specified request if the desired quota
 	// has not been already reserved. Returns the id of the newly reserved quota or the id of the existing one
 	ReserveQuotaIfNotAlreadyReserved(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError)
+	// UpdateQuota replaces the quota reserved for the kafka by the quota of the given size, instead of reserving the quota
+	// of the new size on top of the current one. The current reservation is kept when the new size cannot be reserved.
+	// Returns the id of the reservation of the resized kafka
+	UpdateQuota(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError)
 	// DeleteQuota deletes a reserved quota
 	DeleteQuota(subscriptionId string) *errors.ServiceError
 	// DeleteQuotaForBillingModel deletes a reserved quota only if it is related to the specified billing model, otherwise exits with no error
@@ -314,7 +314,7 @@ func (q amsQuotaService) ReserveQuotaIfNotAlreadyReserved(kafka *dbapi.KafkaRequ
 		return kafka.ID
 	}
 
-	instanceType, err := q.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafka.InstanceType)
+	instanceType, err := q.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(kafka.InstanceType)
 	if err != nil {
 		return "", errors.GeneralError("failed checking current quota: %v", err)
 	}
@@ -348,6 +348,35 @@ func (q amsQuotaService) ReserveQuotaIfNotAlreadyReserved(kafka *dbapi.KafkaRequ
 	return q.ReserveQuota(kafka)
 }
 
+// UpdateQuota releases the subscription of the kafka before reserving the quota of the new size, as AMS holds a single
+// subscription per cluster id. The quota of the current size is reserved again when the new size cannot be reserved.
+func (q amsQuotaService) UpdateQuota(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError) {
+	resizedKafka := *kafka
+	resizedKafka.SizeId = sizeId
+	if kafka.SubscriptionId == "" {
+		return q.ReserveQuota(&resizedKafka)
+	}
+
+	if err := q.DeleteQuota(kafka.SubscriptionId); err != nil {
+		return "", err
+	}
+
+	subscriptionId, err := q.ReserveQuota(&resizedKafka)
+	if err != nil {
+		currentKafka := *kafka
+		currentSubscriptionId, restoreErr := q.ReserveQuota(&currentKafka)
+		if restoreErr != nil {
+			logger.Logger.Errorf("failed to reserve again the quota of size %q of kafka %q: %v", kafka.SizeId, kafka.ID, restoreErr)
+			return "", err
+		}
+		// the kafka keeps its current size, with the subscription of its new reservation
+		kafka.SubscriptionId = currentSubscriptionId
+		return "", err
+	}
+
+	return subscriptionId, nil
+}
+
 func (q amsQuotaService) DeleteQuota(subscriptionID string) *errors.ServiceError {
 	if subscriptionID == "" {
 		return nil
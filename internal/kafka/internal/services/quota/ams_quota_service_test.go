@@ -1862,6 +1862,142 @@ func Test_Delete_Quota(t *testing.T) {
 	}
 }
 
+func Test_amsQuotaService_UpdateQuota(t *testing.T) {
+	supportedInstanceTypes := test.NewAMSTestKafkaSupportedInstanceTypesConfig()
+	standardInstanceType, _ := supportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(types.STANDARD.String())
+	resizableStandardInstanceType := *standardInstanceType
+	x2Size := standardInstanceType.Sizes[0]
+	x2Size.Id = "x2"
+	x2Size.QuotaConsumed = 2
+	resizableStandardInstanceType.Sizes = []config.KafkaInstanceSize{standardInstanceType.Sizes[0], x2Size}
+	kafkaConfig := config.KafkaConfig{
+		Quota: config.NewKafkaQuotaConfig(),
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{resizableStandardInstanceType},
+			},
+		},
+	}
+
+	getOrganisationIdFromExternalId := func(externalId string) (string, error) {
+		return fmt.Sprintf("fake-org-id-%s", externalId), nil
+	}
+	getQuotaCostsForProduct := func(organizationID, resourceName, product string) ([]*v1.QuotaCost, error) {
+		rrbq1 := v1.NewRelatedResource().BillingModel(string(v1.BillingModelMarketplace)).Product(string(ocm.RHOSAKProduct)).ResourceName(resourceName).Cost(1)
+		qcb, err := v1.NewQuotaCost().Allowed(2).Consumed(0).OrganizationID(organizationID).RelatedResources(rrbq1).Build()
+		if err != nil {
+			panic("unexpected error")
+		}
+		return []*v1.QuotaCost{qcb}, nil
+	}
+	// clusterAuthorization allows the reservations of at most maxCount resources
+	clusterAuthorization := func(maxCount int, subscriptionID string) func(cb *v1.ClusterAuthorizationRequest) (*v1.ClusterAuthorizationResponse, error) {
+		return func(cb *v1.ClusterAuthorizationRequest) (*v1.ClusterAuthorizationResponse, error) {
+			sub := v1.SubscriptionBuilder{}
+			sub.ID(subscriptionID)
+			ca, _ := v1.NewClusterAuthorizationResponse().Allowed(cb.Resources()[0].Count() <= maxCount).Subscription(&sub).Build()
+			return ca, nil
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		ocmClient               *ocm.ClientMock
+		subscriptionID          string
+		wantSubscriptionID      string
+		wantErr                 bool
+		wantKafkaSubscription   string
+		wantDeletedSubscription string
+		wantReservedCounts      []int
+	}{
+		{
+			name: "should release the subscription of the current size before reserving the new size",
+			ocmClient: &ocm.ClientMock{
+				DeleteSubscriptionFunc: func(id string) (int, error) {
+					return 1, nil
+				},
+				ClusterAuthorizationFunc:            clusterAuthorization(2, "new-subscription-id"),
+				GetOrganisationIdFromExternalIdFunc: getOrganisationIdFromExternalId,
+				GetQuotaCostsForProductFunc:         getQuotaCostsForProduct,
+			},
+			subscriptionID:          "current-subscription-id",
+			wantSubscriptionID:      "new-subscription-id",
+			wantKafkaSubscription:   "current-subscription-id",
+			wantDeletedSubscription: "current-subscription-id",
+			wantReservedCounts:      []int{2},
+		},
+		{
+			name: "should only reserve the new size when the kafka has no subscription",
+			ocmClient: &ocm.ClientMock{
+				ClusterAuthorizationFunc:            clusterAuthorization(2, "new-subscription-id"),
+				GetOrganisationIdFromExternalIdFunc: getOrganisationIdFromExternalId,
+				GetQuotaCostsForProductFunc:         getQuotaCostsForProduct,
+			},
+			wantSubscriptionID: "new-subscription-id",
+			wantReservedCounts: []int{2},
+		},
+		{
+			name: "should reserve the current size again when the new size cannot be reserved",
+			ocmClient: &ocm.ClientMock{
+				DeleteSubscriptionFunc: func(id string) (int, error) {
+					return 1, nil
+				},
+				ClusterAuthorizationFunc:            clusterAuthorization(1, "restored-subscription-id"),
+				GetOrganisationIdFromExternalIdFunc: getOrganisationIdFromExternalId,
+				GetQuotaCostsForProductFunc:         getQuotaCostsForProduct,
+			},
+			subscriptionID:          "current-subscription-id",
+			wantErr:                 true,
+			wantKafkaSubscription:   "restored-subscription-id",
+			wantDeletedSubscription: "current-subscription-id",
+			wantReservedCounts:      []int{2, 1},
+		},
+		{
+			name: "should not reserve the new size when the current subscription cannot be released",
+			ocmClient: &ocm.ClientMock{
+				DeleteSubscriptionFunc: func(id string) (int, error) {
+					return 0, errors.GeneralError("failed to delete subscription")
+				},
+			},
+			subscriptionID:          "current-subscription-id",
+			wantErr:                 true,
+			wantKafkaSubscription:   "current-subscription-id",
+			wantDeletedSubscription: "current-subscription-id",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			factory := NewDefaultQuotaServiceFactory(tt.ocmClient, nil, nil, &kafkaConfig)
+			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
+			kafka := &dbapi.KafkaRequest{
+				Meta:           api.Meta{ID: "kafka-id"},
+				Owner:          "testUser",
+				InstanceType:   types.STANDARD.String(),
+				SizeId:         "x1",
+				SubscriptionId: tt.subscriptionID,
+			}
+
+			subscriptionID, err := quotaService.UpdateQuota(kafka, "x2")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(subscriptionID).To(gomega.Equal(tt.wantSubscriptionID))
+			g.Expect(kafka.SizeId).To(gomega.Equal("x1"))
+			g.Expect(kafka.SubscriptionId).To(gomega.Equal(tt.wantKafkaSubscription))
+			if tt.wantDeletedSubscription != "" {
+				g.Expect(tt.ocmClient.DeleteSubscriptionCalls()).To(gomega.HaveLen(1))
+				g.Expect(tt.ocmClient.DeleteSubscriptionCalls()[0].ID).To(gomega.Equal(tt.wantDeletedSubscription))
+			}
+			reservedCounts := []int{}
+			for _, call := range tt.ocmClient.ClusterAuthorizationCalls() {
+				reservedCounts = append(reservedCounts, call.Cb.Resources()[0].Count())
+			}
+			g.Expect(reservedCounts).To(gomega.ConsistOf(tt.wantReservedCounts))
+		})
+	}
+}
+
 func Test_amsQuotaService_CheckIfQuotaIsDefinedForInstanceType(t *testing.T) {
 	var amsDefaultKafkaConf = config.KafkaConfig{
 		Quota:                  config.NewKafkaQuotaConfig(),
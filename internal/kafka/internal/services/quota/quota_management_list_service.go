@@ -46,14 +46,14 @@ func (q QuotaManagementListService) ValidateBillingAccount(organisationId string
 func (q QuotaManagementListService) CheckIfQuotaIsDefinedForInstanceType(username string, organisationId string, instanceType types.KafkaInstanceType, kafkaBillingModel config.KafkaBillingModel) (bool, *errors.ServiceError) {
 	orgId := organisationId
 	var account quota_management.Account
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(orgId)
+	org, orgFound := q.quotaManagementList.GetOrganisation(orgId)
 	userIsRegistered := false
 	serviceAccountIsRegistered := false
 
 	if orgFound && org.IsUserRegistered(username) {
 		userIsRegistered = true
 	} else {
-		account, serviceAccountIsRegistered = q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(username)
+		account, serviceAccountIsRegistered = q.quotaManagementList.GetServiceAccount(username)
 	}
 
 	// if the user is registered, check that he has quota defined for the desired instance type
@@ -75,6 +75,14 @@ func (q QuotaManagementListService) CheckIfQuotaIsDefinedForInstanceType(usernam
 }
 
 // ReserveQuota - tries to reserve the quota for the received kafka request
+// UpdateQuota checks the quota of the new size of the kafka. The streaming units of the kafka itself are not accounted
+// for by ReserveQuota, so its current size is not counted twice.
+func (q QuotaManagementListService) UpdateQuota(kafka *dbapi.KafkaRequest, sizeId string) (string, *errors.ServiceError) {
+	resizedKafka := *kafka
+	resizedKafka.SizeId = sizeId
+	return q.ReserveQuota(&resizedKafka)
+}
+
 func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError) {
 	billingModelID, err := q.detectBillingModel(kafka)
 	if err != nil {
@@ -94,14 +102,14 @@ func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest) (str
 	orgId := kafka.OrganisationId
 	var quotaManagementListItem quota_management.QuotaManagementListItem
 	message := fmt.Sprintf("user '%s' has reached a maximum number of %d allowed streaming units", username, quota_management.GetDefaultMaxAllowedInstances())
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(orgId)
+	org, orgFound := q.quotaManagementList.GetOrganisation(orgId)
 	filterByOrg := false
 	if orgFound && org.IsUserRegistered(username) {
 		quotaManagementListItem = org
 		message = fmt.Sprintf("organization '%s' has reached a maximum number of %d allowed streaming units", orgId, org.GetMaxAllowedInstances(kafka.InstanceType, kafka.DesiredKafkaBillingModel))
 		filterByOrg = true
 	} else {
-		user, userFound := q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(username)
+		user, userFound := q.quotaManagementList.GetServiceAccount(username)
 		if userFound {
 			quotaManagementListItem = user
 			message = fmt.Sprintf("user '%s' has reached a maximum number of %d allowed streaming units", username, user.GetMaxAllowedInstances(kafka.InstanceType, kafka.DesiredKafkaBillingModel))
@@ -123,6 +131,9 @@ func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest) (str
 		dbConn = dbConn.Where("owner = ?", username)
 	}
 
+	// the kafka itself is not accounted for in case it already exists, e.g. when it is being resized
+	dbConn = dbConn.Where("id <> ?", kafka.ID)
+
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).
 		Scan(&kafkas).Error; err != nil {
 		return "", errors.GeneralError(errMessage)
@@ -172,7 +183,7 @@ func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest) (str
 // 7) if [6] fails, return the first defined billing model
 func (q QuotaManagementListService) detectBillingModel(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError) {
 	if kafka.DesiredKafkaBillingModel != "" {
-		instanceType, err := q.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafka.InstanceType)
+		instanceType, err := q.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(kafka.InstanceType)
 		if err != nil {
 			return "", errors.InstanceTypeNotSupported("invalid instance type '%s'", instanceType.Id)
 		}
@@ -188,12 +199,12 @@ func (q QuotaManagementListService) detectBillingModel(kafka *dbapi.KafkaRequest
 
 	var grantedQuota []quota_management.Quota
 
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(kafka.OrganisationId)
+	org, orgFound := q.quotaManagementList.GetOrganisation(kafka.OrganisationId)
 	username := kafka.Owner
 	if orgFound {
 		grantedQuota = org.GetGrantedQuota()
 	} else {
-		user, userFound := q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(username)
+		user, userFound := q.quotaManagementList.GetServiceAccount(username)
 		if userFound {
 			grantedQuota = user.GetGrantedQuota()
 		} else {
@@ -239,7 +250,7 @@ func (q QuotaManagementListService) IsQuotaEntitlementActive(kafka *dbapi.KafkaR
 
 	var billingModel *quota_management.BillingModel
 
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(kafka.OrganisationId)
+	org, orgFound := q.quotaManagementList.GetOrganisation(kafka.OrganisationId)
 	if orgFound && org.IsUserRegistered(kafka.Owner) {
 		logger.Logger.Infof("user registered by organisation, checking quota entitlement for organisation %q", org.Id)
 		bm, ok := org.GetBillingModel(kafka.InstanceType, kafka.ActualKafkaBillingModel)
@@ -248,7 +259,7 @@ func (q QuotaManagementListService) IsQuotaEntitlementActive(kafka *dbapi.KafkaR
 		}
 	} else {
 		logger.Logger.Infof("user is not registered by organisation, checking quota entitlement for %q as an individual account", kafka.Owner)
-		account, accountFound := q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(kafka.Owner)
+		account, accountFound := q.quotaManagementList.GetServiceAccount(kafka.Owner)
 		if accountFound {
 			bm, ok := account.GetBillingModel(kafka.InstanceType, kafka.ActualKafkaBillingModel)
 			if ok {
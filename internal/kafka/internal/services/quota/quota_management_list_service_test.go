@@ -394,8 +394,8 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 			setupFn: func() {
 				mocket.Catcher.Reset()
 				mocket.Catcher.NewMock().
-					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND (organisation_id = $4) AND "kafka_requests"."deleted_at" IS NULL`).
-					WithArgs(types.STANDARD.String(), "standard", "standard", "org-id").
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND (organisation_id = $4) AND id <> $5 AND "kafka_requests"."deleted_at" IS NULL`).
+					WithArgs(types.STANDARD.String(), "standard", "standard", "org-id", "").
 					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
@@ -430,8 +430,8 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 			setupFn: func() {
 				mocket.Catcher.Reset()
 				mocket.Catcher.NewMock().
-					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND owner = $4 AND "kafka_requests"."deleted_at" IS NULL`).
-					WithArgs(types.DEVELOPER.String(), "standard", "standard", "username").
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND owner = $4 AND id <> $5 AND "kafka_requests"."deleted_at" IS NULL`).
+					WithArgs(types.DEVELOPER.String(), "standard", "standard", "username", "").
 					WithReply(nil)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
@@ -457,8 +457,8 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 			setupFn: func() {
 				mocket.Catcher.Reset()
 				mocket.Catcher.NewMock().
-					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND owner = $4 AND "kafka_requests"."deleted_at" IS NULL`).
-					WithArgs(types.DEVELOPER.String(), "standard", "standard", "username").
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND owner = $4 AND id <> $5 AND "kafka_requests"."deleted_at" IS NULL`).
+					WithArgs(types.DEVELOPER.String(), "standard", "standard", "username", "").
 					WithReply(converters.ConvertKafkaRequest(
 						buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
 							kafkaRequest.Owner = "username"
@@ -497,8 +497,8 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 			setupFn: func() {
 				mocket.Catcher.Reset()
 				mocket.Catcher.NewMock().
-					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND (organisation_id = $4) AND "kafka_requests"."deleted_at" IS NULL`).
-					WithArgs(types.STANDARD.String(), "standard", "standard", "org-id").
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND (organisation_id = $4) AND id <> $5 AND "kafka_requests"."deleted_at" IS NULL`).
+					WithArgs(types.STANDARD.String(), "standard", "standard", "org-id", "").
 					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
@@ -518,8 +518,8 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 			setupFn: func() {
 				mocket.Catcher.Reset()
 				mocket.Catcher.NewMock().
-					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND owner = $4 AND "kafka_requests"."deleted_at" IS NULL`).
-					WithArgs(types.DEVELOPER.String(), "standard", "standard", "username").
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND owner = $4 AND id <> $5 AND "kafka_requests"."deleted_at" IS NULL`).
+					WithArgs(types.DEVELOPER.String(), "standard", "standard", "username", "").
 					WithReply(nil)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
@@ -551,6 +551,79 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 		})
 	}
 }
+func Test_QuotaManagementListUpdateQuota(t *testing.T) {
+	supportedInstanceTypes := test.NewQuotaListTestKafkaSupportedInstanceTypesConfig()
+	standardInstanceType, _ := supportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(types.STANDARD.String())
+	resizableStandardInstanceType := *standardInstanceType
+	x2Size := standardInstanceType.Sizes[0]
+	x2Size.Id = "x2"
+	x2Size.CapacityConsumed = 2
+	resizableStandardInstanceType.Sizes = []config.KafkaInstanceSize{standardInstanceType.Sizes[0], x2Size}
+	kafkaConfig := config.KafkaConfig{
+		Quota: config.NewKafkaQuotaConfig(),
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{resizableStandardInstanceType},
+			},
+		},
+	}
+	quotaManagementList := &quota_management.QuotaManagementListConfig{
+		EnableInstanceLimitControl: true,
+		QuotaList: quota_management.RegisteredUsersListConfiguration{
+			Organisations: quota_management.OrganisationList{
+				quota_management.Organisation{
+					Id:                  "org-id",
+					MaxAllowedInstances: 2,
+					AnyUser:             true,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		otherKafkas []*dbapi.KafkaRequest
+		wantErr     bool
+	}{
+		{
+			name: "should not account for the current size of the kafka",
+		},
+		{
+			name:        "should return an error when the new size exceeds the quota with the other kafkas",
+			otherKafkas: []*dbapi.KafkaRequest{buildKafkaRequest(nil)},
+			wantErr:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			// the kafka itself is excluded from the kafkas consuming the quota
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND (organisation_id = $4) AND id <> $5 AND "kafka_requests"."deleted_at" IS NULL`).
+				WithArgs(types.STANDARD.String(), "standard", "standard", "org-id", "kafka-id").
+				WithReply(converters.ConvertKafkaRequestList(tt.otherKafkas))
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			factory := NewDefaultQuotaServiceFactory(nil, db.NewMockConnectionFactory(nil), quotaManagementList, &kafkaConfig)
+			quotaService, _ := factory.GetQuotaService(api.QuotaManagementListQuotaType)
+			kafka := &dbapi.KafkaRequest{
+				Meta:           api.Meta{ID: "kafka-id"},
+				Owner:          "username",
+				OrganisationId: "org-id",
+				SizeId:         "x1",
+				InstanceType:   types.STANDARD.String(),
+			}
+			_, err := quotaService.UpdateQuota(kafka, "x2")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(kafka.SizeId).To(gomega.Equal("x1"))
+		})
+	}
+}
+
 func Test_DefaultQuotaServiceFactory_GetQuotaService(t *testing.T) {
 	type fields struct {
 		QuotaServiceContainer map[api.QuotaType]services.QuotaService
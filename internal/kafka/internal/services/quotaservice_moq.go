@@ -39,6 +39,9 @@ var _ QuotaService = &QuotaServiceMock{}
 //			ReserveQuotaIfNotAlreadyReservedFunc: func(kafka *dbapi.KafkaRequest) (string, *serviceError.ServiceError) {
 //				panic("mock out the ReserveQuotaIfNotAlreadyReserved method")
 //			},
+//			UpdateQuotaFunc: func(kafka *dbapi.KafkaRequest, sizeId string) (string, *serviceError.ServiceError) {
+//				panic("mock out the UpdateQuota method")
+//			},
 //			ValidateBillingAccountFunc: func(organisationId string, instanceType kafkaTypes.KafkaInstanceType, billingModelID string, billingCloudAccountId string, marketplace *string) *serviceError.ServiceError {
 //				panic("mock out the ValidateBillingAccount method")
 //			},
@@ -67,6 +70,9 @@ type QuotaServiceMock struct {
 	// ReserveQuotaIfNotAlreadyReservedFunc mocks the ReserveQuotaIfNotAlreadyReserved method.
 	ReserveQuotaIfNotAlreadyReservedFunc func(kafka *dbapi.KafkaRequest) (string, *serviceError.ServiceError)
 
+	// UpdateQuotaFunc mocks the UpdateQuota method.
+	UpdateQuotaFunc func(kafka *dbapi.KafkaRequest, sizeId string) (string, *serviceError.ServiceError)
+
 	// ValidateBillingAccountFunc mocks the ValidateBillingAccount method.
 	ValidateBillingAccountFunc func(organisationId string, instanceType kafkaTypes.KafkaInstanceType, billingModelID string, billingCloudAccountId string, marketplace *string) *serviceError.ServiceError
 
@@ -110,6 +116,13 @@ type QuotaServiceMock struct {
 			// Kafka is the kafka argument value.
 			Kafka *dbapi.KafkaRequest
 		}
+		// UpdateQuota holds details about calls to the UpdateQuota method.
+		UpdateQuota []struct {
+			// Kafka is the kafka argument value.
+			Kafka *dbapi.KafkaRequest
+			// SizeId is the sizeId argument value.
+			SizeId string
+		}
 		// ValidateBillingAccount holds details about calls to the ValidateBillingAccount method.
 		ValidateBillingAccount []struct {
 			// OrganisationId is the organisationId argument value.
@@ -130,6 +143,7 @@ type QuotaServiceMock struct {
 	lockIsQuotaEntitlementActive             sync.RWMutex
 	lockReserveQuota                         sync.RWMutex
 	lockReserveQuotaIfNotAlreadyReserved     sync.RWMutex
+	lockUpdateQuota                          sync.RWMutex
 	lockValidateBillingAccount               sync.RWMutex
 }
 
@@ -341,6 +355,42 @@ func (mock *QuotaServiceMock) ReserveQuotaIfNotAlreadyReservedCalls() []struct {
 	return calls
 }
 
+// UpdateQuota calls UpdateQuotaFunc.
+func (mock *QuotaServiceMock) UpdateQuota(kafka *dbapi.KafkaRequest, sizeId string) (string, *serviceError.ServiceError) {
+	if mock.UpdateQuotaFunc == nil {
+		panic("QuotaServiceMock.UpdateQuotaFunc: method is nil but QuotaService.UpdateQuota was just called")
+	}
+	callInfo := struct {
+		Kafka  *dbapi.KafkaRequest
+		SizeId string
+	}{
+		Kafka:  kafka,
+		SizeId: sizeId,
+	}
+	mock.lockUpdateQuota.Lock()
+	mock.calls.UpdateQuota = append(mock.calls.UpdateQuota, callInfo)
+	mock.lockUpdateQuota.Unlock()
+	return mock.UpdateQuotaFunc(kafka, sizeId)
+}
+
+// UpdateQuotaCalls gets all the calls that were made to UpdateQuota.
+// Check the length with:
+//
+//	len(mockedQuotaService.UpdateQuotaCalls())
+func (mock *QuotaServiceMock) UpdateQuotaCalls() []struct {
+	Kafka  *dbapi.KafkaRequest
+	SizeId string
+} {
+	var calls []struct {
+		Kafka  *dbapi.KafkaRequest
+		SizeId string
+	}
+	mock.lockUpdateQuota.RLock()
+	calls = mock.calls.UpdateQuota
+	mock.lockUpdateQuota.RUnlock()
+	return calls
+}
+
 // ValidateBillingAccount calls ValidateBillingAccountFunc.
 func (mock *QuotaServiceMock) ValidateBillingAccount(organisationId string, instanceType kafkaTypes.KafkaInstanceType, billingModelID string, billingCloudAccountId string, marketplace *string) *serviceError.ServiceError {
 	if mock.ValidateBillingAccountFunc == nil {
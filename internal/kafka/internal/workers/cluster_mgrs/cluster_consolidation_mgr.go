@@ -0,0 +1,112 @@
+package cluster_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	clusterConsolidationWorkerType = "cluster_consolidation"
+)
+
+// ClusterConsolidationManager periodically starts the migrations emptying the underutilised data plane clusters, so that
+// they are removed by the DynamicScaleDownManager once their kafkas are served by the other clusters of their region.
+// The clusters being emptied are cordoned so that no new kafka is placed on them.
+type ClusterConsolidationManager struct {
+	workers.BaseWorker
+
+	dataplaneClusterConfig      *config.DataplaneClusterConfig
+	clusterService              services.ClusterService
+	clusterConsolidationService services.ClusterConsolidationService
+	kafkaMigrationService       services.KafkaMigrationService
+}
+
+var _ workers.Worker = &ClusterConsolidationManager{}
+
+func NewClusterConsolidationManager(
+	reconciler workers.Reconciler,
+	dataplaneClusterConfig *config.DataplaneClusterConfig,
+	clusterService services.ClusterService,
+	clusterConsolidationService services.ClusterConsolidationService,
+	kafkaMigrationService services.KafkaMigrationService,
+) *ClusterConsolidationManager {
+	return &ClusterConsolidationManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: clusterConsolidationWorkerType,
+			Reconciler: reconciler,
+		},
+
+		dataplaneClusterConfig:      dataplaneClusterConfig,
+		clusterService:              clusterService,
+		clusterConsolidationService: clusterConsolidationService,
+		kafkaMigrationService:       kafkaMigrationService,
+	}
+}
+
+func (m *ClusterConsolidationManager) Start() {
+	m.StartWorker(m)
+}
+
+func (m *ClusterConsolidationManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *ClusterConsolidationManager) Reconcile() []error {
+	if !m.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
+		glog.Infoln("dynamic scaling is disabled. Cluster consolidation reconcile event skipped")
+		return nil
+	}
+	if !m.dataplaneClusterConfig.GetDynamicScalingConfig().Consolidation.IsConsolidationEnabled() {
+		glog.Infoln("cluster consolidation is disabled. Cluster consolidation reconcile event skipped")
+		return nil
+	}
+
+	glog.Infoln("running cluster consolidation reconcile event")
+	consolidations, err := m.clusterConsolidationService.Plan()
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to plan the consolidation of the data plane clusters")}
+	}
+
+	var encounteredErrors []error
+	for _, consolidation := range consolidations {
+		if !consolidation.CanBeEmptied() {
+			glog.Infof("underutilised cluster %q cannot be emptied: %s", consolidation.ClusterID, consolidation.Reason)
+			continue
+		}
+
+		glog.Infof("emptying underutilised cluster %q: %d/%d streaming units consumed", consolidation.ClusterID,
+			consolidation.ConsumedStreamingUnits, consolidation.MaxStreamingUnits)
+		encounteredErrors = append(encounteredErrors, m.emptyCluster(consolidation)...)
+	}
+
+	glog.Infoln("cluster consolidation reconcile event finished")
+	return encounteredErrors
+}
+
+// emptyCluster cordons the cluster, so that the new kafkas are not placed on the cluster being emptied, and starts the moves
+// of its kafkas. The cluster is uncordoned when one of its kafkas cannot be moved, so that it is planned again.
+func (m *ClusterConsolidationManager) emptyCluster(consolidation services.ClusterConsolidation) []error {
+	if err := m.clusterService.UpdateCordoned(consolidation.ClusterID, true); err != nil {
+		return []error{errors.Wrapf(err, "failed to cordon cluster %q before emptying it", consolidation.ClusterID)}
+	}
+
+	var encounteredErrors []error
+	for _, move := range consolidation.Moves {
+		if _, err := m.kafkaMigrationService.Move(move.Kafka.ID, move.TargetClusterID); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to move kafka %q from cluster %q to cluster %q",
+				move.Kafka.ID, consolidation.ClusterID, move.TargetClusterID))
+		}
+	}
+
+	if len(encounteredErrors) > 0 {
+		if err := m.clusterService.UpdateCordoned(consolidation.ClusterID, false); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to uncordon cluster %q that could not be emptied", consolidation.ClusterID))
+		}
+	}
+	return encounteredErrors
+}
@@ -0,0 +1,132 @@
+package cluster_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+func Test_ClusterConsolidationManager_Reconcile(t *testing.T) {
+	consolidations := []services.ClusterConsolidation{
+		{
+			ClusterID: "cluster-1",
+			Moves: []services.ClusterConsolidationMove{
+				{Kafka: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}}, TargetClusterID: "cluster-2"},
+				{Kafka: &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-2"}}, TargetClusterID: "cluster-2"},
+			},
+		},
+		{
+			ClusterID: "cluster-3",
+			Moves:     []services.ClusterConsolidationMove{},
+			Reason:    "no other cluster of the region can host kafka \"kafka-3\"",
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		scalingType          string
+		consolidationEnabled bool
+		planErr              *apiErrors.ServiceError
+		moveErr              *apiErrors.ServiceError
+		cordonErr            *apiErrors.ServiceError
+		wantErrCount         int
+		wantPlanCalls        int
+		wantMoveCalls        int
+		wantCordoned         []bool
+	}{
+		{
+			name:                 "should not plan the consolidation when the dynamic scaling is disabled",
+			scalingType:          config.ManualScaling,
+			consolidationEnabled: true,
+		},
+		{
+			name:        "should not plan the consolidation when it is disabled",
+			scalingType: config.AutoScaling,
+		},
+		{
+			name:                 "should cordon the clusters that can be emptied before moving their kafkas",
+			scalingType:          config.AutoScaling,
+			consolidationEnabled: true,
+			wantPlanCalls:        1,
+			wantMoveCalls:        2,
+			wantCordoned:         []bool{true},
+		},
+		{
+			name:                 "should not move the kafkas of a cluster that cannot be cordoned",
+			scalingType:          config.AutoScaling,
+			consolidationEnabled: true,
+			cordonErr:            apiErrors.GeneralError("failed to cordon"),
+			wantErrCount:         1,
+			wantPlanCalls:        1,
+			wantCordoned:         []bool{true},
+		},
+		{
+			name:                 "should return an error when the consolidation cannot be planned",
+			scalingType:          config.AutoScaling,
+			consolidationEnabled: true,
+			planErr:              apiErrors.GeneralError("failed to plan"),
+			wantErrCount:         1,
+			wantPlanCalls:        1,
+		},
+		{
+			name:                 "should return an error for each kafka that cannot be moved",
+			scalingType:          config.AutoScaling,
+			consolidationEnabled: true,
+			moveErr:              apiErrors.BadRequest("cluster \"cluster-2\" cannot host the kafka"),
+			wantErrCount:         2,
+			wantPlanCalls:        1,
+			wantMoveCalls:        2,
+			wantCordoned:         []bool{true, false},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dataplaneClusterConfig := &config.DataplaneClusterConfig{
+				DataPlaneClusterScalingType: tt.scalingType,
+				DynamicScalingConfig:        config.NewDynamicScalingConfig(),
+			}
+			dataplaneClusterConfig.DynamicScalingConfig.Consolidation.Enabled = tt.consolidationEnabled
+			consolidationService := &services.ClusterConsolidationServiceMock{
+				PlanFunc: func() ([]services.ClusterConsolidation, *apiErrors.ServiceError) {
+					if tt.planErr != nil {
+						return nil, tt.planErr
+					}
+					return consolidations, nil
+				},
+			}
+			migrationService := &services.KafkaMigrationServiceMock{
+				MoveFunc: func(kafkaID string, targetClusterID string) (*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+					g.Expect(targetClusterID).To(gomega.Equal("cluster-2"))
+					return &dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaID}}, tt.moveErr
+				},
+			}
+
+			var cordoned []bool
+			clusterService := &services.ClusterServiceMock{
+				UpdateCordonedFunc: func(clusterID string, c bool) *apiErrors.ServiceError {
+					g.Expect(clusterID).To(gomega.Equal("cluster-1"))
+					// the cluster is cordoned before any of its kafkas is moved
+					g.Expect(migrationService.MoveCalls()).To(gomega.HaveLen(len(cordoned) * len(consolidations[0].Moves)))
+					cordoned = append(cordoned, c)
+					return tt.cordonErr
+				},
+			}
+
+			m := NewClusterConsolidationManager(workers.Reconciler{}, dataplaneClusterConfig, clusterService, consolidationService, migrationService)
+			g.Expect(m.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(consolidationService.PlanCalls()).To(gomega.HaveLen(tt.wantPlanCalls))
+			g.Expect(migrationService.MoveCalls()).To(gomega.HaveLen(tt.wantMoveCalls))
+			g.Expect(cordoned).To(gomega.Equal(tt.wantCordoned))
+		})
+	}
+}
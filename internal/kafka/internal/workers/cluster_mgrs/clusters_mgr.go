@@ -1,6 +1,7 @@
 package cluster_mgrs
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
@@ -433,7 +434,7 @@ func (c *ClusterManager) reconcileClusterInstanceType(cluster api.Cluster) error
 	supportedInstanceType := api.AllInstanceTypeSupport.String()
 	manualScalingEnabled := c.DataplaneClusterConfig.IsDataPlaneManualScalingEnabled()
 	if manualScalingEnabled {
-		supportedType, found := c.DataplaneClusterConfig.ClusterConfig.GetClusterSupportedInstanceType(cluster.ClusterID)
+		supportedType, found := c.DataplaneClusterConfig.GetClusterConfig().GetClusterSupportedInstanceType(cluster.ClusterID)
 		if !found && cluster.SupportedInstanceType != "" {
 			logger.Logger.Infof("cluster instance type already set for cluster = %s", cluster.ClusterID)
 			return nil
@@ -690,7 +691,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	}
 
 	//Create all missing clusters
-	for _, p := range c.DataplaneClusterConfig.ClusterConfig.MissingClusters(clusterIdsMap) {
+	for _, p := range c.DataplaneClusterConfig.GetClusterConfig().MissingClusters(clusterIdsMap) {
 		clusterRequest := api.Cluster{
 			CloudProvider:                 p.CloudProvider,
 			Region:                        p.Region,
@@ -703,6 +704,13 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 			AccessKafkasViaPrivateNetwork: false,
 			ClusterType:                   api.ManagedDataPlaneClusterType.String(),
 		}
+		if p.ProviderType == api.ClusterProviderKubernetes {
+			providerSpec, err := json.Marshal(clusters.KubernetesProviderSpec{Kubeconfig: p.Kubeconfig})
+			if err != nil {
+				return []error{errors.Wrapf(err, "failed to marshal the provider spec of cluster %s", p.ClusterId)}
+			}
+			clusterRequest.ProviderSpec = providerSpec
+		}
 		if err := c.ClusterService.RegisterClusterJob(&clusterRequest); err != nil {
 			return []error{errors.Wrapf(err, "failed to register new cluster %s with config file", p.ClusterId)}
 		} else {
@@ -711,7 +719,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	}
 
 	// Remove all clusters that are not in the config file.
-	excessClusterIds := c.DataplaneClusterConfig.ClusterConfig.ExcessClusters(clusterIdsMap)
+	excessClusterIds := c.DataplaneClusterConfig.GetClusterConfig().ExcessClusters(clusterIdsMap)
 	if len(excessClusterIds) == 0 {
 		return nil
 	}
@@ -789,9 +797,9 @@ func (c *ClusterManager) buildResourceSet(cluster api.Cluster) types.ResourceSet
 		kasFleetshardNamespace = kasFleetshardQEAddonNamespace
 	}
 
-	// For standalone clusters, make sure that the namespaces is read from the config
+	// For standalone and kubernetes clusters, make sure that the namespaces is read from the config
 	// and that they are created before the pull secrets that references them
-	if cluster.ProviderType == api.ClusterProviderStandalone {
+	if cluster.ProviderType == api.ClusterProviderStandalone || cluster.ProviderType == api.ClusterProviderKubernetes {
 		strimziNamespace = c.DataplaneClusterConfig.StrimziOperatorOLMConfig.Namespace
 		kasFleetshardNamespace = c.DataplaneClusterConfig.KasFleetshardOperatorOLMConfig.Namespace
 		r = append(r, &k8sCoreV1.Namespace{
@@ -2910,6 +2910,8 @@ func TestClusterManager_reconcileClusterWithManualConfig(t *testing.T) {
 	}
 	testOsdConfig := config.NewDataplaneClusterConfig()
 	testOsdConfig.ClusterConfig = config.NewClusterConfig(config.ClusterList{config.ManualCluster{Schedulable: true, KafkaInstanceLimit: 2}})
+	testKubernetesConfig := config.NewDataplaneClusterConfig()
+	testKubernetesConfig.ClusterConfig = config.NewClusterConfig(config.ClusterList{config.ManualCluster{ClusterId: "test01", ProviderType: api.ClusterProviderKubernetes, Kubeconfig: "kubeconfig-content"}})
 	tests := []struct {
 		name    string
 		fields  fields
@@ -2964,6 +2966,24 @@ func TestClusterManager_reconcileClusterWithManualConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Successfully stores the kubeconfig in the provider spec of a manually configured kubernetes Cluster",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					ListNonEnterpriseClusterIDsFunc: func() ([]api.Cluster, *apiErrors.ServiceError) {
+						return []api.Cluster{}, nil
+					},
+					RegisterClusterJobFunc: func(clusterReq *api.Cluster) *apiErrors.ServiceError {
+						if string(clusterReq.ProviderSpec) != `{"kubeconfig":"kubeconfig-content"}` {
+							return apiErrors.GeneralError("unexpected provider spec %s", clusterReq.ProviderSpec)
+						}
+						return nil
+					},
+				},
+				DataplaneClusterConfig: testKubernetesConfig,
+			},
+			wantErr: false,
+		},
 		{
 			name: "Should fail if UpdateMultiClusterStatus fails on clusters to deprovision",
 			fields: fields{
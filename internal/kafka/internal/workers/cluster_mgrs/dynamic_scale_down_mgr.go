@@ -104,9 +104,9 @@ func (m *DynamicScaleDownManager) processDynamicScaleDownReconcileEvent() error
 		var dynamicScaleDownProcessor dynamicScaleDownProcessor = &standardDynamicScaleDownProcessor{
 			kafkaStreamingUnitCountPerClusterList:  kafkaStreamingUnitCountPerClusterList,
 			regionsSupportedInstanceType:           regionsSupportedInstanceType,
-			supportedKafkaInstanceTypesConfig:      &m.kafkaConfig.SupportedInstanceTypes.Configuration,
+			supportedKafkaInstanceTypesConfig:      m.kafkaConfig.GetSupportedInstanceTypes(),
 			clusterService:                         m.clusterService,
-			dryRun:                                 !m.dataplaneClusterConfig.DynamicScalingConfig.IsDataplaneScaleDownTriggerEnabled(),
+			dryRun:                                 !m.dataplaneClusterConfig.GetDynamicScalingConfig().IsDataplaneScaleDownTriggerEnabled(),
 			clusterID:                              clusterID,
 			indexesOfStreamingUnitForSameClusterID: existing.indexesOfStreamingUnitForSameClusterID,
 		}
@@ -1,9 +1,12 @@
 package cluster_mgrs
 
 import (
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	fleeterrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 	"github.com/golang/glog"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -23,7 +26,8 @@ type DynamicScaleUpManager struct {
 	ClusterProvidersConfig *config.ProviderConfig
 	KafkaConfig            *config.KafkaConfig
 
-	ClusterService services.ClusterService
+	ClusterService                  services.ClusterService
+	DataPlaneScaleUpForecastService services.DataPlaneScaleUpForecastService
 }
 
 var _ workers.Worker = &DynamicScaleUpManager{}
@@ -34,6 +38,7 @@ func NewDynamicScaleUpManager(
 	clusterProvidersConfig *config.ProviderConfig,
 	kafkaConfig *config.KafkaConfig,
 	clusterService services.ClusterService,
+	dataPlaneScaleUpForecastService services.DataPlaneScaleUpForecastService,
 ) *DynamicScaleUpManager {
 
 	return &DynamicScaleUpManager{
@@ -47,7 +52,8 @@ func NewDynamicScaleUpManager(
 		ClusterProvidersConfig: clusterProvidersConfig,
 		KafkaConfig:            kafkaConfig,
 
-		ClusterService: clusterService,
+		ClusterService:                  clusterService,
+		DataPlaneScaleUpForecastService: dataPlaneScaleUpForecastService,
 	}
 }
 
@@ -87,6 +93,12 @@ func (m *DynamicScaleUpManager) processDynamicScaleUpReconcileEvent() error {
 		return errList
 	}
 
+	scaleUpForecasts, err := m.forecastScaleUps()
+	if err != nil {
+		// the forecast is best effort. The scale up on the current capacity is still evaluated
+		errList.AddErrors(err)
+	}
+
 	for _, provider := range m.ClusterProvidersConfig.ProvidersConfig.SupportedProviders {
 		for _, region := range provider.Regions {
 			for supportedInstanceTypeName := range region.SupportedInstanceTypes {
@@ -101,9 +113,9 @@ func (m *DynamicScaleUpManager) processDynamicScaleUpReconcileEvent() error {
 					locator:                               currLocator,
 					instanceTypeConfig:                    &supportedInstanceTypeConfig,
 					kafkaStreamingUnitCountPerClusterList: kafkaStreamingUnitCountPerClusterList,
-					supportedKafkaInstanceTypesConfig:     &m.KafkaConfig.SupportedInstanceTypes.Configuration,
+					supportedKafkaInstanceTypesConfig:     m.KafkaConfig.GetSupportedInstanceTypes(),
 					clusterService:                        m.ClusterService,
-					dryRun:                                !m.DataplaneClusterConfig.DynamicScalingConfig.IsDataplaneScaleUpTriggerEnabled(),
+					dryRun:                                !m.DataplaneClusterConfig.GetDynamicScalingConfig().IsDataplaneScaleUpTriggerEnabled(),
 				}
 				glog.Infof("evaluating dynamic scale up for locator '%+v'", currLocator)
 				shouldScaleUp, err := dynamicScaleUpProcessor.ShouldScaleUp()
@@ -111,6 +123,11 @@ func (m *DynamicScaleUpManager) processDynamicScaleUpReconcileEvent() error {
 					errList.AddErrors(err)
 					continue
 				}
+				if forecast, ok := scaleUpForecasts[currLocator]; !shouldScaleUp && ok && forecast.ScaleUpNeeded {
+					glog.Infof("predicted demand of '%d' streaming units for locator '%+v' exceeds the free capacity of '%d' streaming units minus the capacity slack. Cluster scale up action should be performed",
+						forecast.PredictedDemandStreamingUnits(), currLocator, forecast.FreeStreamingUnits)
+					shouldScaleUp = true
+				}
 				if shouldScaleUp {
 					glog.Infof("data plane scale up need detected for locator '%+v'", currLocator)
 					err := dynamicScaleUpProcessor.ScaleUp()
@@ -130,6 +147,35 @@ func (m *DynamicScaleUpManager) processDynamicScaleUpReconcileEvent() error {
 	return errList
 }
 
+// forecastScaleUps returns the scale up forecasts indexed by locator when the predictive scale up is enabled, and
+// exposes them as metrics
+func (m *DynamicScaleUpManager) forecastScaleUps() (map[supportedInstanceTypeLocator]services.DataPlaneScaleUpForecast, error) {
+	scaleUpForecasts := map[supportedInstanceTypeLocator]services.DataPlaneScaleUpForecast{}
+	if !m.DataplaneClusterConfig.GetDynamicScalingConfig().PredictiveScaleUp.IsPredictiveScaleUpEnabled() {
+		return scaleUpForecasts, nil
+	}
+
+	forecasts, err := m.DataPlaneScaleUpForecastService.Forecast(time.Now())
+	if err != nil {
+		return scaleUpForecasts, err
+	}
+
+	for _, forecast := range forecasts {
+		metrics.UpdatePredictiveScaleUpDemandMetric(forecast.CloudProvider, forecast.Region, forecast.InstanceType, metrics.PredictiveScaleUpDemandSourceHistorical, forecast.HistoricalDemandStreamingUnits)
+		metrics.UpdatePredictiveScaleUpDemandMetric(forecast.CloudProvider, forecast.Region, forecast.InstanceType, metrics.PredictiveScaleUpDemandSourceScheduled, forecast.ScheduledDemandStreamingUnits)
+		metrics.UpdatePredictiveScaleUpNeededMetric(forecast.CloudProvider, forecast.Region, forecast.InstanceType, forecast.ScaleUpNeeded)
+
+		scaleUpForecasts[supportedInstanceTypeLocator{
+			provider:         forecast.CloudProvider,
+			region:           forecast.Region,
+			instanceTypeName: forecast.InstanceType,
+			clusterType:      api.ManagedDataPlaneClusterType.String(),
+		}] = forecast
+	}
+
+	return scaleUpForecasts, nil
+}
+
 // supportedInstanceTypeLocator is a data structure
 // that contains all the information to help locate a
 // supported instance type in a region's cluster of a given cluster type
@@ -377,6 +423,8 @@ type instanceTypeConsumptionSummaryCalculator struct {
 // For the calculation of the max streaming units capacity:
 //   - Clusters in deprovisioning and cleanup state are excluded, as
 //     clusters into those states don't accept kafka instances anymore.
+//   - Cordoned clusters only contribute the streaming units they consume, as
+//     they don't accept new kafka instances until they are uncordoned.
 //   - Clusters that are still not ready to accept kafka instance but that
 //     should eventually accept them (like accepted state for example)
 //     are included
@@ -427,11 +475,17 @@ func (i *instanceTypeConsumptionSummaryCalculator) Calculate() (instanceTypeCons
 			continue
 		}
 
+		consumedStreamingUnitsInRegion = consumedStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
+		// the free streaming units of a cordoned cluster can't be used by new kafka instances
+		if kafkaStreamingUnitCountPerCluster.Cordoned {
+			maxStreamingUnitsInRegion = maxStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
+			continue
+		}
+
 		if kafkaStreamingUnitCountPerCluster.FreeStreamingUnits() >= int32(biggestKafkaInstanceSizeCapacityConsumption) {
 			atLeastOneClusterHasCapacityForBiggestInstanceType = true
 		}
 
-		consumedStreamingUnitsInRegion = consumedStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
 		maxStreamingUnitsInRegion = maxStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.MaxUnits)
 	}
 
@@ -2,10 +2,12 @@ package cluster_mgrs
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/onsi/gomega"
 
 	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -27,6 +29,95 @@ func Test_noopDynamicScaleUpProcessor_ScaleUp(t *testing.T) {
 	g.Expect(err).NotTo(gomega.HaveOccurred())
 }
 
+func Test_DynamicScaleUpManager_processDynamicScaleUpReconcileEvent(t *testing.T) {
+	locator := newTestHelperBaseSupportedInstanceTypeLocator()
+	forecast := services.DataPlaneScaleUpForecast{
+		CloudProvider:                 locator.provider,
+		Region:                        locator.region,
+		InstanceType:                  locator.instanceTypeName,
+		FreeStreamingUnits:            9,
+		ScheduledDemandStreamingUnits: 12,
+		ScaleUpNeeded:                 true,
+	}
+
+	tests := []struct {
+		name                   string
+		predictiveScaleUp      bool
+		forecastErr            *apiErrors.ServiceError
+		wantErr                bool
+		wantForecastCalls      int
+		wantRegisterClusterJob int
+	}{
+		{
+			name:                   "should not create a cluster ahead of the demand when the predictive scale up is disabled",
+			predictiveScaleUp:      false,
+			wantForecastCalls:      0,
+			wantRegisterClusterJob: 0,
+		},
+		{
+			name:                   "should create a cluster ahead of the demand when the forecast needs a scale up",
+			predictiveScaleUp:      true,
+			wantForecastCalls:      1,
+			wantRegisterClusterJob: 1,
+		},
+		{
+			name:                   "should return an error when the demand cannot be forecast",
+			predictiveScaleUp:      true,
+			forecastErr:            apiErrors.GeneralError("failed to forecast"),
+			wantErr:                true,
+			wantForecastCalls:      1,
+			wantRegisterClusterJob: 0,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dataplaneClusterConfig := &config.DataplaneClusterConfig{DynamicScalingConfig: config.NewDynamicScalingConfig()}
+			dataplaneClusterConfig.DynamicScalingConfig.PredictiveScaleUp.Enabled = tt.predictiveScaleUp
+			providerConfig := &config.ProviderConfig{
+				ProvidersConfig: config.ProviderConfiguration{
+					SupportedProviders: config.ProviderList{
+						{Name: locator.provider, Regions: config.RegionList{{Name: locator.region, SupportedInstanceTypes: config.InstanceTypeMap{locator.instanceTypeName: {}}}}},
+					},
+				},
+			}
+			kafkaConfig := &config.KafkaConfig{
+				SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{Configuration: *newTestHelperBaseSupportedKafkaInstanceTypesConfig()},
+			}
+			clusterService := &services.ClusterServiceMock{
+				FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
+					// enough capacity for the current demand
+					return services.KafkaStreamingUnitCountPerClusterList{
+						{CloudProvider: locator.provider, Region: locator.region, InstanceType: locator.instanceTypeName, Count: 1, MaxUnits: 10,
+							Status: api.ClusterReady.String(), ClusterType: api.ManagedDataPlaneClusterType.String()},
+					}, nil
+				},
+				RegisterClusterJobFunc: func(clusterRequest *api.Cluster) *apiErrors.ServiceError {
+					g.Expect(clusterRequest.Region).To(gomega.Equal(locator.region))
+					g.Expect(clusterRequest.SupportedInstanceType).To(gomega.Equal(locator.instanceTypeName))
+					return nil
+				},
+			}
+			forecastService := &services.DataPlaneScaleUpForecastServiceMock{
+				ForecastFunc: func(now time.Time) ([]services.DataPlaneScaleUpForecast, *apiErrors.ServiceError) {
+					if tt.forecastErr != nil {
+						return nil, tt.forecastErr
+					}
+					return []services.DataPlaneScaleUpForecast{forecast}, nil
+				},
+			}
+
+			m := NewDynamicScaleUpManager(workers.Reconciler{}, dataplaneClusterConfig, providerConfig, kafkaConfig, clusterService, forecastService)
+			err := m.processDynamicScaleUpReconcileEvent()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(forecastService.ForecastCalls()).To(gomega.HaveLen(tt.wantForecastCalls))
+			g.Expect(clusterService.RegisterClusterJobCalls()).To(gomega.HaveLen(tt.wantRegisterClusterJob))
+		})
+	}
+}
+
 func Test_standardDynamicScaleUpProcessor_ShouldScaleUp(t *testing.T) {
 	type fields struct {
 		locator                                      supportedInstanceTypeLocator
@@ -1267,6 +1358,39 @@ func Test_instanceTypeConsumptionSummaryCalculator_Calculate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "When one of the clusters that match the locator is cordoned only its consumed units are taken into account",
+			fields: fields{
+				locator: newTestHelperBaseSupportedInstanceTypeLocator(),
+				kafkaStreamingUnitCountPerClusterListFactory: func() services.KafkaStreamingUnitCountPerClusterList {
+					res := []services.KafkaStreamingUnitCountPerCluster(newTestHelperBaseKafkaStreamingUnitCountPerClusterList())
+					locator := newTestHelperBaseSupportedInstanceTypeLocator()
+					cordonedClusterInfo := services.KafkaStreamingUnitCountPerCluster{
+						CloudProvider: locator.provider,
+						Region:        locator.region,
+						InstanceType:  locator.instanceTypeName,
+						Count:         2,
+						MaxUnits:      30,
+						Status:        api.ClusterReady.String(),
+						ClusterType:   locator.clusterType,
+						Cordoned:      true,
+					}
+					res = append(res, cordonedClusterInfo)
+					return res
+				},
+				supportedKafkaInstanceTypesConfigFactory: func() *config.SupportedKafkaInstanceTypesConfig {
+					return newTestHelperBaseSupportedKafkaInstanceTypesConfig()
+				},
+			},
+			want: instanceTypeConsumptionSummary{
+				maxStreamingUnits:                    10,
+				freeStreamingUnits:                   3,
+				consumedStreamingUnits:               7,
+				ongoingScaleUpAction:                 false,
+				biggestInstanceSizeCapacityAvailable: true,
+			},
+			wantErr: false,
+		},
 		{
 			name: "When the provided locator's instance type is not found in the supported providers configuration an error is returned",
 			fields: fields{
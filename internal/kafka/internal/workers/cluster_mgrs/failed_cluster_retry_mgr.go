@@ -0,0 +1,114 @@
+package cluster_mgrs
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	failedClusterRetryWorkerType = "failed_cluster_retry"
+)
+
+// FailedClusterRetryManager periodically replaces the data plane clusters that failed to be created once their backoff
+// has elapsed. The failed clusters are deprovisioned and their replacements are created by the ClusterManager.
+// A cluster failing once its retries are exhausted is deprovisioned without being replaced.
+type FailedClusterRetryManager struct {
+	workers.BaseWorker
+
+	dataplaneClusterConfig      *config.DataplaneClusterConfig
+	clusterService              services.ClusterService
+	clusterCreationRetryService services.ClusterCreationRetryService
+}
+
+var _ workers.Worker = &FailedClusterRetryManager{}
+
+func NewFailedClusterRetryManager(
+	reconciler workers.Reconciler,
+	dataplaneClusterConfig *config.DataplaneClusterConfig,
+	clusterService services.ClusterService,
+	clusterCreationRetryService services.ClusterCreationRetryService,
+) *FailedClusterRetryManager {
+	return &FailedClusterRetryManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: failedClusterRetryWorkerType,
+			Reconciler: reconciler,
+		},
+
+		dataplaneClusterConfig:      dataplaneClusterConfig,
+		clusterService:              clusterService,
+		clusterCreationRetryService: clusterCreationRetryService,
+	}
+}
+
+func (m *FailedClusterRetryManager) Start() {
+	m.StartWorker(m)
+}
+
+func (m *FailedClusterRetryManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *FailedClusterRetryManager) Reconcile() []error {
+	if !m.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
+		glog.Infoln("dynamic scaling is disabled. Failed cluster retry reconcile event skipped")
+		return nil
+	}
+	retryConfig := m.dataplaneClusterConfig.GetDynamicScalingConfig().FailedClusterRetry
+	if !retryConfig.IsFailedClusterRetryEnabled() {
+		glog.Infoln("failed cluster retry is disabled. Failed cluster retry reconcile event skipped")
+		return nil
+	}
+
+	glog.Infoln("running failed cluster retry reconcile event")
+	failedClusters, err := m.clusterService.ListByStatus(api.ClusterFailed)
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to list failed clusters")}
+	}
+
+	var encounteredErrors []error
+	for i := range failedClusters {
+		cluster := &failedClusters[i]
+		// enterprise clusters are not created by their provider
+		if cluster.ClusterType == api.EnterpriseDataPlaneClusterType.String() {
+			continue
+		}
+
+		attempt, err := m.clusterCreationRetryService.RecordFailure(cluster)
+		if err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to record the failed creation of cluster %q", cluster.ID))
+			continue
+		}
+
+		if attempt.Attempt > retryConfig.MaxRetries {
+			glog.Infof("deprovisioning failed cluster %q: the %d retries are exhausted", cluster.ID, retryConfig.MaxRetries)
+			if err := m.clusterCreationRetryService.GiveUp(cluster); err != nil {
+				encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to deprovision failed cluster %q", cluster.ID))
+			}
+			continue
+		}
+
+		retryTime := attempt.CreatedAt.Add(retryConfig.Backoff(attempt.Attempt))
+		if time.Now().Before(retryTime) {
+			glog.V(10).Infof("failed cluster %q is retried after %s", cluster.ID, retryTime)
+			continue
+		}
+
+		newCluster, err := m.clusterCreationRetryService.Retry(cluster)
+		if err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to retry failed cluster %q", cluster.ID))
+			continue
+		}
+		glog.Infof("failed cluster %q replaced by cluster %q (retry %d/%d)", cluster.ID, newCluster.ID, attempt.Attempt, retryConfig.MaxRetries)
+	}
+
+	glog.Infoln("failed cluster retry reconcile event finished")
+	return encounteredErrors
+}
@@ -0,0 +1,139 @@
+package cluster_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+func Test_FailedClusterRetryManager_Reconcile(t *testing.T) {
+	tests := []struct {
+		name            string
+		scalingType     string
+		retryEnabled    bool
+		clusterType     string
+		attempt         int
+		failedFor       time.Duration
+		retryErr        *apiErrors.ServiceError
+		wantErrCount    int
+		wantListCalls   int
+		wantRecordCalls int
+		wantRetryCalls  int
+		wantGiveUpCalls int
+	}{
+		{
+			name:         "should not retry the failed clusters when the dynamic scaling is disabled",
+			scalingType:  config.ManualScaling,
+			retryEnabled: true,
+		},
+		{
+			name:        "should not retry the failed clusters when the retry is disabled",
+			scalingType: config.AutoScaling,
+		},
+		{
+			name:            "should not retry a failed cluster before its backoff has elapsed",
+			scalingType:     config.AutoScaling,
+			retryEnabled:    true,
+			attempt:         2,
+			failedFor:       15 * time.Minute,
+			wantListCalls:   1,
+			wantRecordCalls: 1,
+		},
+		{
+			name:            "should retry a failed cluster once its backoff has elapsed",
+			scalingType:     config.AutoScaling,
+			retryEnabled:    true,
+			attempt:         2,
+			failedFor:       25 * time.Minute,
+			wantListCalls:   1,
+			wantRecordCalls: 1,
+			wantRetryCalls:  1,
+		},
+		{
+			name:            "should deprovision a failed cluster once its retries are exhausted",
+			scalingType:     config.AutoScaling,
+			retryEnabled:    true,
+			attempt:         4,
+			wantListCalls:   1,
+			wantRecordCalls: 1,
+			wantGiveUpCalls: 1,
+		},
+		{
+			name:          "should not retry a failed enterprise cluster",
+			scalingType:   config.AutoScaling,
+			retryEnabled:  true,
+			clusterType:   api.EnterpriseDataPlaneClusterType.String(),
+			attempt:       1,
+			failedFor:     time.Hour,
+			wantListCalls: 1,
+		},
+		{
+			name:            "should return an error when the failed cluster cannot be retried",
+			scalingType:     config.AutoScaling,
+			retryEnabled:    true,
+			attempt:         1,
+			failedFor:       time.Hour,
+			retryErr:        apiErrors.GeneralError("failed to retry"),
+			wantErrCount:    1,
+			wantListCalls:   1,
+			wantRecordCalls: 1,
+			wantRetryCalls:  1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dataplaneClusterConfig := &config.DataplaneClusterConfig{
+				DataPlaneClusterScalingType: tt.scalingType,
+				DynamicScalingConfig:        config.NewDynamicScalingConfig(),
+			}
+			dataplaneClusterConfig.DynamicScalingConfig.FailedClusterRetry.Enabled = tt.retryEnabled
+			clusterType := tt.clusterType
+			if clusterType == "" {
+				clusterType = api.ManagedDataPlaneClusterType.String()
+			}
+			clusterService := &services.ClusterServiceMock{
+				ListByStatusFunc: func(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError) {
+					g.Expect(state).To(gomega.Equal(api.ClusterFailed))
+					return []api.Cluster{{Meta: api.Meta{ID: "id"}, Status: api.ClusterFailed, ClusterType: clusterType}}, nil
+				},
+			}
+			retryService := &services.ClusterCreationRetryServiceMock{
+				RecordFailureFunc: func(cluster *api.Cluster) (*dbapi.ClusterCreationAttempt, *apiErrors.ServiceError) {
+					return &dbapi.ClusterCreationAttempt{
+						Meta:            api.Meta{ID: "attempt-id", CreatedAt: time.Now().Add(-tt.failedFor)},
+						FailedClusterID: cluster.ID,
+						Attempt:         tt.attempt,
+						Status:          dbapi.ClusterCreationAttemptWaitingForRetry,
+					}, nil
+				},
+				RetryFunc: func(cluster *api.Cluster) (*api.Cluster, *apiErrors.ServiceError) {
+					if tt.retryErr != nil {
+						return nil, tt.retryErr
+					}
+					return &api.Cluster{Meta: api.Meta{ID: "new-id"}, Status: api.ClusterAccepted}, nil
+				},
+				GiveUpFunc: func(cluster *api.Cluster) *apiErrors.ServiceError {
+					return nil
+				},
+			}
+
+			m := NewFailedClusterRetryManager(workers.Reconciler{}, dataplaneClusterConfig, clusterService, retryService)
+			g.Expect(m.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(clusterService.ListByStatusCalls()).To(gomega.HaveLen(tt.wantListCalls))
+			g.Expect(retryService.RecordFailureCalls()).To(gomega.HaveLen(tt.wantRecordCalls))
+			g.Expect(retryService.RetryCalls()).To(gomega.HaveLen(tt.wantRetryCalls))
+			g.Expect(retryService.GiveUpCalls()).To(gomega.HaveLen(tt.wantGiveUpCalls))
+		})
+	}
+}
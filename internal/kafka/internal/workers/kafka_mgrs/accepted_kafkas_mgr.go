@@ -53,6 +53,12 @@ func (k *AcceptedKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
+// GetEventTypes returns the outbox events waking the manager up, so that the accepted kafkas are reconciled
+// right away rather than on the next repeat interval
+func (k *AcceptedKafkaManager) GetEventTypes() []string {
+	return []string{services.KafkaStatusChangedSignal}
+}
+
 func (k *AcceptedKafkaManager) Reconcile() []error {
 	glog.Infoln("reconciling accepted kafkas")
 	var encounteredErrors []error
@@ -46,6 +46,12 @@ func (k *DeletingKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
+// GetEventTypes returns the outbox events waking the manager up, so that the deleted kafkas are reconciled
+// right away rather than on the next repeat interval
+func (k *DeletingKafkaManager) GetEventTypes() []string {
+	return []string{services.KafkaStatusChangedSignal}
+}
+
 func (k *DeletingKafkaManager) Reconcile() []error {
 	glog.Infoln("reconciling deleting kafkas")
 	var encounteredErrors []error
@@ -0,0 +1,58 @@
+package kafka_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaMigrationManager represents a kafka manager that periodically switches the routes of the kafkas that are ready on
+// the target cluster of their migration to the target cluster.
+type KafkaMigrationManager struct {
+	workers.BaseWorker
+	migrationService services.KafkaMigrationService
+}
+
+// NewKafkaMigrationManager creates a new kafka manager to switch the migrated kafkas to their target cluster.
+func NewKafkaMigrationManager(migrationService services.KafkaMigrationService, reconciler workers.Reconciler) *KafkaMigrationManager {
+	return &KafkaMigrationManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "migrating_kafka",
+			Reconciler: reconciler,
+		},
+		migrationService: migrationService,
+	}
+}
+
+// Start initializes the kafka manager to switch the migrated kafkas to their target cluster.
+func (k *KafkaMigrationManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for switching the migrated kafkas to their target cluster to stop.
+func (k *KafkaMigrationManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaMigrationManager) Reconcile() []error {
+	glog.Infoln("reconciling migrating kafkas")
+	var encounteredErrors []error
+
+	kafkas, serviceErr := k.migrationService.ListByMigrationStatus(dbapi.KafkaMigrationStatusTargetReady)
+	if serviceErr != nil {
+		return []error{errors.Wrap(serviceErr, "failed to list kafkas ready on the target cluster of their migration")}
+	}
+	glog.Infof("kafkas ready on the target cluster of their migration count = %d", len(kafkas))
+
+	for _, kafka := range kafkas {
+		if err := k.migrationService.SwitchRoutes(kafka); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to switch kafka %q to cluster %q", kafka.ID, kafka.MigrationTargetClusterID))
+		}
+	}
+
+	return encounteredErrors
+}
@@ -0,0 +1,75 @@
+package kafka_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaMigrationManager_Reconcile(t *testing.T) {
+	kafkas := []*dbapi.KafkaRequest{
+		{Meta: api.Meta{ID: "kafka-1"}, MigrationStatus: dbapi.KafkaMigrationStatusTargetReady},
+		{Meta: api.Meta{ID: "kafka-2"}, MigrationStatus: dbapi.KafkaMigrationStatusTargetReady},
+	}
+
+	tests := []struct {
+		name             string
+		migrationService *services.KafkaMigrationServiceMock
+		wantErrCount     int
+		wantSwitchCount  int
+	}{
+		{
+			name: "should switch the routes of every kafka ready on its target cluster",
+			migrationService: &services.KafkaMigrationServiceMock{
+				ListByMigrationStatusFunc: func(status dbapi.KafkaMigrationStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return kafkas, nil
+				},
+				SwitchRoutesFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantSwitchCount: 2,
+		},
+		{
+			name: "should keep switching the other kafkas when switching a kafka fails",
+			migrationService: &services.KafkaMigrationServiceMock{
+				ListByMigrationStatusFunc: func(status dbapi.KafkaMigrationStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return kafkas, nil
+				},
+				SwitchRoutesFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+					if kafka.ID == "kafka-1" {
+						return errors.GeneralError("failed to change the CNAME records")
+					}
+					return nil
+				},
+			},
+			wantErrCount:    1,
+			wantSwitchCount: 2,
+		},
+		{
+			name: "should return an error when listing the kafkas fails",
+			migrationService: &services.KafkaMigrationServiceMock{
+				ListByMigrationStatusFunc: func(status dbapi.KafkaMigrationStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return nil, errors.GeneralError("failed to list kafkas")
+				},
+			},
+			wantErrCount: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			k := NewKafkaMigrationManager(tt.migrationService, w.Reconciler{})
+			g.Expect(k.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(tt.migrationService.ListByMigrationStatusCalls()[0].Status).To(gomega.Equal(dbapi.KafkaMigrationStatusTargetReady))
+			g.Expect(tt.migrationService.SwitchRoutesCalls()).To(gomega.HaveLen(tt.wantSwitchCount))
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package kafka_mgrs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaUpgradeCampaignManager represents a kafka manager that periodically moves the kafkas targeted by the running
+// upgrade campaigns to the campaign versions, one batch at a time.
+type KafkaUpgradeCampaignManager struct {
+	workers.BaseWorker
+	upgradeCampaignService   services.KafkaUpgradeCampaignService
+	kafkaService             services.KafkaService
+	clusterService           services.ClusterService
+	maintenanceWindowService services.KafkaMaintenanceWindowService
+}
+
+// NewKafkaUpgradeCampaignManager creates a new kafka manager to run the kafka upgrade campaigns.
+func NewKafkaUpgradeCampaignManager(upgradeCampaignService services.KafkaUpgradeCampaignService, kafkaService services.KafkaService,
+	clusterService services.ClusterService, maintenanceWindowService services.KafkaMaintenanceWindowService, reconciler workers.Reconciler) *KafkaUpgradeCampaignManager {
+	return &KafkaUpgradeCampaignManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_upgrade_campaign",
+			Reconciler: reconciler,
+		},
+		upgradeCampaignService:   upgradeCampaignService,
+		kafkaService:             kafkaService,
+		clusterService:           clusterService,
+		maintenanceWindowService: maintenanceWindowService,
+	}
+}
+
+// Start initializes the kafka manager to run the kafka upgrade campaigns.
+func (k *KafkaUpgradeCampaignManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for running the kafka upgrade campaigns to stop.
+func (k *KafkaUpgradeCampaignManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaUpgradeCampaignManager) Reconcile() []error {
+	glog.Infoln("reconciling kafka upgrade campaigns")
+	var encounteredErrors []error
+
+	campaigns, serviceErr := k.upgradeCampaignService.ListByStatus(dbapi.KafkaUpgradeCampaignStatusRunning)
+	if serviceErr != nil {
+		return []error{errors.Wrap(serviceErr, "failed to list running kafka upgrade campaigns")}
+	}
+	glog.Infof("running kafka upgrade campaigns count = %d", len(campaigns))
+
+	for _, campaign := range campaigns {
+		if err := k.reconcileCampaign(campaign); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile kafka upgrade campaign %q", campaign.ID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+func (k *KafkaUpgradeCampaignManager) reconcileCampaign(campaign *dbapi.KafkaUpgradeCampaign) error {
+	items, serviceErr := k.upgradeCampaignService.ListItems(campaign.ID)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	for _, item := range items {
+		if item.State != dbapi.KafkaUpgradeCampaignItemStateUpgrading {
+			continue
+		}
+		if err := k.reconcileUpgradingItem(campaign, item); err != nil {
+			return errors.Wrapf(err, "failed to reconcile the upgrade of kafka %q", item.KafkaID)
+		}
+	}
+
+	progress := items.ProgressOf(0)
+	if progress.Upgrading > 0 {
+		// wait for the current batch to complete before starting the next one
+		return nil
+	}
+
+	if campaign.CurrentBatch > campaign.EvaluatedBatch {
+		batchProgress := items.ProgressOf(campaign.CurrentBatch)
+		updates := map[string]interface{}{"evaluated_batch": campaign.CurrentBatch}
+		if batchProgress.FailureRatio() > campaign.MaxFailureRatio {
+			updates["status"] = dbapi.KafkaUpgradeCampaignStatusPaused.String()
+			updates["status_reason"] = fmt.Sprintf("%d out of %d upgrades of batch %d failed, which is above the maximum failure ratio of %g",
+				batchProgress.Failed, batchProgress.Succeeded+batchProgress.Failed, campaign.CurrentBatch, campaign.MaxFailureRatio)
+		}
+		if err := k.updateCampaign(campaign, updates); err != nil {
+			return err
+		}
+		if _, paused := updates["status"]; paused {
+			glog.Infof("kafka upgrade campaign %q paused: %s", campaign.ID, updates["status_reason"])
+			return nil
+		}
+	}
+
+	if progress.Pending == 0 {
+		glog.Infof("kafka upgrade campaign %q completed", campaign.ID)
+		return k.updateCampaign(campaign, map[string]interface{}{
+			"status":        dbapi.KafkaUpgradeCampaignStatusCompleted.String(),
+			"status_reason": fmt.Sprintf("%d kafkas upgraded, %d failed and %d skipped", progress.Succeeded, progress.Failed, progress.Skipped),
+		})
+	}
+
+	return k.startNextBatch(campaign, items)
+}
+
+// startNextBatch starts the upgrade of up to BatchSize pending kafkas. Pending kafkas that don't need or can't be
+// upgraded are finished right away and don't count towards the size of the batch.
+func (k *KafkaUpgradeCampaignManager) startNextBatch(campaign *dbapi.KafkaUpgradeCampaign, items dbapi.KafkaUpgradeCampaignItemList) error {
+	batchSize := campaign.BatchSize
+	if batchSize <= 0 {
+		batchSize = dbapi.KafkaUpgradeCampaignDefaultBatchSize
+	}
+	batch := campaign.CurrentBatch + 1
+
+	started := 0
+	for _, item := range items {
+		if started == batchSize {
+			break
+		}
+		if item.State != dbapi.KafkaUpgradeCampaignItemStatePending {
+			continue
+		}
+
+		state, details, err := k.startItemUpgrade(campaign, item)
+		if err != nil {
+			return errors.Wrapf(err, "failed to start the upgrade of kafka %q", item.KafkaID)
+		}
+
+		now := time.Now()
+		updates := map[string]interface{}{
+			"state":      state.String(),
+			"details":    details,
+			"batch":      batch,
+			"started_at": &now,
+		}
+		if err := k.upgradeCampaignService.UpdateItem(item, updates); err != nil {
+			return err
+		}
+		item.State = state
+
+		if state == dbapi.KafkaUpgradeCampaignItemStateUpgrading {
+			started++
+		}
+	}
+
+	glog.Infof("kafka upgrade campaign %q started batch %d with %d kafkas", campaign.ID, batch, started)
+
+	return k.updateCampaign(campaign, map[string]interface{}{"current_batch": batch})
+}
+
+// startItemUpgrade sets the desired versions of the kafka of the given item to the campaign targets and returns the
+// state the item moves to along with the reason when the kafka is not upgraded.
+func (k *KafkaUpgradeCampaignManager) startItemUpgrade(campaign *dbapi.KafkaUpgradeCampaign, item *dbapi.KafkaUpgradeCampaignItem) (dbapi.KafkaUpgradeCampaignItemState, string, error) {
+	kafka, serviceErr := k.kafkaService.GetByID(item.KafkaID)
+	if serviceErr != nil {
+		if serviceErr.Is404() {
+			return dbapi.KafkaUpgradeCampaignItemStateSkipped, "kafka no longer exists", nil
+		}
+		return "", "", serviceErr
+	}
+
+	if !arrays.Contains(constants.GetUpdateableStatuses(), kafka.Status) || kafka.Status == constants.KafkaRequestStatusDeprovision.String() {
+		return dbapi.KafkaUpgradeCampaignItemStateSkipped, fmt.Sprintf("kafka cannot be upgraded in %q status", kafka.Status), nil
+	}
+
+	if campaign.IsTargetReached(kafka) {
+		return dbapi.KafkaUpgradeCampaignItemStateSkipped, "kafka is already running the target versions", nil
+	}
+
+	if err := k.validateTargetVersions(campaign, kafka); err != nil {
+		return dbapi.KafkaUpgradeCampaignItemStateFailed, err.Error(), nil
+	}
+
+	if err := k.kafkaService.Updates(kafka, campaign.DesiredVersions()); err != nil {
+		return "", "", err
+	}
+
+	return dbapi.KafkaUpgradeCampaignItemStateUpgrading, "", nil
+}
+
+func (k *KafkaUpgradeCampaignManager) validateTargetVersions(campaign *dbapi.KafkaUpgradeCampaign, kafka *dbapi.KafkaRequest) error {
+	strimziVersion := arrays.FirstNonEmptyOrDefault(kafka.DesiredStrimziVersion, campaign.StrimziVersion)
+	kafkaVersion := arrays.FirstNonEmptyOrDefault(kafka.DesiredKafkaVersion, campaign.KafkaVersion)
+	kafkaIBPVersion := arrays.FirstNonEmptyOrDefault(kafka.DesiredKafkaIBPVersion, campaign.KafkaIBPVersion)
+
+	cluster, serviceErr := k.clusterService.FindClusterByID(kafka.ClusterID)
+	if serviceErr != nil {
+		return errors.Wrapf(serviceErr, "unable to find cluster %q", kafka.ClusterID)
+	}
+	if cluster == nil {
+		return errors.Errorf("cluster %q of the kafka not found", kafka.ClusterID)
+	}
+
+	if available, err := k.clusterService.IsStrimziKafkaVersionAvailableInCluster(cluster, strimziVersion, kafkaVersion, kafkaIBPVersion); err != nil {
+		return err
+	} else if !available {
+		return errors.Errorf("strimzi version %q with kafka version %q and ibp version %q is not available in cluster %q", strimziVersion, kafkaVersion, kafkaIBPVersion, cluster.ClusterID)
+	}
+
+	if ready, err := k.clusterService.CheckStrimziVersionReady(cluster, strimziVersion); err != nil {
+		return err
+	} else if !ready {
+		return errors.Errorf("strimzi version %q is not ready in cluster %q", strimziVersion, cluster.ClusterID)
+	}
+
+	return nil
+}
+
+func (k *KafkaUpgradeCampaignManager) reconcileUpgradingItem(campaign *dbapi.KafkaUpgradeCampaign, item *dbapi.KafkaUpgradeCampaignItem) error {
+	kafka, serviceErr := k.kafkaService.GetByID(item.KafkaID)
+	if serviceErr != nil {
+		if serviceErr.Is404() {
+			return k.finishItem(item, dbapi.KafkaUpgradeCampaignItemStateSkipped, "kafka has been deleted while upgrading")
+		}
+		return serviceErr
+	}
+
+	switch {
+	case kafka.Status == constants.KafkaRequestStatusFailed.String():
+		return k.finishItem(item, dbapi.KafkaUpgradeCampaignItemStateFailed, fmt.Sprintf("kafka failed while upgrading: %s", kafka.FailedReason))
+	case kafka.KafkaUpgrading || kafka.StrimziUpgrading || kafka.KafkaIBPUpgrading:
+		if item.UpgradeObserved {
+			break
+		}
+		if err := k.upgradeCampaignService.UpdateItem(item, map[string]interface{}{"upgrade_observed": true}); err != nil {
+			return err
+		}
+		item.UpgradeObserved = true
+		return nil
+	case campaign.IsTargetReached(kafka):
+		return k.finishItem(item, dbapi.KafkaUpgradeCampaignItemStateSucceeded, "")
+	case item.UpgradeObserved:
+		return k.finishItem(item, dbapi.KafkaUpgradeCampaignItemStateFailed, fmt.Sprintf("kafka finished upgrading with strimzi version %q, kafka version %q and ibp version %q",
+			kafka.ActualStrimziVersion, kafka.ActualKafkaVersion, kafka.ActualKafkaIBPVersion))
+	}
+
+	deadline, err := k.upgradeDeadline(campaign, item, kafka)
+	if err != nil {
+		return err
+	}
+	if time.Now().After(deadline) {
+		return k.finishItem(item, dbapi.KafkaUpgradeCampaignItemStateFailed, fmt.Sprintf("kafka did not finish upgrading within %s", campaign.UpgradeTimeout()))
+	}
+
+	return nil
+}
+
+// upgradeDeadline returns the time by which the kafka of the given item is expected to have completed its upgrade.
+// The upgrade of a kafka having a maintenance window is held back until the window opens, so the timeout only starts
+// counting from then.
+func (k *KafkaUpgradeCampaignManager) upgradeDeadline(campaign *dbapi.KafkaUpgradeCampaign, item *dbapi.KafkaUpgradeCampaignItem, kafka *dbapi.KafkaRequest) (time.Time, error) {
+	startedAt := time.Now()
+	if item.StartedAt != nil {
+		startedAt = *item.StartedAt
+	}
+
+	window, serviceErr := k.maintenanceWindowService.Get(kafka)
+	switch {
+	case serviceErr != nil && !serviceErr.Is404():
+		return time.Time{}, serviceErr
+	case serviceErr == nil && !window.IsOpen(startedAt):
+		if nextStart := window.NextStart(startedAt); !nextStart.IsZero() {
+			startedAt = nextStart
+		}
+	}
+
+	return startedAt.Add(campaign.UpgradeTimeout()), nil
+}
+
+func (k *KafkaUpgradeCampaignManager) finishItem(item *dbapi.KafkaUpgradeCampaignItem, state dbapi.KafkaUpgradeCampaignItemState, details string) error {
+	if err := k.upgradeCampaignService.UpdateItem(item, map[string]interface{}{"state": state.String(), "details": details}); err != nil {
+		return err
+	}
+	item.State = state
+	item.Details = details
+	return nil
+}
+
+func (k *KafkaUpgradeCampaignManager) updateCampaign(campaign *dbapi.KafkaUpgradeCampaign, fields map[string]interface{}) error {
+	if err := k.upgradeCampaignService.Updates(campaign, fields); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package kafka_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	mockKafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaUpgradeCampaignManager_Reconcile(t *testing.T) {
+	campaign := &dbapi.KafkaUpgradeCampaign{
+		Meta:            api.Meta{ID: "campaign-id"},
+		KafkaVersion:    "2.8.1",
+		BatchSize:       1,
+		MaxFailureRatio: 0.5,
+		Status:          dbapi.KafkaUpgradeCampaignStatusRunning,
+	}
+	readyKafka := func() *dbapi.KafkaRequest {
+		return mockKafkas.BuildKafkaRequest(
+			mockKafkas.WithPredefinedTestValues(),
+			mockKafkas.With(mockKafkas.STATUS, constants.KafkaRequestStatusReady.String()),
+			mockKafkas.With(mockKafkas.ACTUAL_KAFKA_VERSION, "2.7.0"),
+		)
+	}
+	recentlyStarted := time.Now().Add(-time.Minute)
+	longAgo := time.Now().Add(-24 * time.Hour)
+
+	type fields struct {
+		upgradeCampaignService   services.KafkaUpgradeCampaignService
+		kafkaService             services.KafkaService
+		clusterService           services.ClusterService
+		maintenanceWindowService services.KafkaMaintenanceWindowService
+	}
+
+	tests := []struct {
+		name            string
+		campaign        *dbapi.KafkaUpgradeCampaign
+		items           dbapi.KafkaUpgradeCampaignItemList
+		kafka           *dbapi.KafkaRequest
+		versionsReady   bool
+		wantErr         bool
+		wantItemStates  map[string]dbapi.KafkaUpgradeCampaignItemState
+		wantCampaignSet map[string]interface{}
+		wantDesired     bool
+	}{
+		{
+			name:     "should start the upgrade of the first batch of pending kafkas",
+			campaign: campaign,
+			items: dbapi.KafkaUpgradeCampaignItemList{
+				{Meta: api.Meta{ID: "item-1"}, State: dbapi.KafkaUpgradeCampaignItemStatePending},
+				{Meta: api.Meta{ID: "item-2"}, State: dbapi.KafkaUpgradeCampaignItemStatePending},
+			},
+			kafka:           readyKafka(),
+			versionsReady:   true,
+			wantItemStates:  map[string]dbapi.KafkaUpgradeCampaignItemState{"item-1": dbapi.KafkaUpgradeCampaignItemStateUpgrading},
+			wantCampaignSet: map[string]interface{}{"current_batch": 1},
+			wantDesired:     true,
+		},
+		{
+			name:     "should fail the upgrade when the target versions are not available in the cluster of the kafka",
+			campaign: campaign,
+			items: dbapi.KafkaUpgradeCampaignItemList{
+				{Meta: api.Meta{ID: "item-1"}, State: dbapi.KafkaUpgradeCampaignItemStatePending},
+			},
+			kafka:           readyKafka(),
+			versionsReady:   false,
+			wantItemStates:  map[string]dbapi.KafkaUpgradeCampaignItemState{"item-1": dbapi.KafkaUpgradeCampaignItemStateFailed},
+			wantCampaignSet: map[string]interface{}{"current_batch": 1},
+		},
+		{
+			name:     "should wait for the upgrading kafkas of the current batch",
+			campaign: &dbapi.KafkaUpgradeCampaign{Meta: campaign.Meta, KafkaVersion: "2.8.1", BatchSize: 1, CurrentBatch: 1},
+			items: dbapi.KafkaUpgradeCampaignItemList{
+				{Meta: api.Meta{ID: "item-1"}, State: dbapi.KafkaUpgradeCampaignItemStateUpgrading, Batch: 1, StartedAt: &recentlyStarted},
+				{Meta: api.Meta{ID: "item-2"}, State: dbapi.KafkaUpgradeCampaignItemStatePending},
+			},
+			kafka: readyKafka(),
+		},
+		{
+			name:     "should fail the upgrade of a kafka that did not upgrade before the timeout",
+			campaign: &dbapi.KafkaUpgradeCampaign{Meta: campaign.Meta, KafkaVersion: "2.8.1", BatchSize: 1, MaxFailureRatio: 1, CurrentBatch: 1},
+			items: dbapi.KafkaUpgradeCampaignItemList{
+				{Meta: api.Meta{ID: "item-1"}, State: dbapi.KafkaUpgradeCampaignItemStateUpgrading, Batch: 1, StartedAt: &longAgo},
+			},
+			kafka:           readyKafka(),
+			wantItemStates:  map[string]dbapi.KafkaUpgradeCampaignItemState{"item-1": dbapi.KafkaUpgradeCampaignItemStateFailed},
+			wantCampaignSet: map[string]interface{}{"evaluated_batch": 1, "status": dbapi.KafkaUpgradeCampaignStatusCompleted.String()},
+		},
+		{
+			name:     "should pause the campaign when the failure ratio of the batch is above the maximum",
+			campaign: &dbapi.KafkaUpgradeCampaign{Meta: campaign.Meta, KafkaVersion: "2.8.1", BatchSize: 1, MaxFailureRatio: 0.5, CurrentBatch: 1},
+			items: dbapi.KafkaUpgradeCampaignItemList{
+				{Meta: api.Meta{ID: "item-1"}, State: dbapi.KafkaUpgradeCampaignItemStateFailed, Batch: 1},
+				{Meta: api.Meta{ID: "item-2"}, State: dbapi.KafkaUpgradeCampaignItemStatePending},
+			},
+			kafka:           readyKafka(),
+			wantCampaignSet: map[string]interface{}{"evaluated_batch": 1, "status": dbapi.KafkaUpgradeCampaignStatusPaused.String()},
+		},
+		{
+			name:     "should mark the upgrade as succeeded once the kafka runs the target versions",
+			campaign: &dbapi.KafkaUpgradeCampaign{Meta: campaign.Meta, KafkaVersion: "2.8.1", BatchSize: 1, MaxFailureRatio: 0.5, CurrentBatch: 1},
+			items: dbapi.KafkaUpgradeCampaignItemList{
+				{Meta: api.Meta{ID: "item-1"}, State: dbapi.KafkaUpgradeCampaignItemStateUpgrading, Batch: 1, StartedAt: &recentlyStarted, UpgradeObserved: true},
+			},
+			kafka: mockKafkas.BuildKafkaRequest(
+				mockKafkas.WithPredefinedTestValues(),
+				mockKafkas.With(mockKafkas.ACTUAL_KAFKA_VERSION, "2.8.1"),
+			),
+			wantItemStates:  map[string]dbapi.KafkaUpgradeCampaignItemState{"item-1": dbapi.KafkaUpgradeCampaignItemStateSucceeded},
+			wantCampaignSet: map[string]interface{}{"evaluated_batch": 1, "status": dbapi.KafkaUpgradeCampaignStatusCompleted.String()},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			gotItemStates := map[string]dbapi.KafkaUpgradeCampaignItemState{}
+			gotCampaignSet := map[string]interface{}{}
+			desiredVersionsSet := false
+
+			f := fields{
+				upgradeCampaignService: &services.KafkaUpgradeCampaignServiceMock{
+					ListByStatusFunc: func(status dbapi.KafkaUpgradeCampaignStatus) (dbapi.KafkaUpgradeCampaignList, *errors.ServiceError) {
+						return dbapi.KafkaUpgradeCampaignList{tt.campaign}, nil
+					},
+					ListItemsFunc: func(campaignID string) (dbapi.KafkaUpgradeCampaignItemList, *errors.ServiceError) {
+						return tt.items, nil
+					},
+					UpdateItemFunc: func(item *dbapi.KafkaUpgradeCampaignItem, fields map[string]interface{}) *errors.ServiceError {
+						if state, ok := fields["state"]; ok {
+							gotItemStates[item.ID] = dbapi.KafkaUpgradeCampaignItemState(state.(string))
+						}
+						return nil
+					},
+					UpdatesFunc: func(campaign *dbapi.KafkaUpgradeCampaign, fields map[string]interface{}) *errors.ServiceError {
+						for k, v := range fields {
+							if k != "status_reason" {
+								gotCampaignSet[k] = v
+							}
+						}
+						return nil
+					},
+				},
+				kafkaService: &services.KafkaServiceMock{
+					GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return tt.kafka, nil
+					},
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						desiredVersionsSet = values["desired_kafka_version"] == "2.8.1"
+						return nil
+					},
+				},
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID}, nil
+					},
+					IsStrimziKafkaVersionAvailableInClusterFunc: func(cluster *api.Cluster, strimziVersion, kafkaVersion, ibpVersion string) (bool, error) {
+						return tt.versionsReady, nil
+					},
+					CheckStrimziVersionReadyFunc: func(cluster *api.Cluster, strimziVersion string) (bool, error) {
+						return tt.versionsReady, nil
+					},
+				},
+				maintenanceWindowService: &services.KafkaMaintenanceWindowServiceMock{
+					GetFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+						return nil, errors.NotFound("not found")
+					},
+				},
+			}
+
+			k := NewKafkaUpgradeCampaignManager(f.upgradeCampaignService, f.kafkaService, f.clusterService, f.maintenanceWindowService, w.Reconciler{})
+			errs := k.Reconcile()
+			g.Expect(len(errs) > 0).To(gomega.Equal(tt.wantErr), "%v", errs)
+
+			if tt.wantItemStates == nil {
+				tt.wantItemStates = map[string]dbapi.KafkaUpgradeCampaignItemState{}
+			}
+			if tt.wantCampaignSet == nil {
+				tt.wantCampaignSet = map[string]interface{}{}
+			}
+			g.Expect(gotItemStates).To(gomega.Equal(tt.wantItemStates))
+			g.Expect(gotCampaignSet).To(gomega.Equal(tt.wantCampaignSet))
+			g.Expect(desiredVersionsSet).To(gomega.Equal(tt.wantDesired))
+		})
+	}
+}
+
+func TestKafkaUpgradeCampaignManager_upgradeDeadline(t *testing.T) {
+	g := gomega.NewWithT(t)
+	// 2023-04-10 is a Monday
+	startedAt := time.Date(2023, time.April, 10, 12, 0, 0, 0, time.UTC)
+	campaign := &dbapi.KafkaUpgradeCampaign{UpgradeTimeoutMinutes: 60}
+	item := &dbapi.KafkaUpgradeCampaignItem{StartedAt: &startedAt}
+
+	k := NewKafkaUpgradeCampaignManager(nil, nil, nil, &services.KafkaMaintenanceWindowServiceMock{
+		GetFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dbapi.KafkaMaintenanceWindow, *errors.ServiceError) {
+			return &dbapi.KafkaMaintenanceWindow{DayOfWeek: "sunday", StartHour: 2, DurationHours: 4}, nil
+		},
+	}, w.Reconciler{})
+
+	deadline, err := k.upgradeDeadline(campaign, item, &dbapi.KafkaRequest{})
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(deadline).To(gomega.Equal(time.Date(2023, time.April, 16, 3, 0, 0, 0, time.UTC)))
+}
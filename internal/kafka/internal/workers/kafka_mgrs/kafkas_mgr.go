@@ -16,6 +16,7 @@ import (
 	serviceErr "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 
@@ -47,10 +48,11 @@ type KafkaManager struct {
 	kafkaConfig             *config.KafkaConfig
 	dataplaneClusterConfig  *config.DataplaneClusterConfig
 	cloudProviders          *config.ProviderConfig
+	webhookService          webhook.WebhookService
 }
 
 // NewKafkaManager creates a new kafka manager to reconcile kafkas
-func NewKafkaManager(kafkaService services.KafkaService, accessControlList *acl.AccessControlListConfig, kafka *config.KafkaConfig, clusters *config.DataplaneClusterConfig, providers *config.ProviderConfig, reconciler workers.Reconciler, clusterService services.ClusterService) *KafkaManager {
+func NewKafkaManager(kafkaService services.KafkaService, accessControlList *acl.AccessControlListConfig, kafka *config.KafkaConfig, clusters *config.DataplaneClusterConfig, providers *config.ProviderConfig, reconciler workers.Reconciler, clusterService services.ClusterService, webhookService webhook.WebhookService) *KafkaManager {
 	return &KafkaManager{
 		BaseWorker: workers.BaseWorker{
 			Id:         uuid.New().String(),
@@ -63,6 +65,7 @@ func NewKafkaManager(kafkaService services.KafkaService, accessControlList *acl.
 		dataplaneClusterConfig:  clusters,
 		cloudProviders:          providers,
 		clusterService:          clusterService,
+		webhookService:          webhookService,
 	}
 }
 
@@ -112,9 +115,10 @@ func (k *KafkaManager) Reconcile() []error {
 	accessControlListConfig := k.accessControlListConfig
 	if accessControlListConfig.EnableDenyList {
 		glog.Infoln("Reconciling denied kafka owners")
-		kafkaDeprovisioningForDeniedOwnersErr := k.reconcileDeniedKafkaOwners(accessControlListConfig.DenyList)
+		denyList := accessControlListConfig.GetDenyList()
+		kafkaDeprovisioningForDeniedOwnersErr := k.reconcileDeniedKafkaOwners(denyList)
 		if kafkaDeprovisioningForDeniedOwnersErr != nil {
-			wrappedError := errors.Wrapf(kafkaDeprovisioningForDeniedOwnersErr, "failed to deprovision kafka for denied owners %s", accessControlListConfig.DenyList)
+			wrappedError := errors.Wrapf(kafkaDeprovisioningForDeniedOwnersErr, "failed to deprovision kafka for denied owners %s", denyList)
 			encounteredErrors = append(encounteredErrors, wrappedError)
 		}
 	}
@@ -148,6 +152,10 @@ func (k *KafkaManager) Reconcile() []error {
 
 		if remainingLifespan.LessThanOrEqual(float64(bm.GracePeriodDays)) {
 			glog.Infof("cluster with ID '%s' entered its grace period. Suspending", kafka.ID)
+			// notify the owners that the instance is about to expire, this is only done once per instance
+			if err := k.webhookService.EmitOnce(services.NewKafkaWebhookEvent(webhook.EventTypeKafkaExpiring, kafka)); err != nil {
+				glog.Errorf("failed to emit %q webhook event for kafka %q: %s", webhook.EventTypeKafkaExpiring, kafka.ID, err.Error())
+			}
 			// the instance is in grace period
 			_, err := k.kafkaService.UpdateStatus(kafka.ID, constants.KafkaRequestStatusSuspending)
 			if err != nil {
@@ -306,7 +314,7 @@ func (k *KafkaManager) calculateCapacityByRegionAndInstanceTypeForManualClusters
 		return totalUsed, instanceTypeUsed
 	}
 
-	for _, cluster := range k.dataplaneClusterConfig.ClusterConfig.GetManualClusters() {
+	for _, cluster := range k.dataplaneClusterConfig.GetClusterConfig().GetManualClusters() {
 		if !cluster.Schedulable {
 			continue
 		}
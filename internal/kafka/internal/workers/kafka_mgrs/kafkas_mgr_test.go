@@ -16,6 +16,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/acl"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/onsi/gomega"
 )
@@ -178,6 +179,11 @@ func TestKafkaManager_Reconcile(t *testing.T) {
 				accessControlListConfig: tt.fields.accessControlListConfig,
 				cloudProviders:          &tt.fields.cloudProviders,
 				kafkaConfig:             &tt.fields.kafkaConfig,
+				webhookService: &webhook.WebhookServiceMock{
+					EmitOnceFunc: func(event webhook.Event) *errors.ServiceError {
+						return nil
+					},
+				},
 			}
 
 			g.Expect(len(k.Reconcile()) > 0).To(gomega.Equal(tt.wantErr))
@@ -463,6 +469,11 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
+			webhookService := &webhook.WebhookServiceMock{
+				EmitOnceFunc: func(event webhook.Event) *errors.ServiceError {
+					return nil
+				},
+			}
 			k := &KafkaManager{
 				kafkaService:            tt.fields.kafkaService,
 				clusterService:          tt.fields.clusterService,
@@ -470,6 +481,7 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 				accessControlListConfig: tt.fields.accessControlListConfig,
 				cloudProviders:          &tt.fields.cloudProviders,
 				kafkaConfig:             &tt.fields.kafkaConfig,
+				webhookService:          webhookService,
 			}
 
 			//k.Reconcile()
@@ -478,6 +490,11 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 			if tt.updateStatusCall.count > 0 {
 				g.Expect(tt.fields.kafkaService.UpdateStatusCalls()[0].Status).To(gomega.BeEquivalentTo(tt.updateStatusCall.status))
 			}
+			// the owners are notified once the kafka enters its grace period
+			g.Expect(webhookService.EmitOnceCalls()).To(gomega.HaveLen(tt.updateStatusCall.count))
+			for _, call := range webhookService.EmitOnceCalls() {
+				g.Expect(call.Event.Type).To(gomega.Equal(webhook.EventTypeKafkaExpiring))
+			}
 
 		})
 	}
@@ -609,7 +626,7 @@ func TestKafkaManager_setKafkaStatusCountMetric(t *testing.T) {
 
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			k := NewKafkaManager(tt.fields.kafkaService, nil, nil, nil, nil, workers.Reconciler{}, nil)
+			k := NewKafkaManager(tt.fields.kafkaService, nil, nil, nil, nil, workers.Reconciler{}, nil, nil)
 
 			g.Expect(k.setKafkaStatusCountMetric() != nil).To(gomega.Equal(tt.wantErr))
 		})
@@ -53,22 +53,22 @@ func (k *KafkaRoutesCNAMEManager) Reconcile() []error {
 			if kafka.RoutesCreationId == "" {
 				glog.Infof("creating CNAME records for kafka %s", kafka.ID)
 
-				changeOutput, err := k.kafkaService.ChangeKafkaCNAMErecords(kafka, services.KafkaRoutesActionCreate)
+				recordStatus, err := k.kafkaService.ChangeKafkaCNAMErecords(kafka, services.KafkaRoutesActionCreate)
 
 				if err != nil {
 					errs = append(errs, err)
 					continue
 				}
 
-				kafka.RoutesCreationId = *changeOutput.ChangeInfo.Id
-				kafka.RoutesCreated = *changeOutput.ChangeInfo.Status == "INSYNC"
+				kafka.RoutesCreationId = *recordStatus.Id
+				kafka.RoutesCreated = recordStatus.IsInSync()
 			} else {
 				recordStatus, err := k.kafkaService.GetCNAMERecordStatus(kafka)
 				if err != nil {
 					errs = append(errs, err)
 					continue
 				}
-				kafka.RoutesCreated = *recordStatus.Status == "INSYNC"
+				kafka.RoutesCreated = recordStatus.IsInSync()
 			}
 		} else {
 			glog.Infof("external certificate is disabled, skip CNAME creation for Kafka %s", kafka.ID)
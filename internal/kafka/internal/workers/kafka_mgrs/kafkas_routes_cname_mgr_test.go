@@ -3,7 +3,6 @@ package kafka_mgrs
 import (
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
@@ -17,7 +16,7 @@ import (
 
 func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 	testChangeID := "1234"
-	testChangeINSYNC := route53.ChangeStatusInsync
+	testChangeINSYNC := services.CNameRecordStatusInSync
 
 	type fields struct {
 		kafkaService services.KafkaService
@@ -39,12 +38,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.CNameRecordStatus, *errors.ServiceError) {
+						return &services.CNameRecordStatus{
+							Id:     &testChangeID,
+							Status: &testChangeINSYNC,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -69,12 +66,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.CNameRecordStatus, *errors.ServiceError) {
+						return &services.CNameRecordStatus{
+							Id:     &testChangeID,
+							Status: &testChangeINSYNC,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -104,12 +99,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.CNameRecordStatus, *errors.ServiceError) {
+						return &services.CNameRecordStatus{
+							Id:     &testChangeID,
+							Status: &testChangeINSYNC,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -157,12 +150,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.CNameRecordStatus, *errors.ServiceError) {
+						return &services.CNameRecordStatus{
+							Id:     &testChangeID,
+							Status: &testChangeINSYNC,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -200,7 +191,7 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.CNameRecordStatus, *errors.ServiceError) {
 						return nil, errors.GeneralError("failed to create CNAME")
 					},
 				},
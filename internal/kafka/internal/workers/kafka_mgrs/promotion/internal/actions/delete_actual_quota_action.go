@@ -31,7 +31,7 @@ func (d *DeleteActualQuotaAction) PerformJob(kafkaRequest *dbapi.KafkaRequest, c
 	if factoryErr != nil {
 		return res, true, errors.NewWithCause(errors.ErrorGeneral, factoryErr, "unable to delete quota")
 	}
-	instanceType, err := d.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+	instanceType, err := d.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
 	if err != nil {
 		// instance type was validated at creation stage. This should never happen.
 		return res, true, err
@@ -21,6 +21,7 @@ import (
 	environments2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/providers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
 	"github.com/goava/di"
 )
 
@@ -46,11 +47,12 @@ func ConfigProviders() di.Option {
 
 		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(observatoriumClient.NewObservabilityConfigurationConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
-		di.Provide(config.NewKafkaConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
-		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewKafkaConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator)), di.As(new(environments2.ReloadableConfigModule))),
+		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator)), di.As(new(environments2.ReloadableConfigModule))),
 		di.Provide(config.NewKasFleetshardConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(quota_management.NewQuotaManagementListConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewCertificateManagementConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewDNSConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 
 		// Additional CLI subcommands
 		di.Provide(environments2.Func(ServiceProviders)),
@@ -64,13 +66,27 @@ func ServiceProviders() di.Option {
 	return di.Options(
 		di.Provide(services.NewClusterService),
 		di.Provide(services.NewKafkaService, di.As(new(services.KafkaService))),
+		di.Provide(services.NewKafkaMaintenanceWindowService, di.As(new(services.KafkaMaintenanceWindowService))),
+		di.Provide(services.NewKafkaUpgradeCampaignService, di.As(new(services.KafkaUpgradeCampaignService))),
+		di.Provide(services.NewKafkaRoutesExportService, di.As(new(services.KafkaRoutesExportService))),
+		di.Provide(services.NewKafkaPlacementExplainService, di.As(new(services.KafkaPlacementExplainService))),
+		di.Provide(services.NewKafkaMigrationService, di.As(new(services.KafkaMigrationService))),
+		di.Provide(services.NewKafkaRoleBindingService, di.As(new(services.KafkaRoleBindingService))),
+		di.Provide(services.NewKafkaInstanceTemplateService, di.As(new(services.KafkaInstanceTemplateService))),
+		di.Provide(services.NewDataPlaneScaleUpForecastService, di.As(new(services.DataPlaneScaleUpForecastService))),
+		di.Provide(services.NewClusterConsolidationService, di.As(new(services.ClusterConsolidationService))),
+		di.Provide(services.NewClusterCreationRetryService, di.As(new(services.ClusterCreationRetryService))),
+		di.Provide(services.NewDataPlaneClusterAdminService, di.As(new(services.DataPlaneClusterAdminService))),
+		di.Provide(quota_management.NewQuotaManagementListEntryService, di.As(new(quota_management.QuotaManagementListEntryService)), di.As(new(environments2.BootService))),
 		di.Provide(services.NewCloudProvidersService),
 		di.Provide(services.NewSupportedKafkaInstanceTypesService),
 		di.Provide(services.NewObservatoriumService),
 		di.Provide(services.NewKasFleetshardOperatorAddon),
 		di.Provide(services.NewClusterPlacementStrategy),
+		di.Provide(services.NewDNSProvider),
 		di.Provide(services.NewDataPlaneClusterService, di.As(new(services.DataPlaneClusterService))),
 		di.Provide(services.NewDataPlaneKafkaService, di.As(new(services.DataPlaneKafkaService))),
+		di.Provide(services.NewKafkaOutboxEventConverter, di.As(new(webhook.OutboxEventConverter))),
 		di.Provide(handlers.NewAuthenticationBuilder),
 		di.Provide(clusters.NewDefaultProviderFactory, di.As(new(clusters.ProviderFactory))),
 		di.Provide(routes.NewRouteLoader),
@@ -80,6 +96,8 @@ func ServiceProviders() di.Option {
 		di.Provide(cluster_mgrs.NewCleanupClustersManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewDeprovisioningClustersManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewDynamicScaleDownManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewClusterConsolidationManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewFailedClusterRetryManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewAcceptedKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewPreparingKafkaManager, di.As(new(workers.Worker))),
@@ -89,6 +107,8 @@ func ServiceProviders() di.Option {
 		di.Provide(kafka_mgrs.NewKafkaCNAMEManager, di.As(new(workers.Worker))),
 		di.Provide(promotion.NewPromotionKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkasRoutesTLSCertificateManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaUpgradeCampaignManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaMigrationManager, di.As(new(workers.Worker))),
 		di.Provide(acl.NewEnterpriseClustersAccessControlMiddleware),
 		di.Provide(kafkatlscertmgmt.NewKafkaTLSCertificateManagementService),
 	)
@@ -254,6 +254,7 @@ func BuildPublicKafkaRequest(modifyFn func(kafka *public.KafkaRequest)) *public.
 		CreatedAt:     time.Time{},
 		UpdatedAt:     time.Time{},
 		Version:       kafkaVersion,
+		UpgradeState:  dbapi.KafkaUpgradeStateUpToDate.String(),
 	}
 	if modifyFn != nil {
 		modifyFn(kafka)
@@ -1,6 +1,8 @@
 package acl
 
 import (
+	"sync"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"github.com/spf13/pflag"
@@ -25,6 +27,8 @@ type AccessControlListConfig struct {
 	AccessListConfigFile string
 	EnableDenyList       bool
 	EnableAccessList     bool
+	// lock protects the lists, which are replaced at runtime by the entries stored in the database
+	lock sync.RWMutex
 }
 
 func NewAccessControlListConfig() *AccessControlListConfig {
@@ -60,6 +64,42 @@ func (c *AccessControlListConfig) ReadFiles() (err error) {
 	return nil
 }
 
+// IsUserDenied returns true if the user is in the deny list
+func (c *AccessControlListConfig) IsUserDenied(username string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.DenyList.IsUserDenied(username)
+}
+
+// IsOrganisationAccepted returns true if the organisation is in the access list
+func (c *AccessControlListConfig) IsOrganisationAccepted(orgId string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.AccessList.IsOrganisationAccepted(orgId)
+}
+
+// GetDenyList returns a copy of the deny list
+func (c *AccessControlListConfig) GetDenyList() DeniedUsers {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return append(DeniedUsers{}, c.DenyList...)
+}
+
+// GetAccessList returns a copy of the access list
+func (c *AccessControlListConfig) GetAccessList() AcceptedOrganisations {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return append(AcceptedOrganisations{}, c.AccessList...)
+}
+
+// SetLists replaces the deny list and the access list
+func (c *AccessControlListConfig) SetLists(denyList DeniedUsers, accessList AcceptedOrganisations) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.DenyList = denyList
+	c.AccessList = accessList
+}
+
 // Read the contents of file into the deny list config
 func readDenyListConfigFile(file string, val *DeniedUsers) error {
 	fileContents, err := shared.ReadFile(file)
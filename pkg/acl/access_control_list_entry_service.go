@@ -0,0 +1,232 @@
+package acl
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AccessControlListChangedSignal is the type of the outbox events recorded every time an entry of the access control lists
+// is created or deleted, and so the name of the signal that is notified on the signal bus once the change is committed.
+const AccessControlListChangedSignal = "access_control_list.changed"
+
+//go:generate moq -out access_control_list_entry_service_moq.go . AccessControlListEntryService
+type AccessControlListEntryService interface {
+	// ListEntries returns the entries of the access control lists, oldest first.
+	// When an entry type is given, only the entries of that type are returned.
+	ListEntries(entryType api.AccessControlListEntryType) (api.AccessControlListEntryList, *errors.ServiceError)
+	// CreateEntry adds the entry to its list. It fails with a conflict when the value is already in the list.
+	CreateEntry(entry *api.AccessControlListEntry) *errors.ServiceError
+	// DeleteEntry removes the entry with the given id from its list
+	DeleteEntry(id string) *errors.ServiceError
+	// Reload replaces the lists of the access control list configuration by the entries stored in the database
+	Reload() *errors.ServiceError
+}
+
+var _ AccessControlListEntryService = &accessControlListEntryService{}
+var _ environments.BootService = &accessControlListEntryService{}
+
+// accessControlListEntryService stores the access control lists in the database. The lists of the configuration files
+// only seed the database, the lists of the configuration are then kept in sync with the database on every replica
+// by reloading them when the changes are signaled.
+type accessControlListEntryService struct {
+	connectionFactory       *db.ConnectionFactory
+	accessControlListConfig *AccessControlListConfig
+	signalBus               signalbus.SignalBus
+	outbox                  signalbus.Outbox
+	isRunning               int32
+	stopChan                chan struct{}
+	syncGroup               sync.WaitGroup
+}
+
+func NewAccessControlListEntryService(connectionFactory *db.ConnectionFactory, accessControlListConfig *AccessControlListConfig, signalBus signalbus.SignalBus, outbox signalbus.Outbox) *accessControlListEntryService {
+	return &accessControlListEntryService{
+		connectionFactory:       connectionFactory,
+		accessControlListConfig: accessControlListConfig,
+		signalBus:               signalBus,
+		outbox:                  outbox,
+		stopChan:                make(chan struct{}),
+	}
+}
+
+func (s *accessControlListEntryService) ListEntries(entryType api.AccessControlListEntryType) (api.AccessControlListEntryList, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+	if entryType != "" {
+		dbConn = dbConn.Where("type = ?", entryType)
+	}
+
+	var entries api.AccessControlListEntryList
+	if err := dbConn.Order("created_at").Find(&entries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list access control list entries")
+	}
+	return entries, nil
+}
+
+func (s *accessControlListEntryService) CreateEntry(entry *api.AccessControlListEntry) *errors.ServiceError {
+	var svcErr *errors.ServiceError
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(entry).Error; err != nil {
+			svcErr = services.HandleCreateError("access control list entry", err)
+			return err
+		}
+		if svcErr = s.publishChange(tx, entry); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}); err != nil {
+		if svcErr != nil {
+			return svcErr
+		}
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create access control list entry")
+	}
+	return nil
+}
+
+func (s *accessControlListEntryService) DeleteEntry(id string) *errors.ServiceError {
+	var svcErr *errors.ServiceError
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var entry api.AccessControlListEntry
+		if err := tx.Where("id = ?", id).First(&entry).Error; err != nil {
+			svcErr = services.HandleGetError("AccessControlListEntry", "id", id, err)
+			return err
+		}
+		if err := tx.Delete(&entry).Error; err != nil {
+			svcErr = services.HandleDeleteError("AccessControlListEntry", "id", id, err)
+			return err
+		}
+		if svcErr = s.publishChange(tx, &entry); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}); err != nil {
+		if svcErr != nil {
+			return svcErr
+		}
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete access control list entry %q", id)
+	}
+	return nil
+}
+
+func (s *accessControlListEntryService) Reload() *errors.ServiceError {
+	entries, err := s.ListEntries("")
+	if err != nil {
+		return err
+	}
+
+	denyList := DeniedUsers{}
+	accessList := AcceptedOrganisations{}
+	for _, entry := range entries {
+		switch entry.Type {
+		case api.AccessControlListEntryTypeDeniedUser:
+			denyList = append(denyList, entry.Value)
+		case api.AccessControlListEntryTypeAcceptedOrganisation:
+			accessList = append(accessList, entry.Value)
+		}
+	}
+	s.accessControlListConfig.SetLists(denyList, accessList)
+	return nil
+}
+
+// Start seeds the database with the lists of the configuration files, loads the lists stored in the database
+// and reloads them every time they change until the service is stopped.
+func (s *accessControlListEntryService) Start() {
+	if !atomic.CompareAndSwapInt32(&s.isRunning, 0, 1) {
+		return
+	}
+	s.stopChan = make(chan struct{})
+
+	if err := s.seed(); err != nil {
+		logger.Logger.Errorf("failed to seed the access control lists: %v", err)
+	}
+	// the lists of the configuration files are kept until the lists are successfully loaded from the database
+	if err := s.Reload(); err != nil {
+		logger.Logger.Errorf("failed to load the access control lists: %v", err)
+	}
+
+	sub := s.signalBus.Subscribe(AccessControlListChangedSignal)
+	s.syncGroup.Add(1)
+	go func() {
+		defer s.syncGroup.Done()
+		defer sub.Close()
+		for {
+			select {
+			case <-s.stopChan:
+				return
+			case <-sub.Signal():
+				if err := s.Reload(); err != nil {
+					logger.Logger.Errorf("failed to reload the access control lists: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops reloading the lists. Blocks until the reload in progress, if any, completes.
+func (s *accessControlListEntryService) Stop() {
+	select {
+	case <-s.stopChan:
+		// already closed
+	default:
+		close(s.stopChan)
+		s.syncGroup.Wait()
+	}
+	atomic.StoreInt32(&s.isRunning, 0)
+}
+
+// seed stores the enabled lists of the configuration files in the database, unless entries of the same type have
+// ever been stored. The files only provide the initial content of the lists, so that the entries deleted
+// through the admin API are not restored on restart.
+func (s *accessControlListEntryService) seed() error {
+	if s.accessControlListConfig.EnableDenyList {
+		if err := s.seedEntries(api.AccessControlListEntryTypeDeniedUser, s.accessControlListConfig.GetDenyList()); err != nil {
+			return err
+		}
+	}
+	if s.accessControlListConfig.EnableAccessList {
+		if err := s.seedEntries(api.AccessControlListEntryTypeAcceptedOrganisation, s.accessControlListConfig.GetAccessList()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *accessControlListEntryService) seedEntries(entryType api.AccessControlListEntryType, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var count int64
+		// deleted entries are counted too, the list has been seeded when they were created
+		if err := tx.Unscoped().Model(&api.AccessControlListEntry{}).Where("type = ?", entryType).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return nil
+		}
+
+		entries := api.AccessControlListEntryList{}
+		for _, value := range values {
+			entries = append(entries, &api.AccessControlListEntry{Type: entryType, Value: value, Reason: "configuration file", CreatedBy: "kas-fleet-manager"})
+		}
+		// the replicas starting at the same time may seed the list concurrently
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entries).Error
+	})
+}
+
+// publishChange records the change of the entry in the outbox, using the transaction of the change
+func (s *accessControlListEntryService) publishChange(tx *gorm.DB, entry *api.AccessControlListEntry) *errors.ServiceError {
+	return s.outbox.Publish(tx, &api.OutboxEvent{
+		EventType:  AccessControlListChangedSignal,
+		ResourceId: entry.ID,
+	})
+}
@@ -0,0 +1,201 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package acl
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that AccessControlListEntryServiceMock does implement AccessControlListEntryService.
+// If this is not the case, regenerate this file with moq.
+var _ AccessControlListEntryService = &AccessControlListEntryServiceMock{}
+
+// AccessControlListEntryServiceMock is a mock implementation of AccessControlListEntryService.
+//
+//	func TestSomethingThatUsesAccessControlListEntryService(t *testing.T) {
+//
+//		// make and configure a mocked AccessControlListEntryService
+//		mockedAccessControlListEntryService := &AccessControlListEntryServiceMock{
+//			CreateEntryFunc: func(entry *api.AccessControlListEntry) *errors.ServiceError {
+//				panic("mock out the CreateEntry method")
+//			},
+//			DeleteEntryFunc: func(id string) *errors.ServiceError {
+//				panic("mock out the DeleteEntry method")
+//			},
+//			ListEntriesFunc: func(entryType api.AccessControlListEntryType) (api.AccessControlListEntryList, *errors.ServiceError) {
+//				panic("mock out the ListEntries method")
+//			},
+//			ReloadFunc: func() *errors.ServiceError {
+//				panic("mock out the Reload method")
+//			},
+//		}
+//
+//		// use mockedAccessControlListEntryService in code that requires AccessControlListEntryService
+//		// and then make assertions.
+//
+//	}
+type AccessControlListEntryServiceMock struct {
+	// CreateEntryFunc mocks the CreateEntry method.
+	CreateEntryFunc func(entry *api.AccessControlListEntry) *errors.ServiceError
+
+	// DeleteEntryFunc mocks the DeleteEntry method.
+	DeleteEntryFunc func(id string) *errors.ServiceError
+
+	// ListEntriesFunc mocks the ListEntries method.
+	ListEntriesFunc func(entryType api.AccessControlListEntryType) (api.AccessControlListEntryList, *errors.ServiceError)
+
+	// ReloadFunc mocks the Reload method.
+	ReloadFunc func() *errors.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateEntry holds details about calls to the CreateEntry method.
+		CreateEntry []struct {
+			// Entry is the entry argument value.
+			Entry *api.AccessControlListEntry
+		}
+		// DeleteEntry holds details about calls to the DeleteEntry method.
+		DeleteEntry []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// ListEntries holds details about calls to the ListEntries method.
+		ListEntries []struct {
+			// EntryType is the entryType argument value.
+			EntryType api.AccessControlListEntryType
+		}
+		// Reload holds details about calls to the Reload method.
+		Reload []struct {
+		}
+	}
+	lockCreateEntry sync.RWMutex
+	lockDeleteEntry sync.RWMutex
+	lockListEntries sync.RWMutex
+	lockReload      sync.RWMutex
+}
+
+// CreateEntry calls CreateEntryFunc.
+func (mock *AccessControlListEntryServiceMock) CreateEntry(entry *api.AccessControlListEntry) *errors.ServiceError {
+	if mock.CreateEntryFunc == nil {
+		panic("AccessControlListEntryServiceMock.CreateEntryFunc: method is nil but AccessControlListEntryService.CreateEntry was just called")
+	}
+	callInfo := struct {
+		Entry *api.AccessControlListEntry
+	}{
+		Entry: entry,
+	}
+	mock.lockCreateEntry.Lock()
+	mock.calls.CreateEntry = append(mock.calls.CreateEntry, callInfo)
+	mock.lockCreateEntry.Unlock()
+	return mock.CreateEntryFunc(entry)
+}
+
+// CreateEntryCalls gets all the calls that were made to CreateEntry.
+// Check the length with:
+//
+//	len(mockedAccessControlListEntryService.CreateEntryCalls())
+func (mock *AccessControlListEntryServiceMock) CreateEntryCalls() []struct {
+	Entry *api.AccessControlListEntry
+} {
+	var calls []struct {
+		Entry *api.AccessControlListEntry
+	}
+	mock.lockCreateEntry.RLock()
+	calls = mock.calls.CreateEntry
+	mock.lockCreateEntry.RUnlock()
+	return calls
+}
+
+// DeleteEntry calls DeleteEntryFunc.
+func (mock *AccessControlListEntryServiceMock) DeleteEntry(id string) *errors.ServiceError {
+	if mock.DeleteEntryFunc == nil {
+		panic("AccessControlListEntryServiceMock.DeleteEntryFunc: method is nil but AccessControlListEntryService.DeleteEntry was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockDeleteEntry.Lock()
+	mock.calls.DeleteEntry = append(mock.calls.DeleteEntry, callInfo)
+	mock.lockDeleteEntry.Unlock()
+	return mock.DeleteEntryFunc(id)
+}
+
+// DeleteEntryCalls gets all the calls that were made to DeleteEntry.
+// Check the length with:
+//
+//	len(mockedAccessControlListEntryService.DeleteEntryCalls())
+func (mock *AccessControlListEntryServiceMock) DeleteEntryCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockDeleteEntry.RLock()
+	calls = mock.calls.DeleteEntry
+	mock.lockDeleteEntry.RUnlock()
+	return calls
+}
+
+// ListEntries calls ListEntriesFunc.
+func (mock *AccessControlListEntryServiceMock) ListEntries(entryType api.AccessControlListEntryType) (api.AccessControlListEntryList, *errors.ServiceError) {
+	if mock.ListEntriesFunc == nil {
+		panic("AccessControlListEntryServiceMock.ListEntriesFunc: method is nil but AccessControlListEntryService.ListEntries was just called")
+	}
+	callInfo := struct {
+		EntryType api.AccessControlListEntryType
+	}{
+		EntryType: entryType,
+	}
+	mock.lockListEntries.Lock()
+	mock.calls.ListEntries = append(mock.calls.ListEntries, callInfo)
+	mock.lockListEntries.Unlock()
+	return mock.ListEntriesFunc(entryType)
+}
+
+// ListEntriesCalls gets all the calls that were made to ListEntries.
+// Check the length with:
+//
+//	len(mockedAccessControlListEntryService.ListEntriesCalls())
+func (mock *AccessControlListEntryServiceMock) ListEntriesCalls() []struct {
+	EntryType api.AccessControlListEntryType
+} {
+	var calls []struct {
+		EntryType api.AccessControlListEntryType
+	}
+	mock.lockListEntries.RLock()
+	calls = mock.calls.ListEntries
+	mock.lockListEntries.RUnlock()
+	return calls
+}
+
+// Reload calls ReloadFunc.
+func (mock *AccessControlListEntryServiceMock) Reload() *errors.ServiceError {
+	if mock.ReloadFunc == nil {
+		panic("AccessControlListEntryServiceMock.ReloadFunc: method is nil but AccessControlListEntryService.Reload was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockReload.Lock()
+	mock.calls.Reload = append(mock.calls.Reload, callInfo)
+	mock.lockReload.Unlock()
+	return mock.ReloadFunc()
+}
+
+// ReloadCalls gets all the calls that were made to Reload.
+// Check the length with:
+//
+//	len(mockedAccessControlListEntryService.ReloadCalls())
+func (mock *AccessControlListEntryServiceMock) ReloadCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockReload.RLock()
+	calls = mock.calls.Reload
+	mock.lockReload.RUnlock()
+	return calls
+}
@@ -0,0 +1,149 @@
+package acl
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+	"gorm.io/gorm"
+)
+
+func Test_accessControlListEntryService_CreateEntry(t *testing.T) {
+	tests := []struct {
+		name             string
+		insertErr        error
+		wantErrCode      errors.ServiceErrorCode
+		wantPublishCalls int
+	}{
+		{
+			name:             "should create the entry and publish the change",
+			wantPublishCalls: 1,
+		},
+		{
+			name:        "should fail with a conflict when the value is already in the list",
+			insertErr:   fmt.Errorf(`duplicate key value violates unique constraint "uix_access_control_list_entries_type_value"`),
+			wantErrCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "access_control_list_entries"`)
+			if tt.insertErr != nil {
+				insert.WithError(tt.insertErr)
+			}
+			outbox := &signalbus.OutboxMock{
+				PublishFunc: func(dbConn *gorm.DB, event *api.OutboxEvent) *errors.ServiceError {
+					g.Expect(event.EventType).To(gomega.Equal(AccessControlListChangedSignal))
+					return nil
+				},
+			}
+
+			s := NewAccessControlListEntryService(db.NewMockConnectionFactory(nil), &AccessControlListConfig{}, signalbus.NewSignalBus(), outbox)
+			err := s.CreateEntry(&api.AccessControlListEntry{Type: api.AccessControlListEntryTypeDeniedUser, Value: "denied-user"})
+			if tt.wantErrCode != 0 {
+				g.Expect(err).NotTo(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+			}
+			g.Expect(outbox.PublishCalls()).To(gomega.HaveLen(tt.wantPublishCalls))
+		})
+	}
+}
+
+func Test_accessControlListEntryService_Reload(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupFn        func()
+		wantErr        bool
+		wantDenyList   DeniedUsers
+		wantAccessList AcceptedOrganisations
+	}{
+		{
+			name: "should replace the lists by the entries stored in the database",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "access_control_list_entries"`).
+					WithReply([]map[string]interface{}{
+						{"id": "1", "type": "denied_user", "value": "denied-user"},
+						{"id": "2", "type": "accepted_organisation", "value": "org-id"},
+					})
+			},
+			wantDenyList:   DeniedUsers{"denied-user"},
+			wantAccessList: AcceptedOrganisations{"org-id"},
+		},
+		{
+			name: "should keep the lists when the entries cannot be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "access_control_list_entries"`).WithQueryException()
+			},
+			wantErr:        true,
+			wantDenyList:   DeniedUsers{"file-user"},
+			wantAccessList: AcceptedOrganisations{"file-org-id"},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			config := &AccessControlListConfig{DenyList: DeniedUsers{"file-user"}, AccessList: AcceptedOrganisations{"file-org-id"}}
+			s := NewAccessControlListEntryService(db.NewMockConnectionFactory(nil), config, signalbus.NewSignalBus(), &signalbus.OutboxMock{})
+			err := s.Reload()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(config.GetDenyList()).To(gomega.Equal(tt.wantDenyList))
+			g.Expect(config.GetAccessList()).To(gomega.Equal(tt.wantAccessList))
+		})
+	}
+}
+
+func Test_accessControlListEntryService_seed(t *testing.T) {
+	tests := []struct {
+		name         string
+		storedCount  int
+		wantInserted bool
+	}{
+		{
+			name:         "should seed the deny list with the configuration file when it has never been stored",
+			storedCount:  0,
+			wantInserted: true,
+		},
+		{
+			name:         "should not seed the deny list once it has been stored",
+			storedCount:  1,
+			wantInserted: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var inserted bool
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT count(1) FROM "access_control_list_entries" WHERE type = $1`).
+				WithArgs(api.AccessControlListEntryTypeDeniedUser.String()).
+				WithReply([]map[string]interface{}{{"count": tt.storedCount}})
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "access_control_list_entries"`).WithCallback(func(s string, nv []driver.NamedValue) {
+				inserted = true
+			})
+
+			config := &AccessControlListConfig{EnableDenyList: true, DenyList: DeniedUsers{"file-user"}}
+			s := NewAccessControlListEntryService(db.NewMockConnectionFactory(nil), config, signalbus.NewSignalBus(), &signalbus.OutboxMock{})
+			g.Expect(s.seed()).To(gomega.Succeed())
+			g.Expect(inserted).To(gomega.Equal(tt.wantInserted))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"gorm.io/gorm"
+)
+
+// AccessControlListEntryType is the access control list an entry belongs to
+type AccessControlListEntryType string
+
+const (
+	// AccessControlListEntryTypeDeniedUser entries hold the username of a user denied access to the service
+	AccessControlListEntryTypeDeniedUser AccessControlListEntryType = "denied_user"
+	// AccessControlListEntryTypeAcceptedOrganisation entries hold the id of an organisation allowed to access
+	// the service when the access list is enabled, i.e. during a service maintenance
+	AccessControlListEntryTypeAcceptedOrganisation AccessControlListEntryType = "accepted_organisation"
+)
+
+var ValidAccessControlListEntryTypes = []AccessControlListEntryType{
+	AccessControlListEntryTypeDeniedUser,
+	AccessControlListEntryTypeAcceptedOrganisation,
+}
+
+func (t AccessControlListEntryType) String() string {
+	return string(t)
+}
+
+func (t AccessControlListEntryType) IsValid() bool {
+	return arrays.Contains(ValidAccessControlListEntryTypes, t)
+}
+
+// AccessControlListEntry is an entry of the deny list of users or of the access list of organisations
+type AccessControlListEntry struct {
+	Meta
+	Type AccessControlListEntryType `gorm:"index"`
+	// Value is the username of the denied user or the id of the accepted organisation
+	Value     string
+	Reason    string
+	CreatedBy string
+}
+
+type AccessControlListEntryList []*AccessControlListEntry
+
+func (entry *AccessControlListEntry) BeforeCreate(tx *gorm.DB) error {
+	if entry.ID == "" {
+		entry.ID = NewID()
+	}
+	return nil
+}
+
+// QuotaManagementListEntryType is the kind of account an entry of the quota management list grants quota to
+type QuotaManagementListEntryType string
+
+const (
+	// QuotaManagementListEntryTypeOrganisation entries grant quota to the registered users of an organisation
+	QuotaManagementListEntryTypeOrganisation QuotaManagementListEntryType = "organisation"
+	// QuotaManagementListEntryTypeServiceAccount entries grant quota to a service account
+	QuotaManagementListEntryTypeServiceAccount QuotaManagementListEntryType = "service_account"
+)
+
+var ValidQuotaManagementListEntryTypes = []QuotaManagementListEntryType{
+	QuotaManagementListEntryTypeOrganisation,
+	QuotaManagementListEntryTypeServiceAccount,
+}
+
+func (t QuotaManagementListEntryType) String() string {
+	return string(t)
+}
+
+func (t QuotaManagementListEntryType) IsValid() bool {
+	return arrays.Contains(ValidQuotaManagementListEntryTypes, t)
+}
+
+// QuotaManagementListEntry is an organisation or a service account of the quota management list
+type QuotaManagementListEntry struct {
+	Meta
+	Type QuotaManagementListEntryType `gorm:"index"`
+	// Key is the id of the organisation or the username of the service account
+	Key string
+	// Definition holds the JSON encoded organisation or service account, with the fields of the quota management list configuration file
+	Definition JSON
+	CreatedBy  string
+}
+
+type QuotaManagementListEntryList []*QuotaManagementListEntry
+
+func (entry *QuotaManagementListEntry) BeforeCreate(tx *gorm.DB) error {
+	if entry.ID == "" {
+		entry.ID = NewID()
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"gorm.io/gorm"
+)
+
+// AuditEvent is a persisted record of a mutating request made against the admin or public APIs
+type AuditEvent struct {
+	Meta
+	Actor          string `gorm:"index"`
+	OrganisationId string `gorm:"index"`
+	// Action is the event type of the route that handled the request, e.g. "update-kafka-by-id"
+	Action      string `gorm:"index"`
+	ResourceId  string `gorm:"index"`
+	Method      string
+	RequestPath string
+	// RequestDiff holds the JSON body sent with the request, if any, with the values of its secret fields redacted
+	RequestDiff JSON
+	StatusCode  int
+	OperationId string
+}
+
+type AuditEventList []*AuditEvent
+
+func (auditEvent *AuditEvent) BeforeCreate(tx *gorm.DB) error {
+	if auditEvent.ID == "" {
+		auditEvent.ID = NewID()
+	}
+	return nil
+}
@@ -79,6 +79,8 @@ func (p *ClusterProviderType) UnmarshalYAML(unmarshal func(interface{}) error) e
 		*p = ClusterProviderAwsEKS
 	case ClusterProviderStandalone.String():
 		*p = ClusterProviderStandalone
+	case ClusterProviderKubernetes.String():
+		*p = ClusterProviderKubernetes
 	default:
 		return errors.Errorf("invalid value %s", s)
 	}
@@ -108,6 +110,7 @@ const (
 	ClusterProviderOCM        ClusterProviderType = "ocm"
 	ClusterProviderAwsEKS     ClusterProviderType = "aws_eks"
 	ClusterProviderStandalone ClusterProviderType = "standalone"
+	ClusterProviderKubernetes ClusterProviderType = "kubernetes"
 
 	EnterpriseDataPlaneClusterType DataPlaneClusterType = "enterprise"
 	ManagedDataPlaneClusterType    DataPlaneClusterType = "managed"
@@ -180,6 +183,11 @@ type Cluster struct {
 
 	// AccessKafkasViaPrivateNetwork indicates whether Kafkas deployed on this OSD cluster have to be accessed via private network
 	AccessKafkasViaPrivateNetwork bool `json:"access_kafkas_via_private_network"`
+
+	// Cordoned indicates whether the cluster has been cordoned by an administrator.
+	// No new Kafka instance is placed on a cordoned cluster and its capacity is not counted when evaluating the dynamic scale up,
+	// but the Kafka instances it already hosts keep being served.
+	Cordoned bool `json:"cordoned"`
 }
 
 type ClusterList []*Cluster
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// IdempotencyKey is the key sent by a client in the Idempotency-Key header of a create request, along with the response
+// of the request once it has been handled, so that a retry of the request with the same key gets the same response
+// instead of creating the resource again. StatusCode is 0 while the request is being handled.
+type IdempotencyKey struct {
+	ID             string `gorm:"primaryKey"`
+	Key            string
+	Owner          string
+	OrganisationId string
+	Route          string
+	// RequestHash is the checksum of the request body, so that a key can not be reused for a different request
+	RequestHash  string
+	StatusCode   int
+	ResourceId   string
+	ResponseBody []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time `gorm:"index"`
+}
+
+func (k *IdempotencyKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = NewID()
+	}
+	return nil
+}
+
+// IsInProgress returns whether the request of the key is still being handled
+func (k *IdempotencyKey) IsInProgress() bool {
+	return k.StatusCode == 0
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"time"
+)
+
+// OutboxEvent is a typed event recorded in the same database transaction as the state change it describes,
+// so that it is durable if and only if the change is committed.
+type OutboxEvent struct {
+	// Sequence is assigned by the database and is the cursor the consumers of the outbox resume from
+	Sequence        int64  `gorm:"primaryKey;type:bigserial"`
+	EventType       string `gorm:"index"`
+	ResourceId      string `gorm:"index"`
+	ResourceVersion int64
+	Payload         JSON      `gorm:"type:jsonb"`
+	CreatedAt       time.Time `gorm:"index"`
+	// TransactionId is the id of the transaction that recorded the event, it is assigned by the database
+	TransactionId int64 `gorm:"->"`
+}
+
+type OutboxEventList []*OutboxEvent
+
+// OutboxCursor is the transaction id and the sequence of the last outbox event handled by a consumer of the outbox
+type OutboxCursor struct {
+	Consumer      string `gorm:"primaryKey"`
+	TransactionId int64
+	Sequence      int64
+	UpdatedAt     time.Time
+}
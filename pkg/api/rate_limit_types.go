@@ -0,0 +1,33 @@
+package api
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RateLimitCounter counts the requests sent by a subject, an organisation or a user, to a route during the window starting at WindowStart
+type RateLimitCounter struct {
+	Subject     string    `gorm:"primaryKey"`
+	Route       string    `gorm:"primaryKey"`
+	WindowStart time.Time `gorm:"primaryKey"`
+	Count       int
+	// ExpiresAt is the end of the window, the counter can be deleted afterwards
+	ExpiresAt time.Time `gorm:"index"`
+}
+
+// RateLimitLease is held by a request of a subject to a route while it is handled, so that the concurrent requests
+// can be counted across replicas. The lease of a request whose replica stopped before releasing it expires at ExpiresAt.
+type RateLimitLease struct {
+	ID        string    `gorm:"primaryKey"`
+	Subject   string    `gorm:"index:idx_rate_limit_leases_subject_route"`
+	Route     string    `gorm:"index:idx_rate_limit_leases_subject_route"`
+	ExpiresAt time.Time `gorm:"index"`
+}
+
+func (lease *RateLimitLease) BeforeCreate(tx *gorm.DB) error {
+	if lease.ID == "" {
+		lease.ID = NewID()
+	}
+	return nil
+}
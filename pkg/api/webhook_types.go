@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"gorm.io/gorm"
+)
+
+// WebhookSubscription is the subscription of an organisation to the lifecycle events of its resources
+type WebhookSubscription struct {
+	Meta
+	OrganisationId string `gorm:"index"`
+	Owner          string
+	Url            string
+	// EventTypes holds the list of event types the subscription is notified of
+	EventTypes JSON
+	// Secret is the key used to sign the HMAC-SHA256 signature of the payloads sent to the subscription.
+	// It is never serialised, so that it is not returned nor logged
+	Secret string `json:"-"`
+}
+
+type WebhookSubscriptionList []*WebhookSubscription
+
+func (subscription *WebhookSubscription) BeforeCreate(tx *gorm.DB) error {
+	if subscription.ID == "" {
+		subscription.ID = NewID()
+	}
+	return nil
+}
+
+func (subscription *WebhookSubscription) GetEventTypes() ([]string, error) {
+	var eventTypes []string
+	if len(subscription.EventTypes) == 0 {
+		return eventTypes, nil
+	}
+	if err := json.Unmarshal(subscription.EventTypes, &eventTypes); err != nil {
+		return nil, err
+	}
+	return eventTypes, nil
+}
+
+func (subscription *WebhookSubscription) SetEventTypes(eventTypes []string) error {
+	r, err := json.Marshal(eventTypes)
+	if err != nil {
+		return err
+	}
+	subscription.EventTypes = r
+	return nil
+}
+
+// Subscribes returns whether the subscription is notified of the given event type
+func (subscription *WebhookSubscription) Subscribes(eventType string) bool {
+	eventTypes, err := subscription.GetEventTypes()
+	if err != nil {
+		return false
+	}
+	return arrays.Contains(eventTypes, eventType)
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery is the outbox entry of an event to be sent to a webhook subscription
+type WebhookDelivery struct {
+	Meta
+	SubscriptionId string `gorm:"index"`
+	EventType      string `gorm:"index"`
+	ResourceId     string `gorm:"index"`
+	Payload        JSON
+	Status         WebhookDeliveryStatus `gorm:"index"`
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+}
+
+type WebhookDeliveryList []*WebhookDelivery
+
+func (delivery *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if delivery.ID == "" {
+		delivery.ID = NewID()
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/gorilla/mux"
+)
+
+var auditedMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+type AuditEventMiddleware interface {
+	// RecordMutations persists an audit event for every mutating request handled by the router it is applied to.
+	// The values of the secret fields of the request body are redacted.
+	RecordMutations(next http.Handler) http.Handler
+	// RecordMutationFieldNames persists an audit event for every mutating request handled by the router it is applied to,
+	// with all the values of the request body redacted so that only the names of its fields are recorded. It is used on the
+	// routes whose request bodies carry user defined secrets, e.g. the connector specs, that can not be told apart by their
+	// field names.
+	RecordMutationFieldNames(next http.Handler) http.Handler
+}
+
+type auditEventMiddleware struct {
+	auditEventService audit.AuditEventService
+}
+
+var _ AuditEventMiddleware = &auditEventMiddleware{}
+
+func NewAuditEventMiddleware(auditEventService audit.AuditEventService) AuditEventMiddleware {
+	return &auditEventMiddleware{
+		auditEventService: auditEventService,
+	}
+}
+
+// recordingResponseWriter keeps track of the response status code and body so that they can be used once the request has been handled
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (writer *recordingResponseWriter) Write(body []byte) (int, error) {
+	writer.body.Write(body)
+	return writer.ResponseWriter.Write(body)
+}
+
+func (writer *recordingResponseWriter) WriteHeader(statusCode int) {
+	writer.statusCode = statusCode
+	writer.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (writer *recordingResponseWriter) Flush() {
+	if flusher, ok := writer.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (a *auditEventMiddleware) RecordMutations(next http.Handler) http.Handler {
+	return a.recordMutations(next, RedactSecrets)
+}
+
+func (a *auditEventMiddleware) RecordMutationFieldNames(next http.Handler) http.Handler {
+	return a.recordMutations(next, RedactValues)
+}
+
+// recordMutations records the audit events of the mutating requests, with the request diff returned by toRequestDiff
+// from the request body. The request diff is not recorded when toRequestDiff returns nil.
+func (a *auditEventMiddleware) recordMutations(next http.Handler, toRequestDiff func(body []byte) []byte) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if !arrays.Contains(auditedMethods, request.Method) {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		var requestBody []byte
+		if request.Body != nil {
+			requestBody, _ = io.ReadAll(request.Body)
+			_ = request.Body.Close()
+			request.Body = io.NopCloser(bytes.NewReader(requestBody))
+		}
+
+		auditWriter := &recordingResponseWriter{ResponseWriter: writer}
+		next.ServeHTTP(auditWriter, request)
+
+		auditEvent := &api.AuditEvent{
+			Action:      getRouteAction(request),
+			ResourceId:  getResourceId(request, auditWriter.body.Bytes()),
+			Method:      request.Method,
+			RequestPath: request.URL.Path,
+			StatusCode:  auditWriter.statusCode,
+			OperationId: logger.GetOperationID(request.Context()),
+		}
+		if auditEvent.StatusCode == 0 {
+			// the status code defaults to 200 when the handler writes the body without writing a header first
+			auditEvent.StatusCode = http.StatusOK
+		}
+		if requestDiff := toRequestDiff(requestBody); requestDiff != nil {
+			auditEvent.RequestDiff = api.JSON(requestDiff)
+		}
+		if claims, err := GetClaimsFromContext(request.Context()); err == nil {
+			auditEvent.Actor, _ = claims.GetUsername()
+			auditEvent.OrganisationId, _ = claims.GetOrgId()
+		}
+
+		// the response has already been returned, so failing to record the event is only logged
+		if err := a.auditEventService.Create(auditEvent); err != nil {
+			logger.NewUHCLogger(request.Context()).Errorf("failed to record audit event for %s %s: %s", request.Method, request.URL.Path, err.Error())
+		}
+	})
+}
+
+// getRouteAction returns the event type of the route's log event name, falling back to the method and path template for unnamed routes
+func getRouteAction(request *http.Request) string {
+	route := mux.CurrentRoute(request)
+	if route == nil {
+		return request.Method
+	}
+	if action := logger.NewLogEventFromString(route.GetName()).Type; action != "" {
+		return action
+	}
+	pathTemplate, _ := route.GetPathTemplate()
+	return fmt.Sprintf("%s %s", request.Method, pathTemplate)
+}
+
+// getResourceId returns the "id" path variable, or the last path variable of the route.
+// When the route has no path variables, e.g. on create, the id of the returned resource is used instead.
+func getResourceId(request *http.Request, responseBody []byte) string {
+	vars := mux.Vars(request)
+	if id, ok := vars["id"]; ok {
+		return id
+	}
+	if route := mux.CurrentRoute(request); route != nil {
+		pathTemplate, _ := route.GetPathTemplate()
+		if start := strings.LastIndex(pathTemplate, "{"); start >= 0 {
+			if end := strings.Index(pathTemplate[start:], "}"); end > 0 {
+				// path variables may carry a pattern, e.g. {id:[0-9]+}
+				name := strings.SplitN(pathTemplate[start+1:start+end], ":", 2)[0]
+				return vars[name]
+			}
+		}
+	}
+	var resource struct {
+		Id string `json:"id"`
+	}
+	if err := json.Unmarshal(responseBody, &resource); err == nil {
+		return resource.Id
+	}
+	return ""
+}
@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func TestAuditEventMiddleware_RecordMutations(t *testing.T) {
+	type route struct {
+		path    string
+		name    string
+		handler http.HandlerFunc
+	}
+
+	okHandler := func(writer http.ResponseWriter, request *http.Request) {
+		shared.WriteJSONResponse(writer, http.StatusAccepted, map[string]string{"id": "created-id"})
+	}
+
+	tests := []struct {
+		name            string
+		route           route
+		method          string
+		url             string
+		body            string
+		fieldNamesOnly  bool
+		createErr       *errors.ServiceError
+		wantCode        int
+		wantRecorded    bool
+		wantAuditEvent  api.AuditEvent
+		wantRequestDiff string
+	}{
+		{
+			name:         "should not record read only requests",
+			route:        route{path: "/kafkas/{id}", name: logger.NewLogEvent("get-kafka", "get a kafka").ToString(), handler: okHandler},
+			method:       http.MethodGet,
+			url:          "/kafkas/kafka-id",
+			wantCode:     http.StatusAccepted,
+			wantRecorded: false,
+		},
+		{
+			name:         "should record the action from the route name and the id path variable",
+			route:        route{path: "/kafkas/{id}", name: logger.NewLogEvent("update-kafka-by-id", "update a kafka").ToString(), handler: okHandler},
+			method:       http.MethodPatch,
+			url:          "/kafkas/kafka-id",
+			body:         `{"suspended":true}`,
+			wantCode:     http.StatusAccepted,
+			wantRecorded: true,
+			wantAuditEvent: api.AuditEvent{
+				Actor:          "test-user",
+				OrganisationId: "test-org",
+				Action:         "update-kafka-by-id",
+				ResourceId:     "kafka-id",
+				Method:         http.MethodPatch,
+				RequestPath:    "/kafkas/kafka-id",
+				StatusCode:     http.StatusAccepted,
+			},
+			wantRequestDiff: `{"suspended":true}`,
+		},
+		{
+			name:         "should fall back to the method and path template and the last path variable for unnamed routes",
+			route:        route{path: "/clusters/{cluster_id}/deployments/{deployment_id}", handler: okHandler},
+			method:       http.MethodDelete,
+			url:          "/clusters/cluster-id/deployments/deployment-id",
+			wantCode:     http.StatusAccepted,
+			wantRecorded: true,
+			wantAuditEvent: api.AuditEvent{
+				Actor:          "test-user",
+				OrganisationId: "test-org",
+				Action:         "DELETE /clusters/{cluster_id}/deployments/{deployment_id}",
+				ResourceId:     "deployment-id",
+				Method:         http.MethodDelete,
+				RequestPath:    "/clusters/cluster-id/deployments/deployment-id",
+				StatusCode:     http.StatusAccepted,
+			},
+		},
+		{
+			name:         "should use the id of the returned resource when the route has no path variable",
+			route:        route{path: "/kafkas", name: logger.NewLogEvent("create-kafka", "create a kafka").ToString(), handler: okHandler},
+			method:       http.MethodPost,
+			url:          "/kafkas",
+			body:         `{"name":"test"}`,
+			wantCode:     http.StatusAccepted,
+			wantRecorded: true,
+			wantAuditEvent: api.AuditEvent{
+				Actor:          "test-user",
+				OrganisationId: "test-org",
+				Action:         "create-kafka",
+				ResourceId:     "created-id",
+				Method:         http.MethodPost,
+				RequestPath:    "/kafkas",
+				StatusCode:     http.StatusAccepted,
+			},
+			wantRequestDiff: `{"name":"test"}`,
+		},
+		{
+			name:         "should redact the webhook secret from the request diff",
+			route:        route{path: "/webhook_subscriptions", name: logger.NewLogEvent("create-webhook-subscription", "create a webhook subscription").ToString(), handler: okHandler},
+			method:       http.MethodPost,
+			url:          "/webhook_subscriptions",
+			body:         `{"url":"https://example.com/hook","event_types":["kafka.ready"],"secret":"my-webhook-secret-value"}`,
+			wantCode:     http.StatusAccepted,
+			wantRecorded: true,
+			wantAuditEvent: api.AuditEvent{
+				Actor:          "test-user",
+				OrganisationId: "test-org",
+				Action:         "create-webhook-subscription",
+				ResourceId:     "created-id",
+				Method:         http.MethodPost,
+				RequestPath:    "/webhook_subscriptions",
+				StatusCode:     http.StatusAccepted,
+			},
+			wantRequestDiff: `{"event_types":["kafka.ready"],"secret":"REDACTED","url":"https://example.com/hook"}`,
+		},
+		{
+			name:           "should only record the field names of the request body on the routes carrying user defined secrets",
+			route:          route{path: "/kafka_connectors/{connector_id}", name: logger.NewLogEvent("update-connector", "update a connector").ToString(), handler: okHandler},
+			method:         http.MethodPatch,
+			url:            "/kafka_connectors/connector-id",
+			body:           `{"connector":{"db_host":"db","db_pass":"p4ss"},"service_account":{"client_id":"id","client_secret":"s3cret"}}`,
+			fieldNamesOnly: true,
+			wantCode:       http.StatusAccepted,
+			wantRecorded:   true,
+			wantAuditEvent: api.AuditEvent{
+				Actor:          "test-user",
+				OrganisationId: "test-org",
+				Action:         "update-connector",
+				ResourceId:     "connector-id",
+				Method:         http.MethodPatch,
+				RequestPath:    "/kafka_connectors/connector-id",
+				StatusCode:     http.StatusAccepted,
+			},
+			wantRequestDiff: `{"connector":{"db_host":"REDACTED","db_pass":"REDACTED"},"service_account":{"client_id":"REDACTED","client_secret":"REDACTED"}}`,
+		},
+		{
+			name:      "should not change the response when the audit event cannot be recorded",
+			route:     route{path: "/kafkas/{id}", name: logger.NewLogEvent("delete-kafka", "delete a kafka").ToString(), handler: okHandler},
+			method:    http.MethodDelete,
+			url:       "/kafkas/kafka-id",
+			createErr: errors.GeneralError("db down"),
+			wantCode:  http.StatusAccepted,
+			// the event is still handed over to the service
+			wantRecorded: true,
+			wantAuditEvent: api.AuditEvent{
+				Actor:          "test-user",
+				OrganisationId: "test-org",
+				Action:         "delete-kafka",
+				ResourceId:     "kafka-id",
+				Method:         http.MethodDelete,
+				RequestPath:    "/kafkas/kafka-id",
+				StatusCode:     http.StatusAccepted,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var recorded []*api.AuditEvent
+			auditEventService := &audit.AuditEventServiceMock{
+				CreateFunc: func(auditEvent *api.AuditEvent) *errors.ServiceError {
+					recorded = append(recorded, auditEvent)
+					return tt.createErr
+				},
+			}
+
+			router := mux.NewRouter()
+			router.HandleFunc(tt.route.path, tt.route.handler).Name(tt.route.name)
+			if tt.fieldNamesOnly {
+				router.Use(NewAuditEventMiddleware(auditEventService).RecordMutationFieldNames)
+			} else {
+				router.Use(NewAuditEventMiddleware(auditEventService).RecordMutations)
+			}
+			token := &jwt.Token{Claims: jwt.MapClaims{
+				"username": "test-user",
+				"org_id":   "test-org",
+			}}
+			toTest := setContextToken(router, token)
+
+			req := httptest.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+			toTest.ServeHTTP(recorder, req)
+			resp := recorder.Result()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantCode))
+			_ = resp.Body.Close()
+
+			if !tt.wantRecorded {
+				g.Expect(recorded).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(recorded).To(gomega.HaveLen(1))
+			g.Expect(string(recorded[0].RequestDiff)).To(gomega.Equal(tt.wantRequestDiff))
+			g.Expect(string(recorded[0].RequestDiff)).ToNot(gomega.ContainSubstring("secret-value"))
+			recorded[0].RequestDiff = nil
+			g.Expect(*recorded[0]).To(gomega.Equal(tt.wantAuditEvent))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+)
+
+const (
+	// IdempotencyKeyHeader is the header in which the clients send the key identifying a create request and its retries
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on the responses recorded for a previous request with the same idempotency key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+type IdempotencyMiddleware interface {
+	// Idempotent returns the recorded response of the previous request sent by the same user to the same route with the same
+	// Idempotency-Key header, instead of handling the request again. The requests without the header are handled as usual.
+	Idempotent(next http.Handler) http.Handler
+}
+
+type idempotencyMiddleware struct {
+	idempotencyKeyService idempotency.IdempotencyKeyService
+}
+
+var _ IdempotencyMiddleware = &idempotencyMiddleware{}
+
+func NewIdempotencyMiddleware(idempotencyKeyService idempotency.IdempotencyKeyService) IdempotencyMiddleware {
+	return &idempotencyMiddleware{
+		idempotencyKeyService: idempotencyKeyService,
+	}
+}
+
+func (m *idempotencyMiddleware) Idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		keyValue := request.Header.Get(IdempotencyKeyHeader)
+		if keyValue == "" {
+			next.ServeHTTP(writer, request)
+			return
+		}
+		if len(keyValue) > maxIdempotencyKeyLength {
+			shared.HandleError(request, writer, errors.BadRequest("the %s header can not be longer than %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength))
+			return
+		}
+
+		// the unauthenticated requests are rejected by the authentication middlewares
+		claims, err := GetClaimsFromContext(request.Context())
+		if err != nil {
+			next.ServeHTTP(writer, request)
+			return
+		}
+		owner, _ := claims.GetUsername()
+		if owner == "" {
+			next.ServeHTTP(writer, request)
+			return
+		}
+		orgId, _ := claims.GetOrgId()
+
+		var requestBody []byte
+		if request.Body != nil {
+			requestBody, _ = io.ReadAll(request.Body)
+			_ = request.Body.Close()
+			request.Body = io.NopCloser(bytes.NewReader(requestBody))
+		}
+		requestHash := sha256.Sum256(requestBody)
+
+		key := &api.IdempotencyKey{
+			Key:            keyValue,
+			Owner:          owner,
+			OrganisationId: orgId,
+			Route:          getRouteAction(request),
+			RequestHash:    hex.EncodeToString(requestHash[:]),
+		}
+		existing, svcErr := m.idempotencyKeyService.Begin(key)
+		if svcErr != nil {
+			shared.HandleError(request, writer, svcErr)
+			return
+		}
+		if existing != nil {
+			replayRequest(writer, request, key, existing)
+			return
+		}
+
+		ulog := logger.NewUHCLogger(request.Context())
+		succeeded := false
+		defer func() {
+			if succeeded {
+				return
+			}
+			// the request failed, or panicked, so it can be retried with the same key
+			if err := m.idempotencyKeyService.Release(key); err != nil {
+				ulog.Errorf("failed to release idempotency key %q: %s", key.Key, err.Error())
+			}
+		}()
+
+		recorder := &recordingResponseWriter{ResponseWriter: writer}
+		next.ServeHTTP(recorder, request)
+
+		statusCode := recorder.statusCode
+		if statusCode == 0 {
+			// the status code defaults to 200 when the handler writes the body without writing a header first
+			statusCode = http.StatusOK
+		}
+		if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+			return
+		}
+		succeeded = true
+
+		key.StatusCode = statusCode
+		key.ResourceId = getResourceId(request, recorder.body.Bytes())
+		// the secrets of the response, e.g. the client secret of a created service account, are not stored so they are
+		// not returned to the retries
+		key.ResponseBody = RedactSecrets(recorder.body.Bytes())
+		// the response has already been returned, so failing to record it is only logged
+		if err := m.idempotencyKeyService.Complete(key); err != nil {
+			ulog.Errorf("failed to record the response of idempotency key %q: %s", key.Key, err.Error())
+		}
+	})
+}
+
+// replayRequest returns the response recorded for the existing key, unless the key was used for a different request
+// or the request of the key is still being handled
+func replayRequest(writer http.ResponseWriter, request *http.Request, key *api.IdempotencyKey, existing *api.IdempotencyKey) {
+	if existing.RequestHash != key.RequestHash {
+		shared.HandleError(request, writer, errors.BadRequest("the idempotency key %q has already been used for a different request", key.Key))
+		return
+	}
+	if existing.IsInProgress() {
+		shared.HandleError(request, writer, errors.Conflict("the request with idempotency key %q is still being handled", key.Key))
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set(IdempotentReplayedHeader, "true")
+	writer.WriteHeader(existing.StatusCode)
+	_, _ = writer.Write(existing.ResponseBody)
+}
@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func TestIdempotencyMiddleware_Idempotent(t *testing.T) {
+	const requestBody = `{"name":"test"}`
+	// the sha256 checksum of {"name":"other"}
+	const otherRequestHash = "feb03dfc47835f1e0f3998c544c441e879a2e29b49743eaf2065a9cd2d15bc39"
+
+	tests := []struct {
+		name        string
+		key         string
+		handlerCode int
+		handlerBody map[string]string
+		existing    func(key *api.IdempotencyKey) *api.IdempotencyKey
+		beginErr    *errors.ServiceError
+		wantCode    int
+		wantBody    string
+		// wantRecordedBody is the response body recorded with the key, when it differs from the returned body
+		wantRecordedBody string
+		wantReplayed     bool
+		wantHandled      bool
+		wantBegun        bool
+		wantCompleted    bool
+		wantReleased     bool
+		wantResourceId   string
+	}{
+		{
+			name:        "should handle the requests without an idempotency key as usual",
+			handlerCode: http.StatusAccepted,
+			wantCode:    http.StatusAccepted,
+			wantBody:    `{"id":"created-id"}`,
+			wantHandled: true,
+		},
+		{
+			name:     "should reject an idempotency key that is too long",
+			key:      strings.Repeat("k", maxIdempotencyKeyLength+1),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should record the response of the first request with a key",
+			key:            "test-key",
+			handlerCode:    http.StatusAccepted,
+			wantCode:       http.StatusAccepted,
+			wantBody:       `{"id":"created-id"}`,
+			wantHandled:    true,
+			wantBegun:      true,
+			wantCompleted:  true,
+			wantResourceId: "created-id",
+		},
+		{
+			name:             "should not record the secrets of the response",
+			key:              "test-key",
+			handlerCode:      http.StatusAccepted,
+			handlerBody:      map[string]string{"id": "created-id", "clientSecret": "test-secret"},
+			wantCode:         http.StatusAccepted,
+			wantBody:         `{"id":"created-id","clientSecret":"test-secret"}`,
+			wantRecordedBody: `{"id":"created-id","clientSecret":"REDACTED"}`,
+			wantHandled:      true,
+			wantBegun:        true,
+			wantCompleted:    true,
+			wantResourceId:   "created-id",
+		},
+		{
+			name:         "should release the key when the request fails so that it can be retried",
+			key:          "test-key",
+			handlerCode:  http.StatusBadRequest,
+			wantCode:     http.StatusBadRequest,
+			wantBody:     `{"id":"created-id"}`,
+			wantHandled:  true,
+			wantBegun:    true,
+			wantReleased: true,
+		},
+		{
+			name: "should return the recorded response when the key has already been used for the same request",
+			key:  "test-key",
+			existing: func(key *api.IdempotencyKey) *api.IdempotencyKey {
+				return &api.IdempotencyKey{Key: key.Key, RequestHash: key.RequestHash, StatusCode: http.StatusAccepted, ResponseBody: []byte(`{"id":"first-id"}`)}
+			},
+			wantCode:     http.StatusAccepted,
+			wantBody:     `{"id":"first-id"}`,
+			wantReplayed: true,
+			wantBegun:    true,
+		},
+		{
+			name: "should reject the request when the key has already been used for a different request",
+			key:  "test-key",
+			existing: func(key *api.IdempotencyKey) *api.IdempotencyKey {
+				return &api.IdempotencyKey{Key: key.Key, RequestHash: otherRequestHash, StatusCode: http.StatusAccepted}
+			},
+			wantCode:  http.StatusBadRequest,
+			wantBegun: true,
+		},
+		{
+			name: "should reject the request while the request of the key is still being handled",
+			key:  "test-key",
+			existing: func(key *api.IdempotencyKey) *api.IdempotencyKey {
+				return &api.IdempotencyKey{Key: key.Key, RequestHash: key.RequestHash}
+			},
+			wantCode:  http.StatusConflict,
+			wantBegun: true,
+		},
+		{
+			name:      "should not handle the request when the key cannot be recorded",
+			key:       "test-key",
+			beginErr:  errors.GeneralError("db down"),
+			wantCode:  http.StatusInternalServerError,
+			wantBegun: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var completed *api.IdempotencyKey
+			idempotencyKeyService := &idempotency.IdempotencyKeyServiceMock{
+				BeginFunc: func(key *api.IdempotencyKey) (*api.IdempotencyKey, *errors.ServiceError) {
+					g.Expect(key.Key).To(gomega.Equal(tt.key))
+					g.Expect(key.Owner).To(gomega.Equal("test-user"))
+					g.Expect(key.OrganisationId).To(gomega.Equal("test-org"))
+					g.Expect(key.Route).To(gomega.Equal("create-kafka"))
+					g.Expect(key.RequestHash).To(gomega.HaveLen(64))
+					if tt.existing != nil {
+						return tt.existing(key), nil
+					}
+					return nil, tt.beginErr
+				},
+				CompleteFunc: func(key *api.IdempotencyKey) *errors.ServiceError {
+					completed = key
+					return nil
+				},
+				ReleaseFunc: func(key *api.IdempotencyKey) *errors.ServiceError {
+					return nil
+				},
+			}
+
+			handled := false
+			router := mux.NewRouter()
+			router.HandleFunc("/kafkas", func(writer http.ResponseWriter, request *http.Request) {
+				handled = true
+				body, _ := io.ReadAll(request.Body)
+				g.Expect(string(body)).To(gomega.Equal(requestBody))
+				handlerBody := tt.handlerBody
+				if handlerBody == nil {
+					handlerBody = map[string]string{"id": "created-id"}
+				}
+				shared.WriteJSONResponse(writer, tt.handlerCode, handlerBody)
+			}).Name(logger.NewLogEvent("create-kafka", "create a kafka").ToString())
+			router.Use(NewIdempotencyMiddleware(idempotencyKeyService).Idempotent)
+			token := &jwt.Token{Claims: jwt.MapClaims{
+				"username": "test-user",
+				"org_id":   "test-org",
+			}}
+			toTest := setContextToken(router, token)
+
+			req := httptest.NewRequest(http.MethodPost, "/kafkas", strings.NewReader(requestBody))
+			if tt.key != "" {
+				req.Header.Set(IdempotencyKeyHeader, tt.key)
+			}
+			recorder := httptest.NewRecorder()
+			toTest.ServeHTTP(recorder, req)
+			resp := recorder.Result()
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantCode))
+			if tt.wantBody != "" {
+				g.Expect(body).To(gomega.MatchJSON(tt.wantBody))
+			}
+			g.Expect(resp.Header.Get(IdempotentReplayedHeader) == "true").To(gomega.Equal(tt.wantReplayed))
+
+			g.Expect(handled).To(gomega.Equal(tt.wantHandled))
+			g.Expect(idempotencyKeyService.BeginCalls()).To(gomega.HaveLen(boolToCount(tt.wantBegun)))
+			g.Expect(idempotencyKeyService.CompleteCalls()).To(gomega.HaveLen(boolToCount(tt.wantCompleted)))
+			g.Expect(idempotencyKeyService.ReleaseCalls()).To(gomega.HaveLen(boolToCount(tt.wantReleased)))
+			if tt.wantCompleted {
+				g.Expect(completed.StatusCode).To(gomega.Equal(tt.handlerCode))
+				g.Expect(completed.ResourceId).To(gomega.Equal(tt.wantResourceId))
+				wantRecordedBody := tt.wantRecordedBody
+				if wantRecordedBody == "" {
+					wantRecordedBody = tt.wantBody
+				}
+				g.Expect(completed.ResponseBody).To(gomega.MatchJSON(wantRecordedBody))
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/ratelimit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/gorilla/mux"
+)
+
+// concurrentRequestsRetryAfter is the time the clients are asked to wait before retrying a request rejected by a concurrency quota
+const concurrentRequestsRetryAfter = time.Second
+
+type RateLimitMiddleware interface {
+	// RateLimit rejects the requests exceeding the rate limit or the concurrency quota of their route, counted per organisation
+	// or per user, with a rate limit exceeded error and a Retry-After header. The routes without a limit are not affected.
+	RateLimit(next http.Handler) http.Handler
+}
+
+type rateLimitMiddleware struct {
+	rateLimitConfig  *ratelimit.RateLimitConfig
+	rateLimitService ratelimit.RateLimitService
+}
+
+var _ RateLimitMiddleware = &rateLimitMiddleware{}
+
+func NewRateLimitMiddleware(rateLimitConfig *ratelimit.RateLimitConfig, rateLimitService ratelimit.RateLimitService) RateLimitMiddleware {
+	return &rateLimitMiddleware{
+		rateLimitConfig:  rateLimitConfig,
+		rateLimitService: rateLimitService,
+	}
+}
+
+func (m *rateLimitMiddleware) RateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if !m.rateLimitConfig.EnableRateLimiting {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		route := mux.CurrentRoute(request)
+		if route == nil {
+			next.ServeHTTP(writer, request)
+			return
+		}
+		limit, found := m.rateLimitConfig.GetRouteRateLimit(logger.NewLogEventFromString(route.GetName()).Type)
+		if !found {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		// the unauthenticated requests are rejected by the authentication middlewares
+		subject := getRateLimitSubject(request, limit.Per)
+		if subject == "" {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		// the limits are not enforced when the counters can not be updated, so that the API remains available
+		ulog := logger.NewUHCLogger(request.Context())
+		if limit.Requests > 0 {
+			retryAfter, err := m.rateLimitService.CountRequest(subject, limit)
+			if err != nil {
+				ulog.Errorf("failed to apply the rate limit of route %q: %s", limit.Route, err.Error())
+			} else if retryAfter > 0 {
+				rejectRequest(writer, request, retryAfter, errors.RateLimitExceeded("the rate limit of %d requests per %s of route %q has been exceeded by %s", limit.Requests, limit.Window, limit.Route, subject))
+				return
+			}
+		}
+
+		if limit.MaxConcurrentRequests > 0 {
+			leaseId, err := m.rateLimitService.AcquireLease(subject, limit)
+			if err != nil {
+				ulog.Errorf("failed to apply the concurrency quota of route %q: %s", limit.Route, err.Error())
+			} else if leaseId == "" {
+				rejectRequest(writer, request, concurrentRequestsRetryAfter, errors.RateLimitExceeded("the quota of %d concurrent requests of route %q has been reached by %s", limit.MaxConcurrentRequests, limit.Route, subject))
+				return
+			} else {
+				defer func() {
+					if err := m.rateLimitService.ReleaseLease(leaseId); err != nil {
+						ulog.Errorf("failed to release the concurrency quota lease of route %q: %s", limit.Route, err.Error())
+					}
+				}()
+			}
+		}
+
+		next.ServeHTTP(writer, request)
+	})
+}
+
+// getRateLimitSubject returns the organisation or the user the request is counted for, e.g. "organisation:13640203"
+func getRateLimitSubject(request *http.Request, per ratelimit.RateLimitSubjectType) string {
+	claims, err := GetClaimsFromContext(request.Context())
+	if err != nil {
+		return ""
+	}
+
+	var id string
+	if per == ratelimit.RateLimitPerUser {
+		id, _ = claims.GetUsername()
+	} else {
+		id, _ = claims.GetOrgId()
+	}
+	if id == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s", per, id)
+}
+
+// rejectRequest returns the error with the Retry-After header set to the given duration, rounded up to the second
+func rejectRequest(writer http.ResponseWriter, request *http.Request, retryAfter time.Duration, err *errors.ServiceError) {
+	writer.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	shared.HandleError(request, writer, err)
+}
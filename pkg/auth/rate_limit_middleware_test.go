@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/ratelimit"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func TestRateLimitMiddleware_RateLimit(t *testing.T) {
+	createKafkaLimit := ratelimit.RouteRateLimit{
+		Route:                 "create-kafka",
+		Per:                   ratelimit.RateLimitPerOrganisation,
+		Requests:              10,
+		Window:                time.Minute,
+		MaxConcurrentRequests: 2,
+	}
+	createServiceAccountLimit := ratelimit.RouteRateLimit{
+		Route:    "create-service-accounts",
+		Per:      ratelimit.RateLimitPerUser,
+		Requests: 10,
+		Window:   time.Minute,
+	}
+
+	tests := []struct {
+		name             string
+		enabled          bool
+		routeName        string
+		retryAfter       time.Duration
+		countErr         *errors.ServiceError
+		leaseId          string
+		acquireErr       *errors.ServiceError
+		wantCode         int
+		wantRetryAfter   string
+		wantSubject      string
+		wantCounted      bool
+		wantLeaseChecked bool
+		wantReleased     bool
+	}{
+		{
+			name:      "should not limit the requests when rate limiting is disabled",
+			enabled:   false,
+			routeName: "create-kafka",
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "should not limit the requests to a route without a limit",
+			enabled:   true,
+			routeName: "get-kafka",
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:             "should count the request per organisation and release its lease once handled",
+			enabled:          true,
+			routeName:        "create-kafka",
+			leaseId:          "lease-id",
+			wantCode:         http.StatusOK,
+			wantSubject:      "organisation:test-org",
+			wantCounted:      true,
+			wantLeaseChecked: true,
+			wantReleased:     true,
+		},
+		{
+			name:        "should count the request per user",
+			enabled:     true,
+			routeName:   "create-service-accounts",
+			wantCode:    http.StatusOK,
+			wantSubject: "user:test-user",
+			wantCounted: true,
+		},
+		{
+			name:           "should reject the request with a Retry-After header when the rate limit is exceeded",
+			enabled:        true,
+			routeName:      "create-kafka",
+			retryAfter:     1500 * time.Millisecond,
+			wantCode:       http.StatusTooManyRequests,
+			wantRetryAfter: "2",
+			wantSubject:    "organisation:test-org",
+			wantCounted:    true,
+		},
+		{
+			name:             "should reject the request when the concurrency quota is reached",
+			enabled:          true,
+			routeName:        "create-kafka",
+			wantCode:         http.StatusTooManyRequests,
+			wantRetryAfter:   "1",
+			wantSubject:      "organisation:test-org",
+			wantCounted:      true,
+			wantLeaseChecked: true,
+		},
+		{
+			name:             "should not reject the request when the limits cannot be checked",
+			enabled:          true,
+			routeName:        "create-kafka",
+			countErr:         errors.GeneralError("db down"),
+			acquireErr:       errors.GeneralError("db down"),
+			wantCode:         http.StatusOK,
+			wantSubject:      "organisation:test-org",
+			wantCounted:      true,
+			wantLeaseChecked: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			config := ratelimit.NewRateLimitConfig()
+			config.EnableRateLimiting = tt.enabled
+			config.RouteRateLimits = map[string]ratelimit.RouteRateLimit{
+				createKafkaLimit.Route:          createKafkaLimit,
+				createServiceAccountLimit.Route: createServiceAccountLimit,
+			}
+			handled := false
+			rateLimitService := &ratelimit.RateLimitServiceMock{
+				CountRequestFunc: func(subject string, limit ratelimit.RouteRateLimit) (time.Duration, *errors.ServiceError) {
+					g.Expect(subject).To(gomega.Equal(tt.wantSubject))
+					return tt.retryAfter, tt.countErr
+				},
+				AcquireLeaseFunc: func(subject string, limit ratelimit.RouteRateLimit) (string, *errors.ServiceError) {
+					g.Expect(subject).To(gomega.Equal(tt.wantSubject))
+					return tt.leaseId, tt.acquireErr
+				},
+				ReleaseLeaseFunc: func(id string) *errors.ServiceError {
+					g.Expect(handled).To(gomega.BeTrue())
+					g.Expect(id).To(gomega.Equal(tt.leaseId))
+					return nil
+				},
+			}
+
+			router := mux.NewRouter()
+			router.HandleFunc("/test", func(writer http.ResponseWriter, request *http.Request) {
+				handled = true
+				writer.WriteHeader(http.StatusOK)
+			}).Name(logger.NewLogEvent(tt.routeName, "test route").ToString())
+			router.Use(NewRateLimitMiddleware(config, rateLimitService).RateLimit)
+			token := &jwt.Token{Claims: jwt.MapClaims{
+				"username": "test-user",
+				"org_id":   "test-org",
+			}}
+			toTest := setContextToken(router, token)
+
+			req := httptest.NewRequest(http.MethodPost, "/test", nil)
+			recorder := httptest.NewRecorder()
+			toTest.ServeHTTP(recorder, req)
+			resp := recorder.Result()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantCode))
+			g.Expect(resp.Header.Get("Retry-After")).To(gomega.Equal(tt.wantRetryAfter))
+			_ = resp.Body.Close()
+
+			g.Expect(handled).To(gomega.Equal(tt.wantCode == http.StatusOK))
+			g.Expect(rateLimitService.CountRequestCalls()).To(gomega.HaveLen(boolToCount(tt.wantCounted)))
+			g.Expect(rateLimitService.AcquireLeaseCalls()).To(gomega.HaveLen(boolToCount(tt.wantLeaseChecked)))
+			g.Expect(rateLimitService.ReleaseLeaseCalls()).To(gomega.HaveLen(boolToCount(tt.wantReleased)))
+		})
+	}
+}
+
+func boolToCount(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// RedactedValue replaces the values of the fields of the request and response bodies that must not be persisted
+const RedactedValue = "REDACTED"
+
+// secretFieldNames are the substrings of the names of the fields whose values must never be persisted, e.g. the webhook
+// secrets or the client secrets of the service accounts
+var secretFieldNames = []string{"secret", "password", "token", "credential", "private_key", "privatekey", "api_key", "apikey", "access_key", "accesskey"}
+
+// RedactSecrets returns the JSON body with the values of its secret fields, at any depth, replaced by RedactedValue.
+// A body that is not valid JSON is returned as nil, so that it is never persisted as is.
+func RedactSecrets(body []byte) []byte {
+	return redactBody(body, false)
+}
+
+// RedactValues returns the JSON body with all of its values, at any depth, replaced by RedactedValue so that only the
+// names of its fields are kept. A body that is not valid JSON is returned as nil.
+func RedactValues(body []byte) []byte {
+	return redactBody(body, true)
+}
+
+func redactBody(body []byte, all bool) []byte {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(redactFields(value, all))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redactFields(value interface{}, all bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, fieldValue := range v {
+			if fieldValue != nil && !all && isSecretFieldName(name) {
+				v[name] = RedactedValue
+				continue
+			}
+			v[name] = redactFields(fieldValue, all)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactFields(v[i], all)
+		}
+	case nil:
+	default:
+		if all {
+			return RedactedValue
+		}
+	}
+	return value
+}
+
+func isSecretFieldName(name string) bool {
+	name = strings.ToLower(name)
+	for _, secretFieldName := range secretFieldNames {
+		if strings.Contains(name, secretFieldName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "should redact the secret fields at any depth",
+			body: `{"name":"test","secret":"s","service_account":{"client_id":"id","client_secret":"s"},"items":[{"password":"p"}]}`,
+			want: `{"items":[{"password":"REDACTED"}],"name":"test","secret":"REDACTED","service_account":{"client_id":"id","client_secret":"REDACTED"}}`,
+		},
+		{
+			name: "should match the secret field names whatever their case",
+			body: `{"clientSecret":"s","AccessToken":"t"}`,
+			want: `{"AccessToken":"REDACTED","clientSecret":"REDACTED"}`,
+		},
+		{
+			name: "should keep the unset secret fields",
+			body: `{"secret":null}`,
+			want: `{"secret":null}`,
+		},
+		{
+			name: "should return nil for a body that is not valid JSON",
+			body: `secret=s`,
+		},
+		{
+			name: "should return nil for an empty body",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			redacted := RedactSecrets([]byte(tt.body))
+			if tt.want == "" {
+				g.Expect(redacted).To(gomega.BeNil())
+				return
+			}
+			g.Expect(string(redacted)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestRedactValues(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(string(RedactValues([]byte(`{"name":"test","connector":{"pass":"p","ports":[1,2],"unset":null}}`)))).
+		To(gomega.Equal(`{"connector":{"pass":"REDACTED","ports":["REDACTED","REDACTED"],"unset":null},"name":"REDACTED"}`))
+	g.Expect(RedactValues([]byte(`name=test`))).To(gomega.BeNil())
+}
@@ -24,10 +24,60 @@ type RolesConfiguration struct {
 // RoleConfig represents the role configuration.
 type RoleConfig []RolesConfiguration
 
+// The attributes of the targeted resource the route policies can be restricted to
+const (
+	ResourceAttributeCloudProvider = "cloud_provider"
+	ResourceAttributeRegion        = "region"
+	ResourceAttributeClusterType   = "cluster_type"
+	ResourceAttributeInstanceType  = "instance_type"
+)
+
+var supportedResourceAttributes = []string{
+	ResourceAttributeCloudProvider,
+	ResourceAttributeRegion,
+	ResourceAttributeClusterType,
+	ResourceAttributeInstanceType,
+}
+
+// RoutePolicy grants the roles access to the admin API route with the given name, i.e. the type of the log event the
+// route is named after. The access can be restricted to the resources whose attributes have one of the given values.
+type RoutePolicy struct {
+	Route              string              `yaml:"route"`
+	RoleNames          []string            `yaml:"roles"`
+	ResourceAttributes map[string][]string `yaml:"resource_attributes,omitempty"`
+}
+
+// IsRestricted returns whether the policy only applies to the resources with some attributes
+func (p RoutePolicy) IsRestricted() bool {
+	return len(p.ResourceAttributes) > 0
+}
+
+// Matches returns whether the policy applies to a resource with the given attributes.
+// A restricted policy never applies to a resource missing one of the attributes it is restricted on.
+func (p RoutePolicy) Matches(attributes map[string]string) bool {
+	for name, values := range p.ResourceAttributes {
+		value, ok := attributes[name]
+		if !ok || !arrayUtils.AnyMatch(values, arrayUtils.StringEqualsIgnoreCasePredicate(value)) {
+			return false
+		}
+	}
+	return true
+}
+
+// adminRoleAuthZConfigFile is the content of the configuration file. The file can also only contain the list of
+// the roles per HTTP method.
+type adminRoleAuthZConfigFile struct {
+	Methods RoleConfig    `yaml:"methods"`
+	Routes  []RoutePolicy `yaml:"routes"`
+}
+
 // AdminRoleAuthZConfig is the configuration of the role authZ middleware.
+// The requests to the routes that have policies are authorized by their policies, the other requests by
+// the roles of their HTTP method.
 type AdminRoleAuthZConfig struct {
 	RolesConfigFile string
 	RolesConfig     RoleConfig
+	RoutePolicies   []RoutePolicy
 }
 
 // NewAdminAuthZConfig creates a default AdminRoleAuthZConfig which is enabled and uses the production configuration.
@@ -40,12 +90,12 @@ func NewAdminAuthZConfig() *AdminRoleAuthZConfig {
 // AddFlags adds required flags for the role authZ configuration.
 func (c *AdminRoleAuthZConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.RolesConfigFile, "admin-authz-config-file", c.RolesConfigFile,
-		"Admin API authZ configuration file containing list of required role per API method")
+		"Admin API authZ configuration file containing list of required role per API method and the policies of the API routes")
 }
 
 // ReadFiles will read and validate the contents of the configuration file.
 func (c *AdminRoleAuthZConfig) ReadFiles() error {
-	return readRoleAuthZConfigFile(c.RolesConfigFile, &c.RolesConfig)
+	return readRoleAuthZConfigFile(c.RolesConfigFile, c)
 }
 
 // GetRoleMapping will create a map of the required roles. The key will be the HTTP method and value will be a list of
@@ -60,21 +110,68 @@ func (c *AdminRoleAuthZConfig) GetRoleMapping() map[string][]string {
 	return roleMapping
 }
 
-func readRoleAuthZConfigFile(file string, val *RoleConfig) error {
+// GetRoutePolicies returns the policies of the route with the given name
+func (c *AdminRoleAuthZConfig) GetRoutePolicies(route string) []RoutePolicy {
+	var policies []RoutePolicy
+	for _, policy := range c.RoutePolicies {
+		if policy.Route == route {
+			policies = append(policies, policy)
+		}
+	}
+	return policies
+}
+
+// GetAllowedRoles returns the roles allowed to send a request with the given HTTP method to the route with the given name,
+// targeting a resource with the given attributes, and whether they are granted by the policies of the route rather than
+// by the roles of the method.
+func (c *AdminRoleAuthZConfig) GetAllowedRoles(route string, method string, attributes map[string]string) ([]string, bool) {
+	policies := c.GetRoutePolicies(route)
+	if len(policies) == 0 {
+		return c.GetRoleMapping()[method], false
+	}
+
+	roles := []string{}
+	for _, policy := range policies {
+		if !policy.Matches(attributes) {
+			continue
+		}
+		for _, role := range policy.RoleNames {
+			if !arrayUtils.Contains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+	return roles, true
+}
+
+func readRoleAuthZConfigFile(file string, val *AdminRoleAuthZConfig) error {
 	fileContents, err := shared.ReadFile(file)
 	if err != nil {
 		return errors.Wrap(err, "reading role authz config")
 	}
 
-	if err := yaml.UnmarshalStrict([]byte(fileContents), val); err != nil {
+	// the configuration files deployed before the route policies were introduced only contain the list of roles per method
+	var rolesConfig RoleConfig
+	if err := yaml.UnmarshalStrict([]byte(fileContents), &rolesConfig); err == nil {
+		val.RolesConfig = rolesConfig
+		return nil
+	}
+
+	var configFile adminRoleAuthZConfigFile
+	if err := yaml.UnmarshalStrict([]byte(fileContents), &configFile); err != nil {
 		return errors.Wrap(err, "unmarshalling role authz config")
 	}
+	val.RolesConfig = configFile.Methods
+	val.RoutePolicies = configFile.Routes
 
 	return nil
 }
 
 func (c *AdminRoleAuthZConfig) Validate(env *environments.Env) error {
-	return validateRolesConfiguration(c.RolesConfig)
+	if err := validateRolesConfiguration(c.RolesConfig); err != nil {
+		return err
+	}
+	return validateRoutePolicies(c.RoutePolicies)
 }
 
 var allowedHTTPMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
@@ -88,3 +185,21 @@ func validateRolesConfiguration(configs []RolesConfiguration) error {
 	}
 	return nil
 }
+
+func validateRoutePolicies(policies []RoutePolicy) error {
+	for _, policy := range policies {
+		if policy.Route == "" {
+			return fmt.Errorf("route policy without route name")
+		}
+		if len(policy.RoleNames) == 0 {
+			return fmt.Errorf("route policy of %q does not grant any role", policy.Route)
+		}
+		for name := range policy.ResourceAttributes {
+			if !arrayUtils.Contains(supportedResourceAttributes, name) {
+				return fmt.Errorf("invalid resource attribute %q used in route policy of %q, expected to be one of [%s]",
+					name, policy.Route, strings.Join(supportedResourceAttributes, ","))
+			}
+		}
+	}
+	return nil
+}
@@ -5,8 +5,10 @@ import (
 	"net/http"
 
 	"github.com/golang/glog"
+	"github.com/gorilla/mux"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 )
 
@@ -14,19 +16,37 @@ import (
 type RolesAuthorizationMiddleware interface {
 	// RequireRealmRole will check the given realm role exists in the request token
 	RequireRealmRole(roleName string, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
-	// RequireRolesForMethods will check that at least one of the realm roles exists in the request token based on the http method in the request
+	// RequireRolesForMethods will check that at least one of the realm roles exists in the request token based on the policies
+	// of the route of the request or, when the route has no policy, based on the http method in the request
 	RequireRolesForMethods(code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
 }
 
+// ResourceAttributesLoader loads the attributes of the resource targeted by the requests of the given routes, e.g. the
+// cloud provider and region of a kafka, so that the route policies restricted to some resources can be enforced
+type ResourceAttributesLoader struct {
+	Routes []string
+	Load   func(request *http.Request) (map[string]string, error)
+}
+
 type rolesAuthMiddleware struct {
-	roleMapping map[string][]string
+	roleMapping               map[string][]string
+	config                    *AdminRoleAuthZConfig
+	resourceAttributesLoaders map[string]func(request *http.Request) (map[string]string, error)
 }
 
 var _ RolesAuthorizationMiddleware = &rolesAuthMiddleware{}
 
-func NewRolesAuthzMiddleware(config *AdminRoleAuthZConfig) RolesAuthorizationMiddleware {
+func NewRolesAuthzMiddleware(config *AdminRoleAuthZConfig, resourceAttributesLoaders ...ResourceAttributesLoader) RolesAuthorizationMiddleware {
+	loaders := map[string]func(request *http.Request) (map[string]string, error){}
+	for _, loader := range resourceAttributesLoaders {
+		for _, route := range loader.Routes {
+			loaders[route] = loader.Load
+		}
+	}
 	return &rolesAuthMiddleware{
-		roleMapping: config.GetRoleMapping(),
+		roleMapping:               config.GetRoleMapping(),
+		config:                    config,
+		resourceAttributesLoaders: loaders,
 	}
 }
 
@@ -55,6 +75,12 @@ func (m *rolesAuthMiddleware) RequireRolesForMethods(code errors.ServiceErrorCod
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 			serviceErr := errors.New(code, "")
+			route := getRouteName(request)
+			if policies := m.config.GetRoutePolicies(route); len(policies) > 0 {
+				m.authorizeByRoutePolicies(writer, request, next, route, policies, serviceErr)
+				return
+			}
+
 			method := request.Method
 			allowedRoles, ok := m.roleMapping[method]
 			if !ok {
@@ -86,6 +112,63 @@ func (m *rolesAuthMiddleware) RequireRolesForMethods(code errors.ServiceErrorCod
 	}
 }
 
+// authorizeByRoutePolicies allows the request when the token has one of the roles of a policy of the route that
+// applies to the targeted resource
+func (m *rolesAuthMiddleware) authorizeByRoutePolicies(writer http.ResponseWriter, request *http.Request, next http.Handler,
+	route string, policies []RoutePolicy, serviceErr *errors.ServiceError) {
+	ctx := request.Context()
+	claims, err := GetClaimsFromContext(ctx)
+	if err != nil {
+		shared.HandleError(request, writer, serviceErr)
+		return
+	}
+	realmRoles := getRealmRolesClaim(claims)
+
+	// the attributes of the resource are only loaded when a restricted policy grants one of the roles of the token
+	var attributes map[string]string
+	for _, policy := range policies {
+		if !arrays.AnyMatch(policy.RoleNames, func(r string) bool { return hasRole(realmRoles, r) }) {
+			continue
+		}
+		if policy.IsRestricted() {
+			if attributes == nil {
+				attributes, err = m.loadResourceAttributes(route, request)
+				if err != nil {
+					glog.Infof("failed to load the attributes of the resource of route %s, deny the request for url %s: %v", route, request.URL, err)
+					shared.HandleError(request, writer, serviceErr)
+					return
+				}
+			}
+			if !policy.Matches(attributes) {
+				continue
+			}
+		}
+		ctx = SetIsAdminContext(ctx, true)
+		next.ServeHTTP(writer, request.WithContext(ctx))
+		return
+	}
+	// no policy grants access to the resource, deny the request
+	shared.HandleError(request, writer, serviceErr)
+}
+
+func (m *rolesAuthMiddleware) loadResourceAttributes(route string, request *http.Request) (map[string]string, error) {
+	load, ok := m.resourceAttributesLoaders[route]
+	if !ok {
+		// the restricted policies of the route never apply
+		return map[string]string{}, nil
+	}
+	return load(request)
+}
+
+// getRouteName returns the name of the route of the request, i.e. the type of the log event it is named after
+func getRouteName(request *http.Request) string {
+	route := mux.CurrentRoute(request)
+	if route == nil {
+		return ""
+	}
+	return logger.NewLogEventFromString(route.GetName()).Type
+}
+
 func getRealmRolesClaim(claims KFMClaims) []string {
 	if realmRoles, ok := claims["realm_access"]; ok {
 		if roles, ok := realmRoles.(map[string]interface{}); ok {
@@ -1,13 +1,16 @@
 package auth
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
 	"github.com/openshift-online/ocm-sdk-go/authentication"
 )
 
@@ -181,3 +184,114 @@ func TestRolesAuthMiddleware_RequireRolesForMethods(t *testing.T) {
 		})
 	}
 }
+
+func TestRolesAuthMiddleware_RequireRolesForMethods_RoutePolicies(t *testing.T) {
+	rolesConfig := []RolesConfiguration{
+		{
+			HTTPMethod: http.MethodGet,
+			RoleNames:  []string{"admin-read"},
+		},
+	}
+	routePolicies := []RoutePolicy{
+		{
+			Route:     "admin-get-kafka",
+			RoleNames: []string{"admin-full"},
+		},
+		{
+			Route:              "admin-get-kafka",
+			RoleNames:          []string{"aws-operator"},
+			ResourceAttributes: map[string][]string{ResourceAttributeCloudProvider: {"aws"}},
+		},
+	}
+	awsKafka := func(request *http.Request) (map[string]string, error) {
+		return map[string]string{ResourceAttributeCloudProvider: "AWS", ResourceAttributeRegion: "us-east-1"}, nil
+	}
+	gcpKafka := func(request *http.Request) (map[string]string, error) {
+		return map[string]string{ResourceAttributeCloudProvider: "gcp"}, nil
+	}
+	failingLoad := func(request *http.Request) (map[string]string, error) {
+		return nil, fmt.Errorf("kafka not found")
+	}
+
+	tests := []struct {
+		name  string
+		roles []interface{}
+		route string
+		load  func(request *http.Request) (map[string]string, error)
+		want  int
+	}{
+		{
+			name:  "should allow access when an unrestricted policy of the route grants one of the roles",
+			roles: []interface{}{"admin-full"},
+			route: "admin-get-kafka",
+			load:  failingLoad,
+			want:  http.StatusOK,
+		},
+		{
+			name:  "should not allow access with the roles of the method when the route has policies",
+			roles: []interface{}{"admin-read"},
+			route: "admin-get-kafka",
+			load:  awsKafka,
+			want:  http.StatusUnauthorized,
+		},
+		{
+			name:  "should allow access when a restricted policy grants one of the roles and matches the resource",
+			roles: []interface{}{"aws-operator"},
+			route: "admin-get-kafka",
+			load:  awsKafka,
+			want:  http.StatusOK,
+		},
+		{
+			name:  "should not allow access when the restricted policy does not match the resource",
+			roles: []interface{}{"aws-operator"},
+			route: "admin-get-kafka",
+			load:  gcpKafka,
+			want:  http.StatusUnauthorized,
+		},
+		{
+			name:  "should not allow access when the attributes of the resource cannot be loaded",
+			roles: []interface{}{"aws-operator"},
+			route: "admin-get-kafka",
+			load:  failingLoad,
+			want:  http.StatusUnauthorized,
+		},
+		{
+			name:  "should authorize the routes without policies with the roles of the method",
+			roles: []interface{}{"admin-read"},
+			route: "admin-get-kafkas",
+			load:  failingLoad,
+			want:  http.StatusOK,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			rolesHandler := NewRolesAuthzMiddleware(
+				&AdminRoleAuthZConfig{RolesConfig: rolesConfig, RoutePolicies: routePolicies},
+				ResourceAttributesLoader{Routes: []string{"admin-get-kafka"}, Load: tt.load},
+			)
+			router := mux.NewRouter()
+			router.HandleFunc("/kafkas", func(writer http.ResponseWriter, request *http.Request) {
+				shared.WriteJSONResponse(writer, http.StatusOK, "")
+			}).Name(logger.NewLogEvent(tt.route, "test route").ToString())
+			router.Use(rolesHandler.RequireRolesForMethods(errors.ErrorUnauthenticated))
+			token := &jwt.Token{
+				Claims: jwt.MapClaims{
+					"realm_access": map[string]interface{}{
+						"roles": tt.roles,
+					},
+				},
+			}
+			toTest := setContextToken(router, token)
+			recorder := httptest.NewRecorder()
+			toTest.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://example.com/kafkas", nil))
+			resp := recorder.Result()
+			resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("expected status code %d but got %d", tt.want, resp.StatusCode)
+			}
+		})
+	}
+}
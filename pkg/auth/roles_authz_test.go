@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_readRoleAuthZConfigFile(t *testing.T) {
+	tests := []struct {
+		name              string
+		content           string
+		wantRolesConfig   RoleConfig
+		wantRoutePolicies []RoutePolicy
+		wantErr           bool
+	}{
+		{
+			name: "should read the list of roles per method",
+			content: `
+- method: GET
+  roles: ["admin-read"]
+`,
+			wantRolesConfig: RoleConfig{{HTTPMethod: http.MethodGet, RoleNames: []string{"admin-read"}}},
+		},
+		{
+			name: "should read the roles per method and the route policies",
+			content: `
+methods:
+- method: GET
+  roles: ["admin-read"]
+routes:
+- route: admin-delete-kafka
+  roles: ["aws-operator"]
+  resource_attributes:
+    cloud_provider: ["aws"]
+`,
+			wantRolesConfig: RoleConfig{{HTTPMethod: http.MethodGet, RoleNames: []string{"admin-read"}}},
+			wantRoutePolicies: []RoutePolicy{
+				{
+					Route:              "admin-delete-kafka",
+					RoleNames:          []string{"aws-operator"},
+					ResourceAttributes: map[string][]string{ResourceAttributeCloudProvider: {"aws"}},
+				},
+			},
+		},
+		{
+			name: "should fail when the file contains unknown fields",
+			content: `
+methods:
+- method: GET
+  roles: ["admin-read"]
+policies: []
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			file := filepath.Join(t.TempDir(), "admin-authz-configuration.yaml")
+			g.Expect(os.WriteFile(file, []byte(tt.content), 0600)).To(gomega.Succeed())
+
+			config := &AdminRoleAuthZConfig{}
+			err := readRoleAuthZConfigFile(file, config)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(config.RolesConfig).To(gomega.Equal(tt.wantRolesConfig))
+				g.Expect(config.RoutePolicies).To(gomega.Equal(tt.wantRoutePolicies))
+			}
+		})
+	}
+}
+
+func Test_validateRoutePolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []RoutePolicy
+		wantErr  bool
+	}{
+		{
+			name: "should accept policies restricted on supported attributes",
+			policies: []RoutePolicy{
+				{Route: "admin-get-kafka", RoleNames: []string{"admin-read"}},
+				{Route: "admin-get-kafka", RoleNames: []string{"aws-operator"}, ResourceAttributes: map[string][]string{ResourceAttributeRegion: {"us-east-1"}}},
+			},
+		},
+		{
+			name:     "should reject a policy without route",
+			policies: []RoutePolicy{{RoleNames: []string{"admin-read"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "should reject a policy without roles",
+			policies: []RoutePolicy{{Route: "admin-get-kafka"}},
+			wantErr:  true,
+		},
+		{
+			name:     "should reject a policy restricted on an unsupported attribute",
+			policies: []RoutePolicy{{Route: "admin-get-kafka", RoleNames: []string{"admin-read"}, ResourceAttributes: map[string][]string{"owner": {"someone"}}}},
+			wantErr:  true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(validateRoutePolicies(tt.policies) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestAdminRoleAuthZConfig_GetAllowedRoles(t *testing.T) {
+	g := gomega.NewWithT(t)
+	config := &AdminRoleAuthZConfig{
+		RolesConfig: RoleConfig{{HTTPMethod: http.MethodDelete, RoleNames: []string{"admin-full"}}},
+		RoutePolicies: []RoutePolicy{
+			{Route: "admin-delete-kafka", RoleNames: []string{"admin-full"}},
+			{Route: "admin-delete-kafka", RoleNames: []string{"aws-operator"}, ResourceAttributes: map[string][]string{ResourceAttributeCloudProvider: {"aws"}}},
+		},
+	}
+
+	roles, byRoute := config.GetAllowedRoles("admin-delete-kafka", http.MethodDelete, map[string]string{ResourceAttributeCloudProvider: "aws"})
+	g.Expect(byRoute).To(gomega.BeTrue())
+	g.Expect(roles).To(gomega.Equal([]string{"admin-full", "aws-operator"}))
+
+	roles, byRoute = config.GetAllowedRoles("admin-delete-kafka", http.MethodDelete, map[string]string{ResourceAttributeCloudProvider: "gcp"})
+	g.Expect(byRoute).To(gomega.BeTrue())
+	g.Expect(roles).To(gomega.Equal([]string{"admin-full"}))
+
+	roles, byRoute = config.GetAllowedRoles("admin-delete-cluster", http.MethodDelete, nil)
+	g.Expect(byRoute).To(gomega.BeFalse())
+	g.Expect(roles).To(gomega.Equal([]string{"admin-full"}))
+}
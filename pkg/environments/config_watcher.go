@@ -0,0 +1,170 @@
+package environments
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/goava/di"
+	"github.com/spf13/pflag"
+)
+
+type ConfigWatcherConfig struct {
+	EnableConfigHotReload   bool
+	ConfigHotReloadInterval time.Duration
+}
+
+func NewConfigWatcherConfig() *ConfigWatcherConfig {
+	return &ConfigWatcherConfig{
+		EnableConfigHotReload:   false,
+		ConfigHotReloadInterval: 30 * time.Second,
+	}
+}
+
+func (c *ConfigWatcherConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.EnableConfigHotReload, "enable-config-hot-reload", c.EnableConfigHotReload, "Enable the reload of the configuration files of the reloadable config modules when they change, without restarting the service")
+	fs.DurationVar(&c.ConfigHotReloadInterval, "config-hot-reload-interval", c.ConfigHotReloadInterval, "Interval at which the configuration files of the reloadable config modules are checked for changes")
+}
+
+func (c *ConfigWatcherConfig) ReadFiles() error {
+	return nil
+}
+
+var _ BootService = &ConfigWatcher{}
+
+type ConfigWatcherInjections struct {
+	di.Inject
+	Env     *Env
+	Config  *ConfigWatcherConfig
+	Modules []ReloadableConfigModule `optional:"true"`
+}
+
+// ConfigWatcher periodically checks the content of the files of the reloadable config modules and reloads the modules
+// whose files changed. Every reload, applied or rejected, is logged and counted in the config reload metric.
+// A rejected configuration is not retried until its files change again.
+type ConfigWatcher struct {
+	env       *Env
+	config    *ConfigWatcherConfig
+	modules   []ReloadableConfigModule
+	checksums map[string]string
+	isRunning int32
+	stopChan  chan struct{}
+	syncGroup sync.WaitGroup
+}
+
+func NewConfigWatcher(in ConfigWatcherInjections) *ConfigWatcher {
+	return &ConfigWatcher{
+		env:       in.Env,
+		config:    in.Config,
+		modules:   in.Modules,
+		checksums: map[string]string{},
+		stopChan:  make(chan struct{}),
+	}
+}
+
+// Start records the content of the files of the reloadable config modules, which have been read on boot,
+// and checks them for changes until the watcher is stopped. It does nothing unless the hot reload is enabled.
+func (w *ConfigWatcher) Start() {
+	if !w.config.EnableConfigHotReload || len(w.modules) == 0 {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&w.isRunning, 0, 1) {
+		return
+	}
+	w.stopChan = make(chan struct{})
+
+	for _, module := range w.modules {
+		for file, checksum := range configFilesChecksums(module) {
+			w.checksums[file] = checksum
+		}
+	}
+
+	logger.Logger.Infof("watching the configuration files of %d config modules every %s", len(w.modules), w.config.ConfigHotReloadInterval)
+	ticker := time.NewTicker(w.config.ConfigHotReloadInterval)
+	w.syncGroup.Add(1)
+	go func() {
+		defer w.syncGroup.Done()
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.stopChan:
+				return
+			case <-ticker.C:
+				w.ReloadChangedModules()
+			}
+		}
+	}()
+}
+
+// Stop stops checking the configuration files. Blocks until the reload in progress, if any, completes.
+func (w *ConfigWatcher) Stop() {
+	if atomic.LoadInt32(&w.isRunning) == 0 {
+		return
+	}
+	select {
+	case <-w.stopChan:
+		// already closed
+	default:
+		close(w.stopChan)
+		w.syncGroup.Wait()
+	}
+	atomic.StoreInt32(&w.isRunning, 0)
+}
+
+// ReloadChangedModules reloads the modules with at least one file whose content changed since the last check
+func (w *ConfigWatcher) ReloadChangedModules() {
+	for _, module := range w.modules {
+		checksums := configFilesChecksums(module)
+		changed := false
+		for file, checksum := range checksums {
+			if w.checksums[file] != checksum {
+				changed = true
+			}
+			w.checksums[file] = checksum
+		}
+		if !changed {
+			continue
+		}
+
+		name := configModuleName(module)
+		if err := module.Reload(w.env); err != nil {
+			logger.Logger.Errorf("rejected the reload of the configuration files %v of %s, the current configuration is kept: %v", module.ConfigFiles(), name, err)
+			metrics.IncreaseConfigReloadCountMetric(name, metrics.ConfigReloadResultRejected)
+			continue
+		}
+		logger.Logger.Infof("reloaded the configuration files %v of %s", module.ConfigFiles(), name)
+		metrics.IncreaseConfigReloadCountMetric(name, metrics.ConfigReloadResultSuccess)
+	}
+}
+
+// configFilesChecksums returns the checksum of the content of each file of the module.
+// The checksum of a file that can not be read is empty, so that it is reloaded once it is readable again.
+func configFilesChecksums(module ReloadableConfigModule) map[string]string {
+	checksums := map[string]string{}
+	for _, file := range module.ConfigFiles() {
+		if file == "" {
+			continue
+		}
+		content, err := shared.ReadFile(file)
+		if err != nil {
+			checksums[file] = ""
+			continue
+		}
+		sum := sha256.Sum256([]byte(content))
+		checksums[file] = hex.EncodeToString(sum[:])
+	}
+	return checksums
+}
+
+// configModuleName returns the name of the type of the module, e.g. "KafkaConfig"
+func configModuleName(module ReloadableConfigModule) string {
+	name := fmt.Sprintf("%T", module)
+	return name[strings.LastIndex(name, ".")+1:]
+}
@@ -0,0 +1,88 @@
+package environments
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+type reloadableConfigModule struct {
+	files     []string
+	reloadErr error
+	reloads   int
+}
+
+func (m *reloadableConfigModule) ConfigFiles() []string {
+	return m.files
+}
+
+func (m *reloadableConfigModule) Reload(env *Env) error {
+	m.reloads++
+	return m.reloadErr
+}
+
+func Test_ConfigWatcher_ReloadChangedModules(t *testing.T) {
+	tests := []struct {
+		name        string
+		reloadErr   error
+		changeFile  bool
+		wantReloads int
+	}{
+		{
+			name:        "should not reload the module when its files did not change",
+			wantReloads: 0,
+		},
+		{
+			name:        "should reload the module when one of its files changed",
+			changeFile:  true,
+			wantReloads: 1,
+		},
+		{
+			name:        "should not retry a rejected reload until the files change again",
+			changeFile:  true,
+			reloadErr:   errors.New("invalid configuration"),
+			wantReloads: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dir := t.TempDir()
+			file := filepath.Join(dir, "config.yaml")
+			g.Expect(os.WriteFile(file, []byte("key: value"), 0600)).To(gomega.Succeed())
+			module := &reloadableConfigModule{files: []string{file, filepath.Join(dir, "missing.yaml")}, reloadErr: tt.reloadErr}
+
+			watcher := NewConfigWatcher(ConfigWatcherInjections{
+				Config:  &ConfigWatcherConfig{EnableConfigHotReload: true, ConfigHotReloadInterval: time.Hour},
+				Modules: []ReloadableConfigModule{module},
+			})
+			watcher.Start()
+			defer watcher.Stop()
+
+			if tt.changeFile {
+				g.Expect(os.WriteFile(file, []byte("key: another value"), 0600)).To(gomega.Succeed())
+			}
+			watcher.ReloadChangedModules()
+			watcher.ReloadChangedModules()
+			g.Expect(module.reloads).To(gomega.Equal(tt.wantReloads))
+		})
+	}
+}
+
+func Test_ConfigWatcher_Start(t *testing.T) {
+	g := gomega.NewWithT(t)
+	watcher := NewConfigWatcher(ConfigWatcherInjections{
+		Config:  NewConfigWatcherConfig(),
+		Modules: []ReloadableConfigModule{&reloadableConfigModule{}},
+	})
+	// the hot reload is disabled by default
+	watcher.Start()
+	g.Expect(watcher.isRunning).To(gomega.BeZero())
+	watcher.Stop()
+}
@@ -12,6 +12,16 @@ type ConfigModule interface {
 	ReadFiles() error
 }
 
+// ReloadableConfigModule values can re-read their configuration files while the application is running.
+// See ConfigWatcher.
+type ReloadableConfigModule interface {
+	// ConfigFiles returns the files the module reads its reloadable configuration from
+	ConfigFiles() []string
+	// Reload re-reads and validates the configuration files. The configuration of the module is only
+	// replaced when the content of all its files is valid, otherwise it is left unchanged and an error is returned.
+	Reload(env *Env) error
+}
+
 type ServiceValidator interface {
 	Validate(env *Env) error
 }
@@ -208,6 +208,10 @@ const (
 	ErrorInvalidDnsName       ServiceErrorCode = 47
 	ErrorInvalidDnsNameReason string           = "Dns name is invalid"
 
+	// Rate limit or concurrency quota of the organisation or of the user exceeded on the route
+	ErrorRateLimitExceeded       ServiceErrorCode = 48
+	ErrorRateLimitExceededReason string           = "Rate limit exceeded"
+
 	// Too Many requests error. Used by rate limiting
 	ErrorTooManyRequests       ServiceErrorCode = 429
 	ErrorTooManyRequestsReason string           = "Too many requests"
@@ -319,6 +323,7 @@ func Errors() ServiceErrors {
 		ServiceError{ErrorInvalidClusterId, ErrorInvalidClusterIdReason, http.StatusBadRequest, nil, false},
 		ServiceError{ErrorInvalidExternalClusterId, ErrorInvalidExternalClusterIdReason, http.StatusBadRequest, nil, false},
 		ServiceError{ErrorInvalidDnsName, ErrorInvalidDnsNameReason, http.StatusBadRequest, nil, false},
+		ServiceError{ErrorRateLimitExceeded, ErrorRateLimitExceededReason, http.StatusTooManyRequests, nil, false},
 	}
 }
 
@@ -741,6 +746,10 @@ func InvalidDnsName(reason string, values ...interface{}) *ServiceError {
 	return New(ErrorInvalidDnsName, reason, values...)
 }
 
+func RateLimitExceeded(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorRateLimitExceeded, reason, values...)
+}
+
 func DuplicateKafkaClusterName() *ServiceError {
 	return New(ErrorDuplicateKafkaClusterName, ErrorDuplicateKafkaClusterNameReason)
 }
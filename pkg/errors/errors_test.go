@@ -2513,3 +2513,10 @@ func Test_ServiceError_String(t *testing.T) {
 		})
 	}
 }
+
+func Test_RateLimitExceeded(t *testing.T) {
+	g := gomega.NewWithT(t)
+	err := RateLimitExceeded("rate limit of %d requests per %s exceeded", 10, "1m0s")
+	g.Expect(err).To(gomega.MatchError(New(ErrorRateLimitExceeded, "rate limit of 10 requests per 1m0s exceeded")))
+	g.Expect(err.HttpCode).To(gomega.Equal(http.StatusTooManyRequests))
+}
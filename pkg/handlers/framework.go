@@ -35,6 +35,12 @@ type EventStream struct {
 	Close        func()
 }
 
+// TextResponse is an action result that is written as is with the given content type instead of being encoded in json
+type TextResponse struct {
+	ContentType string
+	Body        string
+}
+
 type Validate func() *errors.ServiceError
 type ErrorHandlerFunc func(r *http.Request, w http.ResponseWriter, err *errors.ServiceError)
 type HttpAction func() (interface{}, *errors.ServiceError)
@@ -136,13 +142,22 @@ func HandleGet(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
 	}
 
 	result, serviceErr := cfg.Action()
-	switch {
-	case serviceErr == nil:
-		shared.WriteJSONResponse(w, http.StatusOK, result)
-		success(r)
-	default:
+	if serviceErr != nil {
 		errorHandler(r, w, cfg, serviceErr)
+		return
 	}
+
+	switch res := result.(type) {
+	case EventStream:
+		if !writeEventStream(w, r, cfg, res) {
+			return
+		}
+	case TextResponse:
+		shared.WriteTextResponse(w, http.StatusOK, res.Body, res.ContentType)
+	default:
+		shared.WriteJSONResponse(w, http.StatusOK, result)
+	}
+	success(r)
 }
 
 func HandleList(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
@@ -150,7 +165,6 @@ func HandleList(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
 		cfg.ErrorHandler = shared.HandleError
 	}
 
-	ctx := r.Context()
 	for _, v := range cfg.Validate {
 		err := v()
 		if err != nil {
@@ -166,47 +180,56 @@ func HandleList(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
 	}
 
 	if stream, ok := results.(EventStream); ok {
-		if stream.Close != nil {
-			defer stream.Close()
-		}
-		flusher, ok := w.(http.Flusher)
-		if !ok {
-			errorHandler(r, w, cfg, errors.BadRequest("streaming unsupported"))
+		if !writeEventStream(w, r, cfg, stream) {
 			return
 		}
+	} else {
+		shared.WriteJSONResponse(w, http.StatusOK, results)
+	}
+	success(r)
+}
+
+// writeEventStream writes the events of the stream to the response until the stream is done or fails.
+// It returns false if the stream ended with an error.
+func writeEventStream(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, stream EventStream) bool {
+	ctx := r.Context()
+	if stream.Close != nil {
+		defer stream.Close()
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		errorHandler(r, w, cfg, errors.BadRequest("streaming unsupported"))
+		return false
+	}
 
-		shared.WriteStreamJSONResponseWithContentType(w, http.StatusOK, nil, stream.ContentType)
-		for {
-			result, err := stream.GetNextEvent()
-			if err != nil {
-				ulog := logger.NewUHCLogger(ctx)
-				operationID := logger.GetOperationID(ctx)
-				// If this is a 400 error, its the user's issue, log as info rather than error
-				if err.HttpCode >= 400 && err.HttpCode <= 499 {
-					ulog.Infof(err.Error())
-				} else {
-					ulog.Error(err)
-				}
-				result := compat.WatchEvent{
-					Type:  "error",
-					Error: ConvertToPrivateError(err.AsOpenapiError(operationID, r.RequestURI)),
-				}
-				_ = json.NewEncoder(w).Encode(result)
-				return
+	shared.WriteStreamJSONResponseWithContentType(w, http.StatusOK, nil, stream.ContentType)
+	for {
+		result, err := stream.GetNextEvent()
+		if err != nil {
+			ulog := logger.NewUHCLogger(ctx)
+			operationID := logger.GetOperationID(ctx)
+			// If this is a 400 error, its the user's issue, log as info rather than error
+			if err.HttpCode >= 400 && err.HttpCode <= 499 {
+				ulog.Infof(err.Error())
 			} else {
-				if result == nil {
-					return // the event stream was done.
-				}
-				_ = json.NewEncoder(w).Encode(result)
-				_, _ = fmt.Fprint(w, "\n")
-				flusher.Flush() // sends the result to the client (forces Transfer-Encoding: chunked)
+				ulog.Error(err)
 			}
+			result := compat.WatchEvent{
+				Type:  "error",
+				Error: ConvertToPrivateError(err.AsOpenapiError(operationID, r.RequestURI)),
+			}
+			_ = json.NewEncoder(w).Encode(result)
+			return false
 		}
-	} else {
-		shared.WriteJSONResponse(w, http.StatusOK, results)
+		if result == nil {
+			return true // the event stream was done.
+		}
+		_ = json.NewEncoder(w).Encode(result)
+		_, _ = fmt.Fprint(w, "\n")
+		flusher.Flush() // sends the result to the client (forces Transfer-Encoding: chunked)
 	}
-	success(r)
 }
+
 func ConvertToPrivateError(e compat.Error) compat.PrivateError {
 	return compat.PrivateError{
 		Id:          e.Id,
@@ -306,6 +306,40 @@ func Test_HandleGet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Should stream the events when the action returns an event stream",
+			args: args{
+				w: rw,
+				r: req,
+				cfg: &HandlerConfig{
+					Action: func() (interface{}, *errors.ServiceError) {
+						sent := false
+						return EventStream{
+							ContentType: "application/json;stream=watch",
+							GetNextEvent: func() (interface{}, *errors.ServiceError) {
+								if sent {
+									return nil, nil
+								}
+								sent = true
+								return map[string]string{"type": "CHANGE"}, nil
+							},
+						}, nil
+					},
+				},
+			},
+		},
+		{
+			name: "Should write the body as is when the action returns a text response",
+			args: args{
+				w: rw,
+				r: req,
+				cfg: &HandlerConfig{
+					Action: func() (interface{}, *errors.ServiceError) {
+						return TextResponse{ContentType: "text/plain", Body: "some text"}, nil
+					},
+				},
+			},
+		},
 	}
 
 	for _, testcase := range tests {
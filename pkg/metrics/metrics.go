@@ -83,6 +83,18 @@ const (
 	// PrewarmingStatusInfoCount - metric name for the total number of prewarmed instances per cluster_id, status and instance type.
 	PrewarmingStatusInfoCount = "prewarmed_kafka_instances"
 
+	// ConfigReloadCount - metric name for the number of hot reloads of the configuration files per config module and result.
+	ConfigReloadCount = "config_reload_count"
+
+	// PredictiveScaleUpDemand - metric name for the demand of streaming units forecast per region, instance type and source ('historical' or 'scheduled')
+	PredictiveScaleUpDemand = "predictive_scale_up_demand_streaming_units"
+
+	// PredictiveScaleUpNeeded - metric name for whether a data plane cluster should be created ahead of the forecast demand per region and instance type
+	PredictiveScaleUpNeeded = "predictive_scale_up_needed"
+
+	// ClusterCreationRetryCount - metric name for the number of steps of the automatic retry of the data plane clusters that failed to be created per region and step ('failed', 'retried' or 'exhausted')
+	ClusterCreationRetryCount = "cluster_creation_retry_count"
+
 	LabelStatusCode = "code"
 	LabelMethod     = "method"
 	LabelPath       = "path"
@@ -100,6 +112,16 @@ const (
 	prewarmingStatusLabel       = "status"
 	prewarmingInstanceTypeLabel = "instance_type"
 	prewarmingClusterIDLabel    = "cluster_id"
+
+	// config reload metric labels
+	configReloadModuleLabel = "module"
+	configReloadResultLabel = "result"
+
+	// predictive scale up metric labels
+	predictiveScaleUpDemandSourceLabel = "source"
+
+	// cluster creation retry metric labels
+	clusterCreationRetryStepLabel = "step"
 )
 
 // JobType metric to capture
@@ -809,6 +831,107 @@ func UpdateClusterPrewarmingStatusInfoCountMetric(prewarmingStatusInfo Prewarmin
 	prewarmingStatusInfoCountMetric.With(labels).Set(float64(prewarmingStatusInfo.Count))
 }
 
+// #### Metrics for configuration hot reload ####
+
+const (
+	// ConfigReloadResultSuccess is the result of a reload that replaced the configuration
+	ConfigReloadResultSuccess = "success"
+	// ConfigReloadResultRejected is the result of a reload whose configuration files are invalid, the configuration is kept unchanged
+	ConfigReloadResultRejected = "rejected"
+)
+
+var configReloadCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: KasFleetManager,
+		Name:      ConfigReloadCount,
+		Help:      "number of hot reloads of the configuration files, partitioned by config module and result ('success' or 'rejected')",
+	}, []string{configReloadModuleLabel, configReloadResultLabel})
+
+// IncreaseConfigReloadCountMetric - Increases the kas_fleet_manager_config_reload_count metric.
+func IncreaseConfigReloadCountMetric(module string, result string) {
+	labels := prometheus.Labels{
+		configReloadModuleLabel: module,
+		configReloadResultLabel: result,
+	}
+	configReloadCountMetric.With(labels).Inc()
+}
+
+// #### Metrics for predictive scale up ####
+
+const (
+	// PredictiveScaleUpDemandSourceHistorical is the source of the demand forecast from the kafkas created in the past
+	PredictiveScaleUpDemandSourceHistorical = "historical"
+	// PredictiveScaleUpDemandSourceScheduled is the source of the demand of the expected demand schedules
+	PredictiveScaleUpDemandSourceScheduled = "scheduled"
+)
+
+var predictiveScaleUpDemandMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      PredictiveScaleUpDemand,
+		Help:      "number of Streaming Units expected to be created within the forecast horizon per region, kafka instance type and source ('historical' or 'scheduled')",
+	}, []string{LabelCloudProvider, LabelRegion, LabelInstanceType, predictiveScaleUpDemandSourceLabel})
+
+var predictiveScaleUpNeededMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      PredictiveScaleUpNeeded,
+		Help:      "whether a data plane cluster should be created ahead of the forecast demand per region and kafka instance type (1) or not (0)",
+	}, []string{LabelCloudProvider, LabelRegion, LabelInstanceType})
+
+// UpdatePredictiveScaleUpDemandMetric - Updates the kas_fleet_manager_predictive_scale_up_demand_streaming_units metric.
+func UpdatePredictiveScaleUpDemandMetric(provider, region, instanceType, source string, streamingUnits int) {
+	labels := prometheus.Labels{
+		LabelCloudProvider:                 provider,
+		LabelRegion:                        region,
+		LabelInstanceType:                  instanceType,
+		predictiveScaleUpDemandSourceLabel: source,
+	}
+	predictiveScaleUpDemandMetric.With(labels).Set(float64(streamingUnits))
+}
+
+// UpdatePredictiveScaleUpNeededMetric - Updates the kas_fleet_manager_predictive_scale_up_needed metric.
+func UpdatePredictiveScaleUpNeededMetric(provider, region, instanceType string, scaleUpNeeded bool) {
+	labels := prometheus.Labels{
+		LabelCloudProvider: provider,
+		LabelRegion:        region,
+		LabelInstanceType:  instanceType,
+	}
+	value := 0.0
+	if scaleUpNeeded {
+		value = 1
+	}
+	predictiveScaleUpNeededMetric.With(labels).Set(value)
+}
+
+// #### Metrics for the retry of the failed data plane clusters ####
+
+const (
+	// ClusterCreationRetryStepFailed is the step recording a failed creation of a data plane cluster
+	ClusterCreationRetryStepFailed = "failed"
+	// ClusterCreationRetryStepRetried is the step replacing a failed data plane cluster with a new one
+	ClusterCreationRetryStepRetried = "retried"
+	// ClusterCreationRetryStepExhausted is the step deprovisioning a failed data plane cluster without replacing it
+	ClusterCreationRetryStepExhausted = "exhausted"
+)
+
+var clusterCreationRetryCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: KasFleetManager,
+		Name:      ClusterCreationRetryCount,
+		Help:      "number of steps of the automatic retry of the data plane clusters that failed to be created per region and step ('failed', 'retried' or 'exhausted')",
+	}, []string{LabelCloudProvider, LabelRegion, clusterCreationRetryStepLabel})
+
+// IncreaseClusterCreationRetryCountMetric - Increases the kas_fleet_manager_cluster_creation_retry_count metric.
+func IncreaseClusterCreationRetryCountMetric(provider, region, step string) {
+	labels := prometheus.Labels{
+		LabelCloudProvider:            provider,
+		LabelRegion:                   region,
+		clusterCreationRetryStepLabel: step,
+	}
+	clusterCreationRetryCountMetric.With(labels).Inc()
+}
+
 // register the metric(s)
 func init() {
 	// metrics for data plane clusters
@@ -824,6 +947,9 @@ func init() {
 	prometheus.MustRegister(clusterProviderResourceQuotaConsumedMetric)
 	prometheus.MustRegister(prewarmingStatusInfoCountMetric)
 	prometheus.MustRegister(clusterProviderResourceQuotaMaxAllowedMetric)
+	prometheus.MustRegister(predictiveScaleUpDemandMetric)
+	prometheus.MustRegister(predictiveScaleUpNeededMetric)
+	prometheus.MustRegister(clusterCreationRetryCountMetric)
 
 	// metrics for Kafkas
 	prometheus.MustRegister(requestKafkaCreationDurationMetric)
@@ -847,6 +973,9 @@ func init() {
 	// metrics for database
 	prometheus.MustRegister(databaseRequestCountMetric)
 	prometheus.MustRegister(databaseQueryDurationMetric)
+
+	// metrics for configuration hot reload
+	prometheus.MustRegister(configReloadCountMetric)
 }
 
 // ResetMetricsForKafkaManagers will reset the metrics for the KafkaManager background reconciler
@@ -867,6 +996,9 @@ func ResetMetricsForClusterManagers() {
 	kafkaPerClusterCountMetric.Reset()
 	clusterProviderResourceQuotaConsumedMetric.Reset()
 	clusterProviderResourceQuotaMaxAllowedMetric.Reset()
+	predictiveScaleUpDemandMetric.Reset()
+	predictiveScaleUpNeededMetric.Reset()
+	clusterCreationRetryCountMetric.Reset()
 }
 
 // ResetMetricsForReconcilers will reset the metrics related to the reconcilers
@@ -899,6 +1031,9 @@ func Reset() {
 	clusterStatusCapacityAvailableMetric.Reset()
 	clusterProviderResourceQuotaConsumedMetric.Reset()
 	clusterProviderResourceQuotaMaxAllowedMetric.Reset()
+	predictiveScaleUpDemandMetric.Reset()
+	predictiveScaleUpNeededMetric.Reset()
+	clusterCreationRetryCountMetric.Reset()
 
 	requestKafkaCreationDurationMetric.Reset()
 	kafkaOperationsSuccessCountMetric.Reset()
@@ -916,4 +1051,6 @@ func Reset() {
 
 	databaseRequestCountMetric.Reset()
 	databaseQueryDurationMetric.Reset()
+
+	configReloadCountMetric.Reset()
 }
@@ -15,11 +15,19 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/ratelimit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers/idempotency_mgrs"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers/outbox_mgrs"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers/ratelimit_mgrs"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers/webhook_mgrs"
 	"github.com/goava/di"
 )
 
@@ -40,6 +48,7 @@ func CoreConfigProviders() di.Option {
 		di.Provide(workers.NewReconcilerConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(auth.NewContextConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(auth.NewAdminAuthZConfig, di.As(new(environments.ConfigModule)), di.As(new(environments.ServiceValidator))),
+		di.Provide(environments.NewConfigWatcherConfig, di.As(new(environments.ConfigModule))),
 
 		// Add common CLI sub commands
 		di.Provide(serve.NewServeCommand),
@@ -50,6 +59,10 @@ func CoreConfigProviders() di.Option {
 		signalbus.ConfigProviders(),
 		authorization.ConfigProviders(),
 		account.ConfigProviders(),
+		audit.ConfigProviders(),
+		webhook.ConfigProviders(),
+		ratelimit.ConfigProviders(),
+		idempotency.ConfigProviders(),
 
 		di.Provide(environments.Func(ServiceProviders)),
 	)
@@ -81,6 +94,7 @@ func ServiceProviders() di.Option {
 		di.Provide(aws.NewDefaultClientFactory, di.As(new(aws.ClientFactory))),
 
 		di.Provide(acl.NewAccessControlListMiddleware),
+		di.Provide(acl.NewAccessControlListEntryService, di.As(new(acl.AccessControlListEntryService)), di.As(new(environments.BootService))),
 		di.Provide(handlers.NewErrorsHandler),
 		di.Provide(func(c *keycloak.KeycloakConfig) sso.KafkaKeycloakService {
 			return sso.NewKeycloakServiceBuilder().
@@ -100,6 +114,12 @@ func ServiceProviders() di.Option {
 		di.Provide(server.NewAPIServer, di.As(new(environments.BootService))),
 		di.Provide(server.NewMetricsServer, di.As(new(environments.BootService))),
 		di.Provide(server.NewHealthCheckServer, di.As(new(environments.BootService))),
+		di.Provide(environments.NewConfigWatcher, di.As(new(environments.BootService))),
 		di.Provide(workers.NewLeaderElectionManager, di.As(new(environments.BootService))),
+
+		di.Provide(webhook_mgrs.NewWebhookDeliveryManager, di.As(new(workers.Worker))),
+		di.Provide(outbox_mgrs.NewOutboxPruningManager, di.As(new(workers.Worker))),
+		di.Provide(ratelimit_mgrs.NewRateLimitPruningManager, di.As(new(workers.Worker))),
+		di.Provide(idempotency_mgrs.NewIdempotencyKeyPruningManager, di.As(new(workers.Worker))),
 	)
 }
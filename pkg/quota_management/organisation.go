@@ -14,11 +14,11 @@ var defaultGrantedQuota = []Quota{defaultQuotaForStandard}
 var _ QuotaManagementListItem = &Organisation{}
 
 type Organisation struct {
-	Id                  string      `yaml:"id"`
-	AnyUser             bool        `yaml:"any_user"`
-	MaxAllowedInstances int         `yaml:"max_allowed_instances"`
-	RegisteredUsers     AccountList `yaml:"registered_users"`
-	GrantedQuota        QuotaList   `yaml:"granted_quota,omitempty"`
+	Id                  string      `yaml:"id" json:"id"`
+	AnyUser             bool        `yaml:"any_user" json:"any_user"`
+	MaxAllowedInstances int         `yaml:"max_allowed_instances" json:"max_allowed_instances"`
+	RegisteredUsers     AccountList `yaml:"registered_users" json:"registered_users"`
+	GrantedQuota        QuotaList   `yaml:"granted_quota,omitempty" json:"granted_quota,omitempty"`
 }
 
 func (org Organisation) IsUserRegistered(username string) bool {
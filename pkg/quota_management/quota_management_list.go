@@ -15,6 +15,6 @@ type QuotaManagementListItem interface {
 }
 
 type RegisteredUsersListConfiguration struct {
-	Organisations   OrganisationList `yaml:"registered_users_per_organisation"`
-	ServiceAccounts AccountList      `yaml:"registered_service_accounts"`
+	Organisations   OrganisationList `yaml:"registered_users_per_organisation" json:"registered_users_per_organisation"`
+	ServiceAccounts AccountList      `yaml:"registered_service_accounts" json:"registered_service_accounts"`
 }
@@ -6,12 +6,15 @@ import (
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
 	"os"
+	"sync"
 )
 
 type QuotaManagementListConfig struct {
 	QuotaList                  RegisteredUsersListConfiguration
 	QuotaListConfigFile        string
 	EnableInstanceLimitControl bool
+	// lock protects the quota list, which is replaced at runtime by the entries stored in the database
+	lock sync.RWMutex
 }
 
 func NewQuotaManagementListConfig() *QuotaManagementListConfig {
@@ -44,14 +47,45 @@ func (c *QuotaManagementListConfig) ReadFiles() error {
 func (c *QuotaManagementListConfig) GetAllowedAccountByUsernameAndOrgId(username string, orgId string) (Account, bool) {
 	var user Account
 	var found bool
-	org, _ := c.QuotaList.Organisations.GetById(orgId)
+	org, _ := c.GetOrganisation(orgId)
 	user, found = org.RegisteredUsers.GetByUsername(username)
 	if found {
 		return user, found
 	}
+	return c.GetServiceAccount(username)
+}
+
+// GetOrganisation returns the organisation of the quota list with the given id
+func (c *QuotaManagementListConfig) GetOrganisation(orgId string) (Organisation, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.QuotaList.Organisations.GetById(orgId)
+}
+
+// GetServiceAccount returns the service account of the quota list with the given username
+func (c *QuotaManagementListConfig) GetServiceAccount(username string) (Account, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 	return c.QuotaList.ServiceAccounts.GetByUsername(username)
 }
 
+// GetQuotaList returns a copy of the quota list
+func (c *QuotaManagementListConfig) GetQuotaList() RegisteredUsersListConfiguration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return RegisteredUsersListConfiguration{
+		Organisations:   append(OrganisationList{}, c.QuotaList.Organisations...),
+		ServiceAccounts: append(AccountList{}, c.QuotaList.ServiceAccounts...),
+	}
+}
+
+// SetQuotaList replaces the quota list
+func (c *QuotaManagementListConfig) SetQuotaList(quotaList RegisteredUsersListConfiguration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.QuotaList = quotaList
+}
+
 // Read the contents of file into the quota list config
 func readQuotaManagementListConfigFile(file string, val *RegisteredUsersListConfiguration) error {
 	fileContents, err := shared.ReadFile(file)
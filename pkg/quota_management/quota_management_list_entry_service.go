@@ -0,0 +1,328 @@
+package quota_management
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QuotaManagementListChangedSignal is the type of the outbox events recorded every time an entry of the quota management list
+// is created, updated or deleted, and so the name of the signal that is notified on the signal bus once the change is committed.
+const QuotaManagementListChangedSignal = "quota_management_list.changed"
+
+//go:generate moq -out quota_management_list_entry_service_moq.go . QuotaManagementListEntryService
+type QuotaManagementListEntryService interface {
+	// ListEntries returns the entries of the quota management list, oldest first.
+	// When an entry type is given, only the entries of that type are returned.
+	ListEntries(entryType api.QuotaManagementListEntryType) (api.QuotaManagementListEntryList, *errors.ServiceError)
+	// GetEntry returns the entry with the given id
+	GetEntry(id string) (*api.QuotaManagementListEntry, *errors.ServiceError)
+	// CreateEntry validates the definition of the entry, sets its key and adds it to the quota management list.
+	// It fails with a conflict when the organisation or service account is already in the list.
+	CreateEntry(entry *api.QuotaManagementListEntry) *errors.ServiceError
+	// UpdateEntry replaces the definition of the entry with the given id and returns the updated entry
+	UpdateEntry(id string, definition api.JSON) (*api.QuotaManagementListEntry, *errors.ServiceError)
+	// DeleteEntry removes the entry with the given id from the quota management list
+	DeleteEntry(id string) *errors.ServiceError
+	// Reload replaces the quota list of the quota management list configuration by the entries stored in the database
+	Reload() *errors.ServiceError
+}
+
+var _ QuotaManagementListEntryService = &quotaManagementListEntryService{}
+var _ environments.BootService = &quotaManagementListEntryService{}
+
+// quotaManagementListEntryService stores the quota management list in the database. The list of the configuration file
+// only seeds the database, the list of the configuration is then kept in sync with the database on every replica
+// by reloading it when the changes are signaled.
+type quotaManagementListEntryService struct {
+	connectionFactory         *db.ConnectionFactory
+	quotaManagementListConfig *QuotaManagementListConfig
+	signalBus                 signalbus.SignalBus
+	outbox                    signalbus.Outbox
+	isRunning                 int32
+	stopChan                  chan struct{}
+	syncGroup                 sync.WaitGroup
+}
+
+func NewQuotaManagementListEntryService(connectionFactory *db.ConnectionFactory, quotaManagementListConfig *QuotaManagementListConfig, signalBus signalbus.SignalBus, outbox signalbus.Outbox) *quotaManagementListEntryService {
+	return &quotaManagementListEntryService{
+		connectionFactory:         connectionFactory,
+		quotaManagementListConfig: quotaManagementListConfig,
+		signalBus:                 signalBus,
+		outbox:                    outbox,
+		stopChan:                  make(chan struct{}),
+	}
+}
+
+func (s *quotaManagementListEntryService) ListEntries(entryType api.QuotaManagementListEntryType) (api.QuotaManagementListEntryList, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+	if entryType != "" {
+		dbConn = dbConn.Where("type = ?", entryType)
+	}
+
+	var entries api.QuotaManagementListEntryList
+	if err := dbConn.Order("created_at").Find(&entries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list quota management list entries")
+	}
+	return entries, nil
+}
+
+func (s *quotaManagementListEntryService) GetEntry(id string) (*api.QuotaManagementListEntry, *errors.ServiceError) {
+	var entry api.QuotaManagementListEntry
+	if err := s.connectionFactory.New().Where("id = ?", id).First(&entry).Error; err != nil {
+		return nil, services.HandleGetError("QuotaManagementListEntry", "id", id, err)
+	}
+	return &entry, nil
+}
+
+func (s *quotaManagementListEntryService) CreateEntry(entry *api.QuotaManagementListEntry) *errors.ServiceError {
+	key, svcErr := decodeEntryKey(entry.Type, entry.Definition)
+	if svcErr != nil {
+		return svcErr
+	}
+	entry.Key = key
+
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(entry).Error; err != nil {
+			svcErr = services.HandleCreateError("quota management list entry", err)
+			return err
+		}
+		if svcErr = s.publishChange(tx, entry); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}); err != nil {
+		if svcErr != nil {
+			return svcErr
+		}
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create quota management list entry")
+	}
+	return nil
+}
+
+func (s *quotaManagementListEntryService) UpdateEntry(id string, definition api.JSON) (*api.QuotaManagementListEntry, *errors.ServiceError) {
+	var entry api.QuotaManagementListEntry
+	var svcErr *errors.ServiceError
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ?", id).First(&entry).Error; err != nil {
+			svcErr = services.HandleGetError("QuotaManagementListEntry", "id", id, err)
+			return err
+		}
+		// the type of the entry can not be changed, the definition is validated against the existing type
+		key, validationErr := decodeEntryKey(entry.Type, definition)
+		if validationErr != nil {
+			svcErr = validationErr
+			return svcErr
+		}
+		entry.Key = key
+		entry.Definition = definition
+		if err := tx.Model(&entry).Select("key", "definition", "updated_at").Updates(&entry).Error; err != nil {
+			svcErr = services.HandleUpdateError("quota management list entry", err)
+			return err
+		}
+		if svcErr = s.publishChange(tx, &entry); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}); err != nil {
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update quota management list entry %q", id)
+	}
+	return &entry, nil
+}
+
+func (s *quotaManagementListEntryService) DeleteEntry(id string) *errors.ServiceError {
+	var svcErr *errors.ServiceError
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var entry api.QuotaManagementListEntry
+		if err := tx.Where("id = ?", id).First(&entry).Error; err != nil {
+			svcErr = services.HandleGetError("QuotaManagementListEntry", "id", id, err)
+			return err
+		}
+		if err := tx.Delete(&entry).Error; err != nil {
+			svcErr = services.HandleDeleteError("QuotaManagementListEntry", "id", id, err)
+			return err
+		}
+		if svcErr = s.publishChange(tx, &entry); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}); err != nil {
+		if svcErr != nil {
+			return svcErr
+		}
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete quota management list entry %q", id)
+	}
+	return nil
+}
+
+func (s *quotaManagementListEntryService) Reload() *errors.ServiceError {
+	entries, err := s.ListEntries("")
+	if err != nil {
+		return err
+	}
+
+	quotaList := RegisteredUsersListConfiguration{
+		Organisations:   OrganisationList{},
+		ServiceAccounts: AccountList{},
+	}
+	for _, entry := range entries {
+		switch entry.Type {
+		case api.QuotaManagementListEntryTypeOrganisation:
+			var org Organisation
+			if err := decodeDefinition(entry.Definition, &org); err != nil {
+				logger.Logger.Errorf("skipping invalid quota management list entry %q: %v", entry.ID, err)
+				continue
+			}
+			quotaList.Organisations = append(quotaList.Organisations, org)
+		case api.QuotaManagementListEntryTypeServiceAccount:
+			var account Account
+			if err := decodeDefinition(entry.Definition, &account); err != nil {
+				logger.Logger.Errorf("skipping invalid quota management list entry %q: %v", entry.ID, err)
+				continue
+			}
+			quotaList.ServiceAccounts = append(quotaList.ServiceAccounts, account)
+		}
+	}
+	s.quotaManagementListConfig.SetQuotaList(quotaList)
+	return nil
+}
+
+// Start seeds the database with the quota list of the configuration file, loads the quota list stored in the database
+// and reloads it every time it changes until the service is stopped.
+func (s *quotaManagementListEntryService) Start() {
+	if !atomic.CompareAndSwapInt32(&s.isRunning, 0, 1) {
+		return
+	}
+	s.stopChan = make(chan struct{})
+
+	if err := s.seed(); err != nil {
+		logger.Logger.Errorf("failed to seed the quota management list: %v", err)
+	}
+	// the quota list of the configuration file is kept until the list is successfully loaded from the database
+	if err := s.Reload(); err != nil {
+		logger.Logger.Errorf("failed to load the quota management list: %v", err)
+	}
+
+	sub := s.signalBus.Subscribe(QuotaManagementListChangedSignal)
+	s.syncGroup.Add(1)
+	go func() {
+		defer s.syncGroup.Done()
+		defer sub.Close()
+		for {
+			select {
+			case <-s.stopChan:
+				return
+			case <-sub.Signal():
+				if err := s.Reload(); err != nil {
+					logger.Logger.Errorf("failed to reload the quota management list: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops reloading the quota list. Blocks until the reload in progress, if any, completes.
+func (s *quotaManagementListEntryService) Stop() {
+	select {
+	case <-s.stopChan:
+		// already closed
+	default:
+		close(s.stopChan)
+		s.syncGroup.Wait()
+	}
+	atomic.StoreInt32(&s.isRunning, 0)
+}
+
+// seed stores the quota list of the configuration file in the database, unless entries have ever been stored.
+// The file only provides the initial content of the list, so that the entries deleted through the admin API
+// are not restored on restart.
+func (s *quotaManagementListEntryService) seed() error {
+	quotaList := s.quotaManagementListConfig.GetQuotaList()
+	entries := api.QuotaManagementListEntryList{}
+	for _, org := range quotaList.Organisations {
+		definition, err := json.Marshal(org)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, &api.QuotaManagementListEntry{Type: api.QuotaManagementListEntryTypeOrganisation, Key: org.Id, Definition: definition, CreatedBy: "kas-fleet-manager"})
+	}
+	for _, account := range quotaList.ServiceAccounts {
+		definition, err := json.Marshal(account)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, &api.QuotaManagementListEntry{Type: api.QuotaManagementListEntryTypeServiceAccount, Key: account.Username, Definition: definition, CreatedBy: "kas-fleet-manager"})
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var count int64
+		// deleted entries are counted too, the list has been seeded when they were created
+		if err := tx.Unscoped().Model(&api.QuotaManagementListEntry{}).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return nil
+		}
+		// the replicas starting at the same time may seed the list concurrently
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entries).Error
+	})
+}
+
+// publishChange records the change of the entry in the outbox, using the transaction of the change
+func (s *quotaManagementListEntryService) publishChange(tx *gorm.DB, entry *api.QuotaManagementListEntry) *errors.ServiceError {
+	return s.outbox.Publish(tx, &api.OutboxEvent{
+		EventType:  QuotaManagementListChangedSignal,
+		ResourceId: entry.ID,
+	})
+}
+
+// decodeEntryKey validates the definition of an entry of the given type and returns its key,
+// i.e. the id of the organisation or the username of the service account
+func decodeEntryKey(entryType api.QuotaManagementListEntryType, definition api.JSON) (string, *errors.ServiceError) {
+	var key string
+	switch entryType {
+	case api.QuotaManagementListEntryTypeOrganisation:
+		var org Organisation
+		if err := decodeDefinition(definition, &org); err != nil {
+			return "", errors.NewWithCause(errors.ErrorBadRequest, err, "invalid organisation definition: %s", err.Error())
+		}
+		key = org.Id
+	case api.QuotaManagementListEntryTypeServiceAccount:
+		var account Account
+		if err := decodeDefinition(definition, &account); err != nil {
+			return "", errors.NewWithCause(errors.ErrorBadRequest, err, "invalid service account definition: %s", err.Error())
+		}
+		key = account.Username
+	default:
+		return "", errors.BadRequest("invalid quota management list entry type %q, accepted values are %v", entryType, api.ValidQuotaManagementListEntryTypes)
+	}
+
+	if key == "" {
+		return "", errors.BadRequest("the %s definition must have an identifier", entryType)
+	}
+	return key, nil
+}
+
+// decodeDefinition decodes the definition of an entry, rejecting the unknown fields as it is done for the configuration file
+func decodeDefinition(definition api.JSON, val interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(definition))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(val)
+}
@@ -0,0 +1,295 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package quota_management
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that QuotaManagementListEntryServiceMock does implement QuotaManagementListEntryService.
+// If this is not the case, regenerate this file with moq.
+var _ QuotaManagementListEntryService = &QuotaManagementListEntryServiceMock{}
+
+// QuotaManagementListEntryServiceMock is a mock implementation of QuotaManagementListEntryService.
+//
+//	func TestSomethingThatUsesQuotaManagementListEntryService(t *testing.T) {
+//
+//		// make and configure a mocked QuotaManagementListEntryService
+//		mockedQuotaManagementListEntryService := &QuotaManagementListEntryServiceMock{
+//			CreateEntryFunc: func(entry *api.QuotaManagementListEntry) *errors.ServiceError {
+//				panic("mock out the CreateEntry method")
+//			},
+//			DeleteEntryFunc: func(id string) *errors.ServiceError {
+//				panic("mock out the DeleteEntry method")
+//			},
+//			GetEntryFunc: func(id string) (*api.QuotaManagementListEntry, *errors.ServiceError) {
+//				panic("mock out the GetEntry method")
+//			},
+//			ListEntriesFunc: func(entryType api.QuotaManagementListEntryType) (api.QuotaManagementListEntryList, *errors.ServiceError) {
+//				panic("mock out the ListEntries method")
+//			},
+//			ReloadFunc: func() *errors.ServiceError {
+//				panic("mock out the Reload method")
+//			},
+//			UpdateEntryFunc: func(id string, definition api.JSON) (*api.QuotaManagementListEntry, *errors.ServiceError) {
+//				panic("mock out the UpdateEntry method")
+//			},
+//		}
+//
+//		// use mockedQuotaManagementListEntryService in code that requires QuotaManagementListEntryService
+//		// and then make assertions.
+//
+//	}
+type QuotaManagementListEntryServiceMock struct {
+	// CreateEntryFunc mocks the CreateEntry method.
+	CreateEntryFunc func(entry *api.QuotaManagementListEntry) *errors.ServiceError
+
+	// DeleteEntryFunc mocks the DeleteEntry method.
+	DeleteEntryFunc func(id string) *errors.ServiceError
+
+	// GetEntryFunc mocks the GetEntry method.
+	GetEntryFunc func(id string) (*api.QuotaManagementListEntry, *errors.ServiceError)
+
+	// ListEntriesFunc mocks the ListEntries method.
+	ListEntriesFunc func(entryType api.QuotaManagementListEntryType) (api.QuotaManagementListEntryList, *errors.ServiceError)
+
+	// ReloadFunc mocks the Reload method.
+	ReloadFunc func() *errors.ServiceError
+
+	// UpdateEntryFunc mocks the UpdateEntry method.
+	UpdateEntryFunc func(id string, definition api.JSON) (*api.QuotaManagementListEntry, *errors.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateEntry holds details about calls to the CreateEntry method.
+		CreateEntry []struct {
+			// Entry is the entry argument value.
+			Entry *api.QuotaManagementListEntry
+		}
+		// DeleteEntry holds details about calls to the DeleteEntry method.
+		DeleteEntry []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// GetEntry holds details about calls to the GetEntry method.
+		GetEntry []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// ListEntries holds details about calls to the ListEntries method.
+		ListEntries []struct {
+			// EntryType is the entryType argument value.
+			EntryType api.QuotaManagementListEntryType
+		}
+		// Reload holds details about calls to the Reload method.
+		Reload []struct {
+		}
+		// UpdateEntry holds details about calls to the UpdateEntry method.
+		UpdateEntry []struct {
+			// ID is the id argument value.
+			ID string
+			// Definition is the definition argument value.
+			Definition api.JSON
+		}
+	}
+	lockCreateEntry sync.RWMutex
+	lockDeleteEntry sync.RWMutex
+	lockGetEntry    sync.RWMutex
+	lockListEntries sync.RWMutex
+	lockReload      sync.RWMutex
+	lockUpdateEntry sync.RWMutex
+}
+
+// CreateEntry calls CreateEntryFunc.
+func (mock *QuotaManagementListEntryServiceMock) CreateEntry(entry *api.QuotaManagementListEntry) *errors.ServiceError {
+	if mock.CreateEntryFunc == nil {
+		panic("QuotaManagementListEntryServiceMock.CreateEntryFunc: method is nil but QuotaManagementListEntryService.CreateEntry was just called")
+	}
+	callInfo := struct {
+		Entry *api.QuotaManagementListEntry
+	}{
+		Entry: entry,
+	}
+	mock.lockCreateEntry.Lock()
+	mock.calls.CreateEntry = append(mock.calls.CreateEntry, callInfo)
+	mock.lockCreateEntry.Unlock()
+	return mock.CreateEntryFunc(entry)
+}
+
+// CreateEntryCalls gets all the calls that were made to CreateEntry.
+// Check the length with:
+//
+//	len(mockedQuotaManagementListEntryService.CreateEntryCalls())
+func (mock *QuotaManagementListEntryServiceMock) CreateEntryCalls() []struct {
+	Entry *api.QuotaManagementListEntry
+} {
+	var calls []struct {
+		Entry *api.QuotaManagementListEntry
+	}
+	mock.lockCreateEntry.RLock()
+	calls = mock.calls.CreateEntry
+	mock.lockCreateEntry.RUnlock()
+	return calls
+}
+
+// DeleteEntry calls DeleteEntryFunc.
+func (mock *QuotaManagementListEntryServiceMock) DeleteEntry(id string) *errors.ServiceError {
+	if mock.DeleteEntryFunc == nil {
+		panic("QuotaManagementListEntryServiceMock.DeleteEntryFunc: method is nil but QuotaManagementListEntryService.DeleteEntry was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockDeleteEntry.Lock()
+	mock.calls.DeleteEntry = append(mock.calls.DeleteEntry, callInfo)
+	mock.lockDeleteEntry.Unlock()
+	return mock.DeleteEntryFunc(id)
+}
+
+// DeleteEntryCalls gets all the calls that were made to DeleteEntry.
+// Check the length with:
+//
+//	len(mockedQuotaManagementListEntryService.DeleteEntryCalls())
+func (mock *QuotaManagementListEntryServiceMock) DeleteEntryCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockDeleteEntry.RLock()
+	calls = mock.calls.DeleteEntry
+	mock.lockDeleteEntry.RUnlock()
+	return calls
+}
+
+// GetEntry calls GetEntryFunc.
+func (mock *QuotaManagementListEntryServiceMock) GetEntry(id string) (*api.QuotaManagementListEntry, *errors.ServiceError) {
+	if mock.GetEntryFunc == nil {
+		panic("QuotaManagementListEntryServiceMock.GetEntryFunc: method is nil but QuotaManagementListEntryService.GetEntry was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockGetEntry.Lock()
+	mock.calls.GetEntry = append(mock.calls.GetEntry, callInfo)
+	mock.lockGetEntry.Unlock()
+	return mock.GetEntryFunc(id)
+}
+
+// GetEntryCalls gets all the calls that were made to GetEntry.
+// Check the length with:
+//
+//	len(mockedQuotaManagementListEntryService.GetEntryCalls())
+func (mock *QuotaManagementListEntryServiceMock) GetEntryCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockGetEntry.RLock()
+	calls = mock.calls.GetEntry
+	mock.lockGetEntry.RUnlock()
+	return calls
+}
+
+// ListEntries calls ListEntriesFunc.
+func (mock *QuotaManagementListEntryServiceMock) ListEntries(entryType api.QuotaManagementListEntryType) (api.QuotaManagementListEntryList, *errors.ServiceError) {
+	if mock.ListEntriesFunc == nil {
+		panic("QuotaManagementListEntryServiceMock.ListEntriesFunc: method is nil but QuotaManagementListEntryService.ListEntries was just called")
+	}
+	callInfo := struct {
+		EntryType api.QuotaManagementListEntryType
+	}{
+		EntryType: entryType,
+	}
+	mock.lockListEntries.Lock()
+	mock.calls.ListEntries = append(mock.calls.ListEntries, callInfo)
+	mock.lockListEntries.Unlock()
+	return mock.ListEntriesFunc(entryType)
+}
+
+// ListEntriesCalls gets all the calls that were made to ListEntries.
+// Check the length with:
+//
+//	len(mockedQuotaManagementListEntryService.ListEntriesCalls())
+func (mock *QuotaManagementListEntryServiceMock) ListEntriesCalls() []struct {
+	EntryType api.QuotaManagementListEntryType
+} {
+	var calls []struct {
+		EntryType api.QuotaManagementListEntryType
+	}
+	mock.lockListEntries.RLock()
+	calls = mock.calls.ListEntries
+	mock.lockListEntries.RUnlock()
+	return calls
+}
+
+// Reload calls ReloadFunc.
+func (mock *QuotaManagementListEntryServiceMock) Reload() *errors.ServiceError {
+	if mock.ReloadFunc == nil {
+		panic("QuotaManagementListEntryServiceMock.ReloadFunc: method is nil but QuotaManagementListEntryService.Reload was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockReload.Lock()
+	mock.calls.Reload = append(mock.calls.Reload, callInfo)
+	mock.lockReload.Unlock()
+	return mock.ReloadFunc()
+}
+
+// ReloadCalls gets all the calls that were made to Reload.
+// Check the length with:
+//
+//	len(mockedQuotaManagementListEntryService.ReloadCalls())
+func (mock *QuotaManagementListEntryServiceMock) ReloadCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockReload.RLock()
+	calls = mock.calls.Reload
+	mock.lockReload.RUnlock()
+	return calls
+}
+
+// UpdateEntry calls UpdateEntryFunc.
+func (mock *QuotaManagementListEntryServiceMock) UpdateEntry(id string, definition api.JSON) (*api.QuotaManagementListEntry, *errors.ServiceError) {
+	if mock.UpdateEntryFunc == nil {
+		panic("QuotaManagementListEntryServiceMock.UpdateEntryFunc: method is nil but QuotaManagementListEntryService.UpdateEntry was just called")
+	}
+	callInfo := struct {
+		ID         string
+		Definition api.JSON
+	}{
+		ID:         id,
+		Definition: definition,
+	}
+	mock.lockUpdateEntry.Lock()
+	mock.calls.UpdateEntry = append(mock.calls.UpdateEntry, callInfo)
+	mock.lockUpdateEntry.Unlock()
+	return mock.UpdateEntryFunc(id, definition)
+}
+
+// UpdateEntryCalls gets all the calls that were made to UpdateEntry.
+// Check the length with:
+//
+//	len(mockedQuotaManagementListEntryService.UpdateEntryCalls())
+func (mock *QuotaManagementListEntryServiceMock) UpdateEntryCalls() []struct {
+	ID         string
+	Definition api.JSON
+} {
+	var calls []struct {
+		ID         string
+		Definition api.JSON
+	}
+	mock.lockUpdateEntry.RLock()
+	calls = mock.calls.UpdateEntry
+	mock.lockUpdateEntry.RUnlock()
+	return calls
+}
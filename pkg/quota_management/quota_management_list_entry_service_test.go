@@ -0,0 +1,150 @@
+package quota_management
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+	"gorm.io/gorm"
+)
+
+func Test_decodeEntryKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		entryType  api.QuotaManagementListEntryType
+		definition string
+		wantKey    string
+		wantErr    bool
+	}{
+		{
+			name:       "should return the id of the organisation",
+			entryType:  api.QuotaManagementListEntryTypeOrganisation,
+			definition: `{"id":"13640203","any_user":true,"max_allowed_instances":2,"granted_quota":[{"instance_type_id":"standard","kafka_billing_models":[{"id":"enterprise","expiration_date":"2023-12-06 +01:00"}]}]}`,
+			wantKey:    "13640203",
+		},
+		{
+			name:       "should return the username of the service account",
+			entryType:  api.QuotaManagementListEntryTypeServiceAccount,
+			definition: `{"username":"sa-user","max_allowed_instances":3}`,
+			wantKey:    "sa-user",
+		},
+		{
+			name:       "should reject unknown fields",
+			entryType:  api.QuotaManagementListEntryTypeServiceAccount,
+			definition: `{"username":"sa-user","registered_users":[]}`,
+			wantErr:    true,
+		},
+		{
+			name:       "should reject a definition without identifier",
+			entryType:  api.QuotaManagementListEntryTypeOrganisation,
+			definition: `{"max_allowed_instances":2}`,
+			wantErr:    true,
+		},
+		{
+			name:       "should reject an invalid expiration date",
+			entryType:  api.QuotaManagementListEntryTypeOrganisation,
+			definition: `{"id":"13640203","granted_quota":[{"instance_type_id":"standard","kafka_billing_models":[{"id":"enterprise","expiration_date":"tomorrow"}]}]}`,
+			wantErr:    true,
+		},
+		{
+			name:       "should reject an unknown entry type",
+			entryType:  "user",
+			definition: `{"username":"sa-user"}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			key, err := decodeEntryKey(tt.entryType, api.JSON(tt.definition))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if err != nil {
+				g.Expect(err.Code).To(gomega.Equal(errors.ErrorBadRequest))
+			}
+			g.Expect(key).To(gomega.Equal(tt.wantKey))
+		})
+	}
+}
+
+func Test_quotaManagementListEntryService_Reload(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().
+		WithQuery(`SELECT * FROM "quota_management_list_entries"`).
+		WithReply([]map[string]interface{}{
+			{"id": "1", "type": "organisation", "key": "13640203", "definition": []byte(`{"id":"13640203","max_allowed_instances":2,"registered_users":[{"username":"org-user"}]}`)},
+			{"id": "2", "type": "service_account", "key": "sa-user", "definition": []byte(`{"username":"sa-user","max_allowed_instances":3}`)},
+			{"id": "3", "type": "service_account", "key": "invalid", "definition": []byte(`{"unknown":true}`)},
+		})
+
+	config := &QuotaManagementListConfig{
+		QuotaList: RegisteredUsersListConfiguration{ServiceAccounts: AccountList{{Username: "file-user"}}},
+	}
+	s := NewQuotaManagementListEntryService(db.NewMockConnectionFactory(nil), config, signalbus.NewSignalBus(), &signalbus.OutboxMock{})
+	g.Expect(s.Reload()).To(gomega.BeNil())
+
+	org, found := config.GetOrganisation("13640203")
+	g.Expect(found).To(gomega.BeTrue())
+	g.Expect(org.MaxAllowedInstances).To(gomega.Equal(2))
+	account, found := config.GetAllowedAccountByUsernameAndOrgId("org-user", "13640203")
+	g.Expect(found).To(gomega.BeTrue())
+	g.Expect(account.Username).To(gomega.Equal("org-user"))
+	account, found = config.GetServiceAccount("sa-user")
+	g.Expect(found).To(gomega.BeTrue())
+	g.Expect(account.MaxAllowedInstances).To(gomega.Equal(3))
+	// the entries that are not in the database anymore are removed, the invalid entries are skipped
+	_, found = config.GetServiceAccount("file-user")
+	g.Expect(found).To(gomega.BeFalse())
+	g.Expect(config.GetQuotaList().ServiceAccounts).To(gomega.HaveLen(1))
+}
+
+func Test_quotaManagementListEntryService_CreateEntry(t *testing.T) {
+	tests := []struct {
+		name             string
+		entry            *api.QuotaManagementListEntry
+		wantErrCode      errors.ServiceErrorCode
+		wantKey          string
+		wantPublishCalls int
+	}{
+		{
+			name:             "should set the key of the entry from its definition and publish the change",
+			entry:            &api.QuotaManagementListEntry{Type: api.QuotaManagementListEntryTypeServiceAccount, Definition: api.JSON(`{"username":"sa-user"}`)},
+			wantKey:          "sa-user",
+			wantPublishCalls: 1,
+		},
+		{
+			name:        "should not store an invalid definition",
+			entry:       &api.QuotaManagementListEntry{Type: api.QuotaManagementListEntryTypeServiceAccount, Definition: api.JSON(`{"id":"13640203"}`)},
+			wantErrCode: errors.ErrorBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			outbox := &signalbus.OutboxMock{
+				PublishFunc: func(dbConn *gorm.DB, event *api.OutboxEvent) *errors.ServiceError {
+					g.Expect(event.EventType).To(gomega.Equal(QuotaManagementListChangedSignal))
+					return nil
+				},
+			}
+			s := NewQuotaManagementListEntryService(db.NewMockConnectionFactory(nil), &QuotaManagementListConfig{}, signalbus.NewSignalBus(), outbox)
+			err := s.CreateEntry(tt.entry)
+			if tt.wantErrCode != 0 {
+				g.Expect(err).NotTo(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+			}
+			g.Expect(tt.entry.Key).To(gomega.Equal(tt.wantKey))
+			g.Expect(outbox.PublishCalls()).To(gomega.HaveLen(tt.wantPublishCalls))
+		})
+	}
+}
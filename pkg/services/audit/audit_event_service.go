@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/queryparser"
+)
+
+//go:generate moq -out audit_event_service_moq.go . AuditEventService
+type AuditEventService interface {
+	// Create persists the given audit event
+	Create(auditEvent *api.AuditEvent) *errors.ServiceError
+	// List returns the audit events matching the search query within the requested paging window.
+	// Events are returned most recent first unless an order is given.
+	List(listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError)
+}
+
+var _ AuditEventService = &auditEventService{}
+
+type auditEventService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewAuditEventService(connectionFactory *db.ConnectionFactory) AuditEventService {
+	return &auditEventService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+// GetValidAuditEventColumns returns the columns that can be used in the search and orderBy list arguments
+func GetValidAuditEventColumns() []string {
+	return []string{"id", "created_at", "actor", "organisation_id", "action", "resource_id", "method", "status_code", "operation_id"}
+}
+
+func (a *auditEventService) Create(auditEvent *api.AuditEvent) *errors.ServiceError {
+	dbConn := a.connectionFactory.New()
+	if err := dbConn.Create(auditEvent).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create audit event")
+	}
+	return nil
+}
+
+func (a *auditEventService) List(listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError) {
+	if err := listArgs.Validate(GetValidAuditEventColumns()); err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "unable to list audit events: %s", err.Error())
+	}
+
+	var auditEventList api.AuditEventList
+	dbConn := a.connectionFactory.New()
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	// Apply search query
+	if len(listArgs.Search) > 0 {
+		searchDbQuery, err := queryparser.NewQueryParser(GetValidAuditEventColumns()...).Parse(listArgs.Search)
+		if err != nil {
+			return auditEventList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list audit events: %s", err.Error())
+		}
+		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
+	}
+
+	if len(listArgs.OrderBy) == 0 {
+		// default orderBy most recent first
+		dbConn = dbConn.Order("created_at DESC")
+	}
+
+	// Set the order by arguments if any
+	for _, orderByArg := range listArgs.OrderBy {
+		dbConn = dbConn.Order(orderByArg)
+	}
+
+	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&auditEventList).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+
+	// execute query
+	if err := dbConn.Find(&auditEventList).Error; err != nil {
+		return auditEventList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list audit events")
+	}
+
+	return auditEventList, pagingMeta, nil
+}
@@ -0,0 +1,121 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package audit
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"sync"
+)
+
+// Ensure, that AuditEventServiceMock does implement AuditEventService.
+// If this is not the case, regenerate this file with moq.
+var _ AuditEventService = &AuditEventServiceMock{}
+
+// AuditEventServiceMock is a mock implementation of AuditEventService.
+//
+//	func TestSomethingThatUsesAuditEventService(t *testing.T) {
+//
+//		// make and configure a mocked AuditEventService
+//		mockedAuditEventService := &AuditEventServiceMock{
+//			CreateFunc: func(auditEvent *api.AuditEvent) *errors.ServiceError {
+//				panic("mock out the Create method")
+//			},
+//			ListFunc: func(listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//		}
+//
+//		// use mockedAuditEventService in code that requires AuditEventService
+//		// and then make assertions.
+//
+//	}
+type AuditEventServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(auditEvent *api.AuditEvent) *errors.ServiceError
+
+	// ListFunc mocks the List method.
+	ListFunc func(listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// AuditEvent is the auditEvent argument value.
+			AuditEvent *api.AuditEvent
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// ListArgs is the listArgs argument value.
+			ListArgs *services.ListArguments
+		}
+	}
+	lockCreate sync.RWMutex
+	lockList   sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *AuditEventServiceMock) Create(auditEvent *api.AuditEvent) *errors.ServiceError {
+	if mock.CreateFunc == nil {
+		panic("AuditEventServiceMock.CreateFunc: method is nil but AuditEventService.Create was just called")
+	}
+	callInfo := struct {
+		AuditEvent *api.AuditEvent
+	}{
+		AuditEvent: auditEvent,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(auditEvent)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedAuditEventService.CreateCalls())
+func (mock *AuditEventServiceMock) CreateCalls() []struct {
+	AuditEvent *api.AuditEvent
+} {
+	var calls []struct {
+		AuditEvent *api.AuditEvent
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *AuditEventServiceMock) List(listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("AuditEventServiceMock.ListFunc: method is nil but AuditEventService.List was just called")
+	}
+	callInfo := struct {
+		ListArgs *services.ListArguments
+	}{
+		ListArgs: listArgs,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(listArgs)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedAuditEventService.ListCalls())
+func (mock *AuditEventServiceMock) ListCalls() []struct {
+	ListArgs *services.ListArguments
+} {
+	var calls []struct {
+		ListArgs *services.ListArguments
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
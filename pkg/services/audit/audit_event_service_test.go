@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_auditEventService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should return an error when the audit event cannot be inserted",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "audit_events"`).WithQueryException().WithExecException()
+			},
+			wantErr: true,
+		},
+		{
+			name: "should create the audit event",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "audit_events"`)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewAuditEventService(db.NewMockConnectionFactory(nil))
+			auditEvent := &api.AuditEvent{Action: "update-kafka-by-id", ResourceId: "kafka-id"}
+			err := s.Create(auditEvent)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(auditEvent.ID).ToNot(gomega.BeEmpty())
+		})
+	}
+}
+
+func Test_auditEventService_List(t *testing.T) {
+	tests := []struct {
+		name        string
+		listArgs    *services.ListArguments
+		setupFn     func()
+		wantErrCode errors.ServiceErrorCode
+		wantIds     []string
+		wantPaging  *api.PagingMeta
+	}{
+		{
+			name:        "should return an error when ordering by an unknown column",
+			listArgs:    &services.ListArguments{Page: 1, Size: 10, OrderBy: []string{"request_diff"}},
+			setupFn:     func() { mocket.Catcher.Reset() },
+			wantErrCode: errors.ErrorMalformedRequest,
+		},
+		{
+			name:        "should return an error when searching on an unknown column",
+			listArgs:    &services.ListArguments{Page: 1, Size: 10, Search: "request_diff = test"},
+			setupFn:     func() { mocket.Catcher.Reset() },
+			wantErrCode: errors.ErrorFailedToParseSearch,
+		},
+		{
+			name:     "should return an error when the query fails",
+			listArgs: &services.ListArguments{Page: 1, Size: 10},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "audit_events"`).WithQueryException()
+			},
+			wantErrCode: errors.ErrorGeneral,
+		},
+		{
+			name:     "should list the audit events matching the search most recent first",
+			listArgs: &services.ListArguments{Page: 1, Size: 10, Search: "resource_id = kafka-id and action = update-kafka-by-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT count(1) FROM "audit_events" WHERE (resource_id = $1 and action = $2)`).
+					WithArgs("kafka-id", "update-kafka-by-id").
+					WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "audit_events" WHERE (resource_id = $1 and action = $2) AND "audit_events"."deleted_at" IS NULL ORDER BY created_at DESC LIMIT 2`).
+					WithArgs("kafka-id", "update-kafka-by-id").
+					WithReply([]map[string]interface{}{{"id": "event-2"}, {"id": "event-1"}})
+			},
+			wantIds:    []string{"event-2", "event-1"},
+			wantPaging: &api.PagingMeta{Page: 1, Size: 2, Total: 2},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewAuditEventService(db.NewMockConnectionFactory(nil))
+			auditEvents, paging, err := s.List(tt.listArgs)
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(paging).To(gomega.Equal(tt.wantPaging))
+			ids := []string{}
+			for _, auditEvent := range auditEvents {
+				ids = append(ids, auditEvent.ID)
+			}
+			g.Expect(ids).To(gomega.Equal(tt.wantIds))
+		})
+	}
+}
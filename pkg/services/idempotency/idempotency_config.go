@@ -0,0 +1,31 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type IdempotencyConfig struct {
+	// KeyTTL is the time during which a request sent again with the same idempotency key gets the response of the first request
+	KeyTTL time.Duration
+	// InProgressTimeout is the time after which a key whose request was never completed, e.g. because its replica stopped,
+	// can be used again by a retry of the request
+	InProgressTimeout time.Duration
+}
+
+func NewIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		KeyTTL:            24 * time.Hour,
+		InProgressTimeout: 5 * time.Minute,
+	}
+}
+
+func (c *IdempotencyConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.KeyTTL, "idempotency-key-ttl", c.KeyTTL, "The time during which a create request sent again with the same Idempotency-Key header returns the response of the first request")
+	fs.DurationVar(&c.InProgressTimeout, "idempotency-key-in-progress-timeout", c.InProgressTimeout, "The time after which the Idempotency-Key of a request that was never completed can be used again")
+}
+
+func (c *IdempotencyConfig) ReadFiles() error {
+	return nil
+}
@@ -0,0 +1,95 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate moq -out idempotency_key_service_moq.go . IdempotencyKeyService
+type IdempotencyKeyService interface {
+	// Begin records the key of a request that is about to be handled. When the same key has already been recorded by the owner
+	// for the route and has not expired, the recorded key is returned instead and the request must not be handled again.
+	Begin(key *api.IdempotencyKey) (*api.IdempotencyKey, *errors.ServiceError)
+	// Complete records the response of the request of the key, so that it is returned to the retries of the request
+	Complete(key *api.IdempotencyKey) *errors.ServiceError
+	// Release deletes the key of a request that failed, so that the request can be retried with the same key
+	Release(key *api.IdempotencyKey) *errors.ServiceError
+	// DeleteExpired deletes the keys whose TTL has passed
+	DeleteExpired() *errors.ServiceError
+}
+
+var _ IdempotencyKeyService = &idempotencyKeyService{}
+
+type idempotencyKeyService struct {
+	connectionFactory *db.ConnectionFactory
+	idempotencyConfig *IdempotencyConfig
+}
+
+func NewIdempotencyKeyService(connectionFactory *db.ConnectionFactory, idempotencyConfig *IdempotencyConfig) IdempotencyKeyService {
+	return &idempotencyKeyService{
+		connectionFactory: connectionFactory,
+		idempotencyConfig: idempotencyConfig,
+	}
+}
+
+func (s *idempotencyKeyService) Begin(key *api.IdempotencyKey) (*api.IdempotencyKey, *errors.ServiceError) {
+	now := time.Now()
+	key.CreatedAt = now
+	key.ExpiresAt = now.Add(s.idempotencyConfig.KeyTTL)
+	key.StatusCode = 0
+
+	var existing *api.IdempotencyKey
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		// the keys that expired, or whose request was abandoned, can be used again
+		if err := tx.Where("owner = ? AND route = ? AND key = ?", key.Owner, key.Route, key.Key).
+			Where("expires_at < ? OR (status_code = 0 AND created_at < ?)", now, now.Add(-s.idempotencyConfig.InProgressTimeout)).
+			Delete(&api.IdempotencyKey{}).Error; err != nil {
+			return err
+		}
+
+		// the unique index on the owner, route and key lets a single one of the concurrent requests with the same key record it
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			return nil
+		}
+
+		existing = &api.IdempotencyKey{}
+		return tx.Where("owner = ? AND route = ? AND key = ?", key.Owner, key.Route, key.Key).First(existing).Error
+	}); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to record the idempotency key %q", key.Key)
+	}
+	return existing, nil
+}
+
+func (s *idempotencyKeyService) Complete(key *api.IdempotencyKey) *errors.ServiceError {
+	if err := s.connectionFactory.New().Model(&api.IdempotencyKey{}).Where("id = ?", key.ID).Updates(map[string]interface{}{
+		"status_code":   key.StatusCode,
+		"resource_id":   key.ResourceId,
+		"response_body": key.ResponseBody,
+	}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record the response of the idempotency key %q", key.Key)
+	}
+	return nil
+}
+
+func (s *idempotencyKeyService) Release(key *api.IdempotencyKey) *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("id = ?", key.ID).Delete(&api.IdempotencyKey{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to release the idempotency key %q", key.Key)
+	}
+	return nil
+}
+
+func (s *idempotencyKeyService) DeleteExpired() *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("expires_at < ?", time.Now()).Delete(&api.IdempotencyKey{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete the expired idempotency keys")
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package idempotency
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that IdempotencyKeyServiceMock does implement IdempotencyKeyService.
+// If this is not the case, regenerate this file with moq.
+var _ IdempotencyKeyService = &IdempotencyKeyServiceMock{}
+
+// IdempotencyKeyServiceMock is a mock implementation of IdempotencyKeyService.
+//
+//	func TestSomethingThatUsesIdempotencyKeyService(t *testing.T) {
+//
+//		// make and configure a mocked IdempotencyKeyService
+//		mockedIdempotencyKeyService := &IdempotencyKeyServiceMock{
+//			BeginFunc: func(key *api.IdempotencyKey) (*api.IdempotencyKey, *errors.ServiceError) {
+//				panic("mock out the Begin method")
+//			},
+//			CompleteFunc: func(key *api.IdempotencyKey) *errors.ServiceError {
+//				panic("mock out the Complete method")
+//			},
+//			DeleteExpiredFunc: func() *errors.ServiceError {
+//				panic("mock out the DeleteExpired method")
+//			},
+//			ReleaseFunc: func(key *api.IdempotencyKey) *errors.ServiceError {
+//				panic("mock out the Release method")
+//			},
+//		}
+//
+//		// use mockedIdempotencyKeyService in code that requires IdempotencyKeyService
+//		// and then make assertions.
+//
+//	}
+type IdempotencyKeyServiceMock struct {
+	// BeginFunc mocks the Begin method.
+	BeginFunc func(key *api.IdempotencyKey) (*api.IdempotencyKey, *errors.ServiceError)
+
+	// CompleteFunc mocks the Complete method.
+	CompleteFunc func(key *api.IdempotencyKey) *errors.ServiceError
+
+	// DeleteExpiredFunc mocks the DeleteExpired method.
+	DeleteExpiredFunc func() *errors.ServiceError
+
+	// ReleaseFunc mocks the Release method.
+	ReleaseFunc func(key *api.IdempotencyKey) *errors.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Begin holds details about calls to the Begin method.
+		Begin []struct {
+			// Key is the key argument value.
+			Key *api.IdempotencyKey
+		}
+		// Complete holds details about calls to the Complete method.
+		Complete []struct {
+			// Key is the key argument value.
+			Key *api.IdempotencyKey
+		}
+		// DeleteExpired holds details about calls to the DeleteExpired method.
+		DeleteExpired []struct {
+		}
+		// Release holds details about calls to the Release method.
+		Release []struct {
+			// Key is the key argument value.
+			Key *api.IdempotencyKey
+		}
+	}
+	lockBegin         sync.RWMutex
+	lockComplete      sync.RWMutex
+	lockDeleteExpired sync.RWMutex
+	lockRelease       sync.RWMutex
+}
+
+// Begin calls BeginFunc.
+func (mock *IdempotencyKeyServiceMock) Begin(key *api.IdempotencyKey) (*api.IdempotencyKey, *errors.ServiceError) {
+	if mock.BeginFunc == nil {
+		panic("IdempotencyKeyServiceMock.BeginFunc: method is nil but IdempotencyKeyService.Begin was just called")
+	}
+	callInfo := struct {
+		Key *api.IdempotencyKey
+	}{
+		Key: key,
+	}
+	mock.lockBegin.Lock()
+	mock.calls.Begin = append(mock.calls.Begin, callInfo)
+	mock.lockBegin.Unlock()
+	return mock.BeginFunc(key)
+}
+
+// BeginCalls gets all the calls that were made to Begin.
+// Check the length with:
+//
+//	len(mockedIdempotencyKeyService.BeginCalls())
+func (mock *IdempotencyKeyServiceMock) BeginCalls() []struct {
+	Key *api.IdempotencyKey
+} {
+	var calls []struct {
+		Key *api.IdempotencyKey
+	}
+	mock.lockBegin.RLock()
+	calls = mock.calls.Begin
+	mock.lockBegin.RUnlock()
+	return calls
+}
+
+// Complete calls CompleteFunc.
+func (mock *IdempotencyKeyServiceMock) Complete(key *api.IdempotencyKey) *errors.ServiceError {
+	if mock.CompleteFunc == nil {
+		panic("IdempotencyKeyServiceMock.CompleteFunc: method is nil but IdempotencyKeyService.Complete was just called")
+	}
+	callInfo := struct {
+		Key *api.IdempotencyKey
+	}{
+		Key: key,
+	}
+	mock.lockComplete.Lock()
+	mock.calls.Complete = append(mock.calls.Complete, callInfo)
+	mock.lockComplete.Unlock()
+	return mock.CompleteFunc(key)
+}
+
+// CompleteCalls gets all the calls that were made to Complete.
+// Check the length with:
+//
+//	len(mockedIdempotencyKeyService.CompleteCalls())
+func (mock *IdempotencyKeyServiceMock) CompleteCalls() []struct {
+	Key *api.IdempotencyKey
+} {
+	var calls []struct {
+		Key *api.IdempotencyKey
+	}
+	mock.lockComplete.RLock()
+	calls = mock.calls.Complete
+	mock.lockComplete.RUnlock()
+	return calls
+}
+
+// DeleteExpired calls DeleteExpiredFunc.
+func (mock *IdempotencyKeyServiceMock) DeleteExpired() *errors.ServiceError {
+	if mock.DeleteExpiredFunc == nil {
+		panic("IdempotencyKeyServiceMock.DeleteExpiredFunc: method is nil but IdempotencyKeyService.DeleteExpired was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDeleteExpired.Lock()
+	mock.calls.DeleteExpired = append(mock.calls.DeleteExpired, callInfo)
+	mock.lockDeleteExpired.Unlock()
+	return mock.DeleteExpiredFunc()
+}
+
+// DeleteExpiredCalls gets all the calls that were made to DeleteExpired.
+// Check the length with:
+//
+//	len(mockedIdempotencyKeyService.DeleteExpiredCalls())
+func (mock *IdempotencyKeyServiceMock) DeleteExpiredCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDeleteExpired.RLock()
+	calls = mock.calls.DeleteExpired
+	mock.lockDeleteExpired.RUnlock()
+	return calls
+}
+
+// Release calls ReleaseFunc.
+func (mock *IdempotencyKeyServiceMock) Release(key *api.IdempotencyKey) *errors.ServiceError {
+	if mock.ReleaseFunc == nil {
+		panic("IdempotencyKeyServiceMock.ReleaseFunc: method is nil but IdempotencyKeyService.Release was just called")
+	}
+	callInfo := struct {
+		Key *api.IdempotencyKey
+	}{
+		Key: key,
+	}
+	mock.lockRelease.Lock()
+	mock.calls.Release = append(mock.calls.Release, callInfo)
+	mock.lockRelease.Unlock()
+	return mock.ReleaseFunc(key)
+}
+
+// ReleaseCalls gets all the calls that were made to Release.
+// Check the length with:
+//
+//	len(mockedIdempotencyKeyService.ReleaseCalls())
+func (mock *IdempotencyKeyServiceMock) ReleaseCalls() []struct {
+	Key *api.IdempotencyKey
+} {
+	var calls []struct {
+		Key *api.IdempotencyKey
+	}
+	mock.lockRelease.RLock()
+	calls = mock.calls.Release
+	mock.lockRelease.RUnlock()
+	return calls
+}
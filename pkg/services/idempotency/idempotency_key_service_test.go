@@ -0,0 +1,49 @@
+package idempotency
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_idempotencyKeyService_Begin(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should record a new key",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "idempotency_keys"`).WithRowsNum(1)
+			},
+		},
+		{
+			name: "should return an error when the key cannot be recorded",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "idempotency_keys"`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewIdempotencyKeyService(db.NewMockConnectionFactory(nil), NewIdempotencyConfig())
+			key := &api.IdempotencyKey{Key: "test-key", Owner: "test-user", Route: "create-kafka"}
+			existing, err := s.Begin(key)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(existing).To(gomega.BeNil())
+			if !tt.wantErr {
+				g.Expect(key.ID).ToNot(gomega.BeEmpty())
+				g.Expect(key.ExpiresAt).To(gomega.BeTemporally("~", key.CreatedAt.Add(NewIdempotencyConfig().KeyTTL)))
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// RateLimitSubjectType is the type of the subject the requests are counted for
+type RateLimitSubjectType string
+
+const (
+	// RateLimitPerOrganisation counts the requests of all the users of an organisation together
+	RateLimitPerOrganisation RateLimitSubjectType = "organisation"
+	// RateLimitPerUser counts the requests of each user separately
+	RateLimitPerUser RateLimitSubjectType = "user"
+)
+
+func (t RateLimitSubjectType) String() string {
+	return string(t)
+}
+
+// RouteRateLimit is the rate limit and the concurrency quota of a route
+type RouteRateLimit struct {
+	// Route is the name of the route, i.e. the type of its log event such as "create-kafka"
+	Route string `yaml:"route"`
+	// Per is whether the requests are counted per organisation or per user. Defaults to organisation
+	Per RateLimitSubjectType `yaml:"per"`
+	// Requests is the maximum number of requests within the window, 0 for no rate limit
+	Requests int           `yaml:"requests"`
+	Window   time.Duration `yaml:"window"`
+	// MaxConcurrentRequests is the maximum number of requests handled at the same time, 0 for no concurrency quota
+	MaxConcurrentRequests int `yaml:"max_concurrent_requests"`
+}
+
+func (l *RouteRateLimit) validate() error {
+	if l.Route == "" {
+		return errors.New("the route of a rate limit is required")
+	}
+	switch l.Per {
+	case "":
+		l.Per = RateLimitPerOrganisation
+	case RateLimitPerOrganisation, RateLimitPerUser:
+	default:
+		return errors.Errorf("invalid rate limit subject %q for route %q, accepted values are %q and %q", l.Per, l.Route, RateLimitPerOrganisation, RateLimitPerUser)
+	}
+	if l.Requests < 0 || l.MaxConcurrentRequests < 0 {
+		return errors.Errorf("the requests and max_concurrent_requests of the rate limit of route %q can not be negative", l.Route)
+	}
+	if l.Requests > 0 && l.Window <= 0 {
+		return errors.Errorf("the window of the rate limit of route %q is required when requests is set", l.Route)
+	}
+	if l.Requests == 0 && l.MaxConcurrentRequests == 0 {
+		return errors.Errorf("the rate limit of route %q sets neither requests nor max_concurrent_requests", l.Route)
+	}
+	return nil
+}
+
+type RateLimitConfig struct {
+	EnableRateLimiting  bool
+	RateLimitConfigFile string
+	// LeaseExpiration is the time after which the lease of a request that was not released, e.g. because its replica
+	// stopped, no longer counts against the concurrency quota
+	LeaseExpiration time.Duration
+	RouteRateLimits map[string]RouteRateLimit
+}
+
+func NewRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		EnableRateLimiting:  false,
+		RateLimitConfigFile: "config/rate-limit-configuration.yaml",
+		LeaseExpiration:     5 * time.Minute,
+		RouteRateLimits:     map[string]RouteRateLimit{},
+	}
+}
+
+func (c *RateLimitConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.EnableRateLimiting, "enable-rate-limiting", c.EnableRateLimiting, "Enable the rate limits and concurrency quotas of the API routes, per organisation or per user")
+	fs.StringVar(&c.RateLimitConfigFile, "rate-limit-config-file", c.RateLimitConfigFile, "File containing the rate limits and concurrency quotas of the API routes")
+	fs.DurationVar(&c.LeaseExpiration, "rate-limit-lease-expiration", c.LeaseExpiration, "The time after which a request that was not released no longer counts against the concurrency quota of its route")
+}
+
+func (c *RateLimitConfig) ReadFiles() error {
+	if !c.EnableRateLimiting {
+		return nil
+	}
+
+	var routeRateLimits []RouteRateLimit
+	if err := shared.ReadYamlFile(c.RateLimitConfigFile, &routeRateLimits); err != nil {
+		return err
+	}
+
+	c.RouteRateLimits = map[string]RouteRateLimit{}
+	for i := range routeRateLimits {
+		limit := routeRateLimits[i]
+		if err := limit.validate(); err != nil {
+			return err
+		}
+		if _, found := c.RouteRateLimits[limit.Route]; found {
+			return errors.Errorf("the rate limit of route %q is defined more than once", limit.Route)
+		}
+		c.RouteRateLimits[limit.Route] = limit
+	}
+	return nil
+}
+
+// GetRouteRateLimit returns the rate limit of the route with the given name, if any
+func (c *RateLimitConfig) GetRouteRateLimit(route string) (RouteRateLimit, bool) {
+	limit, found := c.RouteRateLimits[route]
+	return limit, found
+}
@@ -0,0 +1,121 @@
+package ratelimit
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/onsi/gomega"
+)
+
+func Test_RateLimitConfig_ReadFiles(t *testing.T) {
+	tests := []struct {
+		name        string
+		enabled     bool
+		fileContent string
+		want        map[string]RouteRateLimit
+		wantErr     bool
+	}{
+		{
+			name:        "should not read the file when rate limiting is disabled",
+			enabled:     false,
+			fileContent: "not a list",
+			want:        map[string]RouteRateLimit{},
+		},
+		{
+			name:    "should read the rate limits and default the subject to the organisation",
+			enabled: true,
+			fileContent: `
+- route: create-kafka
+  requests: 10
+  window: 1m
+  max_concurrent_requests: 2
+- route: create-service-accounts
+  per: user
+  max_concurrent_requests: 1
+`,
+			want: map[string]RouteRateLimit{
+				"create-kafka":            {Route: "create-kafka", Per: RateLimitPerOrganisation, Requests: 10, Window: time.Minute, MaxConcurrentRequests: 2},
+				"create-service-accounts": {Route: "create-service-accounts", Per: RateLimitPerUser, MaxConcurrentRequests: 1},
+			},
+		},
+		{
+			name:    "should return an error when a route is defined more than once",
+			enabled: true,
+			fileContent: `
+- route: create-kafka
+  max_concurrent_requests: 2
+- route: create-kafka
+  max_concurrent_requests: 1
+`,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when the subject is invalid",
+			enabled: true,
+			fileContent: `
+- route: create-kafka
+  per: cluster
+  max_concurrent_requests: 2
+`,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when requests is set without a window",
+			enabled: true,
+			fileContent: `
+- route: create-kafka
+  requests: 10
+`,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when neither requests nor max_concurrent_requests is set",
+			enabled: true,
+			fileContent: `
+- route: create-kafka
+`,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when the route is missing",
+			enabled: true,
+			fileContent: `
+- requests: 10
+  window: 1m
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			file, err := shared.CreateTempFileFromStringData("rate-limit-configuration.yaml", tt.fileContent)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			defer os.Remove(file)
+
+			config := NewRateLimitConfig()
+			config.EnableRateLimiting = tt.enabled
+			config.RateLimitConfigFile = file
+			err = config.ReadFiles()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(config.RouteRateLimits).To(gomega.Equal(tt.want))
+			}
+		})
+	}
+}
+
+func Test_RateLimitConfig_ReadFiles_DefaultFile(t *testing.T) {
+	g := gomega.NewWithT(t)
+	config := NewRateLimitConfig()
+	config.EnableRateLimiting = true
+	g.Expect(config.ReadFiles()).To(gomega.Succeed())
+	g.Expect(config.RouteRateLimits).ToNot(gomega.BeEmpty())
+	// the kafka and the connector routes share the configuration file
+	g.Expect(config.RouteRateLimits).To(gomega.HaveKey("create-kafka"))
+	g.Expect(config.RouteRateLimits).To(gomega.HaveKey("create-connector"))
+}
@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+)
+
+//go:generate moq -out rate_limit_service_moq.go . RateLimitService
+type RateLimitService interface {
+	// CountRequest counts a request of the subject in the current window of the rate limit of the route.
+	// When the limit has been exceeded, it returns the time to wait until the next window, otherwise zero.
+	CountRequest(subject string, limit RouteRateLimit) (time.Duration, *errors.ServiceError)
+	// AcquireLease acquires a lease for a request of the subject unless the concurrency quota of the route has been reached,
+	// in which case an empty lease id is returned. The lease must be released once the request has been handled.
+	AcquireLease(subject string, limit RouteRateLimit) (string, *errors.ServiceError)
+	// ReleaseLease releases the lease with the given id
+	ReleaseLease(id string) *errors.ServiceError
+	// DeleteExpired deletes the counters of the past windows and the expired leases
+	DeleteExpired() *errors.ServiceError
+}
+
+var _ RateLimitService = &rateLimitService{}
+
+// rateLimitService keeps the counters and the leases in the database, so that the limits hold across replicas
+type rateLimitService struct {
+	connectionFactory *db.ConnectionFactory
+	rateLimitConfig   *RateLimitConfig
+}
+
+func NewRateLimitService(connectionFactory *db.ConnectionFactory, rateLimitConfig *RateLimitConfig) RateLimitService {
+	return &rateLimitService{
+		connectionFactory: connectionFactory,
+		rateLimitConfig:   rateLimitConfig,
+	}
+}
+
+func (s *rateLimitService) CountRequest(subject string, limit RouteRateLimit) (time.Duration, *errors.ServiceError) {
+	now := time.Now()
+	windowStart := now.Truncate(limit.Window)
+	windowEnd := windowStart.Add(limit.Window)
+
+	// the counter is incremented with a single statement, so that the requests handled concurrently by the replicas are all counted
+	var count int
+	if err := s.connectionFactory.New().Raw(`
+		INSERT INTO rate_limit_counters (subject, route, window_start, count, expires_at) VALUES (?, ?, ?, 1, ?)
+		ON CONFLICT (subject, route, window_start) DO UPDATE SET count = rate_limit_counters.count + 1
+		RETURNING count`, subject, limit.Route, windowStart, windowEnd).Scan(&count).Error; err != nil {
+		return 0, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count the request of %q to route %q", subject, limit.Route)
+	}
+
+	if count > limit.Requests {
+		return windowEnd.Sub(now), nil
+	}
+	return 0, nil
+}
+
+func (s *rateLimitService) AcquireLease(subject string, limit RouteRateLimit) (string, *errors.ServiceError) {
+	lease := &api.RateLimitLease{
+		Subject:   subject,
+		Route:     limit.Route,
+		ExpiresAt: time.Now().Add(s.rateLimitConfig.LeaseExpiration),
+	}
+	acquired := false
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		// the leases of the subject on the route are counted and acquired by a single replica at a time
+		rows, err := tx.Raw("SELECT pg_advisory_xact_lock(hashtext(?))", subject+"/"+limit.Route).Rows()
+		if err != nil {
+			return err
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&api.RateLimitLease{}).
+			Where("subject = ? AND route = ? AND expires_at > ?", subject, limit.Route, time.Now()).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= int64(limit.MaxConcurrentRequests) {
+			return nil
+		}
+
+		if err := tx.Create(lease).Error; err != nil {
+			return err
+		}
+		acquired = true
+		return nil
+	}); err != nil {
+		return "", errors.NewWithCause(errors.ErrorGeneral, err, "failed to acquire a lease for the request of %q to route %q", subject, limit.Route)
+	}
+
+	if !acquired {
+		return "", nil
+	}
+	return lease.ID, nil
+}
+
+func (s *rateLimitService) ReleaseLease(id string) *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("id = ?", id).Delete(&api.RateLimitLease{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to release the rate limit lease %q", id)
+	}
+	return nil
+}
+
+func (s *rateLimitService) DeleteExpired() *errors.ServiceError {
+	now := time.Now()
+	dbConn := s.connectionFactory.New()
+	if err := dbConn.Where("expires_at < ?", now).Delete(&api.RateLimitCounter{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete the expired rate limit counters")
+	}
+	if err := dbConn.Where("expires_at < ?", now).Delete(&api.RateLimitLease{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete the expired rate limit leases")
+	}
+	return nil
+}
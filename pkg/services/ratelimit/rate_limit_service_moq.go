@@ -0,0 +1,213 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package ratelimit
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Ensure, that RateLimitServiceMock does implement RateLimitService.
+// If this is not the case, regenerate this file with moq.
+var _ RateLimitService = &RateLimitServiceMock{}
+
+// RateLimitServiceMock is a mock implementation of RateLimitService.
+//
+//	func TestSomethingThatUsesRateLimitService(t *testing.T) {
+//
+//		// make and configure a mocked RateLimitService
+//		mockedRateLimitService := &RateLimitServiceMock{
+//			AcquireLeaseFunc: func(subject string, limit RouteRateLimit) (string, *errors.ServiceError) {
+//				panic("mock out the AcquireLease method")
+//			},
+//			CountRequestFunc: func(subject string, limit RouteRateLimit) (time.Duration, *errors.ServiceError) {
+//				panic("mock out the CountRequest method")
+//			},
+//			DeleteExpiredFunc: func() *errors.ServiceError {
+//				panic("mock out the DeleteExpired method")
+//			},
+//			ReleaseLeaseFunc: func(id string) *errors.ServiceError {
+//				panic("mock out the ReleaseLease method")
+//			},
+//		}
+//
+//		// use mockedRateLimitService in code that requires RateLimitService
+//		// and then make assertions.
+//
+//	}
+type RateLimitServiceMock struct {
+	// AcquireLeaseFunc mocks the AcquireLease method.
+	AcquireLeaseFunc func(subject string, limit RouteRateLimit) (string, *errors.ServiceError)
+
+	// CountRequestFunc mocks the CountRequest method.
+	CountRequestFunc func(subject string, limit RouteRateLimit) (time.Duration, *errors.ServiceError)
+
+	// DeleteExpiredFunc mocks the DeleteExpired method.
+	DeleteExpiredFunc func() *errors.ServiceError
+
+	// ReleaseLeaseFunc mocks the ReleaseLease method.
+	ReleaseLeaseFunc func(id string) *errors.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AcquireLease holds details about calls to the AcquireLease method.
+		AcquireLease []struct {
+			// Subject is the subject argument value.
+			Subject string
+			// Limit is the limit argument value.
+			Limit RouteRateLimit
+		}
+		// CountRequest holds details about calls to the CountRequest method.
+		CountRequest []struct {
+			// Subject is the subject argument value.
+			Subject string
+			// Limit is the limit argument value.
+			Limit RouteRateLimit
+		}
+		// DeleteExpired holds details about calls to the DeleteExpired method.
+		DeleteExpired []struct {
+		}
+		// ReleaseLease holds details about calls to the ReleaseLease method.
+		ReleaseLease []struct {
+			// ID is the id argument value.
+			ID string
+		}
+	}
+	lockAcquireLease  sync.RWMutex
+	lockCountRequest  sync.RWMutex
+	lockDeleteExpired sync.RWMutex
+	lockReleaseLease  sync.RWMutex
+}
+
+// AcquireLease calls AcquireLeaseFunc.
+func (mock *RateLimitServiceMock) AcquireLease(subject string, limit RouteRateLimit) (string, *errors.ServiceError) {
+	if mock.AcquireLeaseFunc == nil {
+		panic("RateLimitServiceMock.AcquireLeaseFunc: method is nil but RateLimitService.AcquireLease was just called")
+	}
+	callInfo := struct {
+		Subject string
+		Limit   RouteRateLimit
+	}{
+		Subject: subject,
+		Limit:   limit,
+	}
+	mock.lockAcquireLease.Lock()
+	mock.calls.AcquireLease = append(mock.calls.AcquireLease, callInfo)
+	mock.lockAcquireLease.Unlock()
+	return mock.AcquireLeaseFunc(subject, limit)
+}
+
+// AcquireLeaseCalls gets all the calls that were made to AcquireLease.
+// Check the length with:
+//
+//	len(mockedRateLimitService.AcquireLeaseCalls())
+func (mock *RateLimitServiceMock) AcquireLeaseCalls() []struct {
+	Subject string
+	Limit   RouteRateLimit
+} {
+	var calls []struct {
+		Subject string
+		Limit   RouteRateLimit
+	}
+	mock.lockAcquireLease.RLock()
+	calls = mock.calls.AcquireLease
+	mock.lockAcquireLease.RUnlock()
+	return calls
+}
+
+// CountRequest calls CountRequestFunc.
+func (mock *RateLimitServiceMock) CountRequest(subject string, limit RouteRateLimit) (time.Duration, *errors.ServiceError) {
+	if mock.CountRequestFunc == nil {
+		panic("RateLimitServiceMock.CountRequestFunc: method is nil but RateLimitService.CountRequest was just called")
+	}
+	callInfo := struct {
+		Subject string
+		Limit   RouteRateLimit
+	}{
+		Subject: subject,
+		Limit:   limit,
+	}
+	mock.lockCountRequest.Lock()
+	mock.calls.CountRequest = append(mock.calls.CountRequest, callInfo)
+	mock.lockCountRequest.Unlock()
+	return mock.CountRequestFunc(subject, limit)
+}
+
+// CountRequestCalls gets all the calls that were made to CountRequest.
+// Check the length with:
+//
+//	len(mockedRateLimitService.CountRequestCalls())
+func (mock *RateLimitServiceMock) CountRequestCalls() []struct {
+	Subject string
+	Limit   RouteRateLimit
+} {
+	var calls []struct {
+		Subject string
+		Limit   RouteRateLimit
+	}
+	mock.lockCountRequest.RLock()
+	calls = mock.calls.CountRequest
+	mock.lockCountRequest.RUnlock()
+	return calls
+}
+
+// DeleteExpired calls DeleteExpiredFunc.
+func (mock *RateLimitServiceMock) DeleteExpired() *errors.ServiceError {
+	if mock.DeleteExpiredFunc == nil {
+		panic("RateLimitServiceMock.DeleteExpiredFunc: method is nil but RateLimitService.DeleteExpired was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDeleteExpired.Lock()
+	mock.calls.DeleteExpired = append(mock.calls.DeleteExpired, callInfo)
+	mock.lockDeleteExpired.Unlock()
+	return mock.DeleteExpiredFunc()
+}
+
+// DeleteExpiredCalls gets all the calls that were made to DeleteExpired.
+// Check the length with:
+//
+//	len(mockedRateLimitService.DeleteExpiredCalls())
+func (mock *RateLimitServiceMock) DeleteExpiredCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDeleteExpired.RLock()
+	calls = mock.calls.DeleteExpired
+	mock.lockDeleteExpired.RUnlock()
+	return calls
+}
+
+// ReleaseLease calls ReleaseLeaseFunc.
+func (mock *RateLimitServiceMock) ReleaseLease(id string) *errors.ServiceError {
+	if mock.ReleaseLeaseFunc == nil {
+		panic("RateLimitServiceMock.ReleaseLeaseFunc: method is nil but RateLimitService.ReleaseLease was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockReleaseLease.Lock()
+	mock.calls.ReleaseLease = append(mock.calls.ReleaseLease, callInfo)
+	mock.lockReleaseLease.Unlock()
+	return mock.ReleaseLeaseFunc(id)
+}
+
+// ReleaseLeaseCalls gets all the calls that were made to ReleaseLease.
+// Check the length with:
+//
+//	len(mockedRateLimitService.ReleaseLeaseCalls())
+func (mock *RateLimitServiceMock) ReleaseLeaseCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockReleaseLease.RLock()
+	calls = mock.calls.ReleaseLease
+	mock.lockReleaseLease.RUnlock()
+	return calls
+}
@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_rateLimitService_CountRequest(t *testing.T) {
+	limit := RouteRateLimit{Route: "create-kafka", Per: RateLimitPerOrganisation, Requests: 2, Window: time.Hour}
+
+	tests := []struct {
+		name           string
+		setupFn        func()
+		wantRetryAfter bool
+		wantErr        bool
+	}{
+		{
+			name: "should not ask to retry while the limit is not exceeded",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO rate_limit_counters`).WithReply([]map[string]interface{}{{"count": 2}})
+			},
+		},
+		{
+			name: "should ask to retry at the next window when the limit is exceeded",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO rate_limit_counters`).WithReply([]map[string]interface{}{{"count": 3}})
+			},
+			wantRetryAfter: true,
+		},
+		{
+			name: "should return an error when the request cannot be counted",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO rate_limit_counters`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewRateLimitService(db.NewMockConnectionFactory(nil), NewRateLimitConfig())
+			retryAfter, err := s.CountRequest("organisation:test-org", limit)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantRetryAfter {
+				g.Expect(retryAfter).To(gomega.BeNumerically(">", 0))
+				g.Expect(retryAfter).To(gomega.BeNumerically("<=", limit.Window))
+			} else {
+				g.Expect(retryAfter).To(gomega.BeZero())
+			}
+		})
+	}
+}
+
+func Test_rateLimitService_AcquireLease(t *testing.T) {
+	limit := RouteRateLimit{Route: "create-kafka", Per: RateLimitPerOrganisation, MaxConcurrentRequests: 2}
+
+	tests := []struct {
+		name         string
+		setupFn      func()
+		wantAcquired bool
+		wantErr      bool
+	}{
+		{
+			name: "should acquire a lease while the quota is not reached",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "rate_limit_leases"`).WithReply([]map[string]interface{}{{"count": 1}})
+			},
+			wantAcquired: true,
+		},
+		{
+			name: "should not acquire a lease when the quota is reached",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "rate_limit_leases"`).WithReply([]map[string]interface{}{{"count": 2}})
+			},
+		},
+		{
+			name: "should return an error when the leases cannot be counted",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "rate_limit_leases"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewRateLimitService(db.NewMockConnectionFactory(nil), NewRateLimitConfig())
+			leaseId, err := s.AcquireLease("organisation:test-org", limit)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(leaseId != "").To(gomega.Equal(tt.wantAcquired))
+		})
+	}
+}
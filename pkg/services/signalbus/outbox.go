@@ -0,0 +1,178 @@
+package signalbus
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Outbox records typed events in the database, in the same transaction as the state change they describe.
+//
+// Unlike the signals, which are lost when a process is not listening at the time they are sent, the events are
+// kept for the outbox retention period so that the consumers can resume from the sequence of the last event
+// they have handled instead of re-listing everything.
+//
+// The sequence of an event is assigned when it is inserted, so the event of a long running transaction
+// may become visible after events with a higher sequence. The consumers are ordered by the id of the transaction
+// of the events instead, and only read the events of the transactions that are over.
+//
+//go:generate moq -out outbox_moq.go . Outbox
+type Outbox interface {
+	// Publish records the event with the given connection, which should be the transaction of the state change
+	// described by the event. The subscribers of the event type are signaled across the cluster once the transaction is committed.
+	Publish(dbConn *gorm.DB, event *api.OutboxEvent) *errors.ServiceError
+	// ListEvents returns up to limit events recorded after the given sequence, oldest first.
+	// When event types are given, only the events of those types are returned.
+	ListEvents(after int64, limit int, eventTypes ...string) (api.OutboxEventList, *errors.ServiceError)
+	// GetLatestSequence returns the sequence of the last recorded event, or 0 when the outbox is empty
+	GetLatestSequence() (int64, *errors.ServiceError)
+	// Consume passes the events of the given types recorded after the cursor of the consumer to handle, ordered by
+	// transaction and sequence, and moves the cursor past the handled events. Only the events of the transactions older
+	// than the oldest running one are passed, so that no event can be committed behind the cursor afterwards.
+	// It stops at the first event that fails to be handled so that it is handled again by the next call.
+	// A consumer without a cursor starts from the oldest event of the outbox. It returns the number of handled events.
+	Consume(consumer string, eventTypes []string, handle func(event *api.OutboxEvent) error) (int, *errors.ServiceError)
+	// DeleteEventsBefore deletes the events recorded before the given time
+	DeleteEventsBefore(t time.Time) *errors.ServiceError
+}
+
+var _ Outbox = &outbox{}
+
+type outbox struct {
+	connectionFactory *db.ConnectionFactory
+	outboxConfig      *OutboxConfig
+}
+
+func NewOutbox(connectionFactory *db.ConnectionFactory, outboxConfig *OutboxConfig) Outbox {
+	return &outbox{
+		connectionFactory: connectionFactory,
+		outboxConfig:      outboxConfig,
+	}
+}
+
+func (o *outbox) Publish(dbConn *gorm.DB, event *api.OutboxEvent) *errors.ServiceError {
+	if len(event.Payload) == 0 {
+		event.Payload = api.JSON(`{}`)
+	}
+	if err := dbConn.Create(event).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record %q event of resource %q", event.EventType, event.ResourceId)
+	}
+	// notifications sent within a transaction are only delivered once it is committed
+	rows, err := dbConn.Raw("SELECT pg_notify(?, ?)", pgSignalBusChannel, event.EventType).Rows()
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to signal %q event of resource %q", event.EventType, event.ResourceId)
+	}
+	shared.CloseQuietly(rows)
+	return nil
+}
+
+func (o *outbox) ListEvents(after int64, limit int, eventTypes ...string) (api.OutboxEventList, *errors.ServiceError) {
+	dbConn := o.connectionFactory.New().Where("sequence > ?", after)
+	if len(eventTypes) > 0 {
+		dbConn = dbConn.Where("event_type IN (?)", eventTypes)
+	}
+
+	var events api.OutboxEventList
+	if err := dbConn.Order("sequence").Limit(limit).Find(&events).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list outbox events after sequence %d", after)
+	}
+	return events, nil
+}
+
+func (o *outbox) GetLatestSequence() (int64, *errors.ServiceError) {
+	var sequence int64
+	if err := o.connectionFactory.New().Model(&api.OutboxEvent{}).
+		Select("COALESCE(MAX(sequence), 0)").
+		Scan(&sequence).Error; err != nil {
+		return 0, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get the latest outbox event sequence")
+	}
+	return sequence, nil
+}
+
+func (o *outbox) Consume(consumer string, eventTypes []string, handle func(event *api.OutboxEvent) error) (int, *errors.ServiceError) {
+	cursor, err := o.getCursor(consumer)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := o.listFinishedEvents(cursor, eventTypes)
+	if err != nil {
+		return 0, err
+	}
+
+	handled := 0
+	var handleErr error
+	for _, event := range events {
+		if handleErr = handle(event); handleErr != nil {
+			break
+		}
+		handled++
+		cursor.TransactionId = event.TransactionId
+		cursor.Sequence = event.Sequence
+	}
+
+	if handled > 0 {
+		if err := o.saveCursor(cursor); err != nil {
+			return handled, err
+		}
+	}
+	if handleErr != nil {
+		return handled, errors.NewWithCause(errors.ErrorGeneral, handleErr, "consumer %q failed to handle outbox event %d", consumer, events[handled].Sequence)
+	}
+	return handled, nil
+}
+
+// listFinishedEvents returns the events of the given types recorded after the cursor by the transactions older than the
+// oldest running one. The transactions still running, or starting later, cannot record an event behind those.
+func (o *outbox) listFinishedEvents(cursor *api.OutboxCursor, eventTypes []string) (api.OutboxEventList, *errors.ServiceError) {
+	dbConn := o.connectionFactory.New().
+		Where("(transaction_id, sequence) > (?, ?)", cursor.TransactionId, cursor.Sequence).
+		Where("transaction_id < txid_snapshot_xmin(txid_current_snapshot())")
+	if len(eventTypes) > 0 {
+		dbConn = dbConn.Where("event_type IN (?)", eventTypes)
+	}
+
+	var events api.OutboxEventList
+	if err := dbConn.Order("transaction_id, sequence").Limit(o.outboxConfig.ConsumeBatchSize).Find(&events).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the outbox events after the cursor of consumer %q", cursor.Consumer)
+	}
+	return events, nil
+}
+
+func (o *outbox) DeleteEventsBefore(t time.Time) *errors.ServiceError {
+	if err := o.connectionFactory.New().Where("created_at < ?", t).Delete(&api.OutboxEvent{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete the outbox events recorded before %s", t)
+	}
+	return nil
+}
+
+func (o *outbox) getCursor(consumer string) (*api.OutboxCursor, *errors.ServiceError) {
+	var cursor api.OutboxCursor
+	if err := o.connectionFactory.New().Where("consumer = ?", consumer).First(&cursor).Error; err != nil {
+		if services.IsRecordNotFoundError(err) {
+			return &api.OutboxCursor{Consumer: consumer}, nil
+		}
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get the outbox cursor of consumer %q", consumer)
+	}
+	return &cursor, nil
+}
+
+func (o *outbox) saveCursor(cursor *api.OutboxCursor) *errors.ServiceError {
+	if err := o.connectionFactory.New().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "consumer"}},
+		DoUpdates: clause.AssignmentColumns([]string{"transaction_id", "sequence", "updated_at"}),
+	}).Create(&api.OutboxCursor{
+		Consumer:      cursor.Consumer,
+		TransactionId: cursor.TransactionId,
+		Sequence:      cursor.Sequence,
+	}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to save the outbox cursor of consumer %q", cursor.Consumer)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package signalbus
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type OutboxConfig struct {
+	PollInterval     time.Duration `json:"outbox_poll_interval"`
+	RetentionPeriod  time.Duration `json:"outbox_retention_period"`
+	ConsumeBatchSize int           `json:"outbox_consume_batch_size"`
+}
+
+func NewOutboxConfig() *OutboxConfig {
+	return &OutboxConfig{
+		PollInterval:     5 * time.Second,
+		RetentionPeriod:  24 * time.Hour,
+		ConsumeBatchSize: 100,
+	}
+}
+
+func (c *OutboxConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.PollInterval, "outbox-poll-interval", c.PollInterval, "The interval at which the outbox is polled for events whose signal was missed, e.g. while the connection listening to the signals was down.")
+	fs.DurationVar(&c.RetentionPeriod, "outbox-retention-period", c.RetentionPeriod, "The time the events are kept in the outbox. Consumers lagging further behind miss the deleted events.")
+	fs.IntVar(&c.ConsumeBatchSize, "outbox-consume-batch-size", c.ConsumeBatchSize, "The maximum number of outbox events handled by a consumer in a single pass.")
+}
+
+func (c *OutboxConfig) ReadFiles() error {
+	return nil
+}
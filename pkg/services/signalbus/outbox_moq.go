@@ -0,0 +1,277 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package signalbus
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+	"sync"
+	"time"
+)
+
+// Ensure, that OutboxMock does implement Outbox.
+// If this is not the case, regenerate this file with moq.
+var _ Outbox = &OutboxMock{}
+
+// OutboxMock is a mock implementation of Outbox.
+//
+//	func TestSomethingThatUsesOutbox(t *testing.T) {
+//
+//		// make and configure a mocked Outbox
+//		mockedOutbox := &OutboxMock{
+//			ConsumeFunc: func(consumer string, eventTypes []string, handle func(event *api.OutboxEvent) error) (int, *errors.ServiceError) {
+//				panic("mock out the Consume method")
+//			},
+//			DeleteEventsBeforeFunc: func(t time.Time) *errors.ServiceError {
+//				panic("mock out the DeleteEventsBefore method")
+//			},
+//			GetLatestSequenceFunc: func() (int64, *errors.ServiceError) {
+//				panic("mock out the GetLatestSequence method")
+//			},
+//			ListEventsFunc: func(after int64, limit int, eventTypes ...string) (api.OutboxEventList, *errors.ServiceError) {
+//				panic("mock out the ListEvents method")
+//			},
+//			PublishFunc: func(dbConn *gorm.DB, event *api.OutboxEvent) *errors.ServiceError {
+//				panic("mock out the Publish method")
+//			},
+//		}
+//
+//		// use mockedOutbox in code that requires Outbox
+//		// and then make assertions.
+//
+//	}
+type OutboxMock struct {
+	// ConsumeFunc mocks the Consume method.
+	ConsumeFunc func(consumer string, eventTypes []string, handle func(event *api.OutboxEvent) error) (int, *errors.ServiceError)
+
+	// DeleteEventsBeforeFunc mocks the DeleteEventsBefore method.
+	DeleteEventsBeforeFunc func(t time.Time) *errors.ServiceError
+
+	// GetLatestSequenceFunc mocks the GetLatestSequence method.
+	GetLatestSequenceFunc func() (int64, *errors.ServiceError)
+
+	// ListEventsFunc mocks the ListEvents method.
+	ListEventsFunc func(after int64, limit int, eventTypes ...string) (api.OutboxEventList, *errors.ServiceError)
+
+	// PublishFunc mocks the Publish method.
+	PublishFunc func(dbConn *gorm.DB, event *api.OutboxEvent) *errors.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Consume holds details about calls to the Consume method.
+		Consume []struct {
+			// Consumer is the consumer argument value.
+			Consumer string
+			// EventTypes is the eventTypes argument value.
+			EventTypes []string
+			// Handle is the handle argument value.
+			Handle func(event *api.OutboxEvent) error
+		}
+		// DeleteEventsBefore holds details about calls to the DeleteEventsBefore method.
+		DeleteEventsBefore []struct {
+			// T is the t argument value.
+			T time.Time
+		}
+		// GetLatestSequence holds details about calls to the GetLatestSequence method.
+		GetLatestSequence []struct {
+		}
+		// ListEvents holds details about calls to the ListEvents method.
+		ListEvents []struct {
+			// After is the after argument value.
+			After int64
+			// Limit is the limit argument value.
+			Limit int
+			// EventTypes is the eventTypes argument value.
+			EventTypes []string
+		}
+		// Publish holds details about calls to the Publish method.
+		Publish []struct {
+			// DbConn is the dbConn argument value.
+			DbConn *gorm.DB
+			// Event is the event argument value.
+			Event *api.OutboxEvent
+		}
+	}
+	lockConsume            sync.RWMutex
+	lockDeleteEventsBefore sync.RWMutex
+	lockGetLatestSequence  sync.RWMutex
+	lockListEvents         sync.RWMutex
+	lockPublish            sync.RWMutex
+}
+
+// Consume calls ConsumeFunc.
+func (mock *OutboxMock) Consume(consumer string, eventTypes []string, handle func(event *api.OutboxEvent) error) (int, *errors.ServiceError) {
+	if mock.ConsumeFunc == nil {
+		panic("OutboxMock.ConsumeFunc: method is nil but Outbox.Consume was just called")
+	}
+	callInfo := struct {
+		Consumer   string
+		EventTypes []string
+		Handle     func(event *api.OutboxEvent) error
+	}{
+		Consumer:   consumer,
+		EventTypes: eventTypes,
+		Handle:     handle,
+	}
+	mock.lockConsume.Lock()
+	mock.calls.Consume = append(mock.calls.Consume, callInfo)
+	mock.lockConsume.Unlock()
+	return mock.ConsumeFunc(consumer, eventTypes, handle)
+}
+
+// ConsumeCalls gets all the calls that were made to Consume.
+// Check the length with:
+//
+//	len(mockedOutbox.ConsumeCalls())
+func (mock *OutboxMock) ConsumeCalls() []struct {
+	Consumer   string
+	EventTypes []string
+	Handle     func(event *api.OutboxEvent) error
+} {
+	var calls []struct {
+		Consumer   string
+		EventTypes []string
+		Handle     func(event *api.OutboxEvent) error
+	}
+	mock.lockConsume.RLock()
+	calls = mock.calls.Consume
+	mock.lockConsume.RUnlock()
+	return calls
+}
+
+// DeleteEventsBefore calls DeleteEventsBeforeFunc.
+func (mock *OutboxMock) DeleteEventsBefore(t time.Time) *errors.ServiceError {
+	if mock.DeleteEventsBeforeFunc == nil {
+		panic("OutboxMock.DeleteEventsBeforeFunc: method is nil but Outbox.DeleteEventsBefore was just called")
+	}
+	callInfo := struct {
+		T time.Time
+	}{
+		T: t,
+	}
+	mock.lockDeleteEventsBefore.Lock()
+	mock.calls.DeleteEventsBefore = append(mock.calls.DeleteEventsBefore, callInfo)
+	mock.lockDeleteEventsBefore.Unlock()
+	return mock.DeleteEventsBeforeFunc(t)
+}
+
+// DeleteEventsBeforeCalls gets all the calls that were made to DeleteEventsBefore.
+// Check the length with:
+//
+//	len(mockedOutbox.DeleteEventsBeforeCalls())
+func (mock *OutboxMock) DeleteEventsBeforeCalls() []struct {
+	T time.Time
+} {
+	var calls []struct {
+		T time.Time
+	}
+	mock.lockDeleteEventsBefore.RLock()
+	calls = mock.calls.DeleteEventsBefore
+	mock.lockDeleteEventsBefore.RUnlock()
+	return calls
+}
+
+// GetLatestSequence calls GetLatestSequenceFunc.
+func (mock *OutboxMock) GetLatestSequence() (int64, *errors.ServiceError) {
+	if mock.GetLatestSequenceFunc == nil {
+		panic("OutboxMock.GetLatestSequenceFunc: method is nil but Outbox.GetLatestSequence was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetLatestSequence.Lock()
+	mock.calls.GetLatestSequence = append(mock.calls.GetLatestSequence, callInfo)
+	mock.lockGetLatestSequence.Unlock()
+	return mock.GetLatestSequenceFunc()
+}
+
+// GetLatestSequenceCalls gets all the calls that were made to GetLatestSequence.
+// Check the length with:
+//
+//	len(mockedOutbox.GetLatestSequenceCalls())
+func (mock *OutboxMock) GetLatestSequenceCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetLatestSequence.RLock()
+	calls = mock.calls.GetLatestSequence
+	mock.lockGetLatestSequence.RUnlock()
+	return calls
+}
+
+// ListEvents calls ListEventsFunc.
+func (mock *OutboxMock) ListEvents(after int64, limit int, eventTypes ...string) (api.OutboxEventList, *errors.ServiceError) {
+	if mock.ListEventsFunc == nil {
+		panic("OutboxMock.ListEventsFunc: method is nil but Outbox.ListEvents was just called")
+	}
+	callInfo := struct {
+		After      int64
+		Limit      int
+		EventTypes []string
+	}{
+		After:      after,
+		Limit:      limit,
+		EventTypes: eventTypes,
+	}
+	mock.lockListEvents.Lock()
+	mock.calls.ListEvents = append(mock.calls.ListEvents, callInfo)
+	mock.lockListEvents.Unlock()
+	return mock.ListEventsFunc(after, limit, eventTypes...)
+}
+
+// ListEventsCalls gets all the calls that were made to ListEvents.
+// Check the length with:
+//
+//	len(mockedOutbox.ListEventsCalls())
+func (mock *OutboxMock) ListEventsCalls() []struct {
+	After      int64
+	Limit      int
+	EventTypes []string
+} {
+	var calls []struct {
+		After      int64
+		Limit      int
+		EventTypes []string
+	}
+	mock.lockListEvents.RLock()
+	calls = mock.calls.ListEvents
+	mock.lockListEvents.RUnlock()
+	return calls
+}
+
+// Publish calls PublishFunc.
+func (mock *OutboxMock) Publish(dbConn *gorm.DB, event *api.OutboxEvent) *errors.ServiceError {
+	if mock.PublishFunc == nil {
+		panic("OutboxMock.PublishFunc: method is nil but Outbox.Publish was just called")
+	}
+	callInfo := struct {
+		DbConn *gorm.DB
+		Event  *api.OutboxEvent
+	}{
+		DbConn: dbConn,
+		Event:  event,
+	}
+	mock.lockPublish.Lock()
+	mock.calls.Publish = append(mock.calls.Publish, callInfo)
+	mock.lockPublish.Unlock()
+	return mock.PublishFunc(dbConn, event)
+}
+
+// PublishCalls gets all the calls that were made to Publish.
+// Check the length with:
+//
+//	len(mockedOutbox.PublishCalls())
+func (mock *OutboxMock) PublishCalls() []struct {
+	DbConn *gorm.DB
+	Event  *api.OutboxEvent
+} {
+	var calls []struct {
+		DbConn *gorm.DB
+		Event  *api.OutboxEvent
+	}
+	mock.lockPublish.RLock()
+	calls = mock.calls.Publish
+	mock.lockPublish.RUnlock()
+	return calls
+}
@@ -0,0 +1,189 @@
+package signalbus
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_outbox_Publish(t *testing.T) {
+	tests := []struct {
+		name         string
+		setupFn      func()
+		wantErr      bool
+		wantNotified bool
+	}{
+		{
+			name: "should record the event and signal its type",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "outbox_events"`).WithReply([]map[string]interface{}{{"sequence": 1}})
+			},
+			wantNotified: true,
+		},
+		{
+			name: "should not signal the event when it cannot be recorded",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "outbox_events"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			var notifyArgs []interface{}
+			mocket.Catcher.NewMock().WithQuery(`SELECT pg_notify`).WithCallback(func(s string, nv []driver.NamedValue) {
+				for _, v := range nv {
+					notifyArgs = append(notifyArgs, v.Value)
+				}
+			})
+			connectionFactory := db.NewMockConnectionFactory(nil)
+			o := NewOutbox(connectionFactory, NewOutboxConfig())
+			event := &api.OutboxEvent{EventType: "kafka.status_changed", ResourceId: "kafka-id"}
+			err := o.Publish(connectionFactory.New(), event)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(event.Payload).To(gomega.Equal(api.JSON(`{}`)))
+			if tt.wantNotified {
+				g.Expect(notifyArgs).To(gomega.Equal([]interface{}{pgSignalBusChannel, "kafka.status_changed"}))
+			} else {
+				g.Expect(notifyArgs).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
+
+func Test_outbox_Consume(t *testing.T) {
+	events := []map[string]interface{}{
+		{"sequence": 4, "transaction_id": 100, "event_type": "kafka.status_changed", "resource_id": "kafka-1", "payload": []byte(`{}`)},
+		{"sequence": 5, "transaction_id": 100, "event_type": "kafka.status_changed", "resource_id": "kafka-2", "payload": []byte(`{}`)},
+		{"sequence": 6, "transaction_id": 101, "event_type": "kafka.status_changed", "resource_id": "kafka-3", "payload": []byte(`{}`)},
+	}
+
+	tests := []struct {
+		name            string
+		cursor          []map[string]interface{}
+		events          []map[string]interface{}
+		failOn          string
+		wantAfter       []interface{}
+		wantHandled     int
+		wantSavedCursor []interface{}
+		wantErr         bool
+	}{
+		{
+			name:            "should handle the events after the cursor of the consumer and move it past them",
+			cursor:          []map[string]interface{}{{"consumer": "test", "transaction_id": 99, "sequence": 3}},
+			events:          events,
+			wantAfter:       []interface{}{int64(99), int64(3)},
+			wantHandled:     3,
+			wantSavedCursor: []interface{}{int64(101), int64(6)},
+		},
+		{
+			name:            "should start from the oldest event when the consumer has no cursor",
+			events:          events,
+			wantAfter:       []interface{}{int64(0), int64(0)},
+			wantHandled:     3,
+			wantSavedCursor: []interface{}{int64(101), int64(6)},
+		},
+		{
+			name:            "should stop at the first event that fails to be handled",
+			cursor:          []map[string]interface{}{{"consumer": "test", "transaction_id": 99, "sequence": 3}},
+			events:          events,
+			failOn:          "kafka-2",
+			wantAfter:       []interface{}{int64(99), int64(3)},
+			wantHandled:     1,
+			wantSavedCursor: []interface{}{int64(100), int64(4)},
+			wantErr:         true,
+		},
+		{
+			name:      "should not move the cursor when no event has been handled",
+			cursor:    []map[string]interface{}{{"consumer": "test", "transaction_id": 101, "sequence": 6}},
+			wantAfter: []interface{}{int64(101), int64(6)},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var listArgs, savedCursor []interface{}
+			mockConsume(tt.cursor, tt.events, &listArgs, &savedCursor)
+
+			o := NewOutbox(db.NewMockConnectionFactory(nil), NewOutboxConfig())
+			handled, err := o.Consume("test", []string{"kafka.status_changed"}, func(event *api.OutboxEvent) error {
+				if event.ResourceId == tt.failOn {
+					return fmt.Errorf("failed to handle event")
+				}
+				return nil
+			})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(handled).To(gomega.Equal(tt.wantHandled))
+			g.Expect(listArgs).To(gomega.Equal(append(tt.wantAfter, "kafka.status_changed")))
+			g.Expect(savedCursor).To(gomega.Equal(tt.wantSavedCursor))
+		})
+	}
+}
+
+func Test_outbox_Consume_TransactionsCommittedOutOfSequenceOrder(t *testing.T) {
+	g := gomega.NewWithT(t)
+	o := NewOutbox(db.NewMockConnectionFactory(nil), NewOutboxConfig())
+	var handledSequences []int64
+	handle := func(event *api.OutboxEvent) error {
+		handledSequences = append(handledSequences, event.Sequence)
+		return nil
+	}
+
+	// transaction 101 has recorded the event of sequence 1 but is still running, the event of sequence 2 recorded by
+	// transaction 100 is the only one listed once transaction 100 is committed
+	var listArgs, savedCursor []interface{}
+	mockConsume(nil, []map[string]interface{}{
+		{"sequence": 2, "transaction_id": 100, "event_type": "kafka.status_changed", "resource_id": "kafka-2", "payload": []byte(`{}`)},
+	}, &listArgs, &savedCursor)
+	handled, err := o.Consume("test", []string{"kafka.status_changed"}, handle)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(handled).To(gomega.Equal(1))
+	g.Expect(savedCursor).To(gomega.Equal([]interface{}{int64(100), int64(2)}))
+
+	// the event of sequence 1 is listed after the cursor once transaction 101 is committed, although its sequence is lower
+	listArgs, savedCursor = nil, nil
+	mockConsume([]map[string]interface{}{{"consumer": "test", "transaction_id": 100, "sequence": 2}}, []map[string]interface{}{
+		{"sequence": 1, "transaction_id": 101, "event_type": "kafka.status_changed", "resource_id": "kafka-1", "payload": []byte(`{}`)},
+	}, &listArgs, &savedCursor)
+	handled, err = o.Consume("test", []string{"kafka.status_changed"}, handle)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(handled).To(gomega.Equal(1))
+	g.Expect(listArgs).To(gomega.Equal([]interface{}{int64(100), int64(2), "kafka.status_changed"}))
+	g.Expect(savedCursor).To(gomega.Equal([]interface{}{int64(101), int64(1)}))
+	g.Expect(handledSequences).To(gomega.Equal([]int64{2, 1}))
+}
+
+// mockConsume mocks the cursor of the "test" consumer and the events listed after it, recording the arguments of the
+// listing and the transaction id and the sequence of the saved cursor
+func mockConsume(cursor []map[string]interface{}, events []map[string]interface{}, listArgs *[]interface{}, savedCursor *[]interface{}) {
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "outbox_cursors" WHERE consumer = $1`).
+		WithArgs("test").
+		WithReply(cursor)
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "outbox_events" WHERE (transaction_id, sequence) > ($1, $2) AND transaction_id < txid_snapshot_xmin(txid_current_snapshot()) AND event_type IN ($3) ORDER BY transaction_id, sequence LIMIT 100`).
+		WithCallback(func(s string, nv []driver.NamedValue) {
+			for _, v := range nv {
+				*listArgs = append(*listArgs, v.Value)
+			}
+		}).
+		WithReply(events)
+	mocket.Catcher.NewMock().
+		WithQuery(`INSERT INTO "outbox_cursors" ("consumer","transaction_id","sequence","updated_at")`).
+		WithCallback(func(s string, nv []driver.NamedValue) {
+			*savedCursor = []interface{}{nv[1].Value, nv[2].Value}
+		})
+	mocket.Catcher.NewMock().WithExecException().WithQueryException()
+}
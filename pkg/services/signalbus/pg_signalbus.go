@@ -14,6 +14,9 @@ import (
 
 var _ SignalBus = &PgSignalBus{} // type check the interface is implemented.
 
+// pgSignalBusChannel is the postgresql notification channel the signals are sent on
+const pgSignalBusChannel = "signalbus"
+
 // PgSignalBus implements a signalbus.SignalBus that is clustered using postgresql notify events.
 type PgSignalBus struct {
 	isRunning         int32
@@ -21,13 +24,17 @@ type PgSignalBus struct {
 	syncGroup         sync.WaitGroup
 	connectionFactory *db.ConnectionFactory
 	signalBus         SignalBus // typically an in memory signal bus.
+	outbox            Outbox
+	outboxConfig      *OutboxConfig
 }
 
 // NewSignalBusService creates a new PgSignalBus
-func NewPgSignalBus(signalBus SignalBus, connectionFactory *db.ConnectionFactory) *PgSignalBus {
+func NewPgSignalBus(signalBus SignalBus, connectionFactory *db.ConnectionFactory, outbox Outbox, outboxConfig *OutboxConfig) *PgSignalBus {
 	return &PgSignalBus{
 		connectionFactory: connectionFactory,
 		signalBus:         signalBus,
+		outbox:            outbox,
+		outboxConfig:      outboxConfig,
 		stopChan:          make(chan struct{}),
 	}
 }
@@ -38,7 +45,7 @@ func (sbw *PgSignalBus) Notify(name string) {
 	// with the pg_notify function.  The DB will send it back to us and all other processes
 	// that are listening for those events.
 	dbc := sbw.connectionFactory.New()
-	if err := dbc.Exec("SELECT pg_notify('signalbus', ?)", name).Error; err != nil {
+	if err := dbc.Exec("SELECT pg_notify(?, ?)", pgSignalBusChannel, name).Error; err != nil {
 		glog.V(1).Info("notify failed:", err.Error())
 	}
 }
@@ -51,12 +58,15 @@ func (sbw *PgSignalBus) Subscribe(name string) *Subscription {
 
 // Start starts the background worker that listens for the
 // events that are sent from this process and all other processes publishing
-// to the signalbus channel.
+// to the signalbus channel, as well as the one signaling the outbox events whose
+// notification was missed.
 func (sbw *PgSignalBus) Start() {
 	// protect against being called twice...
 	if atomic.CompareAndSwapInt32(&sbw.isRunning, 0, 1) {
 		sbw.stopChan = make(chan struct{})
-		sbw.syncGroup.Add(1)
+		sbw.syncGroup.Add(2)
+
+		go sbw.relayOutbox()
 
 		go func() {
 			defer sbw.syncGroup.Done()
@@ -104,7 +114,7 @@ func (sbw *PgSignalBus) run() (exit bool) {
 	defer shared.CloseQuietly(listener) // clean up connections on return..
 
 	// Listen on the "signalbus" channel.
-	err := listener.Listen("signalbus")
+	err := listener.Listen(pgSignalBusChannel)
 	if err != nil {
 		glog.V(1).Info("error listening to events:", err.Error())
 		return false
@@ -150,3 +160,53 @@ func (sbw *PgSignalBus) waitForNotification(l *pq.Listener) (exit bool, err erro
 		}
 	}
 }
+
+// relayOutbox periodically signals the types of the events recorded in the outbox since its last poll.
+// The notifications of those events are lost when they are sent while the listener is reconnecting, this makes sure
+// that their subscribers are woken up anyway instead of waiting for their next periodic run.
+func (sbw *PgSignalBus) relayOutbox() {
+	defer sbw.syncGroup.Done()
+
+	ticker := time.NewTicker(sbw.outboxConfig.PollInterval)
+	defer ticker.Stop()
+
+	// only the events recorded after the start are signaled, -1 until the latest sequence is known
+	sequence := int64(-1)
+	for {
+		select {
+		case <-sbw.stopChan:
+			return
+		case <-ticker.C:
+			if sequence < 0 {
+				latest, err := sbw.outbox.GetLatestSequence()
+				if err != nil {
+					glog.V(1).Info("failed to get the latest outbox event sequence:", err.Error())
+					continue
+				}
+				sequence = latest
+				continue
+			}
+			sequence = sbw.signalOutboxEvents(sequence)
+		}
+	}
+}
+
+// signalOutboxEvents signals the types of the events recorded after the given sequence on the in memory bus
+// and returns the sequence of the last signaled event
+func (sbw *PgSignalBus) signalOutboxEvents(after int64) int64 {
+	events, err := sbw.outbox.ListEvents(after, sbw.outboxConfig.ConsumeBatchSize)
+	if err != nil {
+		glog.V(1).Info("failed to list outbox events:", err.Error())
+		return after
+	}
+
+	signaled := map[string]bool{}
+	for _, event := range events {
+		if !signaled[event.EventType] {
+			sbw.signalBus.Notify(event.EventType)
+			signaled[event.EventType] = true
+		}
+		after = event.Sequence
+	}
+	return after
+}
@@ -0,0 +1,68 @@
+package signalbus
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func TestPgSignalBus_signalOutboxEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		after         int64
+		events        api.OutboxEventList
+		listErr       *errors.ServiceError
+		wantSequence  int64
+		wantSignaledA bool
+		wantSignaledB bool
+	}{
+		{
+			name:  "should signal the types of the events recorded after the sequence",
+			after: 3,
+			events: api.OutboxEventList{
+				{Sequence: 4, EventType: "a"},
+				{Sequence: 5, EventType: "b"},
+				{Sequence: 6, EventType: "a"},
+			},
+			wantSequence:  6,
+			wantSignaledA: true,
+			wantSignaledB: true,
+		},
+		{
+			name:         "should keep the sequence when no event has been recorded",
+			after:        3,
+			wantSequence: 3,
+		},
+		{
+			name:         "should keep the sequence when the events cannot be listed",
+			after:        3,
+			listErr:      errors.GeneralError("db down"),
+			wantSequence: 3,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			bus := NewSignalBus()
+			subA := bus.Subscribe("a")
+			defer subA.Close()
+			subB := bus.Subscribe("b")
+			defer subB.Close()
+
+			outbox := &OutboxMock{
+				ListEventsFunc: func(after int64, limit int, eventTypes ...string) (api.OutboxEventList, *errors.ServiceError) {
+					g.Expect(after).To(gomega.Equal(tt.after))
+					return tt.events, tt.listErr
+				},
+			}
+			sbw := NewPgSignalBus(bus, nil, outbox, NewOutboxConfig())
+			g.Expect(sbw.signalOutboxEvents(tt.after)).To(gomega.Equal(tt.wantSequence))
+			g.Expect(subA.IsSignaled()).To(gomega.Equal(tt.wantSignaledA))
+			g.Expect(subB.IsSignaled()).To(gomega.Equal(tt.wantSignaledB))
+		})
+	}
+}
@@ -7,11 +7,17 @@ import (
 )
 
 func ConfigProviders() di.Option {
-	return di.Provide(environments.Func(ServiceProviders))
+	return di.Options(
+		di.Provide(NewOutboxConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(environments.Func(ServiceProviders)),
+	)
 }
 
 func ServiceProviders() di.Option {
-	return di.Provide(func(dbFactory *db.ConnectionFactory) *PgSignalBus {
-		return NewPgSignalBus(NewSignalBus(), dbFactory)
-	}, di.As(new(SignalBus)), di.As(new(environments.BootService)))
+	return di.Options(
+		di.Provide(NewOutbox),
+		di.Provide(func(dbFactory *db.ConnectionFactory, outbox Outbox, outboxConfig *OutboxConfig) *PgSignalBus {
+			return NewPgSignalBus(NewSignalBus(), dbFactory, outbox, outboxConfig)
+		}, di.As(new(SignalBus)), di.As(new(environments.BootService))),
+	)
 }
@@ -0,0 +1,18 @@
+package webhook
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// OutboxEventConverter converts the outbox events recording the changes of a kind of resource into the webhook events
+// notifying the subscriptions of those changes. The webhook events are emitted from the outbox rather than right after
+// the change is committed, so that none is lost when the process stops in between.
+//
+//go:generate moq -out outbox_event_converter_moq.go . OutboxEventConverter
+type OutboxEventConverter interface {
+	// GetOutboxEventTypes returns the types of the outbox events handled by the converter
+	GetOutboxEventTypes() []string
+	// Convert returns the webhook event notifying the outbox event, or nil when the subscriptions are not notified of it
+	Convert(event *api.OutboxEvent) (*Event, *errors.ServiceError)
+}
@@ -0,0 +1,113 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package webhook
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that OutboxEventConverterMock does implement OutboxEventConverter.
+// If this is not the case, regenerate this file with moq.
+var _ OutboxEventConverter = &OutboxEventConverterMock{}
+
+// OutboxEventConverterMock is a mock implementation of OutboxEventConverter.
+//
+//	func TestSomethingThatUsesOutboxEventConverter(t *testing.T) {
+//
+//		// make and configure a mocked OutboxEventConverter
+//		mockedOutboxEventConverter := &OutboxEventConverterMock{
+//			ConvertFunc: func(event *api.OutboxEvent) (*Event, *errors.ServiceError) {
+//				panic("mock out the Convert method")
+//			},
+//			GetOutboxEventTypesFunc: func() []string {
+//				panic("mock out the GetOutboxEventTypes method")
+//			},
+//		}
+//
+//		// use mockedOutboxEventConverter in code that requires OutboxEventConverter
+//		// and then make assertions.
+//
+//	}
+type OutboxEventConverterMock struct {
+	// ConvertFunc mocks the Convert method.
+	ConvertFunc func(event *api.OutboxEvent) (*Event, *errors.ServiceError)
+
+	// GetOutboxEventTypesFunc mocks the GetOutboxEventTypes method.
+	GetOutboxEventTypesFunc func() []string
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Convert holds details about calls to the Convert method.
+		Convert []struct {
+			// Event is the event argument value.
+			Event *api.OutboxEvent
+		}
+		// GetOutboxEventTypes holds details about calls to the GetOutboxEventTypes method.
+		GetOutboxEventTypes []struct {
+		}
+	}
+	lockConvert             sync.RWMutex
+	lockGetOutboxEventTypes sync.RWMutex
+}
+
+// Convert calls ConvertFunc.
+func (mock *OutboxEventConverterMock) Convert(event *api.OutboxEvent) (*Event, *errors.ServiceError) {
+	if mock.ConvertFunc == nil {
+		panic("OutboxEventConverterMock.ConvertFunc: method is nil but OutboxEventConverter.Convert was just called")
+	}
+	callInfo := struct {
+		Event *api.OutboxEvent
+	}{
+		Event: event,
+	}
+	mock.lockConvert.Lock()
+	mock.calls.Convert = append(mock.calls.Convert, callInfo)
+	mock.lockConvert.Unlock()
+	return mock.ConvertFunc(event)
+}
+
+// ConvertCalls gets all the calls that were made to Convert.
+// Check the length with:
+//
+//	len(mockedOutboxEventConverter.ConvertCalls())
+func (mock *OutboxEventConverterMock) ConvertCalls() []struct {
+	Event *api.OutboxEvent
+} {
+	var calls []struct {
+		Event *api.OutboxEvent
+	}
+	mock.lockConvert.RLock()
+	calls = mock.calls.Convert
+	mock.lockConvert.RUnlock()
+	return calls
+}
+
+// GetOutboxEventTypes calls GetOutboxEventTypesFunc.
+func (mock *OutboxEventConverterMock) GetOutboxEventTypes() []string {
+	if mock.GetOutboxEventTypesFunc == nil {
+		panic("OutboxEventConverterMock.GetOutboxEventTypesFunc: method is nil but OutboxEventConverter.GetOutboxEventTypes was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetOutboxEventTypes.Lock()
+	mock.calls.GetOutboxEventTypes = append(mock.calls.GetOutboxEventTypes, callInfo)
+	mock.lockGetOutboxEventTypes.Unlock()
+	return mock.GetOutboxEventTypesFunc()
+}
+
+// GetOutboxEventTypesCalls gets all the calls that were made to GetOutboxEventTypes.
+// Check the length with:
+//
+//	len(mockedOutboxEventConverter.GetOutboxEventTypesCalls())
+func (mock *OutboxEventConverterMock) GetOutboxEventTypesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetOutboxEventTypes.RLock()
+	calls = mock.calls.GetOutboxEventTypes
+	mock.lockGetOutboxEventTypes.RUnlock()
+	return calls
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type WebhookConfig struct {
+	DeliveryTimeout         time.Duration `json:"webhook_delivery_timeout"`
+	MaxDeliveryAttempts     int           `json:"webhook_max_delivery_attempts"`
+	DeliveryBackoffInterval time.Duration `json:"webhook_delivery_backoff_interval"`
+	MaxDeliveryBackoff      time.Duration `json:"webhook_max_delivery_backoff"`
+	DeliveryBatchSize       int           `json:"webhook_delivery_batch_size"`
+}
+
+func NewWebhookConfig() *WebhookConfig {
+	return &WebhookConfig{
+		DeliveryTimeout:         10 * time.Second,
+		MaxDeliveryAttempts:     10,
+		DeliveryBackoffInterval: 30 * time.Second,
+		MaxDeliveryBackoff:      1 * time.Hour,
+		DeliveryBatchSize:       100,
+	}
+}
+
+func (c *WebhookConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.DeliveryTimeout, "webhook-delivery-timeout", c.DeliveryTimeout, "The timeout of a single delivery of an event to a webhook subscription.")
+	fs.IntVar(&c.MaxDeliveryAttempts, "webhook-max-delivery-attempts", c.MaxDeliveryAttempts, "The number of attempts made to deliver an event to a webhook subscription before giving up.")
+	fs.DurationVar(&c.DeliveryBackoffInterval, "webhook-delivery-backoff-interval", c.DeliveryBackoffInterval, "The time waited before retrying a failed delivery. It doubles after every failed attempt.")
+	fs.DurationVar(&c.MaxDeliveryBackoff, "webhook-max-delivery-backoff", c.MaxDeliveryBackoff, "The maximum time waited before retrying a failed delivery.")
+	fs.IntVar(&c.DeliveryBatchSize, "webhook-delivery-batch-size", c.DeliveryBatchSize, "The maximum number of pending deliveries sent in a single reconcile loop.")
+}
+
+func (c *WebhookConfig) ReadFiles() error {
+	return nil
+}
+
+// GetDeliveryBackoff returns the time to wait before the next attempt of a delivery that failed the given number of attempts
+func (c *WebhookConfig) GetDeliveryBackoff(attempts int) time.Duration {
+	backoff := c.DeliveryBackoffInterval
+	for i := 1; i < attempts && backoff < c.MaxDeliveryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.MaxDeliveryBackoff {
+		return c.MaxDeliveryBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestWebhookConfig_GetDeliveryBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{
+			name:     "should wait for the backoff interval after the first failed attempt",
+			attempts: 1,
+			want:     30 * time.Second,
+		},
+		{
+			name:     "should double the backoff after every failed attempt",
+			attempts: 3,
+			want:     2 * time.Minute,
+		},
+		{
+			name:     "should not wait for more than the maximum backoff",
+			attempts: 20,
+			want:     time.Hour,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(NewWebhookConfig().GetDeliveryBackoff(tt.attempts)).To(gomega.Equal(tt.want))
+		})
+	}
+}
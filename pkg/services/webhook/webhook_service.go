@@ -0,0 +1,286 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/golang/glog"
+)
+
+const (
+	EventTypeKafkaReady       = "kafka.ready"
+	EventTypeKafkaFailed      = "kafka.failed"
+	EventTypeKafkaSuspended   = "kafka.suspended"
+	EventTypeKafkaExpiring    = "kafka.expiring"
+	EventTypeConnectorReady   = "connector.ready"
+	EventTypeConnectorFailed  = "connector.failed"
+	EventTypeConnectorStopped = "connector.stopped"
+
+	// SignatureHeader holds the hex encoded HMAC-SHA256 signature of the payload, computed with the secret of the subscription
+	SignatureHeader = "X-Webhook-Signature"
+	EventTypeHeader = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+var SupportedEventTypes = []string{
+	EventTypeKafkaReady,
+	EventTypeKafkaFailed,
+	EventTypeKafkaSuspended,
+	EventTypeKafkaExpiring,
+	EventTypeConnectorReady,
+	EventTypeConnectorFailed,
+	EventTypeConnectorStopped,
+}
+
+// Event is a lifecycle event of a resource owned by an organisation
+type Event struct {
+	Type           string
+	OrganisationId string
+	ResourceId     string
+	// Data is the resource specific content of the event, sent as the "data" field of the payload
+	Data interface{}
+}
+
+// EventPayload is the body sent to the webhook subscriptions
+type EventPayload struct {
+	Id             string      `json:"id"`
+	Type           string      `json:"type"`
+	Time           time.Time   `json:"time"`
+	OrganisationId string      `json:"organisation_id"`
+	ResourceId     string      `json:"resource_id"`
+	Data           interface{} `json:"data,omitempty"`
+}
+
+//go:generate moq -out webhook_service_moq.go . WebhookService
+type WebhookService interface {
+	// CreateSubscription creates the subscription once the host of its URL has been checked to resolve to public addresses only
+	CreateSubscription(subscription *api.WebhookSubscription) *errors.ServiceError
+	GetSubscription(organisationId string, id string) (*api.WebhookSubscription, *errors.ServiceError)
+	ListSubscriptions(organisationId string) (api.WebhookSubscriptionList, *errors.ServiceError)
+	DeleteSubscription(organisationId string, id string) *errors.ServiceError
+	// Emit records a pending delivery of the event for every subscription of the organisation of the event
+	// that is notified of its type. The deliveries are sent asynchronously.
+	Emit(event Event) *errors.ServiceError
+	// EmitOnce emits the event unless an event of the same type has already been emitted for the resource
+	EmitOnce(event Event) *errors.ServiceError
+	// ListPendingDeliveries returns the pending deliveries whose next attempt is due, oldest first
+	ListPendingDeliveries() (api.WebhookDeliveryList, *errors.ServiceError)
+	// Deliver sends the delivery to its subscription and records the outcome of the attempt.
+	// A failed attempt is retried with an exponential backoff until the maximum number of attempts is reached.
+	Deliver(delivery *api.WebhookDelivery) *errors.ServiceError
+}
+
+var _ WebhookService = &webhookService{}
+
+type webhookService struct {
+	connectionFactory *db.ConnectionFactory
+	webhookConfig     *WebhookConfig
+	httpClient        *http.Client
+	isAllowedIP       func(ip net.IP) bool
+}
+
+func NewWebhookService(connectionFactory *db.ConnectionFactory, webhookConfig *WebhookConfig) WebhookService {
+	return &webhookService{
+		connectionFactory: connectionFactory,
+		webhookConfig:     webhookConfig,
+		httpClient:        newDeliveryHTTPClient(webhookConfig.DeliveryTimeout, isPublicIP),
+		isAllowedIP:       isPublicIP,
+	}
+}
+
+func (w *webhookService) CreateSubscription(subscription *api.WebhookSubscription) *errors.ServiceError {
+	if err := ValidateURL(subscription.Url); err != nil {
+		return errors.FieldValidationError("failed to create webhook subscription. %s", err.Error())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), w.webhookConfig.DeliveryTimeout)
+	defer cancel()
+	if err := validatePublicHost(ctx, subscription.Url, w.isAllowedIP); err != nil {
+		return errors.FieldValidationError("failed to create webhook subscription. %s", err.Error())
+	}
+	if err := w.connectionFactory.New().Create(subscription).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create webhook subscription")
+	}
+	return nil
+}
+
+func (w *webhookService) GetSubscription(organisationId string, id string) (*api.WebhookSubscription, *errors.ServiceError) {
+	var subscription api.WebhookSubscription
+	if err := w.connectionFactory.New().
+		Where("organisation_id = ? AND id = ?", organisationId, id).
+		First(&subscription).Error; err != nil {
+		return nil, services.HandleGetError("Webhook subscription", "id", id, err)
+	}
+	return &subscription, nil
+}
+
+func (w *webhookService) ListSubscriptions(organisationId string) (api.WebhookSubscriptionList, *errors.ServiceError) {
+	var subscriptions api.WebhookSubscriptionList
+	if err := w.connectionFactory.New().
+		Where("organisation_id = ?", organisationId).
+		Order("created_at").
+		Find(&subscriptions).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list webhook subscriptions")
+	}
+	return subscriptions, nil
+}
+
+func (w *webhookService) DeleteSubscription(organisationId string, id string) *errors.ServiceError {
+	subscription, err := w.GetSubscription(organisationId, id)
+	if err != nil {
+		return err
+	}
+	if err := w.connectionFactory.New().Delete(subscription).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete webhook subscription %q", id)
+	}
+	return nil
+}
+
+func (w *webhookService) Emit(event Event) *errors.ServiceError {
+	if event.OrganisationId == "" {
+		// subscriptions are scoped to an organisation
+		return nil
+	}
+
+	subscriptions, err := w.ListSubscriptions(event.OrganisationId)
+	if err != nil {
+		return err
+	}
+
+	payload, marshalErr := json.Marshal(EventPayload{
+		Id:             api.NewID(),
+		Type:           event.Type,
+		Time:           time.Now(),
+		OrganisationId: event.OrganisationId,
+		ResourceId:     event.ResourceId,
+		Data:           event.Data,
+	})
+	if marshalErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, marshalErr, "failed to marshal %q event of resource %q", event.Type, event.ResourceId)
+	}
+
+	var deliveries api.WebhookDeliveryList
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(event.Type) {
+			continue
+		}
+		deliveries = append(deliveries, &api.WebhookDelivery{
+			SubscriptionId: subscription.ID,
+			EventType:      event.Type,
+			ResourceId:     event.ResourceId,
+			Payload:        payload,
+			Status:         api.WebhookDeliveryStatusPending,
+			NextAttemptAt:  time.Now(),
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := w.connectionFactory.New().Create(&deliveries).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record the deliveries of %q event of resource %q", event.Type, event.ResourceId)
+	}
+	return nil
+}
+
+func (w *webhookService) EmitOnce(event Event) *errors.ServiceError {
+	var count int64
+	if err := w.connectionFactory.New().Model(&api.WebhookDelivery{}).
+		Where("event_type = ? AND resource_id = ?", event.Type, event.ResourceId).
+		Count(&count).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to check whether %q event of resource %q was already emitted", event.Type, event.ResourceId)
+	}
+	if count > 0 {
+		return nil
+	}
+	return w.Emit(event)
+}
+
+func (w *webhookService) ListPendingDeliveries() (api.WebhookDeliveryList, *errors.ServiceError) {
+	var deliveries api.WebhookDeliveryList
+	if err := w.connectionFactory.New().
+		Where("status = ? AND next_attempt_at <= ?", api.WebhookDeliveryStatusPending, time.Now()).
+		Order("next_attempt_at").
+		Limit(w.webhookConfig.DeliveryBatchSize).
+		Find(&deliveries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list pending webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+func (w *webhookService) Deliver(delivery *api.WebhookDelivery) *errors.ServiceError {
+	var subscription api.WebhookSubscription
+	err := w.connectionFactory.New().Unscoped().Where("id = ?", delivery.SubscriptionId).First(&subscription).Error
+	if err != nil {
+		return services.HandleGetError("Webhook subscription", "id", delivery.SubscriptionId, err)
+	}
+
+	values := map[string]interface{}{}
+	if subscription.DeletedAt.Valid {
+		values["status"] = api.WebhookDeliveryStatusFailed
+		values["last_error"] = "the webhook subscription has been deleted"
+	} else if sendErr := w.send(&subscription, delivery); sendErr != nil {
+		glog.Warningf("failed to deliver %q event %q to webhook subscription %q: %v", delivery.EventType, delivery.ID, subscription.ID, sendErr)
+		attempts := delivery.Attempts + 1
+		values["attempts"] = attempts
+		values["last_error"] = sendErr.Error()
+		if attempts >= w.webhookConfig.MaxDeliveryAttempts {
+			values["status"] = api.WebhookDeliveryStatusFailed
+		} else {
+			values["next_attempt_at"] = time.Now().Add(w.webhookConfig.GetDeliveryBackoff(attempts))
+		}
+	} else {
+		values["attempts"] = delivery.Attempts + 1
+		values["last_error"] = ""
+		values["status"] = api.WebhookDeliveryStatusDelivered
+	}
+
+	if err := w.connectionFactory.New().Model(delivery).Updates(values).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update webhook delivery %q", delivery.ID)
+	}
+	return nil
+}
+
+func (w *webhookService) send(subscription *api.WebhookSubscription, delivery *api.WebhookDelivery) error {
+	// the subscriptions created before the https URLs were required are not sent to
+	if err := ValidateURL(subscription.Url); err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, subscription.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventTypeHeader, delivery.EventType)
+	request.Header.Set(DeliveryHeader, delivery.ID)
+	request.Header.Set(SignatureHeader, Sign(subscription.Secret, delivery.Payload))
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status code %d", response.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the value of the signature header of the given payload
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
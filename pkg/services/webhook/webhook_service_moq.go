@@ -0,0 +1,389 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package webhook
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that WebhookServiceMock does implement WebhookService.
+// If this is not the case, regenerate this file with moq.
+var _ WebhookService = &WebhookServiceMock{}
+
+// WebhookServiceMock is a mock implementation of WebhookService.
+//
+//	func TestSomethingThatUsesWebhookService(t *testing.T) {
+//
+//		// make and configure a mocked WebhookService
+//		mockedWebhookService := &WebhookServiceMock{
+//			CreateSubscriptionFunc: func(subscription *api.WebhookSubscription) *errors.ServiceError {
+//				panic("mock out the CreateSubscription method")
+//			},
+//			DeleteSubscriptionFunc: func(organisationId string, id string) *errors.ServiceError {
+//				panic("mock out the DeleteSubscription method")
+//			},
+//			DeliverFunc: func(delivery *api.WebhookDelivery) *errors.ServiceError {
+//				panic("mock out the Deliver method")
+//			},
+//			EmitFunc: func(event Event) *errors.ServiceError {
+//				panic("mock out the Emit method")
+//			},
+//			EmitOnceFunc: func(event Event) *errors.ServiceError {
+//				panic("mock out the EmitOnce method")
+//			},
+//			GetSubscriptionFunc: func(organisationId string, id string) (*api.WebhookSubscription, *errors.ServiceError) {
+//				panic("mock out the GetSubscription method")
+//			},
+//			ListPendingDeliveriesFunc: func() (api.WebhookDeliveryList, *errors.ServiceError) {
+//				panic("mock out the ListPendingDeliveries method")
+//			},
+//			ListSubscriptionsFunc: func(organisationId string) (api.WebhookSubscriptionList, *errors.ServiceError) {
+//				panic("mock out the ListSubscriptions method")
+//			},
+//		}
+//
+//		// use mockedWebhookService in code that requires WebhookService
+//		// and then make assertions.
+//
+//	}
+type WebhookServiceMock struct {
+	// CreateSubscriptionFunc mocks the CreateSubscription method.
+	CreateSubscriptionFunc func(subscription *api.WebhookSubscription) *errors.ServiceError
+
+	// DeleteSubscriptionFunc mocks the DeleteSubscription method.
+	DeleteSubscriptionFunc func(organisationId string, id string) *errors.ServiceError
+
+	// DeliverFunc mocks the Deliver method.
+	DeliverFunc func(delivery *api.WebhookDelivery) *errors.ServiceError
+
+	// EmitFunc mocks the Emit method.
+	EmitFunc func(event Event) *errors.ServiceError
+
+	// EmitOnceFunc mocks the EmitOnce method.
+	EmitOnceFunc func(event Event) *errors.ServiceError
+
+	// GetSubscriptionFunc mocks the GetSubscription method.
+	GetSubscriptionFunc func(organisationId string, id string) (*api.WebhookSubscription, *errors.ServiceError)
+
+	// ListPendingDeliveriesFunc mocks the ListPendingDeliveries method.
+	ListPendingDeliveriesFunc func() (api.WebhookDeliveryList, *errors.ServiceError)
+
+	// ListSubscriptionsFunc mocks the ListSubscriptions method.
+	ListSubscriptionsFunc func(organisationId string) (api.WebhookSubscriptionList, *errors.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateSubscription holds details about calls to the CreateSubscription method.
+		CreateSubscription []struct {
+			// Subscription is the subscription argument value.
+			Subscription *api.WebhookSubscription
+		}
+		// DeleteSubscription holds details about calls to the DeleteSubscription method.
+		DeleteSubscription []struct {
+			// OrganisationId is the organisationId argument value.
+			OrganisationId string
+			// ID is the id argument value.
+			ID string
+		}
+		// Deliver holds details about calls to the Deliver method.
+		Deliver []struct {
+			// Delivery is the delivery argument value.
+			Delivery *api.WebhookDelivery
+		}
+		// Emit holds details about calls to the Emit method.
+		Emit []struct {
+			// Event is the event argument value.
+			Event Event
+		}
+		// EmitOnce holds details about calls to the EmitOnce method.
+		EmitOnce []struct {
+			// Event is the event argument value.
+			Event Event
+		}
+		// GetSubscription holds details about calls to the GetSubscription method.
+		GetSubscription []struct {
+			// OrganisationId is the organisationId argument value.
+			OrganisationId string
+			// ID is the id argument value.
+			ID string
+		}
+		// ListPendingDeliveries holds details about calls to the ListPendingDeliveries method.
+		ListPendingDeliveries []struct {
+		}
+		// ListSubscriptions holds details about calls to the ListSubscriptions method.
+		ListSubscriptions []struct {
+			// OrganisationId is the organisationId argument value.
+			OrganisationId string
+		}
+	}
+	lockCreateSubscription    sync.RWMutex
+	lockDeleteSubscription    sync.RWMutex
+	lockDeliver               sync.RWMutex
+	lockEmit                  sync.RWMutex
+	lockEmitOnce              sync.RWMutex
+	lockGetSubscription       sync.RWMutex
+	lockListPendingDeliveries sync.RWMutex
+	lockListSubscriptions     sync.RWMutex
+}
+
+// CreateSubscription calls CreateSubscriptionFunc.
+func (mock *WebhookServiceMock) CreateSubscription(subscription *api.WebhookSubscription) *errors.ServiceError {
+	if mock.CreateSubscriptionFunc == nil {
+		panic("WebhookServiceMock.CreateSubscriptionFunc: method is nil but WebhookService.CreateSubscription was just called")
+	}
+	callInfo := struct {
+		Subscription *api.WebhookSubscription
+	}{
+		Subscription: subscription,
+	}
+	mock.lockCreateSubscription.Lock()
+	mock.calls.CreateSubscription = append(mock.calls.CreateSubscription, callInfo)
+	mock.lockCreateSubscription.Unlock()
+	return mock.CreateSubscriptionFunc(subscription)
+}
+
+// CreateSubscriptionCalls gets all the calls that were made to CreateSubscription.
+// Check the length with:
+//
+//	len(mockedWebhookService.CreateSubscriptionCalls())
+func (mock *WebhookServiceMock) CreateSubscriptionCalls() []struct {
+	Subscription *api.WebhookSubscription
+} {
+	var calls []struct {
+		Subscription *api.WebhookSubscription
+	}
+	mock.lockCreateSubscription.RLock()
+	calls = mock.calls.CreateSubscription
+	mock.lockCreateSubscription.RUnlock()
+	return calls
+}
+
+// DeleteSubscription calls DeleteSubscriptionFunc.
+func (mock *WebhookServiceMock) DeleteSubscription(organisationId string, id string) *errors.ServiceError {
+	if mock.DeleteSubscriptionFunc == nil {
+		panic("WebhookServiceMock.DeleteSubscriptionFunc: method is nil but WebhookService.DeleteSubscription was just called")
+	}
+	callInfo := struct {
+		OrganisationId string
+		ID             string
+	}{
+		OrganisationId: organisationId,
+		ID:             id,
+	}
+	mock.lockDeleteSubscription.Lock()
+	mock.calls.DeleteSubscription = append(mock.calls.DeleteSubscription, callInfo)
+	mock.lockDeleteSubscription.Unlock()
+	return mock.DeleteSubscriptionFunc(organisationId, id)
+}
+
+// DeleteSubscriptionCalls gets all the calls that were made to DeleteSubscription.
+// Check the length with:
+//
+//	len(mockedWebhookService.DeleteSubscriptionCalls())
+func (mock *WebhookServiceMock) DeleteSubscriptionCalls() []struct {
+	OrganisationId string
+	ID             string
+} {
+	var calls []struct {
+		OrganisationId string
+		ID             string
+	}
+	mock.lockDeleteSubscription.RLock()
+	calls = mock.calls.DeleteSubscription
+	mock.lockDeleteSubscription.RUnlock()
+	return calls
+}
+
+// Deliver calls DeliverFunc.
+func (mock *WebhookServiceMock) Deliver(delivery *api.WebhookDelivery) *errors.ServiceError {
+	if mock.DeliverFunc == nil {
+		panic("WebhookServiceMock.DeliverFunc: method is nil but WebhookService.Deliver was just called")
+	}
+	callInfo := struct {
+		Delivery *api.WebhookDelivery
+	}{
+		Delivery: delivery,
+	}
+	mock.lockDeliver.Lock()
+	mock.calls.Deliver = append(mock.calls.Deliver, callInfo)
+	mock.lockDeliver.Unlock()
+	return mock.DeliverFunc(delivery)
+}
+
+// DeliverCalls gets all the calls that were made to Deliver.
+// Check the length with:
+//
+//	len(mockedWebhookService.DeliverCalls())
+func (mock *WebhookServiceMock) DeliverCalls() []struct {
+	Delivery *api.WebhookDelivery
+} {
+	var calls []struct {
+		Delivery *api.WebhookDelivery
+	}
+	mock.lockDeliver.RLock()
+	calls = mock.calls.Deliver
+	mock.lockDeliver.RUnlock()
+	return calls
+}
+
+// Emit calls EmitFunc.
+func (mock *WebhookServiceMock) Emit(event Event) *errors.ServiceError {
+	if mock.EmitFunc == nil {
+		panic("WebhookServiceMock.EmitFunc: method is nil but WebhookService.Emit was just called")
+	}
+	callInfo := struct {
+		Event Event
+	}{
+		Event: event,
+	}
+	mock.lockEmit.Lock()
+	mock.calls.Emit = append(mock.calls.Emit, callInfo)
+	mock.lockEmit.Unlock()
+	return mock.EmitFunc(event)
+}
+
+// EmitCalls gets all the calls that were made to Emit.
+// Check the length with:
+//
+//	len(mockedWebhookService.EmitCalls())
+func (mock *WebhookServiceMock) EmitCalls() []struct {
+	Event Event
+} {
+	var calls []struct {
+		Event Event
+	}
+	mock.lockEmit.RLock()
+	calls = mock.calls.Emit
+	mock.lockEmit.RUnlock()
+	return calls
+}
+
+// EmitOnce calls EmitOnceFunc.
+func (mock *WebhookServiceMock) EmitOnce(event Event) *errors.ServiceError {
+	if mock.EmitOnceFunc == nil {
+		panic("WebhookServiceMock.EmitOnceFunc: method is nil but WebhookService.EmitOnce was just called")
+	}
+	callInfo := struct {
+		Event Event
+	}{
+		Event: event,
+	}
+	mock.lockEmitOnce.Lock()
+	mock.calls.EmitOnce = append(mock.calls.EmitOnce, callInfo)
+	mock.lockEmitOnce.Unlock()
+	return mock.EmitOnceFunc(event)
+}
+
+// EmitOnceCalls gets all the calls that were made to EmitOnce.
+// Check the length with:
+//
+//	len(mockedWebhookService.EmitOnceCalls())
+func (mock *WebhookServiceMock) EmitOnceCalls() []struct {
+	Event Event
+} {
+	var calls []struct {
+		Event Event
+	}
+	mock.lockEmitOnce.RLock()
+	calls = mock.calls.EmitOnce
+	mock.lockEmitOnce.RUnlock()
+	return calls
+}
+
+// GetSubscription calls GetSubscriptionFunc.
+func (mock *WebhookServiceMock) GetSubscription(organisationId string, id string) (*api.WebhookSubscription, *errors.ServiceError) {
+	if mock.GetSubscriptionFunc == nil {
+		panic("WebhookServiceMock.GetSubscriptionFunc: method is nil but WebhookService.GetSubscription was just called")
+	}
+	callInfo := struct {
+		OrganisationId string
+		ID             string
+	}{
+		OrganisationId: organisationId,
+		ID:             id,
+	}
+	mock.lockGetSubscription.Lock()
+	mock.calls.GetSubscription = append(mock.calls.GetSubscription, callInfo)
+	mock.lockGetSubscription.Unlock()
+	return mock.GetSubscriptionFunc(organisationId, id)
+}
+
+// GetSubscriptionCalls gets all the calls that were made to GetSubscription.
+// Check the length with:
+//
+//	len(mockedWebhookService.GetSubscriptionCalls())
+func (mock *WebhookServiceMock) GetSubscriptionCalls() []struct {
+	OrganisationId string
+	ID             string
+} {
+	var calls []struct {
+		OrganisationId string
+		ID             string
+	}
+	mock.lockGetSubscription.RLock()
+	calls = mock.calls.GetSubscription
+	mock.lockGetSubscription.RUnlock()
+	return calls
+}
+
+// ListPendingDeliveries calls ListPendingDeliveriesFunc.
+func (mock *WebhookServiceMock) ListPendingDeliveries() (api.WebhookDeliveryList, *errors.ServiceError) {
+	if mock.ListPendingDeliveriesFunc == nil {
+		panic("WebhookServiceMock.ListPendingDeliveriesFunc: method is nil but WebhookService.ListPendingDeliveries was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListPendingDeliveries.Lock()
+	mock.calls.ListPendingDeliveries = append(mock.calls.ListPendingDeliveries, callInfo)
+	mock.lockListPendingDeliveries.Unlock()
+	return mock.ListPendingDeliveriesFunc()
+}
+
+// ListPendingDeliveriesCalls gets all the calls that were made to ListPendingDeliveries.
+// Check the length with:
+//
+//	len(mockedWebhookService.ListPendingDeliveriesCalls())
+func (mock *WebhookServiceMock) ListPendingDeliveriesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListPendingDeliveries.RLock()
+	calls = mock.calls.ListPendingDeliveries
+	mock.lockListPendingDeliveries.RUnlock()
+	return calls
+}
+
+// ListSubscriptions calls ListSubscriptionsFunc.
+func (mock *WebhookServiceMock) ListSubscriptions(organisationId string) (api.WebhookSubscriptionList, *errors.ServiceError) {
+	if mock.ListSubscriptionsFunc == nil {
+		panic("WebhookServiceMock.ListSubscriptionsFunc: method is nil but WebhookService.ListSubscriptions was just called")
+	}
+	callInfo := struct {
+		OrganisationId string
+	}{
+		OrganisationId: organisationId,
+	}
+	mock.lockListSubscriptions.Lock()
+	mock.calls.ListSubscriptions = append(mock.calls.ListSubscriptions, callInfo)
+	mock.lockListSubscriptions.Unlock()
+	return mock.ListSubscriptionsFunc(organisationId)
+}
+
+// ListSubscriptionsCalls gets all the calls that were made to ListSubscriptions.
+// Check the length with:
+//
+//	len(mockedWebhookService.ListSubscriptionsCalls())
+func (mock *WebhookServiceMock) ListSubscriptionsCalls() []struct {
+	OrganisationId string
+} {
+	var calls []struct {
+		OrganisationId string
+	}
+	mock.lockListSubscriptions.RLock()
+	calls = mock.calls.ListSubscriptions
+	mock.lockListSubscriptions.RUnlock()
+	return calls
+}
@@ -0,0 +1,315 @@
+package webhook
+
+import (
+	"database/sql/driver"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_webhookService_Emit(t *testing.T) {
+	tests := []struct {
+		name           string
+		event          Event
+		setupFn        func()
+		wantErr        bool
+		wantDeliveries bool
+	}{
+		{
+			name:    "should not record deliveries for events without organisation",
+			event:   Event{Type: EventTypeKafkaReady, ResourceId: "kafka-id"},
+			setupFn: func() { mocket.Catcher.Reset() },
+		},
+		{
+			name:  "should return an error when the subscriptions cannot be listed",
+			event: Event{Type: EventTypeKafkaReady, OrganisationId: "org-id", ResourceId: "kafka-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "webhook_subscriptions"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+		{
+			name:  "should not record deliveries when no subscription is notified of the event type",
+			event: Event{Type: EventTypeKafkaReady, OrganisationId: "org-id", ResourceId: "kafka-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "webhook_subscriptions" WHERE (organisation_id = $1)`).
+					WithArgs("org-id").
+					WithReply([]map[string]interface{}{{"id": "subscription-id", "event_types": []byte(`["kafka.failed"]`)}})
+			},
+		},
+		{
+			name:  "should record a delivery for the subscriptions notified of the event type",
+			event: Event{Type: EventTypeKafkaReady, OrganisationId: "org-id", ResourceId: "kafka-id"},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "webhook_subscriptions" WHERE (organisation_id = $1)`).
+					WithArgs("org-id").
+					WithReply([]map[string]interface{}{{"id": "subscription-id", "event_types": []byte(`["kafka.ready","kafka.failed"]`)}})
+			},
+			wantDeliveries: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			var inserted bool
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "webhook_deliveries"`).WithCallback(func(s string, nv []driver.NamedValue) {
+				inserted = true
+			})
+			s := NewWebhookService(db.NewMockConnectionFactory(nil), NewWebhookConfig())
+			err := s.Emit(tt.event)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(inserted).To(gomega.Equal(tt.wantDeliveries))
+		})
+	}
+}
+
+func Test_webhookService_EmitOnce(t *testing.T) {
+	tests := []struct {
+		name             string
+		emittedCount     int
+		wantListedEvents bool
+	}{
+		{
+			name:             "should emit the event when it has not been emitted for the resource yet",
+			emittedCount:     0,
+			wantListedEvents: true,
+		},
+		{
+			name:             "should not emit the event again",
+			emittedCount:     1,
+			wantListedEvents: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var listed bool
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT count(1) FROM "webhook_deliveries" WHERE (event_type = $1 AND resource_id = $2)`).
+				WithArgs(EventTypeKafkaExpiring, "kafka-id").
+				WithReply([]map[string]interface{}{{"count": tt.emittedCount}})
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT * FROM "webhook_subscriptions"`).
+				WithCallback(func(s string, nv []driver.NamedValue) { listed = true })
+			s := NewWebhookService(db.NewMockConnectionFactory(nil), NewWebhookConfig())
+			err := s.EmitOnce(Event{Type: EventTypeKafkaExpiring, OrganisationId: "org-id", ResourceId: "kafka-id"})
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(listed).To(gomega.Equal(tt.wantListedEvents))
+		})
+	}
+}
+
+func Test_webhookService_CreateSubscription(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name: "should create a subscription whose url resolves to a public address",
+			url:  "https://93.184.216.34/hooks",
+		},
+		{
+			name:        "should not create a subscription with an http url",
+			url:         "http://93.184.216.34/hooks",
+			wantErrCode: errors.ErrorFieldValidationError,
+		},
+		{
+			name:        "should not create a subscription whose url resolves to a loopback address",
+			url:         "https://127.0.0.1/hooks",
+			wantErrCode: errors.ErrorFieldValidationError,
+		},
+		{
+			name:        "should not create a subscription whose url resolves to a link-local address",
+			url:         "https://169.254.169.254/latest/meta-data",
+			wantErrCode: errors.ErrorFieldValidationError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "webhook_subscriptions"`)
+			s := NewWebhookService(db.NewMockConnectionFactory(nil), NewWebhookConfig())
+			err := s.CreateSubscription(&api.WebhookSubscription{OrganisationId: "org-id", Url: tt.url, Secret: "a-very-secret-key"})
+			g.Expect(insert.Triggered).To(gomega.Equal(tt.wantErrCode == 0))
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+		})
+	}
+}
+
+func Test_webhookService_Deliver(t *testing.T) {
+	payload := []byte(`{"type":"kafka.ready"}`)
+	secret := "a-very-secret-key"
+
+	tests := []struct {
+		name       string
+		statusCode int
+		attempts   int
+		deleted    bool
+		// allowLoopback lets the deliveries be sent to the test server listening on a loopback address
+		allowLoopback    bool
+		redirect         bool
+		wantStatus       string
+		wantAttempts     int
+		wantRescheduled  bool
+		wantRequestCount int
+	}{
+		{
+			name:             "should mark the delivery as delivered when the webhook accepts it",
+			statusCode:       http.StatusNoContent,
+			allowLoopback:    true,
+			wantStatus:       api.WebhookDeliveryStatusDelivered.String(),
+			wantAttempts:     1,
+			wantRequestCount: 1,
+		},
+		{
+			name:             "should reschedule the delivery when the webhook rejects it",
+			statusCode:       http.StatusServiceUnavailable,
+			allowLoopback:    true,
+			attempts:         2,
+			wantAttempts:     3,
+			wantRescheduled:  true,
+			wantRequestCount: 1,
+		},
+		{
+			name:             "should fail the delivery once the maximum number of attempts is reached",
+			statusCode:       http.StatusServiceUnavailable,
+			allowLoopback:    true,
+			attempts:         9,
+			wantStatus:       api.WebhookDeliveryStatusFailed.String(),
+			wantAttempts:     10,
+			wantRequestCount: 1,
+		},
+		{
+			name:             "should fail the delivery without sending it when the subscription has been deleted",
+			deleted:          true,
+			allowLoopback:    true,
+			wantStatus:       api.WebhookDeliveryStatusFailed.String(),
+			wantRequestCount: 0,
+		},
+		{
+			name:             "should not connect to a non public address",
+			statusCode:       http.StatusNoContent,
+			wantAttempts:     1,
+			wantRescheduled:  true,
+			wantRequestCount: 0,
+		},
+		{
+			name:             "should not follow the redirects",
+			statusCode:       http.StatusNoContent,
+			allowLoopback:    true,
+			redirect:         true,
+			wantAttempts:     1,
+			wantRescheduled:  true,
+			wantRequestCount: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			requestCount := 0
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				if tt.redirect {
+					http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+					return
+				}
+				body, _ := io.ReadAll(r.Body)
+				g.Expect(body).To(gomega.Equal(payload))
+				g.Expect(r.Header.Get(SignatureHeader)).To(gomega.Equal(Sign(secret, payload)))
+				g.Expect(r.Header.Get(EventTypeHeader)).To(gomega.Equal(EventTypeKafkaReady))
+				g.Expect(r.Header.Get(DeliveryHeader)).To(gomega.Equal("delivery-id"))
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			subscription := map[string]interface{}{"id": "subscription-id", "url": server.URL, "secret": secret}
+			if tt.deleted {
+				subscription["deleted_at"] = time.Now()
+			}
+			var updateArgs []interface{}
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT * FROM "webhook_subscriptions" WHERE id = $1`).
+				WithArgs("subscription-id").
+				WithReply([]map[string]interface{}{subscription})
+			mocket.Catcher.NewMock().
+				WithQuery(`UPDATE "webhook_deliveries" SET`).
+				WithCallback(func(s string, nv []driver.NamedValue) {
+					for _, v := range nv {
+						updateArgs = append(updateArgs, v.Value)
+					}
+				})
+
+			s := NewWebhookService(db.NewMockConnectionFactory(nil), NewWebhookConfig()).(*webhookService)
+			if tt.allowLoopback {
+				s.httpClient = newDeliveryHTTPClient(time.Second, func(ip net.IP) bool { return ip.IsLoopback() })
+			}
+			// the test server certificate is trusted
+			s.httpClient.Transport.(*http.Transport).TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig
+			err := s.Deliver(&api.WebhookDelivery{
+				Meta:           api.Meta{ID: "delivery-id"},
+				SubscriptionId: "subscription-id",
+				EventType:      EventTypeKafkaReady,
+				Payload:        payload,
+				Status:         api.WebhookDeliveryStatusPending,
+				Attempts:       tt.attempts,
+			})
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(requestCount).To(gomega.Equal(tt.wantRequestCount))
+
+			var status string
+			var attempts int
+			var rescheduled bool
+			for _, arg := range updateArgs {
+				switch v := arg.(type) {
+				case string:
+					if v == api.WebhookDeliveryStatusDelivered.String() || v == api.WebhookDeliveryStatusFailed.String() {
+						status = v
+					}
+				case int64:
+					attempts = int(v)
+				case time.Time:
+					rescheduled = rescheduled || v.After(time.Now().Add(time.Second))
+				}
+			}
+			g.Expect(status).To(gomega.Equal(tt.wantStatus))
+			g.Expect(attempts).To(gomega.Equal(tt.wantAttempts))
+			g.Expect(rescheduled).To(gomega.Equal(tt.wantRescheduled))
+		})
+	}
+}
+
+func Test_Sign(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(Sign("secret", []byte(`{"type":"kafka.ready"}`))).To(gomega.HavePrefix("sha256="))
+	g.Expect(Sign("secret", []byte("payload"))).To(gomega.Equal(Sign("secret", []byte("payload"))))
+	g.Expect(Sign("secret", []byte("payload"))).NotTo(gomega.Equal(Sign("another-secret", []byte("payload"))))
+}
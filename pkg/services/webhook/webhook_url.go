@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, also used for the internal addresses of some cloud providers
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// ValidateURL checks that the URL of a webhook subscription is an absolute https URL
+func ValidateURL(rawUrl string) error {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil || parsedUrl.Scheme != "https" || parsedUrl.Hostname() == "" {
+		return fmt.Errorf("url: %q should be an absolute https URL", rawUrl)
+	}
+	return nil
+}
+
+// validatePublicHost checks that all the addresses the host of the URL resolves to are public addresses, so that the
+// webhooks can not be used to reach the loopback, private, link-local or in-cluster addresses
+func validatePublicHost(ctx context.Context, rawUrl string, isAllowedIP func(ip net.IP) bool) error {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, parsedUrl.Hostname())
+	if err != nil {
+		return fmt.Errorf("host of url %q can not be resolved", rawUrl)
+	}
+	for _, address := range addresses {
+		if !isAllowedIP(address.IP) {
+			return fmt.Errorf("host of url %q resolves to the non public address %s", rawUrl, address.IP)
+		}
+	}
+	return nil
+}
+
+// isPublicIP returns whether the address is neither a loopback, private, link-local, multicast, unspecified nor shared address
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified() && !sharedAddressSpace.Contains(ip)
+}
+
+// newDeliveryHTTPClient returns the client sending the deliveries. The address of every connection is checked once resolved,
+// so that a host resolving to a different address after the subscription was created is not reached, and the redirects
+// are not followed. No proxy is used as it would connect to the webhook on behalf of the client.
+func newDeliveryHTTPClient(timeout time.Duration, isAllowedIP func(ip net.IP) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isAllowedIP(ip) {
+				return fmt.Errorf("connection to the non public address %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: timeout,
+		},
+		// the redirect response is returned as is, and the delivery fails as its status code is not a 2xx one
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
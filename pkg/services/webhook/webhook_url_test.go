@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_ValidateURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{
+			name: "should accept an absolute https url",
+			url:  "https://example.com/hooks",
+		},
+		{
+			name:    "should reject an http url",
+			url:     "http://example.com/hooks",
+			wantErr: true,
+		},
+		{
+			name:    "should reject a url without host",
+			url:     "https:///hooks",
+			wantErr: true,
+		},
+		{
+			name:    "should reject a relative url",
+			url:     "/hooks",
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(ValidateURL(tt.url) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_validatePublicHost(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{
+			name: "should accept a public address",
+			url:  "https://93.184.216.34/hooks",
+		},
+		{
+			name:    "should reject a loopback address",
+			url:     "https://127.0.0.1:8443/hooks",
+			wantErr: true,
+		},
+		{
+			name:    "should reject an IPv6 loopback address",
+			url:     "https://[::1]/hooks",
+			wantErr: true,
+		},
+		{
+			name:    "should reject a link-local address",
+			url:     "https://169.254.169.254/latest/meta-data",
+			wantErr: true,
+		},
+		{
+			name:    "should reject a private address",
+			url:     "https://10.0.0.12/hooks",
+			wantErr: true,
+		},
+		{
+			name:    "should reject a shared address",
+			url:     "https://100.64.0.1/hooks",
+			wantErr: true,
+		},
+		{
+			name:    "should reject an unspecified address",
+			url:     "https://0.0.0.0/hooks",
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(validatePublicHost(context.Background(), tt.url, isPublicIP) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_isPublicIP(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(isPublicIP(net.ParseIP("93.184.216.34"))).To(gomega.BeTrue())
+	g.Expect(isPublicIP(net.ParseIP("2606:2800:220:1:248:1893:25c8:1946"))).To(gomega.BeTrue())
+	g.Expect(isPublicIP(net.ParseIP("192.168.1.1"))).To(gomega.BeFalse())
+	g.Expect(isPublicIP(net.ParseIP("172.30.0.1"))).To(gomega.BeFalse())
+	g.Expect(isPublicIP(net.ParseIP("fd00::1"))).To(gomega.BeFalse())
+	g.Expect(isPublicIP(net.ParseIP("fe80::1"))).To(gomega.BeFalse())
+}
@@ -26,3 +26,11 @@ func WriteStreamJSONResponseWithContentType(w http.ResponseWriter, code int, pay
 		//_, _ = w.Write(response)
 	}
 }
+
+// WriteTextResponse writes a HTTP response of the given HTTP status code with the given body and content type
+func WriteTextResponse(w http.ResponseWriter, code int, body string, contentType string) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Vary", "Authorization")
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(body))
+}
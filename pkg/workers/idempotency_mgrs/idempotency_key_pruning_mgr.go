@@ -0,0 +1,48 @@
+package idempotency_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// IdempotencyKeyPruningManager represents a manager that periodically deletes the idempotency keys whose TTL has passed.
+type IdempotencyKeyPruningManager struct {
+	workers.BaseWorker
+	idempotencyKeyService idempotency.IdempotencyKeyService
+}
+
+// NewIdempotencyKeyPruningManager creates a new manager to delete the expired idempotency keys.
+func NewIdempotencyKeyPruningManager(idempotencyKeyService idempotency.IdempotencyKeyService, reconciler workers.Reconciler) *IdempotencyKeyPruningManager {
+	return &IdempotencyKeyPruningManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "idempotency_key_pruning",
+			Reconciler: reconciler,
+		},
+		idempotencyKeyService: idempotencyKeyService,
+	}
+}
+
+// Start initializes the manager to delete the expired idempotency keys.
+func (m *IdempotencyKeyPruningManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for deleting the expired idempotency keys to stop.
+func (m *IdempotencyKeyPruningManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *IdempotencyKeyPruningManager) Reconcile() []error {
+	glog.Infoln("pruning idempotency keys")
+	var encounteredErrors []error
+
+	if err := m.idempotencyKeyService.DeleteExpired(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to delete expired idempotency keys"))
+	}
+
+	return encounteredErrors
+}
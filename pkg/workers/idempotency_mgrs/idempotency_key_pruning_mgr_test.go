@@ -0,0 +1,42 @@
+package idempotency_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/idempotency"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestIdempotencyKeyPruningManager_Reconcile(t *testing.T) {
+	tests := []struct {
+		name         string
+		deleteErr    *errors.ServiceError
+		wantErrCount int
+	}{
+		{
+			name: "should delete the expired idempotency keys",
+		},
+		{
+			name:         "should return an error when the idempotency keys cannot be deleted",
+			deleteErr:    errors.GeneralError("db down"),
+			wantErrCount: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			idempotencyKeyService := &idempotency.IdempotencyKeyServiceMock{
+				DeleteExpiredFunc: func() *errors.ServiceError {
+					return tt.deleteErr
+				},
+			}
+			m := NewIdempotencyKeyPruningManager(idempotencyKeyService, workers.Reconciler{})
+			g.Expect(m.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(idempotencyKeyService.DeleteExpiredCalls()).To(gomega.HaveLen(1))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package outbox_mgrs
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// OutboxPruningManager represents a manager that periodically deletes the outbox events older than the outbox retention period.
+type OutboxPruningManager struct {
+	workers.BaseWorker
+	outbox       signalbus.Outbox
+	outboxConfig *signalbus.OutboxConfig
+}
+
+// NewOutboxPruningManager creates a new manager to delete the expired outbox events.
+func NewOutboxPruningManager(outbox signalbus.Outbox, outboxConfig *signalbus.OutboxConfig, reconciler workers.Reconciler) *OutboxPruningManager {
+	return &OutboxPruningManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "outbox_pruning",
+			Reconciler: reconciler,
+		},
+		outbox:       outbox,
+		outboxConfig: outboxConfig,
+	}
+}
+
+// Start initializes the manager to delete the expired outbox events.
+func (m *OutboxPruningManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for deleting the expired outbox events to stop.
+func (m *OutboxPruningManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *OutboxPruningManager) Reconcile() []error {
+	glog.Infoln("pruning outbox events")
+	var encounteredErrors []error
+
+	if err := m.outbox.DeleteEventsBefore(time.Now().Add(-m.outboxConfig.RetentionPeriod)); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to delete expired outbox events"))
+	}
+
+	return encounteredErrors
+}
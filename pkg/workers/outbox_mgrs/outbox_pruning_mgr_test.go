@@ -0,0 +1,45 @@
+package outbox_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestOutboxPruningManager_Reconcile(t *testing.T) {
+	tests := []struct {
+		name         string
+		deleteErr    *errors.ServiceError
+		wantErrCount int
+	}{
+		{
+			name: "should delete the events older than the retention period",
+		},
+		{
+			name:         "should return an error when the events cannot be deleted",
+			deleteErr:    errors.GeneralError("db down"),
+			wantErrCount: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			outboxConfig := signalbus.NewOutboxConfig()
+			outbox := &signalbus.OutboxMock{
+				DeleteEventsBeforeFunc: func(before time.Time) *errors.ServiceError {
+					g.Expect(before).To(gomega.BeTemporally("~", time.Now().Add(-outboxConfig.RetentionPeriod), time.Minute))
+					return tt.deleteErr
+				},
+			}
+			m := NewOutboxPruningManager(outbox, outboxConfig, workers.Reconciler{})
+			g.Expect(m.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(outbox.DeleteEventsBeforeCalls()).To(gomega.HaveLen(1))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package ratelimit_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/ratelimit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// RateLimitPruningManager represents a manager that periodically deletes the rate limit counters of the past windows
+// and the expired concurrency leases.
+type RateLimitPruningManager struct {
+	workers.BaseWorker
+	rateLimitService ratelimit.RateLimitService
+}
+
+// NewRateLimitPruningManager creates a new manager to delete the expired rate limit counters and leases.
+func NewRateLimitPruningManager(rateLimitService ratelimit.RateLimitService, reconciler workers.Reconciler) *RateLimitPruningManager {
+	return &RateLimitPruningManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "rate_limit_pruning",
+			Reconciler: reconciler,
+		},
+		rateLimitService: rateLimitService,
+	}
+}
+
+// Start initializes the manager to delete the expired rate limit counters and leases.
+func (m *RateLimitPruningManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for deleting the expired rate limit counters and leases to stop.
+func (m *RateLimitPruningManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *RateLimitPruningManager) Reconcile() []error {
+	glog.Infoln("pruning rate limit counters and leases")
+	var encounteredErrors []error
+
+	if err := m.rateLimitService.DeleteExpired(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to delete expired rate limit counters and leases"))
+	}
+
+	return encounteredErrors
+}
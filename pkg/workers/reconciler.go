@@ -48,6 +48,12 @@ func (r *Reconciler) Start(worker Worker) {
 	sub := r.SignalBus.Subscribe("reconcile:" + worker.GetWorkerType())
 	ticker := time.NewTicker(r.ReconcilerConfig.ReconcilerRepeatInterval)
 
+	if eventDrivenWorker, ok := worker.(EventDrivenWorker); ok {
+		for _, eventType := range eventDrivenWorker.GetEventTypes() {
+			r.wakeupOnSignal(r.SignalBus.Subscribe(eventType), *worker.GetStopChan())
+		}
+	}
+
 	go func() {
 		defer sub.Close()
 		//starts reconcile immediately and then on every repeat interval
@@ -77,6 +83,21 @@ func (r *Reconciler) Start(worker Worker) {
 	}()
 }
 
+// wakeupOnSignal wakes the reconciler up every time the subscription is signaled, until the stop channel is closed
+func (r *Reconciler) wakeupOnSignal(sub *signalbus.Subscription, stopChan chan struct{}) {
+	go func() {
+		defer sub.Close()
+		for {
+			select {
+			case <-sub.Signal():
+				r.Wakeup(false)
+			case <-stopChan:
+				return
+			}
+		}
+	}()
+}
+
 func (r *Reconciler) runReconcile(worker Worker) {
 	start := time.Now()
 	errors := worker.Reconcile()
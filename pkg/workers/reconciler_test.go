@@ -79,3 +79,73 @@ func TestReconciler_Wakeup(t *testing.T) {
 	// We can use a 0 timeout here because Wakeup will wait for the reconcile to occur first.
 	g.Expect(waitForReconcile(0)).Should(gomega.Equal(false))
 }
+
+type eventDrivenWorkerMock struct {
+	*WorkerMock
+	eventTypes []string
+}
+
+func (w *eventDrivenWorkerMock) GetEventTypes() []string {
+	return w.eventTypes
+}
+
+func TestReconciler_EventDrivenWorker(t *testing.T) {
+	g := gomega.NewWithT(t)
+	bus := signalbus.NewSignalBus()
+	r := Reconciler{
+		SignalBus:        bus,
+		ReconcilerConfig: NewReconcilerConfig(),
+	}
+	var stopchan chan struct{}
+	var wg sync.WaitGroup
+
+	reconcileChan := make(chan time.Time, 1000)
+	worker := &eventDrivenWorkerMock{
+		WorkerMock: &WorkerMock{
+			GetStopChanFunc: func() *chan struct{} {
+				return &stopchan
+			},
+			GetSyncGroupFunc: func() *sync.WaitGroup {
+				return &wg
+			},
+			SetIsRunningFunc: func(val bool) {
+			},
+			GetIDFunc: func() string {
+				return "test"
+			},
+			GetWorkerTypeFunc: func() string {
+				return "test"
+			},
+			ReconcileFunc: func() []error {
+				reconcileChan <- time.Now()
+				return nil
+			},
+		},
+		eventTypes: []string{"test.changed"},
+	}
+
+	waitForReconcile := func(d time.Duration) (timeout bool) {
+		ctx, cancel := context.WithTimeout(context.Background(), d)
+		defer cancel()
+		select {
+		case <-reconcileChan:
+		case <-ctx.Done():
+			timeout = true
+		}
+		return
+	}
+
+	r.Start(worker)
+	defer r.Stop(worker)
+
+	// initial reconcile
+	g.Expect(waitForReconcile(1 * time.Second)).Should(gomega.Equal(false))
+
+	// signals of other event types do not wake the worker up
+	bus.Notify("other.changed")
+	g.Expect(waitForReconcile(2 * time.Second)).Should(gomega.Equal(true))
+
+	// signals of the event types of the worker wake it up
+	bus.Notify("test.changed")
+	g.Expect(waitForReconcile(1 * time.Second)).Should(gomega.Equal(false))
+}
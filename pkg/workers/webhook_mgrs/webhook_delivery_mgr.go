@@ -0,0 +1,137 @@
+package webhook_mgrs
+
+import (
+	"sort"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/goava/di"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// outboxConsumer is the consumer of the outbox whose cursor is the last outbox event emitted as a webhook event
+const outboxConsumer = "webhook_delivery"
+
+// WebhookDeliveryManager represents a manager that emits the webhook events of the outbox events and periodically sends
+// the pending webhook deliveries to their subscriptions.
+type WebhookDeliveryManager struct {
+	workers.BaseWorker
+	webhookService webhook.WebhookService
+	outbox         signalbus.Outbox
+	converters     map[string]webhook.OutboxEventConverter
+}
+
+type WebhookDeliveryManagerOptions struct {
+	di.Inject
+	WebhookService        webhook.WebhookService
+	Outbox                signalbus.Outbox
+	Reconciler            workers.Reconciler
+	OutboxEventConverters []webhook.OutboxEventConverter `di:"optional"`
+}
+
+// NewWebhookDeliveryManager creates a new manager to emit the webhook events and send the pending webhook deliveries.
+func NewWebhookDeliveryManager(o WebhookDeliveryManagerOptions) *WebhookDeliveryManager {
+	converters := map[string]webhook.OutboxEventConverter{}
+	for _, converter := range o.OutboxEventConverters {
+		for _, eventType := range converter.GetOutboxEventTypes() {
+			converters[eventType] = converter
+		}
+	}
+	return &WebhookDeliveryManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "webhook_delivery",
+			Reconciler: o.Reconciler,
+		},
+		webhookService: o.WebhookService,
+		outbox:         o.Outbox,
+		converters:     converters,
+	}
+}
+
+// Start initializes the manager to send the pending webhook deliveries.
+func (m *WebhookDeliveryManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for sending the pending webhook deliveries to stop.
+func (m *WebhookDeliveryManager) Stop() {
+	m.StopWorker(m)
+}
+
+// GetEventTypes returns the outbox events converted into webhook events, so that they are emitted and sent
+// right away rather than on the next repeat interval
+func (m *WebhookDeliveryManager) GetEventTypes() []string {
+	eventTypes := make([]string, 0, len(m.converters))
+	for eventType := range m.converters {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+	return eventTypes
+}
+
+func (m *WebhookDeliveryManager) Reconcile() []error {
+	glog.Infoln("reconciling webhook deliveries")
+	var encounteredErrors []error
+
+	// the webhook events are emitted first so that their deliveries are sent by this reconcile
+	if err := m.emitOutboxEvents(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to emit the webhook events of the outbox events"))
+	}
+
+	deliveries, err := m.webhookService.ListPendingDeliveries()
+	if err != nil {
+		return append(encounteredErrors, errors.Wrap(err, "failed to list pending webhook deliveries"))
+	}
+	glog.Infof("pending webhook deliveries count = %d", len(deliveries))
+
+	// failed attempts are rescheduled by the service, only failures to record the outcome of an attempt are reported
+	for _, delivery := range deliveries {
+		if err := m.webhookService.Deliver(delivery); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to deliver webhook delivery %q", delivery.ID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+// emitOutboxEvents emits the webhook events of the outbox events recorded after the cursor of the manager.
+// The cursor is stored along with the events, so the events recorded while the manager was not running are emitted
+// once it is started again. An event that fails to be emitted stops the pass and is emitted again by the next reconcile.
+func (m *WebhookDeliveryManager) emitOutboxEvents() error {
+	eventTypes := m.GetEventTypes()
+	if len(eventTypes) == 0 {
+		return nil
+	}
+	for {
+		handled, err := m.outbox.Consume(outboxConsumer, eventTypes, m.emitOutboxEvent)
+		if err != nil {
+			return err
+		}
+		if handled == 0 {
+			return nil
+		}
+	}
+}
+
+func (m *WebhookDeliveryManager) emitOutboxEvent(outboxEvent *api.OutboxEvent) error {
+	converter, ok := m.converters[outboxEvent.EventType]
+	if !ok {
+		return nil
+	}
+	event, err := converter.Convert(outboxEvent)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return nil
+	}
+	if err := m.webhookService.Emit(*event); err != nil {
+		return err
+	}
+	return nil
+}
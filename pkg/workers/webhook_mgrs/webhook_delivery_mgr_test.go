@@ -0,0 +1,179 @@
+package webhook_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhook"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestWebhookDeliveryManager_Reconcile(t *testing.T) {
+	tests := []struct {
+		name             string
+		webhookService   *webhook.WebhookServiceMock
+		wantErrCount     int
+		wantDeliverCalls int
+	}{
+		{
+			name: "should return an error when the pending deliveries cannot be listed",
+			webhookService: &webhook.WebhookServiceMock{
+				ListPendingDeliveriesFunc: func() (api.WebhookDeliveryList, *errors.ServiceError) {
+					return nil, errors.GeneralError("db down")
+				},
+			},
+			wantErrCount: 1,
+		},
+		{
+			name: "should send every pending delivery",
+			webhookService: &webhook.WebhookServiceMock{
+				ListPendingDeliveriesFunc: func() (api.WebhookDeliveryList, *errors.ServiceError) {
+					return api.WebhookDeliveryList{{Meta: api.Meta{ID: "delivery-1"}}, {Meta: api.Meta{ID: "delivery-2"}}}, nil
+				},
+				DeliverFunc: func(delivery *api.WebhookDelivery) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantDeliverCalls: 2,
+		},
+		{
+			name: "should keep sending the pending deliveries when the outcome of one cannot be recorded",
+			webhookService: &webhook.WebhookServiceMock{
+				ListPendingDeliveriesFunc: func() (api.WebhookDeliveryList, *errors.ServiceError) {
+					return api.WebhookDeliveryList{{Meta: api.Meta{ID: "delivery-1"}}, {Meta: api.Meta{ID: "delivery-2"}}}, nil
+				},
+				DeliverFunc: func(delivery *api.WebhookDelivery) *errors.ServiceError {
+					if delivery.ID == "delivery-1" {
+						return errors.GeneralError("db down")
+					}
+					return nil
+				},
+			},
+			wantErrCount:     1,
+			wantDeliverCalls: 2,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			m := NewWebhookDeliveryManager(WebhookDeliveryManagerOptions{WebhookService: tt.webhookService, Reconciler: workers.Reconciler{}})
+			g.Expect(m.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(tt.webhookService.DeliverCalls()).To(gomega.HaveLen(tt.wantDeliverCalls))
+		})
+	}
+}
+
+func TestWebhookDeliveryManager_emitOutboxEvents(t *testing.T) {
+	outboxEvents := api.OutboxEventList{
+		{Sequence: 1, EventType: "kafka.status_changed", ResourceId: "kafka-1"},
+		{Sequence: 2, EventType: "kafka.status_changed", ResourceId: "kafka-2"},
+		{Sequence: 3, EventType: "kafka.status_changed", ResourceId: "kafka-3"},
+	}
+	converter := &webhook.OutboxEventConverterMock{
+		GetOutboxEventTypesFunc: func() []string {
+			return []string{"kafka.status_changed"}
+		},
+		ConvertFunc: func(event *api.OutboxEvent) (*webhook.Event, *errors.ServiceError) {
+			switch event.ResourceId {
+			case "kafka-2":
+				// the subscriptions are not notified of this change
+				return nil, nil
+			case "kafka-3":
+				return nil, errors.GeneralError("invalid payload")
+			}
+			return &webhook.Event{Type: webhook.EventTypeKafkaReady, ResourceId: event.ResourceId}, nil
+		},
+	}
+	// consume hands the events after the cursor to handle and moves the cursor past the handled ones, as the outbox does
+	consume := func(cursor *int) func(consumer string, eventTypes []string, handle func(event *api.OutboxEvent) error) (int, *errors.ServiceError) {
+		return func(consumer string, eventTypes []string, handle func(event *api.OutboxEvent) error) (int, *errors.ServiceError) {
+			handled := 0
+			for _, event := range outboxEvents[*cursor:] {
+				if err := handle(event); err != nil {
+					return handled, errors.NewWithCause(errors.ErrorGeneral, err, "failed to handle outbox event %d", event.Sequence)
+				}
+				handled++
+				*cursor++
+			}
+			return handled, nil
+		}
+	}
+
+	tests := []struct {
+		name           string
+		converters     []webhook.OutboxEventConverter
+		emitErr        *errors.ServiceError
+		consumeErr     *errors.ServiceError
+		wantErr        bool
+		wantEmitted    []string
+		wantCursor     int
+		wantConsumeNil bool
+	}{
+		{
+			name:           "should not consume the outbox when no outbox event is converted into webhook events",
+			wantConsumeNil: true,
+		},
+		{
+			name:        "should emit the converted events and stop at the first event that fails to be converted",
+			converters:  []webhook.OutboxEventConverter{converter},
+			wantErr:     true,
+			wantEmitted: []string{"kafka-1"},
+			wantCursor:  2,
+		},
+		{
+			name:        "should not move past an event that fails to be emitted",
+			converters:  []webhook.OutboxEventConverter{converter},
+			emitErr:     errors.GeneralError("db down"),
+			wantErr:     true,
+			wantEmitted: []string{"kafka-1"},
+			wantCursor:  0,
+		},
+		{
+			name:       "should return an error when the outbox cannot be consumed",
+			converters: []webhook.OutboxEventConverter{converter},
+			consumeErr: errors.GeneralError("db down"),
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			cursor := 0
+			var emitted []string
+			outbox := &signalbus.OutboxMock{
+				ConsumeFunc: func(consumer string, eventTypes []string, handle func(event *api.OutboxEvent) error) (int, *errors.ServiceError) {
+					g.Expect(consumer).To(gomega.Equal(outboxConsumer))
+					g.Expect(eventTypes).To(gomega.Equal([]string{"kafka.status_changed"}))
+					if tt.consumeErr != nil {
+						return 0, tt.consumeErr
+					}
+					return consume(&cursor)(consumer, eventTypes, handle)
+				},
+			}
+			webhookService := &webhook.WebhookServiceMock{
+				EmitFunc: func(event webhook.Event) *errors.ServiceError {
+					emitted = append(emitted, event.ResourceId)
+					return tt.emitErr
+				},
+			}
+			m := NewWebhookDeliveryManager(WebhookDeliveryManagerOptions{
+				WebhookService:        webhookService,
+				Outbox:                outbox,
+				Reconciler:            workers.Reconciler{},
+				OutboxEventConverters: tt.converters,
+			})
+			err := m.emitOutboxEvents()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(emitted).To(gomega.Equal(tt.wantEmitted))
+			g.Expect(cursor).To(gomega.Equal(tt.wantCursor))
+			g.Expect(outbox.ConsumeCalls() == nil).To(gomega.Equal(tt.wantConsumeNil))
+		})
+	}
+}
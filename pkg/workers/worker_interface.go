@@ -20,6 +20,12 @@ type Worker interface {
 	HasTerminated() bool
 }
 
+// EventDrivenWorker is implemented by the workers that are also reconciled every time an outbox event of
+// one of the returned types is published, rather than only on their repeat interval.
+type EventDrivenWorker interface {
+	GetEventTypes() []string
+}
+
 type BaseWorker struct {
 	Id           string
 	WorkerType   string